@@ -156,6 +156,7 @@ func (s *AnteTestSuite) getMsgCreateRollapp(from string, tokenless bool, metadat
 		MinSequencerBond: rollapptypes.DefaultMinSequencerBondGlobalCoin,
 		Alias:            strings.ToLower(rand.Str(7)),
 		VmType:           rollapptypes.Rollapp_EVM,
+		DaScheme:         rollapptypes.DASchemeMock,
 		GenesisInfo:      genesisInfo,
 		Metadata:         metadata,
 	}
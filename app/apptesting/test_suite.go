@@ -1,6 +1,7 @@
 package apptesting
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -59,8 +60,9 @@ func (s *KeeperTestHelper) CreateRollappByName(name string) {
 		InitialSequencer: "*",
 		MinSequencerBond: rollapptypes.DefaultMinSequencerBondGlobalCoin,
 
-		Alias:  strings.ToLower(rand.Str(7)),
-		VmType: rollapptypes.Rollapp_EVM,
+		Alias:    strings.ToLower(rand.Str(7)),
+		VmType:   rollapptypes.Rollapp_EVM,
+		DaScheme: rollapptypes.DASchemeMock,
 		GenesisInfo: &rollapptypes.GenesisInfo{
 			Bech32Prefix:    strings.ToLower(rand.Str(3)),
 			GenesisChecksum: "1234567890abcdefg",
@@ -142,7 +144,7 @@ func (s *KeeperTestHelper) PostStateUpdateWithOptions(ctx sdk.Context, rollappId
 		RollappId:       rollappId,
 		StartHeight:     startHeight,
 		NumBlocks:       numOfBlocks,
-		DAPath:          "",
+		DAPath:          fmt.Sprintf("%s|%d", rollapptypes.DASchemeMock, startHeight),
 		BDs:             bds,
 		RollappRevision: revision,
 		Last:            false,
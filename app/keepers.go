@@ -362,6 +362,8 @@ func (a *AppKeepers) InitKeepers(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		nil,
 	)
+	// no fraud proof verifier is set: fraud challenges stay disabled until a verifier for the rollapp execution
+	// is available, see SetFraudProofVerifier
 
 	a.GAMMKeeper.SetRollapp(a.RollappKeeper)
 
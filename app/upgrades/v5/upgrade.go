@@ -40,6 +40,7 @@ import (
 	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 	rollappmoduletypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	sequencerkeeper "github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	sponsorshipkeeper "github.com/dymensionxyz/dymension/v3/x/sponsorship/keeper"
 	sponsorshiptypes "github.com/dymensionxyz/dymension/v3/x/sponsorship/types"
 	streamermoduletypes "github.com/dymensionxyz/dymension/v3/x/streamer/types"
@@ -243,8 +244,16 @@ func updateIROParams(ctx sdk.Context, k *irokeeper.Keeper) {
 	params.MinLiquidityPart = defParams.MinLiquidityPart                                     // default: at least 40% goes to the liquidity pool
 	params.MinVestingDuration = defParams.MinVestingDuration                                 // default: min 7 days
 	params.MinVestingStartTimeAfterSettlement = defParams.MinVestingStartTimeAfterSettlement // default: no enforced minimum by default
+	params.PlanFailureDeadline = defParams.PlanFailureDeadline                               // default: fail 90 days after the pre-launch time
 
 	k.SetParams(ctx, params)
+
+	// the plans which can fail are indexed by their failure deadline
+	for _, plan := range k.GetAllPlans(ctx, false) {
+		if err := k.UpdateFailureDeadline(ctx, plan); err != nil {
+			panic(fmt.Errorf("update failure deadline: planId: %d: %w", plan.Id, err))
+		}
+	}
 }
 
 func updateGovParams(ctx sdk.Context, k *govkeeper.Keeper) {
@@ -380,6 +389,8 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 		eibcParams.EpochIdentifier,
 		eibcParams.TimeoutFee,
 		eibcParams.ErrackFee,
+		eibcmoduletypes.DefaultMatcherMaxOrders,
+		eibcmoduletypes.DefaultMatcherGasBudget,
 	))
 
 	// DymNS module
@@ -434,6 +445,16 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 		rollappParams.LivenessSlashInterval,
 		rollappParams.AppRegistrationFee,
 		rollappParams.MinSequencerBondGlobal,
+		rollappParams.DisputePeriodInBlocks,
+		rollappParams.DisputePeriodInBlocks,
+		rollappmoduletypes.DefaultLivenessWarningThresholds,
+		rollappmoduletypes.DefaultFraudChallengeBond,
+		rollappmoduletypes.DefaultFraudChallengeStepBlocks,
+		rollappmoduletypes.DefaultStateRetentionCount,
+		rollappmoduletypes.DefaultStateRetentionPeriod,
+		rollappmoduletypes.DefaultStatePruningBudget,
+		rollappmoduletypes.DefaultOwnershipTransferTimelock,
+		rollappmoduletypes.DefaultMinDRSDeprecationNotice,
 	))
 
 	// Streamer module
@@ -477,13 +498,19 @@ func updateRollappParams(ctx sdk.Context, k *rollappkeeper.Keeper) {
 	// 1. params
 	params := k.GetParams(ctx)
 	params.DisputePeriodInBlocks = fastBlocksParamDisputePeriod
+	params = params.WithDisputePeriodBounds(fastBlocksParamDisputePeriod, fastBlocksParamDisputePeriod)
 	params.LivenessSlashBlocks = fastBlocksParamLivenessSlashBlocks
 	params.LivenessSlashInterval = fastBlocksParamLivenessSlashInterval
 	k.SetParams(ctx, params)
 
 	// 2. other state
-	// (other migration for dispute not needed because finalization is computed based on stored creation height)
+	// the queues are finalized at the height computed from the dispute period when they are created
+	if err := k.MigrateFinalizationHeights(ctx); err != nil {
+		panic(fmt.Errorf("migrate finalization heights: %w", err))
+	}
 	migrateLivenessEvents(ctx, k)
+	// the DA scheme is required, the rollapps mostly post their batches to celestia
+	k.MigrateDASchemes(ctx, rollappmoduletypes.DASchemeCelestia)
 }
 
 func migrateLivenessEvents(ctx sdk.Context, k *rollappkeeper.Keeper) {
@@ -505,6 +532,10 @@ func updateSequencerParams(ctx sdk.Context, k *sequencerkeeper.Keeper) {
 	params.SetPenaltyLiveness(newPenaltyLiveness)
 	params.SetPenaltyKickThreshold(NewPenaltyKickThreshold)
 	params.SetPenaltyReductionStateUpdate(newPenaltyReductionStateUpdate)
+	params.FinalStateUpdateTimeout = sequencertypes.DefaultFinalStateUpdateTimeout
+	params.DishonorHalfLife = sequencertypes.DefaultDishonorHalfLife
+	params.CommissionMaxChangeRate = sequencertypes.DefaultCommissionMaxChangeRate
+	params.CommissionChangePeriod = sequencertypes.DefaultCommissionChangePeriod
 	k.SetParams(ctx, params)
 }
 
@@ -513,8 +544,10 @@ func migrateSequencers(ctx sdk.Context, k *sequencerkeeper.Keeper) {
 	for _, s := range sequencers {
 		if NewPenaltyKickThreshold < s.GetPenalty() {
 			s.SetPenalty(NewPenaltyKickThreshold)
-			k.SetSequencer(ctx, s)
 		}
+		// the dishonor starts decaying from the upgrade
+		s.DishonorUpdatedAt = ctx.BlockTime()
+		k.SetSequencer(ctx, s)
 	}
 }
 
@@ -87,6 +87,7 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 				s.setDymNSParams()
 				s.populateSequencers(s.Ctx, s.App.SequencerKeeper)
 				s.populateLivenessEvents(s.Ctx, s.App.RollappKeeper)
+				s.populateRollapps(s.Ctx, s.App.RollappKeeper)
 				s.populateIBCChannels()
 				return nil
 			},
@@ -137,6 +138,8 @@ func (s *UpgradeTestSuite) TestUpgrade() {
 
 				s.validateSequencersMigration(s.Ctx, s.App.SequencerKeeper)
 
+				s.validateRollappsMigration(s.Ctx, s.App.RollappKeeper)
+
 				s.validateIBCRateLimits()
 
 				// validate consensus params
@@ -280,6 +283,21 @@ func (s *UpgradeTestSuite) validateLivenessEventsMigration(ctx sdk.Context, k *r
 	return nil
 }
 
+// one rollapp posted its last state update to avail, the other did not post any
+func (s *UpgradeTestSuite) populateRollapps(ctx sdk.Context, k *rollappkeeper.Keeper) {
+	k.SetRollapp(ctx, rollapptypes.Rollapp{RollappId: "rollappa_1-1"})
+	k.SetRollapp(ctx, rollapptypes.Rollapp{RollappId: "rollappb_2-1"})
+
+	idx := rollapptypes.StateInfoIndex{RollappId: "rollappa_1-1", Index: 1}
+	k.SetStateInfo(ctx, rollapptypes.StateInfo{StateInfoIndex: idx, DAPath: "avail|1|0x01|0x02"})
+	k.SetLatestStateInfoIndex(ctx, idx)
+}
+
+func (s *UpgradeTestSuite) validateRollappsMigration(ctx sdk.Context, k *rollappkeeper.Keeper) {
+	s.Require().Equal(rollapptypes.DASchemeAvail, k.MustGetRollapp(ctx, "rollappa_1-1").DaScheme)
+	s.Require().Equal(rollapptypes.DASchemeCelestia, k.MustGetRollapp(ctx, "rollappb_2-1").DaScheme)
+}
+
 func (s *UpgradeTestSuite) populateSequencers(ctx sdk.Context, k *sequencerkeeper.Keeper) {
 	k.SetSequencer(ctx, sequencertypes.Sequencer{
 		Address:  "dym19pas0pqwje540u5ptwnffjxeamdxc9tajmdrfa",
@@ -181,6 +181,72 @@ func (s *GenesisBridgeSuite) TestIRO() {
 	s.Require().Equal(plan.SettledDenom, expectedIBCdenom)
 }
 
+// TestIROFailed tests a failed IRO plan does not block the genesis bridge
+// We expect the plan to stay unsettled, and the rollapp tokens to be released to the owner
+func (s *GenesisBridgeSuite) TestIROFailed() {
+	// fund the rollapp owner account for iro creation fee
+	iroFee := sdk.NewCoin(appparams.BaseDenom, s.hubApp().IROKeeper.GetParams(s.hubCtx()).CreationFee)
+	apptesting.FundAccount(s.hubApp(), s.hubCtx(), s.hubChain().SenderAccount.GetAddress(), sdk.NewCoins(iroFee))
+
+	amt := math.NewIntFromUint64(1_000_000).MulRaw(1e18)
+
+	// Add the iro module to the genesis accounts
+	gAddr := s.hubApp().IROKeeper.GetModuleAccountAddress()
+	gAccounts := []rollapptypes.GenesisAccount{
+		{
+			Address: gAddr,
+			Amount:  amt,
+		},
+	}
+	s.addGenesisAccounts(gAccounts)
+
+	// create IRO plan, and fail it
+	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	planId, err := s.hubApp().IROKeeper.CreatePlan(s.hubCtx(), "adym", amt, 0, time.Time{}, true, rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams(), irotypes.DefaultParams().MinLiquidityPart, time.Hour, 0)
+	s.Require().NoError(err)
+	err = s.hubApp().IROKeeper.FailPlan(s.hubCtx(), planId)
+	s.Require().NoError(err)
+
+	// register the sequencer
+	s.registerSequencer()
+
+	// create the expected genesis bridge packet
+	rollapp = s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	packet := s.genesisBridgePacket(rollapp.GenesisInfo)
+
+	// send the packet on the rollapp chain
+	seq, err := s.path.EndpointB.SendPacket(packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data)
+	s.Require().NoError(err)
+	packet.Sequence = seq
+
+	// submit rollapp's state update
+	s.updateRollappState(uint64(s.rollappChain().App.LastBlockHeight())) //nolint:gosec
+
+	_, err = s.path.EndpointA.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	// assert the ack succeeded
+	ack, found := s.hubApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().True(found)
+	s.Require().Equal(successAck, ack)
+
+	// assert the transfers are enabled
+	rollapp = s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	s.Require().True(rollapp.GenesisState.IsTransferEnabled())
+
+	// the iro plan should stay failed and unsettled
+	plan, found := s.hubApp().IROKeeper.GetPlanByRollapp(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Require().True(plan.Failed)
+	s.Require().False(plan.IsSettled())
+
+	// the rollapp tokens are released to the owner
+	expectedIBCdenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, rollapp.GenesisInfo.NativeDenom.Base)).IBCDenom()
+	owner := sdk.MustAccAddressFromBech32(rollapp.Owner)
+	s.Require().True(s.hubApp().BankKeeper.GetBalance(s.hubCtx(), owner, expectedIBCdenom).Amount.Equal(amt))
+	s.Require().True(s.hubApp().BankKeeper.GetBalance(s.hubCtx(), s.hubApp().IROKeeper.AK.GetModuleAddress(irotypes.ModuleName), expectedIBCdenom).IsZero())
+}
+
 // TestInvalidGenesisInfo tests an invalid genesis info
 func (s *GenesisBridgeSuite) TestInvalidGenesisInfo() {
 	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
@@ -60,7 +60,7 @@ func (s *lightClientSuite) TestSetCanonicalClient_ParamsMismatch() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		startHeight,
 		2,
 		2, // revision
@@ -101,7 +101,7 @@ func (s *lightClientSuite) TestSetCanonicalClient_ConsStateMismatch() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		startHeight,
 		2,
 		2, // revision
@@ -145,7 +145,7 @@ func (s *lightClientSuite) TestSetCanonicalClient_FailsIncompatibleState() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		startHeight,
 		2,
 		2, // revision
@@ -184,7 +184,7 @@ func (s *lightClientSuite) TestSetCanonicalClient_Succeeds() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		startHeight,
 		2,
 		2, // revision
@@ -234,7 +234,7 @@ func (s *lightClientSuite) TestSetCanonicalClient_MultipleClients_Succeeds() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		startHeight,
 		2,
 		2, // revision
@@ -272,7 +272,7 @@ func (s *lightClientSuite) TestSetCanonicalClient_FailsWithExistingConnections()
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		startHeight,
 		2,
 		2, // revision
@@ -348,7 +348,7 @@ func (s *lightClientSuite) TestMsgUpdateClient_StateUpdateExists_Compatible() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		bds.BD[0].Height,
 		uint64(len(bds.BD)),
 		2, // revision
@@ -406,7 +406,7 @@ func (s *lightClientSuite) TestMsgUpdateClient_StateUpdateExists_NotCompatible()
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		bds.BD[0].Height, uint64(len(bds.BD)), 2, &bds,
 	)
 	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
@@ -467,7 +467,7 @@ func (s *lightClientSuite) TestAfterUpdateState_OptimisticUpdateExists_Compatibl
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		bds.BD[0].Height, uint64(len(bds.BD)), 2, &bds,
 	)
 	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
@@ -528,7 +528,7 @@ func (s *lightClientSuite) TestAfterUpdateState_OptimisticUpdateExists_NotCompat
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		bds.BD[0].Height, uint64(len(bds.BD)), 2, &bds,
 	)
 	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
@@ -649,7 +649,7 @@ func (s *lightClientSuite) TestAfterUpdateState_Rollback() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		bds.BD[0].Height,
 		uint64(len(bds.BD)),
 		3,
@@ -701,7 +701,7 @@ func (s *lightClientSuite) TestAfterUpdateState_AutoUpdateIBCClient() {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		bds.BD[0].Height, uint64(len(bds.BD)), 2, &bds,
 	)
 	_, err := s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
@@ -144,6 +144,7 @@ func (s *ibcTestingSuite) createRollapp(transfersEnabled bool, channelID *string
 			InitialSupply: math.NewInt(1_000_000_000).MulRaw(1e18),
 		},
 	)
+	msgCreateRollapp.DaScheme = rollapptypes.DASchemeMock
 
 	apptesting.FundForAliasRegistration(
 		s.hubApp(), s.hubCtx(), msgCreateRollapp.Alias, msgCreateRollapp.Creator,
@@ -230,7 +231,7 @@ func (s *ibcTestingSuite) updateRollappState(endHeight uint64) {
 	msgUpdateState := rollapptypes.NewMsgUpdateState(
 		s.hubChain().SenderAccount.GetAddress().String(),
 		rollappChainID(),
-		"mock-da-path",
+		"mock|1",
 		startHeight,
 		endHeight-startHeight+1, // numBlocks
 		revision,
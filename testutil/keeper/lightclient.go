@@ -225,6 +225,10 @@ func (m *MockRollappKeeper) GetStateInfo(ctx sdk.Context, rollappId string, inde
 	return rollapptypes.StateInfo{}, false
 }
 
+func (m *MockRollappKeeper) FirstRetainedStateIndex(ctx sdk.Context, rollappID string) uint64 {
+	return 1
+}
+
 func (m *MockRollappKeeper) SetRollapp(ctx sdk.Context, rollapp rollapptypes.Rollapp) {
 }
 
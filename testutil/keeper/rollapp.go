@@ -1,15 +1,38 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 func RollappKeeper(t *testing.T) (*keeper.Keeper, sdk.Context) {
 	app := apptesting.Setup(t)
 	return app.RollappKeeper, app.NewContext(false)
 }
+
+// MockFraudProofVerifier is a deterministic verifier for tests. The only valid state root of a block
+// is MockStateRoot of the previous state root and the height, and the proof is ignored.
+type MockFraudProofVerifier struct{}
+
+func (MockFraudProofVerifier) VerifyBlock(_ sdk.Context, _ string, preStateRoot []byte, bd types.BlockDescriptor, _ []byte) error {
+	if !bytes.Equal(bd.StateRoot, MockStateRoot(preStateRoot, bd.Height)) {
+		return errorsmod.Wrapf(types.ErrInvalidFraudProof, "state root mismatch: height: %d", bd.Height)
+	}
+	return nil
+}
+
+// MockStateRoot is the state root accepted by MockFraudProofVerifier
+func MockStateRoot(preStateRoot []byte, height uint64) []byte {
+	h := sha256.New()
+	h.Write(preStateRoot)
+	h.Write(sdk.Uint64ToBigEndian(height))
+	return h.Sum(nil)
+}
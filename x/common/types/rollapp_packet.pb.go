@@ -67,6 +67,9 @@ type RollappPacket struct {
 	// who was the original person who gets the money (recipient of ics20
 	// transfer) of the packet?
 	OriginalTransferTarget string `protobuf:"bytes,9,opt,name=original_transfer_target,json=originalTransferTarget,proto3" json:"original_transfer_target,omitempty"`
+	// true if the recipient cancelled the eIBC demand order of the packet, so
+	// the packet is finalized by plain delayed ack
+	EibcOrderCancelled bool `protobuf:"varint,10,opt,name=eibc_order_cancelled,json=eibcOrderCancelled,proto3" json:"eibc_order_cancelled,omitempty"`
 }
 
 func (m *RollappPacket) Reset()         { *m = RollappPacket{} }
@@ -165,6 +168,13 @@ func (m *RollappPacket) GetOriginalTransferTarget() string {
 	return ""
 }
 
+func (m *RollappPacket) GetEibcOrderCancelled() bool {
+	if m != nil {
+		return m.EibcOrderCancelled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.common.RollappPacket_Type", RollappPacket_Type_name, RollappPacket_Type_value)
 	proto.RegisterType((*RollappPacket)(nil), "dymensionxyz.dymension.common.RollappPacket")
@@ -175,36 +185,38 @@ func init() {
 }
 
 var fileDescriptor_febc8c0e69213bc2 = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
+	// 485 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xb3, 0x6d, 0x9a, 0x90, 0x09, 0x94, 0x68, 0x85, 0xaa, 0x55, 0x51, 0x2d, 0x53, 0x09,
-	0xc9, 0xe2, 0xb0, 0x56, 0x92, 0x0b, 0x17, 0x0e, 0xd0, 0x1a, 0x88, 0x50, 0x9d, 0xca, 0xb8, 0x1c,
-	0xb8, 0x58, 0x1b, 0x67, 0xeb, 0x58, 0x75, 0x76, 0xad, 0xf5, 0x36, 0xd4, 0x3c, 0x05, 0x8f, 0xc5,
-	0xb1, 0x47, 0x8e, 0x28, 0xb9, 0xf0, 0x16, 0xa0, 0xac, 0xe3, 0xaa, 0x20, 0xd1, 0xfa, 0xe4, 0x99,
-	0xf9, 0xff, 0x99, 0x6f, 0x47, 0x03, 0x83, 0x69, 0x39, 0xe7, 0xa2, 0x48, 0xa5, 0xb8, 0x2a, 0xbf,
-	0xba, 0x37, 0x81, 0x1b, 0xcb, 0xf9, 0x5c, 0x0a, 0x57, 0xc9, 0x2c, 0x63, 0x79, 0x1e, 0xe5, 0x2c,
-	0xbe, 0xe0, 0x9a, 0xe6, 0x4a, 0x6a, 0x89, 0x0f, 0x6e, 0x7b, 0xe8, 0x4d, 0x40, 0x2b, 0xcf, 0xfe,
-	0xb3, 0x74, 0x12, 0xbb, 0xb1, 0x54, 0xdc, 0x8d, 0x67, 0x4c, 0x08, 0x9e, 0xb9, 0x8b, 0x7e, 0xfd,
-	0x5b, 0x75, 0xd8, 0x7f, 0x71, 0xf7, 0xd4, 0x42, 0x33, 0x7d, 0x59, 0x54, 0xda, 0xc3, 0x5f, 0xdb,
-	0xf0, 0x28, 0xa8, 0x30, 0x4e, 0x0d, 0x05, 0x3e, 0x00, 0xa8, 0xb9, 0xd2, 0x29, 0x41, 0x36, 0x72,
-	0x3a, 0x41, 0x67, 0x93, 0x19, 0x4d, 0xf1, 0x10, 0x5a, 0x15, 0x2e, 0xd9, 0xb2, 0x91, 0xd3, 0x1d,
-	0x3c, 0xa5, 0xe9, 0x24, 0xa6, 0x6b, 0x20, 0x5a, 0x53, 0x2c, 0xfa, 0xb4, 0xea, 0x15, 0x6c, 0xa4,
-	0xd8, 0x81, 0xc7, 0x2c, 0xbe, 0x10, 0xf2, 0x4b, 0xc6, 0xa7, 0x09, 0x9f, 0x73, 0xa1, 0xc9, 0xb6,
-	0x8d, 0x9c, 0x87, 0xc1, 0xbf, 0x69, 0xfc, 0x0a, 0x5a, 0x15, 0x1f, 0x69, 0xda, 0xc8, 0xd9, 0x1d,
-	0x3c, 0xa7, 0x77, 0xae, 0x83, 0x7e, 0x34, 0xe2, 0x60, 0x63, 0xc2, 0x36, 0x74, 0x4f, 0x95, 0x94,
-	0xe7, 0xef, 0x79, 0x9a, 0xcc, 0x34, 0xd9, 0xb1, 0x91, 0xd3, 0x0c, 0x6e, 0xa7, 0x30, 0x81, 0xb6,
-	0xe2, 0x19, 0x2b, 0xb9, 0x22, 0x2d, 0x83, 0x50, 0x87, 0xd8, 0x83, 0xa6, 0x2e, 0x73, 0x4e, 0xda,
-	0x66, 0x70, 0xff, 0x9e, 0xc1, 0x7f, 0x2d, 0x8d, 0x86, 0x65, 0xce, 0x03, 0x63, 0xc7, 0x4f, 0x60,
-	0x87, 0x2b, 0x25, 0x15, 0x79, 0x60, 0x56, 0x57, 0x05, 0xf8, 0x25, 0x10, 0xa9, 0xd2, 0x24, 0x15,
-	0x2c, 0x8b, 0xb4, 0x62, 0xa2, 0x38, 0xe7, 0x2a, 0xd2, 0x4c, 0x25, 0x5c, 0x93, 0x8e, 0x11, 0xee,
-	0xd5, 0xf5, 0x70, 0x53, 0x0e, 0x4d, 0xf5, 0xf0, 0x1d, 0x34, 0xd7, 0xdd, 0x71, 0x17, 0xda, 0x63,
-	0x3f, 0x0a, 0xbc, 0xa3, 0x4f, 0xbd, 0x06, 0x06, 0x68, 0x8d, 0xfd, 0xe8, 0xf5, 0xd1, 0x87, 0x1e,
-	0xc2, 0xbb, 0x00, 0x63, 0x3f, 0x0a, 0x47, 0x27, 0xde, 0xf8, 0x2c, 0xec, 0x6d, 0xe1, 0x3d, 0xe8,
-	0x9c, 0xf9, 0xc7, 0xde, 0xdb, 0x91, 0xef, 0x1d, 0xf7, 0x7e, 0xd7, 0x1f, 0x7a, 0x73, 0xf2, 0x7d,
-	0x69, 0xa1, 0xeb, 0xa5, 0x85, 0x7e, 0x2e, 0x2d, 0xf4, 0x6d, 0x65, 0x35, 0xae, 0x57, 0x56, 0xe3,
-	0xc7, 0xca, 0x6a, 0x7c, 0x1e, 0x26, 0xa9, 0x9e, 0x5d, 0x4e, 0xd6, 0x4f, 0x73, 0xff, 0x73, 0x3b,
-	0x8b, 0xa1, 0x7b, 0x55, 0x1f, 0xd0, 0xfa, 0x99, 0xc5, 0xa4, 0x65, 0x0e, 0x68, 0xf8, 0x27, 0x00,
-	0x00, 0xff, 0xff, 0x31, 0x7e, 0x5c, 0x8f, 0xe4, 0x02, 0x00, 0x00,
+	0x10, 0x86, 0xb3, 0x25, 0x4d, 0x9a, 0x09, 0x94, 0x68, 0x55, 0x55, 0xab, 0xa2, 0x5a, 0xa6, 0x12,
+	0x92, 0xc5, 0x61, 0x4d, 0x92, 0x0b, 0x17, 0x0e, 0x90, 0x06, 0x88, 0x50, 0x93, 0xca, 0xa4, 0x1c,
+	0xb8, 0x58, 0x9b, 0xf5, 0xd6, 0xb1, 0xea, 0xec, 0x5a, 0xeb, 0x6d, 0xa8, 0x79, 0x0a, 0xce, 0x3c,
+	0x11, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0x41, 0x40, 0xb6, 0xe3, 0xaa, 0x20, 0xd1, 0xfa, 0xe4, 0x99,
+	0x7f, 0xfe, 0x9d, 0x4f, 0xbf, 0x06, 0x7a, 0x41, 0xb6, 0x10, 0x32, 0x8d, 0x94, 0xbc, 0xca, 0xbe,
+	0xba, 0x37, 0x85, 0xcb, 0xd5, 0x62, 0xa1, 0xa4, 0xab, 0x55, 0x1c, 0xb3, 0x24, 0xf1, 0x13, 0xc6,
+	0x2f, 0x84, 0xa1, 0x89, 0x56, 0x46, 0xe1, 0xc3, 0xdb, 0x1e, 0x7a, 0x53, 0xd0, 0xd2, 0x73, 0xf0,
+	0x34, 0x9a, 0x71, 0x97, 0x2b, 0x2d, 0x5c, 0x3e, 0x67, 0x52, 0x8a, 0xd8, 0x5d, 0x76, 0xab, 0xdf,
+	0xf2, 0x85, 0x83, 0xe7, 0x77, 0x6f, 0x4d, 0x0d, 0x33, 0x97, 0x69, 0x39, 0x7b, 0xf4, 0xbd, 0x0e,
+	0x8f, 0xbc, 0x12, 0xe3, 0xb4, 0xa0, 0xc0, 0x87, 0x00, 0x15, 0x57, 0x14, 0x10, 0x64, 0x23, 0xa7,
+	0xe5, 0xb5, 0x36, 0x9d, 0x51, 0x80, 0xfb, 0xd0, 0x28, 0x71, 0xc9, 0x96, 0x8d, 0x9c, 0x76, 0xef,
+	0x09, 0x8d, 0x66, 0x9c, 0xe6, 0x40, 0xb4, 0xa2, 0x58, 0x76, 0x69, 0xf9, 0x96, 0xb7, 0x19, 0xc5,
+	0x0e, 0x3c, 0x66, 0xfc, 0x42, 0xaa, 0x2f, 0xb1, 0x08, 0x42, 0xb1, 0x10, 0xd2, 0x90, 0x07, 0x36,
+	0x72, 0x1e, 0x7a, 0xff, 0xb6, 0xf1, 0x2b, 0x68, 0x94, 0x7c, 0xa4, 0x6e, 0x23, 0x67, 0xb7, 0xf7,
+	0x8c, 0xde, 0x19, 0x07, 0xfd, 0x58, 0x0c, 0x7b, 0x1b, 0x13, 0xb6, 0xa1, 0x7d, 0xaa, 0x95, 0x3a,
+	0x7f, 0x2f, 0xa2, 0x70, 0x6e, 0xc8, 0xb6, 0x8d, 0x9c, 0xba, 0x77, 0xbb, 0x85, 0x09, 0x34, 0xb5,
+	0x88, 0x59, 0x26, 0x34, 0x69, 0x14, 0x08, 0x55, 0x89, 0x87, 0x50, 0x37, 0x59, 0x22, 0x48, 0xb3,
+	0x58, 0xdc, 0xbd, 0x67, 0xf1, 0x5f, 0xa1, 0xd1, 0x69, 0x96, 0x08, 0xaf, 0xb0, 0xe3, 0x3d, 0xd8,
+	0x16, 0x5a, 0x2b, 0x4d, 0x76, 0x8a, 0xe8, 0xca, 0x02, 0xbf, 0x04, 0xa2, 0x74, 0x14, 0x46, 0x92,
+	0xc5, 0xbe, 0xd1, 0x4c, 0xa6, 0xe7, 0x42, 0xfb, 0x86, 0xe9, 0x50, 0x18, 0xd2, 0x2a, 0x06, 0xf7,
+	0x2b, 0x7d, 0xba, 0x91, 0xa7, 0x85, 0x8a, 0x5f, 0xc0, 0x9e, 0x88, 0x66, 0xdc, 0x57, 0x3a, 0x10,
+	0xda, 0xe7, 0x4c, 0x72, 0x11, 0xc7, 0x22, 0x20, 0x60, 0x23, 0x67, 0xc7, 0xc3, 0xb9, 0x36, 0xc9,
+	0xa5, 0x41, 0xa5, 0x1c, 0xbd, 0x83, 0x7a, 0xce, 0x83, 0xdb, 0xd0, 0x9c, 0x8c, 0x7d, 0x6f, 0x38,
+	0xf8, 0xd4, 0xa9, 0x61, 0x80, 0xc6, 0x64, 0xec, 0xbf, 0x1e, 0x7c, 0xe8, 0x20, 0xbc, 0x0b, 0x30,
+	0x19, 0xfb, 0xd3, 0xd1, 0xc9, 0x70, 0x72, 0x36, 0xed, 0x6c, 0xe1, 0x7d, 0x68, 0x9d, 0x8d, 0x8f,
+	0x87, 0x6f, 0x47, 0xe3, 0xe1, 0x71, 0xe7, 0x77, 0xf5, 0xa1, 0x37, 0x27, 0x3f, 0x56, 0x16, 0xba,
+	0x5e, 0x59, 0xe8, 0xd7, 0xca, 0x42, 0xdf, 0xd6, 0x56, 0xed, 0x7a, 0x6d, 0xd5, 0x7e, 0xae, 0xad,
+	0xda, 0xe7, 0x7e, 0x18, 0x99, 0xf9, 0xe5, 0x2c, 0x0f, 0xc3, 0xfd, 0xcf, 0xb5, 0x2d, 0xfb, 0xee,
+	0x55, 0x75, 0x72, 0x79, 0x30, 0xe9, 0xac, 0x51, 0x9c, 0x5c, 0xff, 0xcf, 0x00, 0xec, 0x75, 0x6a,
+	0x5b, 0x16, 0x03, 0x00, 0x00,
 }
 
 func (m *RollappPacket) Marshal() (dAtA []byte, err error) {
@@ -227,6 +239,16 @@ func (m *RollappPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EibcOrderCancelled {
+		i--
+		if m.EibcOrderCancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.OriginalTransferTarget) > 0 {
 		i -= len(m.OriginalTransferTarget)
 		copy(dAtA[i:], m.OriginalTransferTarget)
@@ -342,6 +364,9 @@ func (m *RollappPacket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRollappPacket(uint64(l))
 	}
+	if m.EibcOrderCancelled {
+		n += 2
+	}
 	return n
 }
 
@@ -637,6 +662,26 @@ func (m *RollappPacket) Unmarshal(dAtA []byte) error {
 			}
 			m.OriginalTransferTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EibcOrderCancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollappPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EibcOrderCancelled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollappPacket(dAtA[iNdEx:])
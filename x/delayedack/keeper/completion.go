@@ -49,7 +49,8 @@ func (k Keeper) RunCompletionHook(ctx sdk.Context, fundsSrc sdk.AccAddress, budg
 // Should be called after packet finalization
 // Recipient can either be the fulfiller of a hook that already occurred, or the original recipient still, who probably still wants the hook to happen
 // NOTE: there is an asymmetry currently because on fulfill supports multiple hooks, but this finalization onRecv is hardcoded for x/forward atm
-func (k Keeper) finalizeOnRecv(ctx sdk.Context, ibc porttypes.IBCModule, p *commontypes.RollappPacket) error {
+// Returns whether the transfer stack credited the funds (the ack is a success).
+func (k Keeper) finalizeOnRecv(ctx sdk.Context, ibc porttypes.IBCModule, p *commontypes.RollappPacket) (bool, error) {
 	// Because we intercepted the packet, the core ibc library wasn't able to write the ack when we first
 	// got the packet. So we try to write it here.
 
@@ -65,14 +66,16 @@ func (k Keeper) finalizeOnRecv(ctx sdk.Context, ibc porttypes.IBCModule, p *comm
 					non-eibc: sender will get the funds back
 		            eibc:     effective transfer from fulfiller to original target
 	*/
+	credited := true
 	if ack != nil { // NOTE: in practice ack should not be nil, since ibc transfer core module always returns something
+		credited = ack.Success()
 		err := osmoutils.ApplyFuncIfNoError(ctx, k.writeRecvAck(*p, ack))
 		if err != nil {
-			return err
+			return credited, err
 		}
 	}
 
-	return k.finalizeCompletionHook(ctx, p)
+	return credited, k.finalizeCompletionHook(ctx, p)
 }
 
 // *In general* we want a way to do something whenever an ibc transfer finishes ("Hook"). It can happen
@@ -92,6 +95,11 @@ func (k Keeper) finalizeOnRecv(ctx sdk.Context, ibc porttypes.IBCModule, p *comm
 //
 // NOTE: Case (4) is handled elsewhere
 func (k Keeper) finalizeCompletionHook(ctx sdk.Context, p *commontypes.RollappPacket) error {
+	if p.EibcOrderCancelled {
+		// the order was deleted, and orders with a completion hook cannot be cancelled
+		return nil
+	}
+
 	o, err := k.PendingOrderByPacket(ctx, p)
 	if errorsmod.IsOf(err, eibctypes.ErrDemandOrderDoesNotExist) {
 		// not much we can do here, it should exist...
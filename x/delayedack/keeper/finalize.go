@@ -51,9 +51,10 @@ func (k Keeper) finalizeRollappPacket(
 	)
 
 	var packetErr error
+	credited := true
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
-		packetErr = k.finalizeOnRecv(ctx, ibc, &rollappPacket)
+		credited, packetErr = k.finalizeOnRecv(ctx, ibc, &rollappPacket)
 	case commontypes.RollappPacket_ON_ACK:
 		packetErr = osmoutils.ApplyFuncIfNoError(ctx, k.onAckPacket(rollappPacket, ibc))
 	case commontypes.RollappPacket_ON_TIMEOUT:
@@ -69,11 +70,16 @@ func (k Keeper) finalizeRollappPacket(
 	}
 
 	// Update status to finalized
-	_, err := k.UpdateRollappPacketAfterFinalization(ctx, rollappPacket)
+	rollappPacket, err := k.UpdateRollappPacketAfterFinalization(ctx, rollappPacket)
 	if err != nil {
 		return fmt.Errorf("update rollapp packet: %w", err)
 	}
 
+	err = k.GetHooks().AfterPacketFinalized(ctx, &rollappPacket, credited && packetErr == nil)
+	if err != nil {
+		return fmt.Errorf("after packet finalized: %w", err)
+	}
+
 	logger.Debug("finalized IBC rollapp packet")
 
 	return nil
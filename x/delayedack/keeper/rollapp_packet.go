@@ -168,6 +168,21 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 	return nil
 }
 
+// MarkEIBCOrderCancelled records that the eIBC demand order of the packet was cancelled, so that the
+// packet is finalized without it. Only pending packets can be updated.
+func (k Keeper) MarkEIBCOrderCancelled(ctx sdk.Context, rollappPacketKey string) error {
+	rollappPacket, err := k.GetRollappPacket(ctx, rollappPacketKey)
+	if err != nil {
+		return err
+	}
+	if rollappPacket.Status != commontypes.Status_PENDING {
+		return types.ErrCanOnlyUpdatePendingPacket
+	}
+	rollappPacket.EibcOrderCancelled = true
+	k.SetRollappPacket(ctx, *rollappPacket)
+	return nil
+}
+
 // UpdateRollappPacketAfterFinalization deletes the current rollapp packet and creates a new one with and updated status under a new key.
 // Updating the status should be called only with this method as it effects the key of the packet.
 // The assumption is that the passed rollapp packet status field is not updated directly.
@@ -9,6 +9,10 @@ import (
 type DelayedAckHooks interface {
 	AfterPacketStatusUpdated(ctx sdk.Context, packet *commontypes.RollappPacket, oldPacketKey string, newPacketKey string) error
 	AfterPacketDeleted(ctx sdk.Context, rollappPacket *commontypes.RollappPacket)
+	// AfterPacketFinalized is called after the packet is finalized and its status updated.
+	// success is false if the transfer stack failed to process the packet, including an error
+	// acknowledgement for an ON_RECV packet (the funds were not credited to the receiver).
+	AfterPacketFinalized(ctx sdk.Context, packet *commontypes.RollappPacket, success bool) error
 }
 
 type MultiDelayedAckHooks []DelayedAckHooks
@@ -29,6 +33,16 @@ func (h MultiDelayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *
 	return nil
 }
 
+func (h MultiDelayedAckHooks) AfterPacketFinalized(ctx sdk.Context, packet *commontypes.RollappPacket, success bool) error {
+	for i := range h {
+		err := h[i].AfterPacketFinalized(ctx, packet, success)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h MultiDelayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *commontypes.RollappPacket) {
 	for i := range h {
 		h[i].AfterPacketDeleted(ctx, rollappPacket)
@@ -45,3 +59,7 @@ func (b BaseDelayedAckHook) AfterPacketStatusUpdated(ctx sdk.Context, packet *co
 
 func (b BaseDelayedAckHook) AfterPacketDeleted(sdk.Context, *commontypes.RollappPacket) {
 }
+
+func (b BaseDelayedAckHook) AfterPacketFinalized(sdk.Context, *commontypes.RollappPacket, bool) error {
+	return nil
+}
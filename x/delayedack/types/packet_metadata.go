@@ -21,6 +21,14 @@ type EIBCMemo struct {
 	Fee string `json:"fee"`
 	// can be nil
 	OnCompletionHook []byte `json:"dym_on_completion,omitempty"`
+	// can be nil, if set the fee decays from Fee to the floor fee
+	FeeSchedule *EIBCFeeSchedule `json:"fee_schedule,omitempty"`
+}
+
+// EIBCFeeSchedule makes the order fee decay each hub block, starting from the memo fee
+type EIBCFeeSchedule struct {
+	FloorFee      string `json:"floor_fee"`
+	DecayPerBlock string `json:"decay_per_block"`
 }
 
 func DefaultEIBCMemo() EIBCMemo {
@@ -71,9 +79,33 @@ func (e EIBCMemo) ValidateBasic() error {
 	if _, err := e.GetCompletionHook(); err != nil {
 		return fmt.Errorf("get on completion hook: %w", err)
 	}
+	if _, _, err := e.FeeScheduleInts(); err != nil {
+		return fmt.Errorf("fee schedule: %w", err)
+	}
 	return nil
 }
 
+// FeeScheduleInts returns the floor fee and the decay per block of the fee schedule.
+// Returns zero values if the memo has no schedule.
+func (e EIBCMemo) FeeScheduleInts() (floor, decay math.Int, err error) {
+	if e.FeeSchedule == nil {
+		return math.Int{}, math.Int{}, nil
+	}
+	fee, err := e.FeeInt()
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+	floor, ok := math.NewIntFromString(e.FeeSchedule.FloorFee)
+	if !ok || floor.IsNegative() || floor.GT(fee) {
+		return math.Int{}, math.Int{}, ErrBadEIBCFeeSchedule
+	}
+	decay, ok = math.NewIntFromString(e.FeeSchedule.DecayPerBlock)
+	if !ok || decay.IsNegative() {
+		return math.Int{}, math.Int{}, ErrBadEIBCFeeSchedule
+	}
+	return floor, decay, nil
+}
+
 func (e EIBCMemo) FeeInt() (math.Int, error) {
 	i, ok := math.NewIntFromString(e.Fee)
 	if !ok || i.IsNegative() {
@@ -3,6 +3,8 @@ package types
 import (
 	"reflect"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func Test_parsePacketMetadata(t *testing.T) {
@@ -40,6 +42,19 @@ func Test_parsePacketMetadata(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid with fee schedule",
+			args{
+				`{"eibc":{"fee":"100","fee_schedule":{"floor_fee":"10","decay_per_block":"5"}}}`,
+			},
+			&Memo{
+				EIBC: &EIBCMemo{
+					Fee:         "100",
+					FeeSchedule: &EIBCFeeSchedule{FloorFee: "10", DecayPerBlock: "5"},
+				},
+			},
+			false,
+		},
 		{
 			"invalid - misquoted fee",
 			args{
@@ -78,3 +93,15 @@ func Test_parsePacketMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestEIBCMemoFeeSchedule(t *testing.T) {
+	m := EIBCMemo{Fee: "100", FeeSchedule: &EIBCFeeSchedule{FloorFee: "10", DecayPerBlock: "5"}}
+	require.NoError(t, m.ValidateBasic())
+
+	m.FeeSchedule.FloorFee = "101"
+	require.ErrorIs(t, m.ValidateBasic(), ErrBadEIBCFeeSchedule)
+
+	m.FeeSchedule.FloorFee = "10"
+	m.FeeSchedule.DecayPerBlock = "-1"
+	require.ErrorIs(t, m.ValidateBasic(), ErrBadEIBCFeeSchedule)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 
+	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
 	dymnsutils "github.com/dymensionxyz/dymension/v3/x/dymns/utils"
 
 	errorsmod "cosmossdk.io/errors"
@@ -85,6 +86,21 @@ func (h rollappHooks) AfterTransfersEnabled(_ sdk.Context, _, _ string) error {
 	return nil
 }
 
+// BeforeRollappOwnerChanged rejects the change while an alias of the RollApp is on sale,
+// same as the transfer of a Dym-Name.
+func (h rollappHooks) BeforeRollappOwnerChanged(ctx sdk.Context, rollappID string, _ sdk.AccAddress) error {
+	for _, alias := range h.GetAliasesOfRollAppId(ctx, rollappID) {
+		if so := h.GetSellOrder(ctx, alias, dymnstypes.TypeAlias); so != nil {
+			// by ignoring SO, the previous owner would sell the alias of the new owner
+			return errorsmod.Wrapf(
+				gerrc.ErrFailedPrecondition,
+				"can not transfer ownership while there is an active Sell Order of the alias: %s", alias,
+			)
+		}
+	}
+	return nil
+}
+
 type FutureRollappHooks interface {
 	// TODO: remove/deprecate - rollapp id cannot change
 	OnRollAppIdChanged(ctx sdk.Context, previousRollAppId, newRollAppId string)
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
 func (s *KeeperTestSuite) Test_rollappHooks_RollappCreated() {
@@ -944,3 +945,23 @@ func (s *KeeperTestSuite) Test_rollappHooks_OnRollAppIdChanged() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) Test_rollappHooks_BeforeRollappOwnerChanged() {
+	const rollAppId = "rollapp_1-1"
+	newOwner := testAddr(1).bech32()
+
+	s.persistRollApp(*newRollApp(rollAppId).WithAlias("alias"))
+
+	s.Run("pass - no active Sell-Order", func() {
+		err := s.dymNsKeeper.GetRollAppHooks().BeforeRollappOwnerChanged(s.ctx, rollAppId, sdk.MustAccAddressFromBech32(newOwner))
+		s.Require().NoError(err)
+	})
+
+	s.Run("fail - reject while the alias is on sale", func() {
+		err := s.dymNsKeeper.SetSellOrder(s.ctx, s.newAliasSellOrder("alias").WithMinPrice(100).Build())
+		s.Require().NoError(err)
+
+		err = s.dymNsKeeper.GetRollAppHooks().BeforeRollappOwnerChanged(s.ctx, rollAppId, sdk.MustAccAddressFromBech32(newOwner))
+		s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+	})
+}
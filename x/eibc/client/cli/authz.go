@@ -26,6 +26,7 @@ const (
 	FlagMaxPrice            = "max-price"
 	FlagOperatorFeeShare    = "operator-fee-share"
 	FlagSettlementValidated = "settlement-validated"
+	FlagSpendWindow         = "spend-window"
 )
 
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
@@ -37,7 +38,8 @@ func NewCmdGrantAuthorization() *cobra.Command {
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
- $ %s tx %s grant dym1skjw.. --spend-limit=1000stake... --from=dym1skl..`, version.AppName, authz.ModuleName),
+ $ %s tx %s grant dym1skjw.. --spend-limit=1000stake... --from=dym1skl..
+ $ %[1]s tx %[2]s grant dym1skjw.. --spend-limit=1000stake --spend-window=24h... --from=dym1skl..`, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -114,6 +116,11 @@ Examples:
 				}
 			}
 
+			spendWindow, err := cmd.Flags().GetDuration(FlagSpendWindow)
+			if err != nil {
+				return fmt.Errorf("failed to get spend window: %w", err)
+			}
+
 			rollappCriteria := types.NewRollappCriteria(
 				rollappID,
 				denoms,
@@ -123,6 +130,7 @@ Examples:
 				fulfillerFeePart,
 				settlementValidated,
 			)
+			rollappCriteria.SpendWindow = spendWindow
 
 			authorization := types.NewFulfillOrderAuthorization(
 				[]*types.RollappCriteria{rollappCriteria},
@@ -146,6 +154,7 @@ Examples:
 	cmd.Flags().String(FlagRollapp, "", "Allowed Rollapp ID")
 	cmd.Flags().StringSlice(FlagDenoms, []string{}, "An array of denoms allowed to use")
 	cmd.Flags().String(FlagSpendLimit, "", "An array of Coins allowed to spend")
+	cmd.Flags().Duration(FlagSpendWindow, 0, "Reset the amount spent after each window (e.g. 24h), so the spend limit applies per window")
 	cmd.Flags().Bool(FlagSettlementValidated, false, "Settlement validated flag")
 	cmd.Flags().String(FlagMinFeePercentage, "", "Minimum fee")
 	cmd.Flags().String(FlagMaxPrice, "", "Maximum price")
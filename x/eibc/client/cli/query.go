@@ -26,8 +26,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListDemandOrdersByStatus())
+	cmd.AddCommand(CmdQueryOrderBook())
 	cmd.AddCommand(CmdQueryOnDemandLPs())
 	cmd.AddCommand(CmdQueryOnDemandLPsAddr())
+	cmd.AddCommand(CmdQueryOnDemandLPStats())
+	cmd.AddCommand(CmdQueryOnDemandLPStatsAddr())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
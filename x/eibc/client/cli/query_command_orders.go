@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -149,3 +150,72 @@ func parseAndFormat(amount sdk.Coins) string {
 	}
 	return fmt.Sprintf("%s %s", amount[0].Amount, amount[0].Denom)
 }
+
+func CmdQueryOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order-book",
+		Short:   "Query a summary of a page of outstanding demand orders per rollapp and denom",
+		Example: "dymd q eibc order-book --rollapp rollapp_1234-1 --fee-bounds 0,0.01,0.05 --age-bounds 0,100,1000",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := &types.QueryOrderBookRequest{}
+
+			var err error
+			request.RollappId, err = cmd.Flags().GetString("rollapp")
+			if err != nil {
+				return err
+			}
+
+			request.Denom, err = cmd.Flags().GetString("denom")
+			if err != nil {
+				return err
+			}
+
+			feeBounds, err := cmd.Flags().GetStringSlice("fee-bounds")
+			if err != nil {
+				return err
+			}
+			for _, b := range feeBounds {
+				d, err := math.LegacyNewDecFromStr(b)
+				if err != nil {
+					return fmt.Errorf("invalid fee bound: %s: %w", b, err)
+				}
+				request.FeeBounds = append(request.FeeBounds, d)
+			}
+
+			ageBounds, err := cmd.Flags().GetUintSlice("age-bounds")
+			if err != nil {
+				return err
+			}
+			for _, b := range ageBounds {
+				request.AgeBounds = append(request.AgeBounds, uint64(b))
+			}
+
+			request.Pagination, err = client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OrderBook(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringP("rollapp", "r", "", "Rollapp ID")
+	cmd.Flags().StringP("denom", "d", "", "Denom")
+	cmd.Flags().StringSlice("fee-bounds", nil, "Lower bounds of the fee buckets, as a fraction of the price")
+	cmd.Flags().UintSlice("age-bounds", nil, "Lower bounds of the age buckets, in blocks")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "order-book")
+
+	return cmd
+}
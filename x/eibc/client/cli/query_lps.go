@@ -64,3 +64,62 @@ func CmdQueryOnDemandLPsAddr() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryOnDemandLPStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lps-demand-stats [ids]",
+		Short: "Query the fulfillment history and realized APR of on demand lps by space separated ids. If no ids are provided, all lps are returned",
+		Args:  cobra.MinimumNArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			m := &types.QueryOnDemandLPStatsRequest{Pagination: pageReq}
+			for _, id := range args {
+				parse, err := strconv.ParseUint(id, 10, 64)
+				if err != nil {
+					return err
+				}
+				m.Ids = append(m.Ids, parse)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OnDemandLPStats(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "lps-demand-stats")
+	return cmd
+}
+
+func CmdQueryOnDemandLPStatsAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lps-demand-stats-addr [addr]",
+		Short: "Query the fulfillment history and realized APR of on demand lps by creator addr",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := &types.QueryOnDemandLPStatsByAddrRequest{Addr: args[0]}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OnDemandLPStatsByAddr(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	math "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,8 +26,10 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewFulfillOrderTxCmd())
+	cmd.AddCommand(NewFulfillOrderPartialTxCmd())
 	cmd.AddCommand(NewFulfillOrderAuthorizedTxCmd())
 	cmd.AddCommand(NewUpdateDemandOrderTxCmd())
+	cmd.AddCommand(NewCancelDemandOrderTxCmd())
 	cmd.AddCommand(NewCmdGrantAuthorization())
 	cmd.AddCommand(NewCmdTryFulfillOnDemand())
 	cmd.AddCommand(NewCmdCreateOnDemandLP())
@@ -69,11 +72,56 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewFulfillOrderPartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-order-partial [order-id] [expected-fee-amount] [amount]",
+		Short:   "Fulfill a share of an eibc order",
+		Example: "dymd tx eibc fulfill-order-partial <order-id> <expected-fee-amount> <amount>",
+		Long: `Fulfill a share of an eibc order by providing the order ID, the expected fee amount and the part of the price to front.
+		The fulfiller receives its share of the price and a pro-rata share of the fee when the order is finalized.
+		`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderId := args[0]
+			fee := args[1]
+
+			amount, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount")
+			}
+
+			msg := types.NewMsgFulfillOrderPartial(
+				clientCtx.GetFromAddress().String(),
+				orderId,
+				fee,
+				amount,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 const (
 	FlagOperatorFeeAddress = "operator-fee-address"
 	FlagRollappId          = "rollapp-id"
 	FlagPrice              = "price"
 	FlagAmount             = "amount"
+	FlagAllowPartial       = "allow-partial"
+	FlagFloorFee           = "floor-fee"
+	FlagDecayPerBlock      = "decay-per-block"
+	FlagTargets            = "targets"
 )
 
 func NewFulfillOrderAuthorizedTxCmd() *cobra.Command {
@@ -173,7 +221,7 @@ func NewUpdateDemandOrderTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "update-demand-order [order-id] [new-fee-amount]",
 		Short:   "Update a demand order",
-		Example: "dymd tx eibc update-demand-order <order-id> <new-fee-amount>",
+		Example: "dymd tx eibc update-demand-order <order-id> <new-fee-amount> [--floor-fee <floor-fee-amount> --decay-per-block <amount>]",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -183,10 +231,21 @@ func NewUpdateDemandOrderTxCmd() *cobra.Command {
 			orderId := args[0]
 			newFee := args[1]
 
-			msg := types.NewMsgUpdateDemandOrder(
+			floorFee, err := cmd.Flags().GetString(FlagFloorFee)
+			if err != nil {
+				return err
+			}
+			decayPerBlock, err := cmd.Flags().GetString(FlagDecayPerBlock)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDemandOrderWithSchedule(
 				clientCtx.GetFromAddress().String(),
 				orderId,
 				newFee,
+				floorFee,
+				decayPerBlock,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -196,6 +255,34 @@ func NewUpdateDemandOrderTxCmd() *cobra.Command {
 		},
 	}
 
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagFloorFee, "", "Let the fee decay from the new fee down to this floor fee")
+	cmd.Flags().String(FlagDecayPerBlock, "", "Fee decrease per hub block, used with --floor-fee")
+
+	return cmd
+}
+
+func NewCancelDemandOrderTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-demand-order [order-id]",
+		Short:   "Cancel a demand order which was not fulfilled, the funds are transferred when the packet is finalized",
+		Example: "dymd tx eibc cancel-demand-order <order-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelDemandOrder(clientCtx.GetFromAddress().String(), args[0])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -248,7 +335,7 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 		Use:     "create-demand-lp [rollapp] [denom] [max-price] [min-fee] [spend-limit] [order-min-age-blocks]",
 		Short:   short,
 		Long:    long,
-		Example: "dymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100",
+		Example: "dymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100\ndymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100 --targets 'rollapp2:foo,rollapp3:*'",
 
 		Args: cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -280,6 +367,29 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 				return fmt.Errorf("invalid order min age blocks: %w", err)
 			}
 
+			allowPartial, err := cmd.Flags().GetBool(FlagAllowPartial)
+			if err != nil {
+				return err
+			}
+
+			spendWindow, err := cmd.Flags().GetDuration(FlagSpendWindow)
+			if err != nil {
+				return err
+			}
+
+			targetsStr, err := cmd.Flags().GetStringSlice(FlagTargets)
+			if err != nil {
+				return err
+			}
+			var targets []types.OnDemandLPTarget
+			for _, t := range targetsStr {
+				rollapp, denom, ok := strings.Cut(t, ":")
+				if !ok {
+					return fmt.Errorf("invalid target, expected rollapp:denom: %s", t)
+				}
+				targets = append(targets, types.OnDemandLPTarget{Rollapp: rollapp, Denom: denom})
+			}
+
 			msg := &types.MsgCreateOnDemandLP{
 				Lp: &types.OnDemandLP{
 					FundsAddr:         clientCtx.GetFromAddress().String(),
@@ -289,6 +399,9 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 					MinFee:            minFee,
 					SpendLimit:        spendLimit,
 					OrderMinAgeBlocks: orderMinAgeBlocks,
+					AllowPartial:      allowPartial,
+					SpendWindow:       spendWindow,
+					Targets:           targets,
 				},
 				Signer: clientCtx.GetFromAddress().String(),
 			}
@@ -301,6 +414,9 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagAllowPartial, false, "Fulfill a share of the order price if the whole price cannot be covered")
+	cmd.Flags().Duration(FlagSpendWindow, 0, "Reset the amount spent after each window (e.g. 24h), so the spend limit applies per window")
+	cmd.Flags().StringSlice(FlagTargets, nil, "Additional rollapp:denom pairs sharing the spend limit, either can be * to match any")
 
 	return cmd
 }
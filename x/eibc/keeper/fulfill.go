@@ -4,9 +4,13 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
 // basic i.e. not authorized
@@ -38,6 +42,10 @@ func (k Keeper) fulfill(ctx sdk.Context,
 	o *types.DemandOrder,
 	args fulfillArgs,
 ) error {
+	if o.IsPartiallyFulfilled() {
+		return types.ErrDemandOrderPartiallyFilled
+	}
+
 	if err := k.ensureAccount(ctx, args.FundsSource); err != nil {
 		return errorsmod.Wrap(err, "ensure fulfiller account")
 	}
@@ -47,6 +55,8 @@ func (k Keeper) fulfill(ctx sdk.Context,
 		return errorsmod.Wrap(err, "send coins")
 	}
 
+	// the fee is fixed once the order is fulfilled
+	o.FeeSchedule = nil
 	o.FulfillerAddress = args.Fulfiller.String()
 	err = k.SetDemandOrder(ctx, o)
 	if err != nil {
@@ -60,3 +70,94 @@ func (k Keeper) fulfill(ctx sdk.Context,
 
 	return nil
 }
+
+// fulfillPartial fronts a share of the order price. On the first partial fulfillment the
+// underlying packet is redirected to the escrow account, which splits the funds between the
+// fulfillers (and the recipient, for the unfilled part) on finalization.
+func (k Keeper) fulfillPartial(ctx sdk.Context,
+	o *types.DemandOrder,
+	fulfiller sdk.AccAddress,
+	amt math.Int,
+) error {
+	// completion hooks are executed once, with the whole price
+	if o.CompletionHook != nil {
+		return errorsmod.Wrap(types.ErrPartialFillNotSupported, "order has completion hook")
+	}
+	if amt.GT(o.UnfilledAmount()) {
+		return errorsmod.Wrapf(types.ErrPartialFillTooLarge, "amount: %s: unfilled: %s", amt, o.UnfilledAmount())
+	}
+
+	if err := k.ensureAccount(ctx, fulfiller); err != nil {
+		return errorsmod.Wrap(err, "ensure fulfiller account")
+	}
+
+	err := k.bk.SendCoins(ctx, fulfiller, o.GetRecipientBech32Address(), sdk.NewCoins(sdk.NewCoin(o.Denom(), amt)))
+	if err != nil {
+		return errorsmod.Wrap(err, "send coins")
+	}
+
+	if !o.IsPartiallyFulfilled() {
+		err = k.dack.UpdateRollappPacketTransferAddress(ctx, o.TrackingPacketKey, types.PartialFulfillmentEscrowAddr().String())
+		if err != nil {
+			return errorsmod.Wrap(err, "update rollapp packet transfer address")
+		}
+	}
+
+	// the fee is fixed from the first partial fulfillment on
+	o.FeeSchedule = nil
+	o.PartialFulfillments = append(o.PartialFulfillments, types.PartialFulfillment{
+		FulfillerAddress: fulfiller.String(),
+		Amount:           amt,
+	})
+	err = k.SetDemandOrder(ctx, o)
+	if err != nil {
+		return err
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, types.GetPartiallyFulfilledEvent(o, fulfiller.String(), amt)); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// payoutPartialFulfillments distributes the funds of a finalized partially fulfilled order from the escrow
+// account. The received amount mirrors what the transfer stack credited on finalization.
+func (k Keeper) payoutPartialFulfillments(ctx sdk.Context, o *types.DemandOrder, p *commontypes.RollappPacket) error {
+	transfer, err := p.GetTransferPacketData()
+	if err != nil {
+		return errorsmod.Wrap(err, "get transfer packet data")
+	}
+	received, ok := math.NewIntFromString(transfer.Amount)
+	if !ok {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "transfer amount: %s", transfer.Amount)
+	}
+	if p.Type == commontypes.RollappPacket_ON_RECV {
+		// the bridging fee middleware takes its fee before the escrow is credited
+		received = received.Sub(k.dack.BridgingFeeFromAmt(ctx, received))
+	}
+
+	payouts, remainder := o.PartialPayouts(received)
+	escrow := types.PartialFulfillmentEscrowAddr()
+	pay := func(addr string, amt math.Int, isRecipient bool) error {
+		if !amt.IsPositive() {
+			return nil
+		}
+		err := k.bk.SendCoins(ctx, escrow, sdk.MustAccAddressFromBech32(addr), sdk.NewCoins(sdk.NewCoin(o.Denom(), amt)))
+		if err != nil {
+			return errorsmod.Wrapf(err, "send coins: %s", addr)
+		}
+		return uevent.EmitTypedEvent(ctx, &types.EventDemandOrderPartialPayout{
+			OrderId:     o.Id,
+			Address:     addr,
+			Amount:      amt.String(),
+			IsRecipient: isRecipient,
+		})
+	}
+	for i, f := range o.PartialFulfillments {
+		if err := pay(f.FulfillerAddress, payouts[i], false); err != nil {
+			return err
+		}
+	}
+	return pay(o.Recipient, remainder, true)
+}
@@ -47,6 +47,7 @@ func (q Querier) DemandOrderById(goCtx context.Context, req *types.QueryGetDeman
 	for _, status := range statuses {
 		demandOrder, err = q.GetDemandOrder(ctx, status, req.Id)
 		if err == nil && demandOrder != nil {
+			demandOrder.ApplyFeeSchedule(uint64(ctx.BlockHeight()))
 			return &types.QueryGetDemandOrderResponse{DemandOrder: demandOrder}, nil
 		}
 	}
@@ -57,11 +58,16 @@ func (q Querier) DemandOrdersByStatus(goCtx context.Context, req *types.QueryDem
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Get the demand orders by status, with optional filters
-	demandOrders, pageResp, err := q.ListDemandOrdersByStatusPaginated(sdk.UnwrapSDKContext(goCtx), req.Status, req.Pagination, filterOpts(req)...)
+	demandOrders, pageResp, err := q.ListDemandOrdersByStatusPaginated(ctx, req.Status, req.Pagination, filterOpts(req)...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	// show the current fee of scheduled orders
+	for _, o := range demandOrders {
+		o.ApplyFeeSchedule(uint64(ctx.BlockHeight()))
+	}
 	// Construct the response
 	return &types.QueryDemandOrdersByStatusResponse{
 		DemandOrders: demandOrders,
@@ -175,3 +181,83 @@ func (q Querier) OnDemandLPsByByAddr(gctx context.Context, r *types.QueryOnDeman
 	}
 	return &types.QueryOnDemandLPsByAddrResponse{Lps: lps}, nil
 }
+
+func (q Querier) OnDemandLPStats(gctx context.Context, r *types.QueryOnDemandLPStatsRequest) (*types.QueryOnDemandLPStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(gctx)
+
+	if len(r.Ids) == 0 {
+		stats, pageResp, err := q.LPs.GetStatsPaginated(ctx, r.Pagination)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryOnDemandLPStatsResponse{Stats: withAPR(stats), Pagination: pageResp}, nil
+	}
+
+	var stats []types.OnDemandLPStats
+	for _, id := range r.Ids {
+		s, err := q.LPs.GetStatsByID(ctx, id)
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+
+	return &types.QueryOnDemandLPStatsResponse{Stats: withAPR(stats)}, nil
+}
+
+func (q Querier) OnDemandLPStatsByAddr(gctx context.Context, r *types.QueryOnDemandLPStatsByAddrRequest) (*types.QueryOnDemandLPStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(gctx)
+	acc, err := sdk.AccAddressFromBech32(r.Addr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "acc address from bech32")
+	}
+	lps, err := q.LPs.GetByAddr(ctx, acc)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get by addr")
+	}
+	var stats []types.OnDemandLPStats
+	for _, lp := range lps {
+		s, err := q.LPs.GetStats(ctx, *lp)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "get stats")
+		}
+		stats = append(stats, s)
+	}
+	return &types.QueryOnDemandLPStatsResponse{Stats: withAPR(stats)}, nil
+}
+
+func withAPR(stats []types.OnDemandLPStats) []types.OnDemandLPStatsWithAPR {
+	ret := make([]types.OnDemandLPStatsWithAPR, 0, len(stats))
+	for _, s := range stats {
+		ret = append(ret, types.OnDemandLPStatsWithAPR{Stats: s, Apr: s.APR()})
+	}
+	return ret
+}
+
+func (q Querier) OrderBook(goCtx context.Context, req *types.QueryOrderBookRequest) (*types.QueryOrderBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeBounds := req.FeeBounds
+	if len(feeBounds) == 0 {
+		feeBounds = types.DefaultOrderBookFeeBounds
+	}
+	ageBounds := req.AgeBounds
+	if len(ageBounds) == 0 {
+		ageBounds = types.DefaultOrderBookAgeBounds
+	}
+	if err := types.ValidateOrderBookBounds(feeBounds, ageBounds); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	books, pageResp, err := q.OrderBooks(ctx, req.RollappId, req.Denom, feeBounds, ageBounds, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryOrderBookResponse{PageBooks: books, Pagination: pageResp}, nil
+}
@@ -6,9 +6,11 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -100,3 +102,125 @@ func (suite *KeeperTestSuite) TestQueryDemandOrdersByStatus() {
 	suite.Require().NotNil(res.DemandOrders)
 	suite.Require().Equal(false, res.DemandOrders[0].IsFulfilled(), "Expected 0 demand orders with fulfillment state unfulfilled")
 }
+
+func (suite *KeeperTestSuite) TestQueryOrderBook() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(100)
+	recipient := apptesting.AddTestAddrs(suite.App, ctx, 1, math.NewInt(1000))[0]
+
+	seq := uint64(0)
+	newOrder := func(rollapp string, price, fee int64, creationHeight uint64) *types.DemandOrder {
+		seq++
+		pkt := packet
+		pkt.Sequence = seq
+		p := commontypes.RollappPacket{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 2, Packet: &pkt}
+		o := types.NewDemandOrder(p, math.NewInt(price), math.NewInt(fee), "stake", recipient.String(), creationHeight, nil)
+		suite.Require().NoError(k.SetDemandOrder(ctx, o))
+		return o
+	}
+	newOrder("ra1", 1000, 5, 1)  // fee 0.5%, age 99
+	newOrder("ra1", 100, 10, 95) // fee 10%, age 5
+	fulfilled := newOrder("ra1", 500, 50, 1)
+	fulfilled.FulfillerAddress = recipient.String()
+	suite.Require().NoError(k.SetDemandOrder(ctx, fulfilled))
+	newOrder("ra2", 200, 0, 50) // fee 0, age 50
+
+	q := keeper.NewQuerier(k)
+	res, err := q.OrderBook(ctx, &types.QueryOrderBookRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PageBooks, 2)
+
+	ra1 := res.PageBooks[0]
+	suite.Require().Equal("ra1", ra1.RollappId)
+	suite.Require().Equal("stake", ra1.Denom)
+	suite.Require().Equal(uint64(2), ra1.PageOrders)
+	suite.Require().True(math.NewInt(1100).Equal(ra1.PageTotalPrice))
+	suite.Require().True(math.NewInt(15).Equal(ra1.PageTotalFee))
+	feeOrders := func(b *types.OrderBook) []uint64 {
+		var ret []uint64
+		for _, bucket := range b.FeeBuckets {
+			ret = append(ret, bucket.PageOrders)
+		}
+		return ret
+	}
+	ageOrders := func(b *types.OrderBook) []uint64 {
+		var ret []uint64
+		for _, bucket := range b.AgeBuckets {
+			ret = append(ret, bucket.PageOrders)
+		}
+		return ret
+	}
+	suite.Require().Equal([]uint64{0, 0, 1, 0, 0, 1}, feeOrders(ra1))
+	suite.Require().Equal([]uint64{1, 1, 0, 0, 0}, ageOrders(ra1))
+	suite.Require().True(math.NewInt(1000).Equal(ra1.FeeBuckets[2].PageTotalPrice))
+
+	ra2 := res.PageBooks[1]
+	suite.Require().Equal("ra2", ra2.RollappId)
+	suite.Require().Equal([]uint64{1, 0, 0, 0, 0, 0}, feeOrders(ra2))
+	suite.Require().Equal([]uint64{0, 1, 0, 0, 0}, ageOrders(ra2))
+
+	// filter and custom buckets
+	res, err = q.OrderBook(ctx, &types.QueryOrderBookRequest{
+		RollappId: "ra1",
+		FeeBounds: []math.LegacyDec{math.LegacyNewDecWithPrec(1, 2)},
+		AgeBounds: []uint64{50},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.PageBooks, 1)
+	// orders below the lowest bound are only in the totals
+	suite.Require().Equal(uint64(2), res.PageBooks[0].PageOrders)
+	suite.Require().Equal([]uint64{1}, feeOrders(res.PageBooks[0]))
+	suite.Require().Equal([]uint64{1}, ageOrders(res.PageBooks[0]))
+
+	// the books of all the pages add up to the full summary
+	var key []byte
+	orders, pages := uint64(0), 0
+	for {
+		res, err = q.OrderBook(ctx, &types.QueryOrderBookRequest{Pagination: &query.PageRequest{Key: key, Limit: 2}})
+		suite.Require().NoError(err)
+		for _, b := range res.PageBooks {
+			orders += b.PageOrders
+		}
+		pages++
+		key = res.Pagination.NextKey
+		if len(key) == 0 {
+			break
+		}
+	}
+	suite.Require().Equal(2, pages)
+	suite.Require().Equal(uint64(3), orders)
+
+	_, err = q.OrderBook(ctx, &types.QueryOrderBookRequest{AgeBounds: []uint64{10, 5}})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryOnDemandLPStatsPagination() {
+	k := suite.App.EIBCKeeper
+	addr := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0]
+
+	for i := range 3 {
+		id, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+			FundsAddr:  addr.String(),
+			Rollapp:    "ra1",
+			Denom:      "stake",
+			MaxPrice:   math.NewInt(100),
+			MinFee:     math.LegacyZeroDec(),
+			SpendLimit: math.NewInt(1000),
+		})
+		suite.Require().NoError(err)
+		lp, err := k.LPs.Get(suite.Ctx, id)
+		suite.Require().NoError(err)
+		suite.Require().NoError(k.LPs.RecordFill(suite.Ctx, *lp, strconv.Itoa(i), math.NewInt(100), math.NewInt(1)))
+	}
+
+	q := keeper.NewQuerier(k)
+	res, err := q.OnDemandLPStats(suite.Ctx, &types.QueryOnDemandLPStatsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 2)
+	suite.Require().NotEmpty(res.Pagination.NextKey)
+
+	res, err = q.OnDemandLPStats(suite.Ctx, &types.QueryOnDemandLPStatsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Stats, 1)
+	suite.Require().Empty(res.Pagination.NextKey)
+}
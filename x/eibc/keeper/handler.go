@@ -95,6 +95,10 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	}
 
 	order := types.NewDemandOrder(*rollappPacket, demandOrderPrice, fee, demandOrderDenom, demandOrderRecipient, creationHeight, onComplete)
+	if memoEIBC.FeeSchedule != nil {
+		floor, decay, _ := memoEIBC.FeeScheduleInts() // guaranteed ok by above validation
+		order.FeeSchedule = types.NewFeeSchedule(fee, floor, decay, creationHeight)
+	}
 	return order, nil
 }
 
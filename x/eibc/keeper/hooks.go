@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
@@ -53,16 +54,61 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 	return nil
 }
 
+// AfterPacketFinalized is called after the underlying IBC packet is finalized, with whether the
+// transfer succeeded. It settles the lp fills and pays out the partial fulfillments of the order.
+func (d delayedAckHooks) AfterPacketFinalized(ctx sdk.Context, packet *commontypes.RollappPacket, success bool) error {
+	// the order ID was built from the packet key with PENDING status
+	pending := *packet
+	pending.Status = commontypes.Status_PENDING
+	demandOrderID := types.BuildDemandIDFromPacketKey(string(pending.RollappPacketKey()))
+	demandOrder, err := d.GetDemandOrder(ctx, commontypes.Status_FINALIZED, demandOrderID)
+	if err != nil {
+		if errors.Is(err, types.ErrDemandOrderDoesNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	// a failed transfer is a loss for the lps, same as a revert
+	if err := d.settleLPFills(ctx, demandOrder.Id, !success); err != nil {
+		return errorsmod.Wrap(err, "settle lp fills")
+	}
+
+	if demandOrder.IsPartiallyFulfilled() {
+		// if the transfer failed on finalization, the escrow did not get the funds, same as
+		// a regular fulfiller would not get them
+		if !success {
+			d.Logger(ctx).Error("Skip partial fulfillment payout: packet finalization failed.",
+				"order", demandOrder.Id, "error", packet.Error)
+			return nil
+		}
+		if err := d.payoutPartialFulfillments(ctx, demandOrder, packet); err != nil {
+			return errorsmod.Wrap(err, "payout partial fulfillments")
+		}
+	}
+
+	return nil
+}
+
 // AfterPacketDeleted is called every time the underlying IBC packet is deleted.
 // We only want to delete the demand order when the underlying packet is deleted to not
 // break the invariant that the demand order is always in sync with the underlying packet.
 func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *commontypes.RollappPacket) {
+	// Pending packets are only deleted when reverted by a hard fork
+	reverted := rollappPacket.Status == commontypes.Status_PENDING
+
 	// Get the demand order from the packet key. The initial demand order was built when
 	// the packet was created, hence with PENDING status.
 	rollappPacket.Status = commontypes.Status_PENDING
 	packetKey := rollappPacket.RollappPacketKey()
 	demandOrderID := types.BuildDemandIDFromPacketKey(string(packetKey))
 
+	if reverted {
+		if err := d.settleLPFills(ctx, demandOrderID, true); err != nil {
+			d.Logger(ctx).Error("Settle lp fills.", "order", demandOrderID, "error", err)
+		}
+	}
+
 	statuses := []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED}
 	for _, status := range statuses {
 		d.deleteDemandOrder(ctx, status, demandOrderID)
@@ -79,3 +79,91 @@ func (suite *KeeperTestSuite) TestAfterRollappPacketDeleted() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPartialFulfillmentPayout() {
+	denom := sdk.DefaultBondDenom
+	testCases := []struct {
+		name            string
+		fills           []int64
+		transferFailed  bool
+		expectRecipient bool
+	}{
+		{
+			name:  "completely filled",
+			fills: []int64{300, 0}, // 0 means fill the rest
+		},
+		{
+			name:            "partially filled",
+			fills:           []int64{300},
+			expectRecipient: true,
+		},
+		{
+			name:           "transfer failed on finalization",
+			fills:          []int64{300, 0},
+			transferFailed: true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			recipient := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.ZeroInt())[0]
+			fulfillers := apptesting.AddTestAddrs(suite.App, suite.Ctx, len(tc.fills), math.NewInt(1000))
+
+			// the packet transfers 1000, of which the escrow gets the part after the bridging fee
+			amt := math.NewInt(1000)
+			received := amt.Sub(suite.App.DelayedAckKeeper.BridgingFeeFromAmt(suite.Ctx, amt))
+			fee := math.NewInt(100)
+			price := received.Sub(fee)
+
+			suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+			order := types.NewDemandOrder(*rollappPacket, price, fee, denom, recipient.String(), 1, nil)
+			suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, order))
+
+			for i, f := range tc.fills {
+				fill := math.NewInt(f)
+				if f == 0 {
+					fill = price.Sub(math.NewInt(tc.fills[0]))
+				}
+				_, err := suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfillers[i].String(), order.Id, fee.String(), fill))
+				suite.Require().NoError(err)
+			}
+
+			// simulate the transfer crediting the escrow on finalization
+			if !tc.transferFailed {
+				apptesting.FundAccount(suite.App, suite.Ctx, types.PartialFulfillmentEscrowAddr(), sdk.NewCoins(sdk.NewCoin(denom, received)))
+			}
+			p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacketKey))
+			suite.Require().NoError(err)
+			finalized, err := suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *p)
+			suite.Require().NoError(err)
+			err = suite.App.DelayedAckKeeper.GetHooks().AfterPacketFinalized(suite.Ctx, &finalized, !tc.transferFailed)
+			suite.Require().NoError(err)
+
+			o, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_FINALIZED, order.Id)
+			suite.Require().NoError(err)
+			payouts, remainder := o.PartialPayouts(received)
+
+			total := math.ZeroInt()
+			for i, f := range o.PartialFulfillments {
+				bal := suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillers[i], denom).Amount
+				expect := math.NewInt(1000).Sub(f.Amount)
+				if !tc.transferFailed {
+					expect = expect.Add(payouts[i])
+					// the fulfiller earns its pro-rata share of the fee
+					suite.Require().True(payouts[i].GT(f.Amount))
+				}
+				suite.Require().True(expect.Equal(bal), "fulfiller %d: expect %s: got %s", i, expect, bal)
+				total = total.Add(payouts[i])
+			}
+			recipientBal := suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount
+			expectRecipient := o.FilledAmount()
+			if !tc.transferFailed {
+				expectRecipient = expectRecipient.Add(remainder)
+			}
+			suite.Require().True(expectRecipient.Equal(recipientBal), "recipient: expect %s: got %s", expectRecipient, recipientBal)
+			suite.Require().Equal(tc.expectRecipient, remainder.IsPositive())
+			suite.Require().True(received.Equal(total.Add(remainder)))
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, types.PartialFulfillmentEscrowAddr(), denom).IsZero())
+		})
+	}
+}
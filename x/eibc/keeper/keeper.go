@@ -33,6 +33,9 @@ type (
 		Schema    collections.Schema
 		LPs       LPs
 		authority string
+
+		// last order id scanned by the end block matcher
+		matcherCursor collections.Item[string]
 	}
 )
 
@@ -49,6 +52,7 @@ func NewKeeper(
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
 	lps := makeLPsStore(sb, cdc)
+	matcherCursor := collections.NewItem(sb, MatcherCursorPrefix, "matcherCursor", collections.StringValue)
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -66,6 +70,8 @@ func NewKeeper(
 		Schema:    schema,
 		LPs:       lps,
 		authority: authority,
+
+		matcherCursor: matcherCursor,
 	}
 }
 
@@ -114,6 +120,21 @@ func (k *Keeper) UpdateDemandOrderWithStatus(ctx sdk.Context, demandOrder *types
 	return demandOrder, nil
 }
 
+// CancelOrder deletes the pending order, and marks its packet so that it is finalized as a plain delayed ack
+// packet. It is up to the caller to check the order can be cancelled.
+func (k Keeper) CancelOrder(ctx sdk.Context, demandOrder *types.DemandOrder) error {
+	if err := k.dack.MarkEIBCOrderCancelled(ctx, demandOrder.TrackingPacketKey); err != nil {
+		return errorsmod.Wrap(err, "mark packet")
+	}
+
+	k.deleteDemandOrder(ctx, commontypes.Status_PENDING, demandOrder.Id)
+
+	if err := uevent.EmitTypedEvent(ctx, types.GetCancelledEvent(demandOrder)); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
 func (k Keeper) PendingOrderByPacket(ctx sdk.Context, p *commontypes.RollappPacket) (*types.DemandOrder, error) {
 	key := p.RollappPacketKey()
 	id := types.BuildDemandIDFromPacketKey(string(key))
@@ -161,6 +182,8 @@ func (k Keeper) GetOutstandingOrder(ctx sdk.Context, orderId string) (*types.Dem
 		return nil, types.ErrDemandOrderInactive
 	}
 
+	demandOrder.ApplyFeeSchedule(uint64(ctx.BlockHeight()))
+
 	return demandOrder, nil
 }
 
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// GetStats returns the stats of the lp, or empty stats if it never filled
+func (s LPs) GetStats(ctx sdk.Context, lp types.OnDemandLPRecord) (types.OnDemandLPStats, error) {
+	stats, err := s.stats.Get(ctx, lp.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewOnDemandLPStats(lp.Id, lp.Lp.FundsAddr), nil
+	}
+	return stats, err
+}
+
+func (s LPs) GetStatsByID(ctx sdk.Context, id uint64) (types.OnDemandLPStats, error) {
+	return s.stats.Get(ctx, id)
+}
+
+// GetStatsPaginated returns the stats of all the lps, by ascending id
+func (s LPs) GetStatsPaginated(ctx sdk.Context, pageReq *query.PageRequest) ([]types.OnDemandLPStats, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, s.stats, pageReq,
+		func(_ uint64, stats types.OnDemandLPStats) (types.OnDemandLPStats, error) {
+			return stats, nil
+		},
+	)
+}
+
+// RecordFill records that the lp fronted amt of the order price, for the given fee. The fill is settled
+// when the order is finalized or reverted.
+func (s LPs) RecordFill(ctx sdk.Context, lp types.OnDemandLPRecord, orderID string, amt, fee math.Int) error {
+	stats, err := s.GetStats(ctx, lp)
+	if err != nil {
+		return errorsmod.Wrap(err, "get stats")
+	}
+	stats.OrdersFilled++
+	stats.PendingVolume = stats.PendingVolume.Add(amt)
+	if err := s.stats.Set(ctx, lp.Id, stats); err != nil {
+		return errorsmod.Wrap(err, "set stats")
+	}
+
+	key := collections.Join(orderID, lp.Id)
+	fill, err := s.fills.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		fill = types.OnDemandLPFill{
+			LpId:     lp.Id,
+			OrderId:  orderID,
+			Amount:   math.ZeroInt(),
+			Fee:      math.ZeroInt(),
+			FillTime: ctx.BlockTime().Unix(),
+		}
+	} else if err != nil {
+		return errorsmod.Wrap(err, "get fill")
+	}
+	fill.Amount = fill.Amount.Add(amt)
+	fill.Fee = fill.Fee.Add(fee)
+	return s.fills.Set(ctx, key, fill)
+}
+
+// settleLPFills accounts for the finalization or the revert of the order in the stats of the lps which filled it
+func (k Keeper) settleLPFills(ctx sdk.Context, orderID string, reverted bool) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](orderID)
+	it, err := k.LPs.fills.Iterate(ctx, rng)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate fills")
+	}
+	fills, err := it.Values()
+	if err != nil {
+		return errorsmod.Wrap(err, "fills")
+	}
+
+	for _, f := range fills {
+		stats, err := k.LPs.stats.Get(ctx, f.LpId)
+		if err != nil {
+			return errorsmod.Wrapf(err, "get stats: lp: %d", f.LpId)
+		}
+		stats.Settle(f, ctx.BlockTime().Unix(), reverted)
+		if err := k.LPs.stats.Set(ctx, f.LpId, stats); err != nil {
+			return errorsmod.Wrap(err, "set stats")
+		}
+		if err := k.LPs.fills.Remove(ctx, collections.Join(orderID, f.LpId)); err != nil {
+			return errorsmod.Wrap(err, "remove fill")
+		}
+
+		fee := f.Fee
+		if reverted {
+			fee = math.ZeroInt()
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventOnDemandLPFillSettled{
+			LpId:     f.LpId,
+			OrderId:  orderID,
+			Amount:   f.Amount.String(),
+			Fee:      fee.String(),
+			Reverted: reverted,
+			Apr:      stats.APR().String(),
+		}); err != nil {
+			return errorsmod.Wrap(err, "emit event")
+		}
+	}
+	return nil
+}
@@ -1,8 +1,15 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -176,3 +183,419 @@ func (suite *KeeperTestSuite) TestLPQueriesByAddr() {
 		}
 	}
 }
+
+// no single lp can cover the price, so several partial lps fill it in shares
+func (suite *KeeperTestSuite) TestLPFulfillPartial() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 4, math.NewInt(1000))
+	recipient := addrs[0]
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(10), denom, recipient.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, order))
+
+	for i, lp := range []types.OnDemandLP{
+		{MaxPrice: math.NewInt(60), SpendLimit: math.NewInt(1000), AllowPartial: true},
+		{MaxPrice: math.NewInt(60), SpendLimit: math.NewInt(1000), AllowPartial: false}, // only full fills
+		{MaxPrice: math.NewInt(1000), SpendLimit: math.NewInt(70), AllowPartial: true},
+	} {
+		lp.FundsAddr = addrs[i+1].String()
+		lp.Rollapp = rollappPacket.RollappId
+		lp.Denom = denom
+		lp.MinFee = math.LegacyZeroDec()
+		_, err := k.LPs.Create(suite.Ctx, &lp)
+		suite.Require().NoError(err)
+	}
+
+	err := k.FulfillByOnDemandLP(suite.Ctx, order.Id, 0)
+	suite.Require().NoError(err)
+
+	got, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(got.IsFulfilled())
+	suite.Require().Len(got.PartialFulfillments, 2)
+	for _, f := range got.PartialFulfillments {
+		suite.Require().NotEqual(addrs[2].String(), f.FulfillerAddress)
+	}
+
+	lps, err := k.LPs.GetAll(suite.Ctx)
+	suite.Require().NoError(err)
+	spent := math.ZeroInt()
+	for _, lp := range lps {
+		spent = spent.Add(lp.Spent)
+	}
+	suite.Require().True(math.NewInt(100).Equal(spent))
+	suite.Require().True(math.NewInt(1100).Equal(suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount))
+}
+
+// the end block matcher fills the best fee orders first, with the cheapest lp
+func (suite *KeeperTestSuite) TestMatchOnDemandLPs() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	recipient := addrs[0]
+
+	var orders []*types.DemandOrder
+	for i, fee := range []int64{10, 30, 20} {
+		p := *rollappPacket
+		pkt := *p.Packet
+		pkt.Sequence = uint64(i + 1)
+		p.Packet = &pkt
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, p)
+		o := types.NewDemandOrder(p, math.NewInt(100), math.NewInt(fee), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+		orders = append(orders, o)
+	}
+
+	// can only cover one order
+	for i, minFee := range []string{"0.15", "0.1"} {
+		_, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+			FundsAddr:  addrs[i+1].String(),
+			Rollapp:    rollappPacket.RollappId,
+			Denom:      denom,
+			MaxPrice:   math.NewInt(100),
+			MinFee:     math.LegacyMustNewDecFromStr(minFee),
+			SpendLimit: math.NewInt(150),
+		})
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(k.MatchOnDemandLPs(suite.Ctx))
+
+	// best fee orders are filled, each lp fills one, the cheaper lp first
+	expect := []string{"", addrs[2].String(), addrs[1].String()}
+	for i, o := range orders {
+		got, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, o.Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expect[i], got.FulfillerAddress, "order: %d", i)
+	}
+}
+
+// the matcher sorts by the scheduled fee, and skips an order which fails without halting
+func (suite *KeeperTestSuite) TestMatchOnDemandLPsFeeSchedule() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient := addrs[0]
+	ctx := suite.Ctx.WithBlockHeight(10)
+
+	schedules := []*types.FeeSchedule{
+		// the stored fee is the best, but it decayed to the floor
+		types.NewFeeSchedule(math.NewInt(30), math.NewInt(5), math.NewInt(25), 9),
+		nil,
+		// invalid, applying it panics
+		types.NewFeeSchedule(math.NewInt(500), math.ZeroInt(), math.NewInt(1), 9),
+	}
+	var orders []*types.DemandOrder
+	for i, fee := range []int64{30, 20, 40} {
+		p := *rollappPacket
+		pkt := *p.Packet
+		pkt.Sequence = uint64(i + 1)
+		p.Packet = &pkt
+		suite.App.DelayedAckKeeper.SetRollappPacket(ctx, p)
+		o := types.NewDemandOrder(p, math.NewInt(100), math.NewInt(fee), denom, recipient.String(), 1, nil)
+		o.FeeSchedule = schedules[i]
+		suite.Require().NoError(k.SetDemandOrder(ctx, o))
+		orders = append(orders, o)
+	}
+
+	// can only cover one order
+	_, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		FundsAddr:  addrs[1].String(),
+		Rollapp:    rollappPacket.RollappId,
+		Denom:      denom,
+		MaxPrice:   math.NewInt(200),
+		MinFee:     math.LegacyZeroDec(),
+		SpendLimit: math.NewInt(150),
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(k.MatchOnDemandLPs(ctx))
+
+	expect := []string{"", addrs[1].String(), ""}
+	for i, o := range orders {
+		got, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, o.Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expect[i], got.FulfillerAddress, "order: %d", i)
+	}
+}
+
+// the matcher scans a bounded number of orders per block, and resumes where it stopped
+func (suite *KeeperTestSuite) TestMatchOnDemandLPsBudget() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient := addrs[0]
+
+	params := k.GetParams(suite.Ctx)
+	params.MatcherMaxOrders = 2
+	k.SetParams(suite.Ctx, params)
+
+	var orders []*types.DemandOrder
+	for i := range 3 {
+		p := *rollappPacket
+		pkt := *p.Packet
+		pkt.Sequence = uint64(i + 1)
+		p.Packet = &pkt
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, p)
+		o := types.NewDemandOrder(p, math.NewInt(100), math.NewInt(10), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+		orders = append(orders, o)
+	}
+	_, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+		FundsAddr:  addrs[1].String(),
+		Rollapp:    rollappPacket.RollappId,
+		Denom:      denom,
+		MaxPrice:   math.NewInt(100),
+		MinFee:     math.LegacyZeroDec(),
+		SpendLimit: math.NewInt(1000),
+	})
+	suite.Require().NoError(err)
+
+	countFulfilled := func() int {
+		n := 0
+		for _, o := range orders {
+			got, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, o.Id)
+			suite.Require().NoError(err)
+			if got.IsFulfilled() {
+				n++
+			}
+		}
+		return n
+	}
+
+	suite.Require().NoError(k.MatchOnDemandLPs(suite.Ctx))
+	suite.Require().Equal(2, countFulfilled())
+	suite.Require().NoError(k.MatchOnDemandLPs(suite.Ctx))
+	suite.Require().Equal(3, countFulfilled())
+
+	// disabled
+	params.MatcherGasBudget = 0
+	params.MatcherMaxOrders = 0
+	k.SetParams(suite.Ctx, params)
+	suite.Require().NoError(k.MatchOnDemandLPs(suite.Ctx))
+}
+
+// the lp stats account for finalized and reverted fills
+func (suite *KeeperTestSuite) TestLPStats() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient := addrs[0]
+	t0 := time.Unix(1_000_000, 0)
+	ctx := suite.Ctx.WithBlockTime(t0)
+
+	lpID, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		FundsAddr:  addrs[1].String(),
+		Rollapp:    rollappPacket.RollappId,
+		Denom:      denom,
+		MaxPrice:   math.NewInt(100),
+		MinFee:     math.LegacyZeroDec(),
+		SpendLimit: math.NewInt(1000),
+	})
+	suite.Require().NoError(err)
+
+	var packets []commontypes.RollappPacket
+	for i := range 2 {
+		p := *rollappPacket
+		pkt := *p.Packet
+		pkt.Sequence = uint64(i + 1)
+		p.Packet = &pkt
+		suite.App.DelayedAckKeeper.SetRollappPacket(ctx, p)
+		o := types.NewDemandOrder(p, math.NewInt(100), math.NewInt(10), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(ctx, o))
+		suite.Require().NoError(k.FulfillByOnDemandLP(ctx, o.Id, 0))
+		packets = append(packets, p)
+	}
+
+	q := keeper.NewQuerier(k)
+	getStats := func() types.OnDemandLPStatsWithAPR {
+		res, err := q.OnDemandLPStatsByAddr(ctx, &types.QueryOnDemandLPStatsByAddrRequest{Addr: addrs[1].String()})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Stats, 1)
+		suite.Require().Equal(lpID, res.Stats[0].Stats.LpId)
+		return res.Stats[0]
+	}
+	s := getStats()
+	suite.Require().Equal(uint64(2), s.Stats.OrdersFilled)
+	suite.Require().True(math.NewInt(200).Equal(s.Stats.PendingVolume))
+	suite.Require().True(s.Apr.IsZero())
+
+	// finalize the first after a year
+	ctx = ctx.WithBlockTime(t0.Add(types.SecondsPerYear * time.Second))
+	finalized, err := suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(ctx, packets[0])
+	suite.Require().NoError(err)
+	err = suite.App.DelayedAckKeeper.GetHooks().AfterPacketFinalized(ctx, &finalized, true)
+	suite.Require().NoError(err)
+	s = getStats()
+	suite.Require().Equal(uint64(1), s.Stats.OrdersFinalized)
+	suite.Require().True(math.NewInt(10).Equal(s.Stats.FeesEarned))
+	suite.Require().True(math.LegacyMustNewDecFromStr("0.1").Equal(s.Apr), s.Apr)
+
+	// revert the second
+	suite.App.DelayedAckKeeper.DeleteRollappPacket(ctx, &packets[1])
+	s = getStats()
+	suite.Require().Equal(uint64(1), s.Stats.OrdersReverted)
+	suite.Require().True(math.NewInt(100).Equal(s.Stats.RevertedVolume))
+	suite.Require().True(s.Stats.PendingVolume.IsZero())
+	suite.Require().True(math.LegacyMustNewDecFromStr("-0.45").Equal(s.Apr), s.Apr)
+}
+
+// the spent amount of an lp with a spend window resets after the window
+func (suite *KeeperTestSuite) TestLPSpendWindow() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient := addrs[0]
+	t0 := time.Unix(1_000_000, 0).UTC()
+	ctx := suite.Ctx.WithBlockTime(t0)
+
+	lpID, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		FundsAddr:   addrs[1].String(),
+		Rollapp:     rollappPacket.RollappId,
+		Denom:       denom,
+		MaxPrice:    math.NewInt(100),
+		MinFee:      math.LegacyZeroDec(),
+		SpendLimit:  math.NewInt(100),
+		SpendWindow: time.Hour,
+	})
+	suite.Require().NoError(err)
+
+	var orders []*types.DemandOrder
+	for i := range 2 {
+		p := *rollappPacket
+		pkt := *p.Packet
+		pkt.Sequence = uint64(i + 1)
+		p.Packet = &pkt
+		suite.App.DelayedAckKeeper.SetRollappPacket(ctx, p)
+		o := types.NewDemandOrder(p, math.NewInt(100), math.NewInt(10), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(ctx, o))
+		orders = append(orders, o)
+	}
+
+	suite.Require().NoError(k.FulfillByOnDemandLP(ctx, orders[0].Id, 0))
+	// limit reached in the current window
+	ctx = ctx.WithBlockTime(t0.Add(30 * time.Minute))
+	suite.Require().Error(k.FulfillByOnDemandLP(ctx, orders[1].Id, 0))
+	// next window
+	ctx = ctx.WithBlockTime(t0.Add(time.Hour))
+	suite.Require().NoError(k.FulfillByOnDemandLP(ctx, orders[1].Id, 0))
+
+	lp, err := k.LPs.Get(ctx, lpID)
+	suite.Require().NoError(err)
+	suite.Require().True(math.NewInt(100).Equal(lp.Spent))
+	suite.Require().Equal(t0.Add(time.Hour), lp.WindowStart)
+}
+
+// a wildcard denom lp shares one spend limit across the denoms, and is skipped when it is out of the
+// order denom, until it failed too many times in a row
+func (suite *KeeperTestSuite) TestLPMultiDenomSpend() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx
+	addrs := apptesting.AddTestAddrs(suite.App, ctx, 2, math.NewInt(1000))
+	recipient := addrs[0]
+	apptesting.FundAccount(suite.App, ctx, addrs[1], sdk.NewCoins(sdk.NewCoin("bbb", math.NewInt(1000))))
+
+	newLP := func() uint64 {
+		id, err := k.LPs.Create(ctx, &types.OnDemandLP{
+			FundsAddr:  addrs[1].String(),
+			Rollapp:    rollappPacket.RollappId,
+			Denom:      types.LPWildcard,
+			MaxPrice:   math.NewInt(100),
+			MinFee:     math.LegacyZeroDec(),
+			SpendLimit: math.NewInt(200),
+		})
+		suite.Require().NoError(err)
+		return id
+	}
+	seq := uint64(0)
+	newOrder := func(denom string) *types.DemandOrder {
+		seq++
+		p := *rollappPacket
+		pkt := *p.Packet
+		pkt.Sequence = seq
+		p.Packet = &pkt
+		suite.App.DelayedAckKeeper.SetRollappPacket(ctx, p)
+		o := types.NewDemandOrder(p, math.NewInt(100), math.NewInt(10), denom, recipient.String(), 1, nil)
+		suite.Require().NoError(k.SetDemandOrder(ctx, o))
+		return o
+	}
+	lpID := newLP()
+
+	// no funds in the denom: skipped but kept
+	suite.Require().Error(k.FulfillByOnDemandLP(ctx, newOrder("ccc").Id, 0))
+	lp, err := k.LPs.Get(ctx, lpID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint32(1), lp.InsufficientFundsFailures)
+
+	// a fill resets the failures
+	suite.Require().NoError(k.FulfillByOnDemandLP(ctx, newOrder(sdk.DefaultBondDenom).Id, 0))
+	lp, err = k.LPs.Get(ctx, lpID)
+	suite.Require().NoError(err)
+	suite.Require().Zero(lp.InsufficientFundsFailures)
+
+	suite.Require().NoError(k.FulfillByOnDemandLP(ctx, newOrder("bbb").Id, 0))
+	// the limit is shared by the denoms
+	suite.Require().Error(k.FulfillByOnDemandLP(ctx, newOrder(sdk.DefaultBondDenom).Id, 0))
+	lp, err = k.LPs.Get(ctx, lpID)
+	suite.Require().NoError(err)
+	suite.Require().True(math.NewInt(200).Equal(lp.Spent))
+	suite.Require().NoError(lp.Validate())
+
+	// deleted after too many failures in a row
+	lpID = newLP()
+	for range types.MaxLPInsufficientFundsFailures - 1 {
+		suite.Require().Error(k.FulfillByOnDemandLP(ctx, newOrder("ccc").Id, 0))
+	}
+	_, err = k.LPs.Get(ctx, lpID)
+	suite.Require().NoError(err)
+	suite.Require().Error(k.FulfillByOnDemandLP(ctx, newOrder("ccc").Id, 0))
+	_, err = k.LPs.Get(ctx, lpID)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+// an lp with several targets and wildcards is found for each of them
+func (suite *KeeperTestSuite) TestLPMultiTarget() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx
+	newLP := func(rollapp, denom string, targets ...types.OnDemandLPTarget) uint64 {
+		id, err := k.LPs.Create(ctx, &types.OnDemandLP{
+			Rollapp:    rollapp,
+			Denom:      denom,
+			MaxPrice:   math.NewInt(10),
+			MinFee:     math.LegacyZeroDec(),
+			SpendLimit: math.NewInt(100),
+			Targets:    targets,
+		})
+		suite.Require().NoError(err)
+		return id
+	}
+	multi := newLP("", "", types.OnDemandLPTarget{Rollapp: "1", Denom: "aaa"}, types.OnDemandLPTarget{Rollapp: "2", Denom: types.LPWildcard})
+	anyRollapp := newLP(types.LPWildcard, "bbb")
+	anything := newLP(types.LPWildcard, types.LPWildcard, types.OnDemandLPTarget{Rollapp: "2", Denom: "bbb"})
+
+	compatIDs := func(rollapp, denom string) []uint64 {
+		lps, err := k.LPs.GetOrderCompatibleLPs(ctx, types.DemandOrder{
+			RollappId: rollapp,
+			Price:     sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(5))),
+			Fee:       sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1))),
+		})
+		suite.Require().NoError(err)
+		var ids []uint64
+		for _, lp := range lps {
+			ids = append(ids, lp.Id)
+		}
+		return ids
+	}
+	suite.Require().Equal([]uint64{multi, anything}, compatIDs("1", "aaa"))
+	suite.Require().Equal([]uint64{anyRollapp, anything}, compatIDs("1", "bbb"))
+	suite.Require().Equal([]uint64{multi, anything}, compatIDs("2", "ccc"))
+	// found once, even if several targets match
+	suite.Require().Equal([]uint64{multi, anyRollapp, anything}, compatIDs("2", "bbb"))
+	suite.Require().Equal([]uint64{anyRollapp, anything}, compatIDs("3", "bbb"))
+
+	suite.Require().NoError(k.LPs.Del(ctx, multi, "test"))
+	suite.Require().Equal([]uint64{anything}, compatIDs("1", "aaa"))
+	suite.Require().Equal([]uint64{anyRollapp, anything}, compatIDs("2", "bbb"))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"math/rand/v2"
+	"slices"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -20,6 +21,8 @@ var (
 	LPsByIDPrefix           = collections.NewPrefix("lps1")
 	LPsNextIDPrefix         = collections.NewPrefix("lps2")
 	LPsByAddrPrefix         = collections.NewPrefix("lps3")
+	LPsStatsPrefix          = collections.NewPrefix("lps4")
+	LPsFillsPrefix          = collections.NewPrefix("lps5")
 )
 
 type LPs struct {
@@ -30,6 +33,10 @@ type LPs struct {
 	// <addr,id>
 	byAddr collections.KeySet[collections.Pair[string, uint64]]
 	nextID collections.Sequence
+	// id -> stats, kept after the lp is deleted
+	stats collections.Map[uint64, types.OnDemandLPStats]
+	// <order,id> -> fill, until the order is settled
+	fills collections.Map[collections.Pair[string, uint64], types.OnDemandLPFill]
 }
 
 func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
@@ -53,6 +60,18 @@ func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
 			),
 		),
 		nextID: collections.NewSequence(sb, LPsNextIDPrefix, "nextID"),
+		stats: collections.NewMap(
+			sb, LPsStatsPrefix, "stats",
+			collections.Uint64Key, codec.CollValue[types.OnDemandLPStats](cdc),
+		),
+		fills: collections.NewMap(
+			sb, LPsFillsPrefix, "fills",
+			collections.PairKeyCodec(
+				collections.StringKey,
+				collections.Uint64Key,
+			),
+			codec.CollValue[types.OnDemandLPFill](cdc),
+		),
 	}
 }
 
@@ -63,9 +82,10 @@ func (s LPs) Create(ctx sdk.Context, lp *types.OnDemandLP) (uint64, error) {
 		return 0, errorsmod.Wrap(err, "next id")
 	}
 	if err := s.Set(ctx, types.OnDemandLPRecord{
-		Id:    id,
-		Lp:    lp,
-		Spent: math.ZeroInt(),
+		Id:          id,
+		Lp:          lp,
+		Spent:       math.ZeroInt(),
+		WindowStart: ctx.BlockTime(),
 	}); err != nil {
 		return 0, errorsmod.Wrap(err, "set")
 	}
@@ -87,9 +107,11 @@ func (s LPs) Set(ctx sdk.Context, lp types.OnDemandLPRecord) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "set by addr")
 	}
-	err = s.byRollAppDenom.Set(ctx, collections.Join3(lp.Lp.Rollapp, lp.Lp.Denom, lp.Id))
-	if err != nil {
-		return errorsmod.Wrap(err, "set by rollapp denom")
+	for _, t := range lp.Lp.AllTargets() {
+		err = s.byRollAppDenom.Set(ctx, collections.Join3(t.Rollapp, t.Denom, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "set by rollapp denom")
+		}
 	}
 	return nil
 }
@@ -125,9 +147,11 @@ func (s LPs) Del(ctx sdk.Context, id uint64, reason string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "remove id")
 	}
-	err = s.byRollAppDenom.Remove(ctx, collections.Join3(lp.Lp.Rollapp, lp.Lp.Denom, id))
-	if err != nil {
-		return errorsmod.Wrap(err, "remove by rollapp denom")
+	for _, t := range lp.Lp.AllTargets() {
+		err = s.byRollAppDenom.Remove(ctx, collections.Join3(t.Rollapp, t.Denom, id))
+		if err != nil {
+			return errorsmod.Wrap(err, "remove by rollapp denom")
+		}
 	}
 	err = s.byAddr.Remove(ctx, collections.Join(lp.Lp.FundsAddr, lp.Id))
 	if err != nil {
@@ -166,33 +190,71 @@ func (s LPs) GetByAddr(ctx sdk.Context, addr sdk.AccAddress) ([]*types.OnDemandL
 }
 
 func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
-	rol := o.RollappId
-	denom := o.Denom()
-	ranger := collections.NewSuperPrefixedTripleRange[string, string, uint64](rol, denom)
-	iter, err := s.byRollAppDenom.Iterate(ctx, ranger)
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	return s.getOrderLPs(ctx, o, func(lpr types.OnDemandLPRecord) bool {
+		return lpr.Accepts(h, &o)
+	})
+}
+
+// GetOrderPartialCompatibleLPs returns the LPs willing to front a share of the unfilled order price
+func (s LPs) GetOrderPartialCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	return s.getOrderLPs(ctx, o, func(lpr types.OnDemandLPRecord) bool {
+		return lpr.AcceptsPartial(h, &o).IsPositive()
+	})
+}
+
+func (s LPs) getOrderLPs(ctx sdk.Context, o types.DemandOrder, accepts func(types.OnDemandLPRecord) bool) ([]types.OnDemandLPRecord, error) {
+	ids, err := s.getOrderLPIDs(ctx, o.RollappId, o.Denom())
 	if err != nil {
 		return nil, err
 	}
-	defer iter.Close()
 
 	var compat []types.OnDemandLPRecord
-	for ; iter.Valid(); iter.Next() {
-		key, err := iter.Key()
+	for _, id := range ids {
+		lpr, err := s.byID.Get(ctx, id)
 		if err != nil {
 			return nil, err
 		}
-		id := key.K3()
+		// the reset is persisted if the lp fills the order
+		lpr.ResetSpendWindow(ctx.BlockTime())
+		if accepts(lpr) {
+			compat = append(compat, lpr)
+		}
+	}
+	return compat, nil
+}
 
-		lpr, err := s.byID.Get(ctx, id)
+// getOrderLPIDs returns the ids of the lps targeting the rollapp and denom, directly or by wildcard, in ascending order
+func (s LPs) getOrderLPIDs(ctx sdk.Context, rol, denom string) ([]uint64, error) {
+	seen := make(map[uint64]struct{})
+	var ids []uint64
+	for _, t := range []types.OnDemandLPTarget{
+		{Rollapp: rol, Denom: denom},
+		{Rollapp: rol, Denom: types.LPWildcard},
+		{Rollapp: types.LPWildcard, Denom: denom},
+		{Rollapp: types.LPWildcard, Denom: types.LPWildcard},
+	} {
+		ranger := collections.NewSuperPrefixedTripleRange[string, string, uint64](t.Rollapp, t.Denom)
+		iter, err := s.byRollAppDenom.Iterate(ctx, ranger)
 		if err != nil {
 			return nil, err
 		}
-		h := uint64(ctx.BlockHeight()) //nolint:gosec
-		if lpr.Accepts(h, &o) {
-			compat = append(compat, lpr)
+		keys, err := iter.Keys()
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			id := key.K3()
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
 		}
 	}
-	return compat, nil
+	slices.Sort(ids)
+	return ids, nil
 }
 
 func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) error {
@@ -200,20 +262,28 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 	if err != nil {
 		return errorsmod.Wrap(err, "get outstanding order")
 	}
+	r := rand.New(rand.NewPCG(rng, 0)) //nolint:gosec // This is used for deterministic shuffling in order processing
+	return k.fulfillByOnDemandLPs(ctx, o, func(lps []types.OnDemandLPRecord) {
+		r.Shuffle(len(lps), func(i, j int) {
+			lps[i], lps[j] = lps[j], lps[i]
+		})
+	})
+}
+
+// fulfillByOnDemandLPs tries the compatible LPs in the order given by sortLPs, falling back
+// to partial fulfillment if no LP can cover the whole price.
+func (k Keeper) fulfillByOnDemandLPs(ctx sdk.Context, o *types.DemandOrder, sortLPs func([]types.OnDemandLPRecord)) error {
 	lps, err := k.LPs.GetOrderCompatibleLPs(ctx, *o)
 	if err != nil {
 		return errorsmod.Wrap(err, "get compatible lp")
 	}
-	r := rand.New(rand.NewPCG(rng, 0)) //nolint:gosec // This is used for deterministic shuffling in order processing
-	r.Shuffle(len(lps), func(i, j int) {
-		lps[i], lps[j] = lps[j], lps[i]
-	})
+	sortLPs(lps)
 	for _, lp := range lps {
 		err := k.fulfillBasic(ctx, o, lp.Lp.MustAddr())
 		if err != nil {
 			if errorsmod.IsOf(err, sdkerrors.ErrInsufficientFunds) {
-				if err := k.LPs.Del(ctx, lp.Id, "out of funds"); err != nil {
-					return errorsmod.Wrapf(err, "delete lp: %d", lp.Id)
+				if err := k.onLPInsufficientFunds(ctx, lp, o.Denom()); err != nil {
+					return err
 				}
 				ctx.Logger().Error("Fulfill via on demand dlp - insufficient funds.", "lp", lp.Id)
 				// note: in case fulfill will get more complicated, we'll need to wrap this with cache ctx
@@ -229,12 +299,89 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 			return errorsmod.Wrap(err, "emit event")
 		}
 		lp.Spent = lp.Spent.Add(o.PriceAmount())
+		lp.InsufficientFundsFailures = 0
+		if err = k.LPs.Set(ctx, lp); err != nil {
+			return errorsmod.Wrap(err, "set lp")
+		}
+		if err = k.LPs.RecordFill(ctx, lp, o.Id, o.PriceAmount(), o.GetFeeAmount()); err != nil {
+			return errorsmod.Wrap(err, "record fill")
+		}
+		return nil
+	}
+	return k.fulfillPartialByOnDemandLPs(ctx, o, sortLPs)
+}
+
+// fulfillPartialByOnDemandLPs fills the order in shares from the LPs which allow partial fulfillment.
+// It succeeds if at least one share was fronted, even if the order is not completely filled.
+func (k Keeper) fulfillPartialByOnDemandLPs(ctx sdk.Context, o *types.DemandOrder, sortLPs func([]types.OnDemandLPRecord)) error {
+	if o.CompletionHook != nil {
+		return errorsmod.Wrap(gerrc.ErrNotFound, "no compatible lp")
+	}
+	lps, err := k.LPs.GetOrderPartialCompatibleLPs(ctx, *o)
+	if err != nil {
+		return errorsmod.Wrap(err, "get partial compatible lp")
+	}
+	sortLPs(lps)
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	matched := false
+	for _, lp := range lps {
+		if o.UnfilledAmount().IsZero() {
+			break
+		}
+		amt := lp.AcceptsPartial(h, o)
+		if !amt.IsPositive() {
+			continue
+		}
+		err := k.fulfillPartial(ctx, o, lp.Lp.MustAddr(), amt)
+		if err != nil {
+			if errorsmod.IsOf(err, sdkerrors.ErrInsufficientFunds) {
+				if err := k.onLPInsufficientFunds(ctx, lp, o.Denom()); err != nil {
+					return err
+				}
+				ctx.Logger().Error("Partial fulfill via on demand dlp - insufficient funds.", "lp", lp.Id)
+				continue
+			}
+			return errorsmod.Wrap(err, "fulfill partial lp")
+		}
+		if err = uevent.EmitTypedEvent(ctx, &types.EventMatchedOnDemandLP{
+			OrderId:   o.Id,
+			LpId:      lp.Id,
+			Fulfiller: lp.Lp.MustAddr().String(),
+		}); err != nil {
+			return errorsmod.Wrap(err, "emit event")
+		}
+		lp.Spent = lp.Spent.Add(amt)
+		lp.InsufficientFundsFailures = 0
 		if err = k.LPs.Set(ctx, lp); err != nil {
 			return errorsmod.Wrap(err, "set lp")
 		}
+		if err = k.LPs.RecordFill(ctx, lp, o.Id, amt, o.PartialFeeShare(amt)); err != nil {
+			return errorsmod.Wrap(err, "record fill")
+		}
+		matched = true
+	}
+	if !matched {
+		return errorsmod.Wrap(gerrc.ErrNotFound, "no compatible lp")
+	}
+	return nil
+}
+
+// onLPInsufficientFunds is called when the lp cannot pay for an order. The lp is skipped for the order,
+// and deleted if it fills orders in the order denom alone and has no balance of it left, or if it failed
+// for insufficient funds too many times in a row.
+func (k Keeper) onLPInsufficientFunds(ctx sdk.Context, lp types.OnDemandLPRecord, denom string) error {
+	lp.InsufficientFundsFailures++
+	drained := !lp.Lp.MultiDenom() && !k.bk.SpendableCoins(ctx, lp.Lp.MustAddr()).AmountOf(denom).IsPositive()
+	if !drained && lp.InsufficientFundsFailures < types.MaxLPInsufficientFundsFailures {
+		if err := k.LPs.Set(ctx, lp); err != nil {
+			return errorsmod.Wrapf(err, "set lp: %d", lp.Id)
+		}
 		return nil
 	}
-	return errorsmod.Wrap(gerrc.ErrNotFound, "no compatible lp")
+	if err := k.LPs.Del(ctx, lp.Id, "out of funds"); err != nil {
+		return errorsmod.Wrapf(err, "delete lp: %d", lp.Id)
+	}
+	return nil
 }
 
 func (k Keeper) CreateLP(ctx sdk.Context, lp *types.OnDemandLP) (uint64, error) {
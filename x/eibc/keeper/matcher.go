@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var MatcherCursorPrefix = collections.NewPrefix("matcher0")
+
+// MatchOnDemandLPs is called in EndBlock. It scans up to MatcherMaxOrders pending orders, resuming
+// where the previous block stopped, and fills them from the on-demand LPs, best fee first, until the
+// gas budget is spent. Each order is handled in its own cache context, so a failing (or panicking)
+// order is skipped without affecting the others.
+func (k Keeper) MatchOnDemandLPs(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.MatcherMaxOrders == 0 {
+		return nil
+	}
+
+	// track the gas separately, the end block gas meter is infinite
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	candidates, err := k.matcherCandidates(ctx, params.MatcherMaxOrders)
+	if err != nil {
+		return errorsmod.Wrap(err, "matcher candidates")
+	}
+
+	// the order might be finalized already, and the fee schedule must be applied before sorting
+	orders := make([]*types.DemandOrder, 0, len(candidates))
+	for _, candidate := range candidates {
+		var o *types.DemandOrder
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			var err error
+			o, err = k.GetOutstandingOrder(ctx, candidate.Id)
+			return err
+		})
+		if err != nil {
+			continue
+		}
+		orders = append(orders, o)
+	}
+	sortOrdersBestFeeFirst(orders)
+
+	for _, o := range orders {
+		if params.MatcherGasBudget <= ctx.GasMeter().GasConsumed() {
+			break
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.fulfillByOnDemandLPs(ctx, o, sortLPsBestFirst)
+		})
+		if err != nil && !errorsmod.IsOf(err, gerrc.ErrNotFound) {
+			k.Logger(ctx).Error("Match on demand lp.", "order", o.Id, "err", err)
+		}
+	}
+	return nil
+}
+
+// matcherCandidates returns the pending orders which are not fulfilled yet and have on-demand LPs for their
+// rollapp and denom, out of the next max orders after the cursor. When the end is reached, the next scan
+// starts from the beginning, so that each order is eventually considered.
+func (k Keeper) matcherCandidates(ctx sdk.Context, max uint64) ([]*types.DemandOrder, error) {
+	cursor, err := k.matcherCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(err, "get cursor")
+	}
+
+	pageReq := &query.PageRequest{Key: []byte(cursor), Limit: max}
+	orders, pageResp, err := k.ListDemandOrdersByStatusPaginated(ctx, commontypes.Status_PENDING, pageReq,
+		isNotFulfilled(), k.hasOnDemandLPs(ctx))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "list demand orders")
+	}
+
+	next := string(pageResp.GetNextKey())
+	if next != cursor {
+		if err := k.matcherCursor.Set(ctx, next); err != nil {
+			return nil, errorsmod.Wrap(err, "set cursor")
+		}
+	}
+	return orders, nil
+}
+
+func isNotFulfilled() filterOption {
+	return func(order types.DemandOrder) bool {
+		return !order.IsFulfilled()
+	}
+}
+
+// hasOnDemandLPs filters the orders by the LPs rollapp and denom index
+func (k Keeper) hasOnDemandLPs(ctx sdk.Context) filterOption {
+	return func(order types.DemandOrder) bool {
+		ids, err := k.LPs.getOrderLPIDs(ctx, order.RollappId, order.Denom())
+		return err == nil && len(ids) > 0
+	}
+}
+
+// sortOrdersBestFeeFirst sorts by fee percentage, descending. Ties are broken by id.
+func sortOrdersBestFeeFirst(orders []*types.DemandOrder) {
+	sort.SliceStable(orders, func(i, j int) bool {
+		fi, fj := orders[i].GetFeePercent(), orders[j].GetFeePercent()
+		if !fi.Equal(fj) {
+			return fi.GT(fj)
+		}
+		return orders[i].Id < orders[j].Id
+	})
+}
+
+// sortLPsBestFirst sorts by min fee, ascending, so the cheapest LP is tried first. Ties are broken by id.
+func sortLPsBestFirst(lps []types.OnDemandLPRecord) {
+	sort.SliceStable(lps, func(i, j int) bool {
+		fi, fj := lps[i].Lp.MinFee, lps[j].Lp.MinFee
+		if !fi.Equal(fj) {
+			return fi.LT(fj)
+		}
+		return lps[i].Id < lps[j].Id
+	})
+}
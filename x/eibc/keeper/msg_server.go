@@ -75,6 +75,34 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 	return &types.MsgFulfillOrderResponse{}, nil
 }
 
+func (m msgServer) FulfillOrderPartial(goCtx context.Context, msg *types.MsgFulfillOrderPartial) (*types.MsgFulfillOrderPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	demandOrder, err := m.GetOutstandingOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the fulfiller expected fee is equal to the demand order fee
+	expectedFee, _ := math.NewIntFromString(msg.ExpectedFee)
+	orderFee := demandOrder.GetFeeAmount()
+	if !orderFee.Equal(expectedFee) {
+		return nil, types.ErrExpectedFeeNotMet
+	}
+
+	err = m.fulfillPartial(ctx, demandOrder, msg.GetFulfillerBech32Address(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFulfillOrderPartialResponse{}, nil
+}
+
 func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgFulfillOrderAuthorized) (*types.MsgFulfillOrderAuthorizedResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := ctx.Logger()
@@ -198,6 +226,11 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		return nil, err
 	}
 
+	// The payout of partial fulfillers relies on the fee set at fulfillment
+	if demandOrder.IsPartiallyFulfilled() {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
+
 	// Check that the signer is the order owner
 	orderOwner := demandOrder.GetRecipientBech32Address()
 	msgSigner := msg.GetSignerAddr()
@@ -236,6 +269,15 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 	demandOrder.Fee = sdk.NewCoins(sdk.NewCoin(denom, newFeeInt))
 	demandOrder.Price = sdk.NewCoins(sdk.NewCoin(denom, newPrice))
 
+	// a fixed fee replaces any existing schedule
+	demandOrder.FeeSchedule = nil
+	if msg.HasFeeSchedule() {
+		demandOrder.FeeSchedule, err = msg.FeeSchedule(uint64(ctx.BlockHeight()))
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
 	if err = m.SetDemandOrder(ctx, demandOrder); err != nil {
 		return nil, err
 	}
@@ -247,6 +289,45 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 	return &types.MsgUpdateDemandOrderResponse{}, nil
 }
 
+// CancelDemandOrder implements types.MsgServer.
+func (m msgServer) CancelDemandOrder(goCtx context.Context, msg *types.MsgCancelDemandOrder) (*types.MsgCancelDemandOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the order exists in status PENDING, and is not fulfilled
+	demandOrder, err := m.GetOutstandingOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The partial fulfillers are paid out of the order on finalization
+	if demandOrder.IsPartiallyFulfilled() {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
+
+	// The completion hook is only run through the order on finalization
+	if demandOrder.CompletionHook != nil {
+		return nil, types.ErrCancelWithCompletionHook
+	}
+
+	// Check that the signer is the order owner
+	orderOwner := demandOrder.GetRecipientBech32Address()
+	msgSigner := msg.GetSignerAddr()
+	if !msgSigner.Equals(orderOwner) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the recipient can cancel the order")
+	}
+
+	if err = m.CancelOrder(ctx, demandOrder); err != nil {
+		return nil, errorsmod.Wrap(err, "cancel order")
+	}
+
+	return &types.MsgCancelDemandOrderResponse{}, nil
+}
+
 func (m msgServer) TryFulfillOnDemand(goCtx context.Context, msg *types.MsgTryFulfillOnDemand) (*types.MsgTryFulfillOnDemandResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -682,6 +682,55 @@ func (suite *KeeperTestSuite) TestMsgUpdateDemandOrder() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgCancelDemandOrder() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(100_000))
+	recipient := testAddresses[0]
+	denom, err := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	suite.Require().NoError(err)
+
+	newOrder := func(seq uint64, hook *commontypes.CompletionHookCall) *types.DemandOrder {
+		p := *rollappPacket
+		pkt := *p.Packet
+		pkt.Sequence = seq
+		p.Packet = &pkt
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, p)
+		o := types.NewDemandOrder(p, math.NewInt(890), math.NewInt(100), denom, recipient.String(), 1, hook)
+		suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, o))
+		return o
+	}
+
+	// only the recipient can cancel
+	order := newOrder(1, nil)
+	_, err = suite.msgServer.CancelDemandOrder(suite.Ctx, types.NewMsgCancelDemandOrder(testAddresses[1].String(), order.Id))
+	suite.Require().Error(err)
+
+	// the completion hook would be lost
+	withHook := newOrder(2, &commontypes.CompletionHookCall{Name: "hook"})
+	_, err = suite.msgServer.CancelDemandOrder(suite.Ctx, types.NewMsgCancelDemandOrder(recipient.String(), withHook.Id))
+	suite.Require().ErrorIs(err, types.ErrCancelWithCompletionHook)
+
+	// fulfilled orders cannot be cancelled
+	fulfilled := newOrder(3, nil)
+	fulfilled.FulfillerAddress = testAddresses[1].String()
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, fulfilled))
+	_, err = suite.msgServer.CancelDemandOrder(suite.Ctx, types.NewMsgCancelDemandOrder(recipient.String(), fulfilled.Id))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.CancelDemandOrder(suite.Ctx, types.NewMsgCancelDemandOrder(recipient.String(), order.Id))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(suite.Ctx, proto.MessageName(&types.EventDemandOrderCancelled{}), 1)
+
+	_, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().ErrorIs(err, types.ErrDemandOrderDoesNotExist)
+	packet, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, order.TrackingPacketKey)
+	suite.Require().NoError(err)
+	suite.Require().True(packet.EibcOrderCancelled)
+
+	// already cancelled
+	_, err = suite.msgServer.CancelDemandOrder(suite.Ctx, types.NewMsgCancelDemandOrder(recipient.String(), order.Id))
+	suite.Require().ErrorIs(err, types.ErrDemandOrderDoesNotExist)
+}
+
 func (suite *KeeperTestSuite) TestUpdateDemandOrderOnAckOrTimeout() {
 	// Create and fund the account
 	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(100_000))
@@ -830,3 +879,121 @@ func (suite *KeeperTestSuite) TestMsgOnDemandLPFlow() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderPartial() {
+	denom := sdk.DefaultBondDenom
+	tests := []struct {
+		name              string
+		hook              *commontypes.CompletionHookCall
+		fills             []math.Int
+		expectErr         error
+		expectFilled      math.Int
+		expectIsFulfilled bool
+	}{
+		{
+			name:         "single partial fill",
+			fills:        []math.Int{math.NewInt(30)},
+			expectFilled: math.NewInt(30),
+		},
+		{
+			name:              "partial fills cover the price",
+			fills:             []math.Int{math.NewInt(30), math.NewInt(60)},
+			expectFilled:      math.NewInt(90),
+			expectIsFulfilled: true,
+		},
+		{
+			name:         "partial fill above unfilled price",
+			fills:        []math.Int{math.NewInt(50), math.NewInt(50)},
+			expectErr:    types.ErrPartialFillTooLarge,
+			expectFilled: math.NewInt(50),
+		},
+		{
+			name:         "order with completion hook",
+			hook:         &commontypes.CompletionHookCall{Name: "foo"},
+			fills:        []math.Int{math.NewInt(30)},
+			expectErr:    types.ErrPartialFillNotSupported,
+			expectFilled: math.ZeroInt(),
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			recipient := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.ZeroInt())[0]
+			fulfillers := apptesting.AddTestAddrs(suite.App, suite.Ctx, len(tc.fills), math.NewInt(1000))
+
+			rPacket := *rollappPacket
+			suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+			order := types.NewDemandOrder(rPacket, math.NewInt(90), math.NewInt(10), denom, recipient.String(), 1, tc.hook)
+			suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, order))
+
+			var err error
+			for i, amt := range tc.fills {
+				msg := types.NewMsgFulfillOrderPartial(fulfillers[i].String(), order.Id, "10", amt)
+				_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, msg)
+				if err != nil {
+					break
+				}
+			}
+			if tc.expectErr != nil {
+				suite.Require().True(errorsmod.IsOf(err, tc.expectErr), err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			got, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+			suite.Require().NoError(err)
+			suite.Require().True(tc.expectFilled.Equal(got.FilledAmount()), "filled: %s", got.FilledAmount())
+			suite.Require().Equal(tc.expectIsFulfilled, got.IsFulfilled())
+			recipientBal := suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount
+			suite.Require().True(tc.expectFilled.Equal(recipientBal), "recipient balance: %s", recipientBal)
+
+			if got.IsPartiallyFulfilled() {
+				// the packet is redirected to the escrow, and the order is out of the regular fulfillment flow
+				p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, got.TrackingPacketKey)
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.PartialFulfillmentEscrowAddr().String(), p.MustGetTransferPacketData().Receiver)
+
+				_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfillers[0].String(), order.Id, "10"))
+				suite.Require().Error(err)
+				_, err = suite.msgServer.UpdateDemandOrder(suite.Ctx, types.NewMsgUpdateDemandOrder(recipient.String(), order.Id, "20"))
+				suite.Require().True(errorsmod.IsOf(err, types.ErrDemandOrderPartiallyFilled), err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderWithFeeSchedule() {
+	denom := sdk.DefaultBondDenom
+	recipient := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.ZeroInt())[0]
+	fulfiller := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))[0]
+
+	rPacket := *rollappPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rPacket)
+	order := types.NewDemandOrder(rPacket, math.NewInt(900), math.NewInt(100), denom, recipient.String(), 1, nil)
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, order))
+
+	// let the fee decay by 10 per block, down to 40
+	ctx := suite.Ctx.WithBlockHeight(1)
+	msgUpdate := types.NewMsgUpdateDemandOrderWithSchedule(recipient.String(), order.Id, "100", "40", "10")
+	_, err := suite.msgServer.UpdateDemandOrder(ctx, msgUpdate)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockHeight(4)
+	res, err := keeper.NewQuerier(suite.App.EIBCKeeper).DemandOrderById(ctx, &types.QueryGetDemandOrderRequest{Id: order.Id})
+	suite.Require().NoError(err)
+	suite.Require().True(math.NewInt(70).Equal(res.DemandOrder.GetFeeAmount()))
+	expectPrice := res.DemandOrder.PriceAmount()
+
+	// the fee expected by the fulfiller must be the current one
+	_, err = suite.msgServer.FulfillOrder(ctx, types.NewMsgFulfillOrder(fulfiller.String(), order.Id, "100"))
+	suite.Require().ErrorIs(err, types.ErrExpectedFeeNotMet)
+	_, err = suite.msgServer.FulfillOrder(ctx, types.NewMsgFulfillOrder(fulfiller.String(), order.Id, "70"))
+	suite.Require().NoError(err)
+
+	got, err := suite.App.EIBCKeeper.GetDemandOrder(ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().Nil(got.FeeSchedule)
+	suite.Require().True(math.NewInt(70).Equal(got.GetFeeAmount()))
+	suite.Require().True(expectPrice.Equal(got.PriceAmount()))
+	suite.Require().True(expectPrice.Equal(suite.App.BankKeeper.GetBalance(ctx, recipient, denom).Amount))
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// OrderBooks summarizes the outstanding orders of a page of pending orders per rollapp and denom, optionally
+// filtered by rollapp and denom. The totals of the books are local to the page, the books of a rollapp and denom
+// on all the pages add up to its full book. The fee schedules are applied at the current height. The books are
+// sorted by rollapp, then denom.
+func (k Keeper) OrderBooks(
+	ctx sdk.Context,
+	rollappID, denom string,
+	feeBounds []math.LegacyDec,
+	ageBounds []uint64,
+	pageReq *query.PageRequest,
+) ([]*types.OrderBook, *query.PageResponse, error) {
+	opts := []filterOption{isFulfillmentState(types.FulfillmentState_UNFULFILLED)}
+	if rollappID != "" {
+		opts = append(opts, isRollappId(rollappID))
+	}
+	if denom != "" {
+		opts = append(opts, isDenom(denom))
+	}
+	orders, pageResp, err := k.ListDemandOrdersByStatusPaginated(ctx, commontypes.Status_PENDING, pageReq, opts...)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "list demand orders")
+	}
+
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	type key struct{ rollapp, denom string }
+	books := make(map[key]*types.OrderBook)
+	for _, o := range orders {
+		o.ApplyFeeSchedule(h)
+		bk := key{o.RollappId, o.Denom()}
+		b, ok := books[bk]
+		if !ok {
+			b = types.NewOrderBook(bk.rollapp, bk.denom, feeBounds, ageBounds)
+			books[bk] = b
+		}
+		b.Add(o, h)
+	}
+
+	ret := make([]*types.OrderBook, 0, len(books))
+	for _, b := range books {
+		ret = append(ret, b)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].RollappId != ret[j].RollappId {
+			return ret[i].RollappId < ret[j].RollappId
+		}
+		return ret[i].Denom < ret[j].Denom
+	})
+	return ret, pageResp, nil
+}
@@ -11,15 +11,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -94,8 +99,15 @@ type RollappCriteria struct {
 	// been settlement validated
 	SettlementValidated bool `protobuf:"varint,6,opt,name=settlement_validated,json=settlementValidated,proto3" json:"settlement_validated,omitempty"`
 	// spend_limit is the optional maximum amount of coins that can be spent by
-	// the grantee
+	// the grantee. If spend_window is set, it is the maximum per window.
 	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// spend_window is the optional duration after which the spent amount
+	// resets. If zero, spend_limit is a lifetime cap.
+	SpendWindow time.Duration `protobuf:"bytes,8,opt,name=spend_window,json=spendWindow,proto3,stdduration" json:"spend_window,omitempty"`
+	// window_spent is the amount spent in the current window
+	WindowSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=window_spent,json=windowSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"window_spent,omitempty"`
+	// window_start is the start time of the current window
+	WindowStart time.Time `protobuf:"bytes,10,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start,omitempty"`
 }
 
 func (m *RollappCriteria) Reset()         { *m = RollappCriteria{} }
@@ -166,6 +178,27 @@ func (m *RollappCriteria) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *RollappCriteria) GetSpendWindow() time.Duration {
+	if m != nil {
+		return m.SpendWindow
+	}
+	return 0
+}
+
+func (m *RollappCriteria) GetWindowSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WindowSpent
+	}
+	return nil
+}
+
+func (m *RollappCriteria) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*FulfillOrderAuthorization)(nil), "dymensionxyz.dymension.eibc.FulfillOrderAuthorization")
 	proto.RegisterType((*RollappCriteria)(nil), "dymensionxyz.dymension.eibc.RollappCriteria")
@@ -176,40 +209,49 @@ func init() {
 }
 
 var fileDescriptor_b67acbbd9757b985 = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x31, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc6, 0xe3, 0x7f, 0xfa, 0x0f, 0xc9, 0x75, 0xa0, 0x32, 0x15, 0x72, 0x5a, 0xe1, 0x44, 0x19,
-	0x20, 0x03, 0xb1, 0x09, 0xdd, 0xd8, 0x48, 0x51, 0x04, 0xa2, 0x12, 0x95, 0x91, 0x3a, 0xb0, 0x58,
-	0x17, 0xdf, 0x5b, 0xfb, 0x54, 0xdf, 0x9d, 0x75, 0x77, 0x89, 0x92, 0xcc, 0xac, 0x48, 0x7c, 0x8e,
-	0xce, 0xfd, 0x10, 0x15, 0x53, 0xc5, 0x84, 0x18, 0x5a, 0x48, 0xbe, 0x08, 0xb2, 0x7d, 0x35, 0x05,
-	0x89, 0x4e, 0x30, 0xd9, 0xcf, 0x3d, 0xef, 0xfb, 0xbc, 0x3f, 0x9f, 0xf5, 0xa2, 0x47, 0x64, 0xc1,
-	0x80, 0x2b, 0x2a, 0xf8, 0x7c, 0xb1, 0xf4, 0x2b, 0xe1, 0x03, 0x9d, 0x44, 0x3e, 0x9e, 0xea, 0x64,
-	0xe9, 0x65, 0x52, 0x68, 0x61, 0xef, 0xde, 0x2c, 0xf4, 0x2a, 0xe1, 0xe5, 0x85, 0x3b, 0xdb, 0xb1,
-	0x88, 0x45, 0x51, 0xe7, 0xe7, 0x6f, 0x65, 0xcb, 0x4e, 0x3b, 0x12, 0x8a, 0x09, 0x15, 0x96, 0x46,
-	0x29, 0x8c, 0xe5, 0x96, 0xca, 0x9f, 0x60, 0x05, 0xfe, 0x6c, 0x38, 0x01, 0x8d, 0x87, 0x7e, 0x24,
-	0x28, 0x2f, 0xfd, 0xde, 0x07, 0x0b, 0xb5, 0xc7, 0xd3, 0xf4, 0x98, 0xa6, 0xe9, 0x1b, 0x49, 0x40,
-	0x3e, 0x9f, 0xea, 0x44, 0x48, 0xba, 0xc4, 0x9a, 0x0a, 0x6e, 0xbf, 0x44, 0x4d, 0x29, 0xd2, 0x14,
-	0x67, 0x99, 0x72, 0xac, 0x6e, 0xbd, 0xbf, 0xf9, 0xf4, 0xb1, 0x77, 0x0b, 0x9e, 0x17, 0x94, 0xc5,
-	0xfb, 0x92, 0x6a, 0x90, 0x14, 0x07, 0x55, 0xf7, 0xb3, 0x87, 0x9f, 0xce, 0x06, 0x3d, 0x43, 0x56,
-	0x7e, 0xad, 0x81, 0xf1, 0x7e, 0x99, 0xd8, 0xfb, 0xbe, 0x81, 0xee, 0xfe, 0x96, 0x62, 0x3f, 0x40,
-	0xc8, 0xe4, 0x84, 0x94, 0x38, 0x56, 0xd7, 0xea, 0xb7, 0x82, 0x96, 0x39, 0x79, 0x45, 0xec, 0xfb,
-	0xa8, 0x41, 0x80, 0x0b, 0xa6, 0x9c, 0xff, 0xba, 0xf5, 0x7e, 0x2b, 0x30, 0xca, 0x4e, 0x50, 0x8b,
-	0xe1, 0x79, 0x98, 0x49, 0x1a, 0x81, 0x53, 0x2f, 0xe8, 0xdb, 0x9e, 0x41, 0xc8, 0xaf, 0xa3, 0x22,
-	0xd8, 0x17, 0x94, 0x8f, 0x9e, 0x9c, 0x5f, 0x76, 0x6a, 0xa7, 0x57, 0x9d, 0x7e, 0x4c, 0x75, 0x32,
-	0x9d, 0x78, 0x91, 0x60, 0xe6, 0x26, 0xcd, 0x63, 0xa0, 0xc8, 0x89, 0xaf, 0x17, 0x19, 0xa8, 0xa2,
-	0x41, 0x05, 0x4d, 0x86, 0xe7, 0x87, 0x79, 0xb8, 0xfd, 0xde, 0x42, 0x36, 0xa3, 0x3c, 0x3c, 0x06,
-	0x08, 0x33, 0x90, 0x11, 0x70, 0x8d, 0x63, 0x70, 0x36, 0x72, 0xd2, 0xd1, 0x51, 0x1e, 0xfc, 0xf5,
-	0xb2, 0xb3, 0x5b, 0xc6, 0x28, 0x72, 0xe2, 0x51, 0xe1, 0x33, 0xac, 0x13, 0xef, 0x00, 0x62, 0x1c,
-	0x2d, 0x5e, 0x40, 0x74, 0x7a, 0x75, 0xab, 0xfd, 0xf9, 0x6c, 0xb0, 0x65, 0xc0, 0xab, 0xb3, 0x60,
-	0x8b, 0x51, 0x3e, 0x06, 0x38, 0xac, 0xe6, 0x15, 0x18, 0x22, 0x03, 0x89, 0xb5, 0x90, 0x05, 0x8b,
-	0x4a, 0xb0, 0x04, 0xe7, 0xff, 0x7f, 0x8b, 0x71, 0x3d, 0x71, 0x0c, 0xf0, 0x36, 0x9f, 0x67, 0x0f,
-	0xd1, 0xb6, 0x02, 0xad, 0x53, 0x60, 0xc0, 0x75, 0x38, 0xc3, 0x29, 0x25, 0x58, 0x03, 0x71, 0x1a,
-	0x5d, 0xab, 0xdf, 0x0c, 0xee, 0xfd, 0xf4, 0x8e, 0xae, 0x2d, 0x3b, 0x45, 0x9b, 0x2a, 0x03, 0x4e,
-	0xc2, 0x94, 0x32, 0xaa, 0x9d, 0x3b, 0x7f, 0xff, 0x67, 0xa1, 0x22, 0xff, 0x20, 0x8f, 0x1f, 0xbd,
-	0x3e, 0x5f, 0xb9, 0xd6, 0xc5, 0xca, 0xb5, 0xbe, 0xad, 0x5c, 0xeb, 0xe3, 0xda, 0xad, 0x5d, 0xac,
-	0xdd, 0xda, 0x97, 0xb5, 0x5b, 0x7b, 0x37, 0xbc, 0x91, 0xf7, 0x87, 0x7d, 0x9d, 0xed, 0xf9, 0xf3,
-	0x72, 0x69, 0x8b, 0xf8, 0x49, 0xa3, 0xd8, 0xa3, 0xbd, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf9,
-	0x63, 0x94, 0xe0, 0xe0, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x4f, 0xd4, 0x4c,
+	0x18, 0xdf, 0xbe, 0xbc, 0x2f, 0xec, 0x0e, 0x24, 0x2f, 0xa9, 0x84, 0x14, 0x88, 0xdd, 0xcd, 0x1e,
+	0x74, 0x0f, 0xd0, 0xba, 0x72, 0xf3, 0xe6, 0x42, 0x88, 0x7f, 0x48, 0x24, 0xc5, 0x60, 0xe2, 0xa5,
+	0xce, 0xb6, 0x0f, 0xdd, 0x09, 0x9d, 0x4e, 0x33, 0x33, 0x0b, 0xbb, 0x9c, 0x3d, 0x78, 0x31, 0xe1,
+	0xe8, 0x67, 0xe0, 0x66, 0xc2, 0x87, 0x20, 0x9e, 0x88, 0x27, 0xe3, 0x01, 0x0c, 0xdc, 0xfc, 0x14,
+	0x66, 0x66, 0xba, 0x7f, 0xc4, 0x88, 0x31, 0xd1, 0x53, 0xfb, 0xcc, 0xef, 0x79, 0x7e, 0x7f, 0x66,
+	0x32, 0x83, 0xee, 0xc6, 0x7d, 0x0a, 0x99, 0x20, 0x2c, 0xeb, 0xf5, 0x0f, 0xfd, 0x61, 0xe1, 0x03,
+	0x69, 0x47, 0x3e, 0xee, 0xca, 0xce, 0xa1, 0x97, 0x73, 0x26, 0x99, 0xbd, 0x34, 0xde, 0xe8, 0x0d,
+	0x0b, 0x4f, 0x35, 0x2e, 0xce, 0x25, 0x2c, 0x61, 0xba, 0xcf, 0x57, 0x7f, 0x66, 0x64, 0x71, 0x21,
+	0x62, 0x82, 0x32, 0x11, 0x1a, 0xc0, 0x14, 0x05, 0xe4, 0x9a, 0xca, 0x6f, 0x63, 0x01, 0xfe, 0x7e,
+	0xb3, 0x0d, 0x12, 0x37, 0xfd, 0x88, 0x91, 0x6c, 0x80, 0x27, 0x8c, 0x25, 0x29, 0xf8, 0xba, 0x6a,
+	0x77, 0x77, 0xfd, 0xb8, 0xcb, 0xb1, 0x54, 0x7a, 0x06, 0xaf, 0x5e, 0xc7, 0x25, 0xa1, 0x20, 0x24,
+	0xa6, 0xb9, 0x69, 0xa8, 0xbf, 0xb5, 0xd0, 0xc2, 0x46, 0x37, 0xdd, 0x25, 0x69, 0xfa, 0x8c, 0xc7,
+	0xc0, 0x1f, 0x76, 0x65, 0x87, 0x71, 0x72, 0xa8, 0x49, 0xec, 0x47, 0xa8, 0xcc, 0x59, 0x9a, 0xe2,
+	0x3c, 0x17, 0x8e, 0x55, 0x9b, 0x68, 0x4c, 0xdf, 0x5f, 0xf6, 0x6e, 0xc8, 0xe7, 0x05, 0xa6, 0x79,
+	0x8d, 0x13, 0x09, 0x9c, 0xe0, 0x60, 0x38, 0xfd, 0xe0, 0xce, 0x87, 0x93, 0x95, 0x7a, 0x11, 0xcd,
+	0x6c, 0x57, 0x91, 0xc6, 0xfb, 0x4e, 0xb1, 0xfe, 0x7e, 0x0a, 0xfd, 0x7f, 0x8d, 0xc5, 0xbe, 0x8d,
+	0x50, 0xc1, 0x13, 0x92, 0xd8, 0xb1, 0x6a, 0x56, 0xa3, 0x12, 0x54, 0x8a, 0x95, 0xc7, 0xb1, 0x3d,
+	0x8f, 0x26, 0x63, 0xc8, 0x18, 0x15, 0xce, 0x3f, 0xb5, 0x89, 0x46, 0x25, 0x28, 0x2a, 0xbb, 0x83,
+	0x2a, 0x14, 0xf7, 0xc2, 0x9c, 0x93, 0x08, 0x9c, 0x09, 0xed, 0x7e, 0xc1, 0x2b, 0x2c, 0xa8, 0xfd,
+	0x1c, 0x3a, 0x58, 0x63, 0x24, 0x6b, 0xdd, 0x3b, 0x3d, 0xaf, 0x96, 0x8e, 0x2f, 0xaa, 0x8d, 0x84,
+	0xc8, 0x4e, 0xb7, 0xed, 0x45, 0x8c, 0x16, 0x47, 0x51, 0x7c, 0x56, 0x44, 0xbc, 0xe7, 0xcb, 0x7e,
+	0x0e, 0x42, 0x0f, 0x88, 0xa0, 0x4c, 0x71, 0x6f, 0x4b, 0x91, 0xdb, 0xaf, 0x2d, 0x64, 0x53, 0x92,
+	0x85, 0xbb, 0x00, 0x61, 0x0e, 0x3c, 0x82, 0x4c, 0xe2, 0x04, 0x9c, 0x7f, 0x95, 0xd3, 0xd6, 0x8e,
+	0x22, 0xfe, 0x7c, 0x5e, 0x5d, 0x32, 0x34, 0x22, 0xde, 0xf3, 0x08, 0xf3, 0x29, 0x96, 0x1d, 0x6f,
+	0x13, 0x12, 0x1c, 0xf5, 0xd7, 0x21, 0x3a, 0xbe, 0xb8, 0x11, 0xfe, 0x78, 0xb2, 0x32, 0x5b, 0x18,
+	0x1f, 0xae, 0x05, 0xb3, 0x94, 0x64, 0x1b, 0x00, 0x5b, 0x43, 0x3d, 0x6d, 0x83, 0xe5, 0xc0, 0xb1,
+	0x64, 0x5c, 0x7b, 0x11, 0x1d, 0xcc, 0xc1, 0xf9, 0xef, 0xef, 0xda, 0x18, 0x28, 0x6e, 0x00, 0x6c,
+	0x2b, 0x3d, 0xbb, 0x89, 0xe6, 0x04, 0x48, 0x99, 0x02, 0x85, 0x4c, 0x86, 0xfb, 0x38, 0x25, 0x31,
+	0x96, 0x10, 0x3b, 0x93, 0x35, 0xab, 0x51, 0x0e, 0x6e, 0x8d, 0xb0, 0x9d, 0x01, 0x64, 0xa7, 0x68,
+	0x5a, 0xe4, 0x90, 0xc5, 0x61, 0x4a, 0x28, 0x91, 0xce, 0xd4, 0x9f, 0x3f, 0x2c, 0xa4, 0xf9, 0x37,
+	0x15, 0xbd, 0xfd, 0x0a, 0xcd, 0x18, 0xb5, 0x03, 0x92, 0xc5, 0xec, 0xc0, 0x29, 0xd7, 0x2c, 0x2d,
+	0x67, 0xee, 0x8a, 0x37, 0xb8, 0x2b, 0xde, 0x7a, 0x71, 0x97, 0x5a, 0x75, 0x25, 0xf7, 0xf5, 0xbc,
+	0x3a, 0x3f, 0x3e, 0xb6, 0xcc, 0x28, 0x91, 0x40, 0x73, 0xd9, 0x7f, 0x77, 0x51, 0xb5, 0x02, 0x13,
+	0xe0, 0x85, 0x86, 0xec, 0x37, 0x16, 0x9a, 0x31, 0x5d, 0xa1, 0x5a, 0x96, 0x4e, 0xe5, 0x57, 0x89,
+	0x9e, 0x0c, 0x24, 0xc6, 0xc7, 0x46, 0x12, 0xbf, 0x95, 0x75, 0xda, 0x70, 0x6c, 0x2b, 0x0a, 0x1b,
+	0x8f, 0x9c, 0x48, 0xcc, 0xa5, 0x83, 0x74, 0xd8, 0xc5, 0x1f, 0xc2, 0x3e, 0x1f, 0x3c, 0x0c, 0xa3,
+	0xb4, 0xe3, 0x73, 0x23, 0x2b, 0x47, 0x3a, 0x6d, 0x21, 0xa1, 0xa0, 0xd6, 0xd3, 0xd3, 0x4b, 0xd7,
+	0x3a, 0xbb, 0x74, 0xad, 0x2f, 0x97, 0xae, 0x75, 0x74, 0xe5, 0x96, 0xce, 0xae, 0xdc, 0xd2, 0xa7,
+	0x2b, 0xb7, 0xf4, 0xb2, 0x39, 0xe6, 0xf9, 0x27, 0x0f, 0xe8, 0xfe, 0xaa, 0xdf, 0x33, 0xaf, 0xa8,
+	0x8e, 0xd0, 0x9e, 0xd4, 0x8e, 0x56, 0xbf, 0x0d, 0x00, 0x9f, 0xa3, 0x39, 0xae, 0x71, 0x05, 0x00,
+	0x00,
 }
 
 func (m *FulfillOrderAuthorization) Marshal() (dAtA []byte, err error) {
@@ -269,6 +311,36 @@ func (m *RollappCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if len(m.WindowSpent) > 0 {
+		for iNdEx := len(m.WindowSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindowSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SpendWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
 	if len(m.SpendLimit) > 0 {
 		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -407,6 +479,16 @@ func (m *RollappCriteria) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindow)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.WindowSpent) > 0 {
+		for _, e := range m.WindowSpent {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -749,6 +831,106 @@ func (m *RollappCriteria) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SpendWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowSpent = append(m.WindowSpent, types.Coin{})
+			if err := m.WindowSpent[len(m.WindowSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -10,8 +10,10 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFulfillOrder{}, "eibc/MsgFulfillOrder", nil)
+	cdc.RegisterConcrete(&MsgFulfillOrderPartial{}, "eibc/MsgFulfillOrderPartial", nil)
 	cdc.RegisterConcrete(&MsgFulfillOrderAuthorized{}, "eibc/MsgFulfillOrderAuthorized", nil)
 	cdc.RegisterConcrete(&MsgUpdateDemandOrder{}, "eibc/MsgUpdateDemandOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelDemandOrder{}, "eibc/MsgCancelDemandOrder", nil)
 	cdc.RegisterConcrete(&MsgCreateOnDemandLP{}, "eibc/CreateOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgDeleteOnDemandLP{}, "eibc/DeleteOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgTryFulfillOnDemand{}, "eibc/TryFulfillOnDemand", nil)
@@ -25,8 +27,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgFulfillOrder{},
+		&MsgFulfillOrderPartial{},
 		&MsgFulfillOrderAuthorized{},
 		&MsgUpdateDemandOrder{},
+		&MsgCancelDemandOrder{},
 		&MsgCreateOnDemandLP{},
 		&MsgDeleteOnDemandLP{},
 		&MsgTryFulfillOnDemand{},
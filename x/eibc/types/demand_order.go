@@ -5,9 +5,11 @@ import (
 	"encoding/hex"
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
@@ -66,6 +68,29 @@ func (m *DemandOrder) ValidateBasic() error {
 		return ErrInvalidCreationHeight
 	}
 
+	if m.FeeSchedule != nil {
+		if err := m.FeeSchedule.Validate(); err != nil {
+			return errorsmod.Wrap(err, "fee schedule")
+		}
+		if m.FeeSchedule.StartFee.GTE(m.PriceAmount().Add(m.GetFeeAmount())) {
+			return ErrFeeTooHigh
+		}
+	}
+
+	filled := math.ZeroInt()
+	for _, p := range m.PartialFulfillments {
+		if _, err := sdk.AccAddressFromBech32(p.FulfillerAddress); err != nil {
+			return errors.Join(ErrInvalidFulfillerAddress, err)
+		}
+		if p.Amount.IsNil() || !p.Amount.IsPositive() {
+			return ErrInvalidPartialFulfillment
+		}
+		filled = filled.Add(p.Amount)
+	}
+	if filled.GT(m.PriceAmount()) {
+		return ErrPartialFillTooLarge
+	}
+
 	return nil
 }
 
@@ -113,7 +138,52 @@ func (m *DemandOrder) ValidateOrderIsOutstanding() error {
 }
 
 func (m *DemandOrder) IsFulfilled() bool {
-	return m.FulfillerAddress != "" || m.DeprecatedIsFulfilled
+	return m.FulfillerAddress != "" || m.DeprecatedIsFulfilled || m.IsPartiallyFulfilled() && m.UnfilledAmount().IsZero()
+}
+
+// IsPartiallyFulfilled returns true if at least a share of the price was fronted in partial-fill mode.
+func (m *DemandOrder) IsPartiallyFulfilled() bool {
+	return len(m.PartialFulfillments) > 0
+}
+
+// FilledAmount returns the part of the price fronted so far in partial-fill mode.
+func (m *DemandOrder) FilledAmount() math.Int {
+	filled := math.ZeroInt()
+	for _, p := range m.PartialFulfillments {
+		filled = filled.Add(p.Amount)
+	}
+	return filled
+}
+
+// UnfilledAmount returns the part of the price which is not yet fronted in partial-fill mode.
+func (m *DemandOrder) UnfilledAmount() math.Int {
+	return m.PriceAmount().Sub(m.FilledAmount())
+}
+
+// PartialFeeShare returns the pro-rata share of the fee for fronting amt of the price.
+func (m *DemandOrder) PartialFeeShare(amt math.Int) math.Int {
+	return amt.Mul(m.GetFeeAmount()).Quo(m.PriceAmount())
+}
+
+// PartialPayouts splits the funds received on finalization between the partial fulfillers.
+// Each fulfiller gets its share of the price plus the pro-rata share of the fee, the rest
+// is returned as the remainder for the recipient. If the order is completely filled, the
+// rounding dust goes to the last fulfiller. If received is lower than expected (e.g. the
+// bridging fee was increased after the order was created) the payouts are capped in order.
+func (m *DemandOrder) PartialPayouts(received math.Int) (payouts []math.Int, remainder math.Int) {
+	price := m.PriceAmount()
+	total := price.Add(m.GetFeeAmount())
+	remainder = received
+	for _, p := range m.PartialFulfillments {
+		payout := math.MinInt(p.Amount.Mul(total).Quo(price), remainder)
+		payouts = append(payouts, payout)
+		remainder = remainder.Sub(payout)
+	}
+	if m.UnfilledAmount().IsZero() && len(payouts) > 0 {
+		payouts[len(payouts)-1] = payouts[len(payouts)-1].Add(remainder)
+		remainder = math.ZeroInt()
+	}
+	return payouts, remainder
 }
 
 // BuildDemandIDFromPacketKey returns a unique demand order id from the packet key.
@@ -126,6 +196,12 @@ func BuildDemandIDFromPacketKey(packetKey string) string {
 	return hashString
 }
 
+// PartialFulfillmentEscrowAddr is the account which gets the funds of partially fulfilled orders
+// on finalization, to be split between the fulfillers.
+func PartialFulfillmentEscrowAddr() sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName)
+}
+
 func (m *DemandOrder) Denom() string {
 	// it's guaranteed price/fee are exactly one coin with the same denom
 	return m.Price[0].Denom
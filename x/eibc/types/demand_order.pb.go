@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -56,6 +58,14 @@ type DemandOrder struct {
 	CreationHeight uint64 `protobuf:"varint,12,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 	// an optional hook which uses the funds when the order is fulfilled
 	CompletionHook *types1.CompletionHookCall `protobuf:"bytes,13,opt,name=completion_hook,json=completionHook,proto3" json:"completion_hook,omitempty"`
+	// partial_fulfillments are the shares of the price fronted so far by
+	// fulfillers in partial-fill mode. The order is fulfilled once they sum up to
+	// the price.
+	PartialFulfillments []PartialFulfillment `protobuf:"bytes,14,rep,name=partial_fulfillments,json=partialFulfillments,proto3" json:"partial_fulfillments"`
+	// fee_schedule is an optional schedule which decays the fee over hub blocks.
+	// When set, the fee (and price) are evaluated at fulfillment time, and fixed
+	// once the order is fulfilled.
+	FeeSchedule *FeeSchedule `protobuf:"bytes,15,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
 }
 
 func (m *DemandOrder) Reset()         { *m = DemandOrder{} }
@@ -176,8 +186,128 @@ func (m *DemandOrder) GetCompletionHook() *types1.CompletionHookCall {
 	return nil
 }
 
+func (m *DemandOrder) GetPartialFulfillments() []PartialFulfillment {
+	if m != nil {
+		return m.PartialFulfillments
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetFeeSchedule() *FeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return nil
+}
+
+// FeeSchedule is a time-decaying (dutch auction) fee. The fee at hub height h
+// is max(floor_fee, start_fee - decay_per_block * (h - start_height)). The sum
+// of price and fee stays constant.
+type FeeSchedule struct {
+	// start_fee is the fee at start_height
+	StartFee cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=start_fee,json=startFee,proto3,customtype=cosmossdk.io/math.Int" json:"start_fee"`
+	// floor_fee is the lowest fee the schedule decays to
+	FloorFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=floor_fee,json=floorFee,proto3,customtype=cosmossdk.io/math.Int" json:"floor_fee"`
+	// decay_per_block is the amount the fee decreases by every hub block
+	DecayPerBlock cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=decay_per_block,json=decayPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"decay_per_block"`
+	// start_height is the hub height the decay starts from
+	StartHeight uint64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *FeeSchedule) Reset()         { *m = FeeSchedule{} }
+func (m *FeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*FeeSchedule) ProtoMessage()    {}
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{1}
+}
+func (m *FeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSchedule.Merge(m, src)
+}
+func (m *FeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSchedule proto.InternalMessageInfo
+
+func (m *FeeSchedule) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// PartialFulfillment is a share of a demand order price fronted by a single
+// fulfiller.
+type PartialFulfillment struct {
+	// fulfiller_address is the bech32-encoded address of the account which
+	// fronted the amount and will receive the pro-rata payout on finalization.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// amount is the part of the price sent to the order recipient
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *PartialFulfillment) Reset()         { *m = PartialFulfillment{} }
+func (m *PartialFulfillment) String() string { return proto.CompactTextString(m) }
+func (*PartialFulfillment) ProtoMessage()    {}
+func (*PartialFulfillment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{2}
+}
+func (m *PartialFulfillment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialFulfillment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialFulfillment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartialFulfillment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialFulfillment.Merge(m, src)
+}
+func (m *PartialFulfillment) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialFulfillment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialFulfillment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialFulfillment proto.InternalMessageInfo
+
+func (m *PartialFulfillment) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DemandOrder)(nil), "dymensionxyz.dymension.eibc.DemandOrder")
+	proto.RegisterType((*FeeSchedule)(nil), "dymensionxyz.dymension.eibc.FeeSchedule")
+	proto.RegisterType((*PartialFulfillment)(nil), "dymensionxyz.dymension.eibc.PartialFulfillment")
 }
 
 func init() {
@@ -185,42 +315,55 @@ func init() {
 }
 
 var fileDescriptor_2fc99140861fbacd = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x18, 0x9d, 0x4c, 0x2f, 0x6a, 0x3d, 0xff, 0x3f, 0xa5, 0xa6, 0x80, 0x29, 0x90, 0x46, 0x48, 0x88,
-	0x08, 0x84, 0xc3, 0xb4, 0x3b, 0x76, 0xb4, 0x80, 0x5a, 0x75, 0x01, 0x0a, 0xac, 0x8a, 0x50, 0xe4,
-	0xb1, 0xbf, 0x4e, 0xad, 0x5c, 0x1c, 0xc5, 0x6e, 0xd5, 0xf0, 0x14, 0x3c, 0x07, 0x4f, 0xd2, 0x65,
-	0x97, 0xac, 0x0a, 0x6a, 0x5f, 0x04, 0xc5, 0xce, 0xf4, 0x26, 0x4d, 0xbb, 0x61, 0x65, 0xe7, 0xf8,
-	0x1c, 0x1f, 0x7f, 0xc7, 0x9f, 0x83, 0xa8, 0xa8, 0x73, 0x28, 0xb4, 0x54, 0xc5, 0x61, 0xfd, 0x3d,
-	0x3a, 0xff, 0x88, 0x40, 0x0e, 0x79, 0x24, 0x20, 0x67, 0x85, 0x48, 0x54, 0x25, 0xa0, 0xa2, 0x65,
-	0xa5, 0x8c, 0xc2, 0x8f, 0x2e, 0xf3, 0x2f, 0xc4, 0xb4, 0xe1, 0x2f, 0xfb, 0x5c, 0xe9, 0x5c, 0xe9,
-	0x68, 0xc8, 0x34, 0x44, 0x07, 0x83, 0x21, 0x18, 0x36, 0x88, 0xb8, 0x92, 0x85, 0x13, 0x2f, 0xaf,
-	0x4d, 0x30, 0xe3, 0x2a, 0xcf, 0xdd, 0x50, 0x66, 0x60, 0xa4, 0x2a, 0x92, 0x3d, 0xa5, 0xd2, 0x56,
-	0xb4, 0x7a, 0xb3, 0xa8, 0x52, 0x59, 0xc6, 0xca, 0x32, 0x29, 0x19, 0x4f, 0xc1, 0xb4, 0x9a, 0x17,
-	0x37, 0x6b, 0xb4, 0x61, 0x66, 0x5f, 0xb7, 0xdc, 0xa5, 0x91, 0x1a, 0x29, 0x3b, 0x8d, 0x9a, 0x99,
-	0x43, 0x9f, 0x9e, 0xcc, 0xa0, 0xde, 0x3b, 0x5b, 0xfe, 0xc7, 0xa6, 0x7a, 0xdc, 0x47, 0x5d, 0x29,
-	0x88, 0x17, 0x78, 0xe1, 0x7c, 0xdc, 0x95, 0x02, 0x53, 0x74, 0xd7, 0x54, 0x8c, 0xa7, 0xb2, 0x18,
-	0xb5, 0xd6, 0x49, 0x0a, 0x35, 0xe9, 0x5a, 0xc2, 0xe2, 0x78, 0xe9, 0x93, 0x5d, 0xd9, 0x86, 0x1a,
-	0x33, 0x34, 0x53, 0x56, 0x92, 0x03, 0x99, 0x0a, 0xa6, 0xc2, 0xde, 0xea, 0x43, 0xea, 0xa2, 0xa2,
-	0x4d, 0x54, 0xb4, 0x8d, 0x8a, 0x6e, 0x28, 0x59, 0xac, 0xbf, 0x3e, 0x3a, 0x59, 0xe9, 0xfc, 0xfc,
-	0xbd, 0x12, 0x8e, 0xa4, 0xd9, 0xdb, 0x1f, 0x52, 0xae, 0xf2, 0xa8, 0xcd, 0xd5, 0x0d, 0xaf, 0xb4,
-	0x48, 0x23, 0x53, 0x97, 0xa0, 0xad, 0x40, 0xc7, 0x6e, 0x67, 0xfc, 0x0d, 0x4d, 0xed, 0x02, 0x90,
-	0xe9, 0x7f, 0x6f, 0xd0, 0xec, 0x8b, 0x1f, 0xa3, 0xf9, 0x0a, 0xb8, 0x2c, 0x25, 0x14, 0x86, 0xcc,
-	0xd8, 0x3a, 0x2f, 0x00, 0xfc, 0x06, 0x3d, 0x10, 0x50, 0x56, 0xc0, 0x99, 0x01, 0x91, 0x48, 0x9d,
-	0xec, 0xee, 0x67, 0xbb, 0x32, 0xcb, 0x40, 0x90, 0xd9, 0xc0, 0x0b, 0xe7, 0xd6, 0xbb, 0xc4, 0x8b,
-	0xef, 0x5d, 0x50, 0xb6, 0xf4, 0x87, 0x31, 0x01, 0x7f, 0x45, 0xf7, 0xaf, 0x67, 0xe9, 0x6e, 0x88,
-	0xcc, 0x05, 0x5e, 0xd8, 0x5f, 0x7d, 0x46, 0x27, 0x34, 0x9d, 0xbb, 0x4e, 0xfa, 0xd9, 0x92, 0xe3,
-	0xa5, 0xab, 0xa9, 0x3b, 0x14, 0x3f, 0x41, 0x68, 0xdc, 0x22, 0x52, 0x90, 0xf9, 0xf6, 0xdc, 0x0e,
-	0xd9, 0x12, 0xf8, 0x3d, 0x9a, 0x6e, 0x2a, 0x25, 0xc8, 0x3a, 0x0d, 0x6e, 0x71, 0x8a, 0x9d, 0xce,
-	0x19, 0xd0, 0x2f, 0x75, 0x09, 0xb1, 0x95, 0xe3, 0x97, 0x68, 0x71, 0x5c, 0x70, 0x95, 0x30, 0x21,
-	0x2a, 0xd0, 0x9a, 0xf4, 0xac, 0xd9, 0x9d, 0xf3, 0x85, 0xb7, 0x0e, 0xc7, 0xcf, 0xd1, 0x02, 0xaf,
-	0x80, 0xb9, 0x46, 0x07, 0x39, 0xda, 0x33, 0xe4, 0xbf, 0xc0, 0x0b, 0xa7, 0xe3, 0xfe, 0x18, 0xde,
-	0xb4, 0x28, 0xde, 0x41, 0x0b, 0xd7, 0xde, 0x04, 0xf9, 0x3f, 0xf0, 0xc2, 0xde, 0xad, 0xe7, 0xdc,
-	0x38, 0x57, 0x6d, 0x2a, 0x95, 0x6e, 0xb0, 0x2c, 0x8b, 0xfb, 0xfc, 0x0a, 0xb6, 0xbe, 0x7d, 0x74,
-	0xea, 0x7b, 0xc7, 0xa7, 0xbe, 0xf7, 0xe7, 0xd4, 0xf7, 0x7e, 0x9c, 0xf9, 0x9d, 0xe3, 0x33, 0xbf,
-	0xf3, 0xeb, 0xcc, 0xef, 0xec, 0x0c, 0x2e, 0xf5, 0xc5, 0x84, 0x77, 0x74, 0xb0, 0x16, 0x1d, 0xba,
-	0x5f, 0x84, 0x6d, 0x93, 0xe1, 0xac, 0x7d, 0x34, 0x6b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x72,
-	0xa5, 0x5b, 0xb0, 0x4e, 0x04, 0x00, 0x00,
+	// 764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xf6, 0xd8, 0xde, 0x10, 0xb7, 0xb3, 0x36, 0xdb, 0x9b, 0x85, 0xde, 0x05, 0x1c, 0x13, 0x09,
+	0x31, 0x62, 0xb5, 0x33, 0x38, 0xb9, 0x71, 0xc3, 0x06, 0x2b, 0x51, 0x0e, 0x44, 0x13, 0x4e, 0x8b,
+	0xd0, 0xa8, 0x3d, 0x5d, 0xb6, 0x5b, 0xf3, 0xd3, 0xa3, 0xee, 0xf6, 0x6a, 0xcd, 0x03, 0x70, 0xe6,
+	0xc2, 0x81, 0x57, 0xe0, 0xcc, 0x43, 0xe4, 0x18, 0x71, 0x42, 0x1c, 0x02, 0x4a, 0x5e, 0x04, 0x4d,
+	0xf7, 0x38, 0xce, 0x0f, 0x76, 0x44, 0xc4, 0xc9, 0xd3, 0x55, 0xf5, 0x7d, 0xf5, 0xd3, 0x5f, 0x97,
+	0x91, 0xc7, 0xe6, 0x29, 0x64, 0x8a, 0x8b, 0xec, 0xed, 0xfc, 0x07, 0xff, 0xea, 0xe0, 0x03, 0x1f,
+	0x45, 0x3e, 0x83, 0x94, 0x66, 0x2c, 0x14, 0x92, 0x81, 0xf4, 0x72, 0x29, 0xb4, 0xc0, 0x1f, 0x5c,
+	0x8f, 0x5f, 0x82, 0xbd, 0x22, 0xfe, 0x45, 0x27, 0x12, 0x2a, 0x15, 0xca, 0x1f, 0x51, 0x05, 0xfe,
+	0x9b, 0xde, 0x08, 0x34, 0xed, 0xf9, 0x91, 0xe0, 0x99, 0x05, 0xbf, 0xd8, 0x5f, 0x91, 0x2c, 0x12,
+	0x69, 0x6a, 0x7f, 0xf2, 0x04, 0x34, 0x17, 0x59, 0x38, 0x15, 0x22, 0x2e, 0x41, 0x7b, 0xeb, 0x41,
+	0x52, 0x24, 0x09, 0xcd, 0xf3, 0x30, 0xa7, 0x51, 0x0c, 0xba, 0xc4, 0x7c, 0xb6, 0x1e, 0xa3, 0x34,
+	0xd5, 0x33, 0x55, 0xc6, 0x6e, 0x4f, 0xc4, 0x44, 0x98, 0x4f, 0xbf, 0xf8, 0x2a, 0xad, 0xcf, 0x6d,
+	0x2b, 0xa1, 0x75, 0xd8, 0x83, 0x75, 0xed, 0xfe, 0xfc, 0x0e, 0x6a, 0x7e, 0x65, 0x26, 0xf3, 0x4d,
+	0x31, 0x18, 0xdc, 0x42, 0x55, 0xce, 0x88, 0xd3, 0x75, 0xdc, 0x46, 0x50, 0xe5, 0x0c, 0x7b, 0xe8,
+	0xa9, 0x96, 0x34, 0x8a, 0x79, 0x36, 0x29, 0xab, 0x0a, 0x63, 0x98, 0x93, 0xaa, 0x09, 0x78, 0xb2,
+	0x70, 0x1d, 0x1b, 0xcf, 0x11, 0xcc, 0x31, 0x45, 0x8f, 0x72, 0xc9, 0x23, 0x20, 0xb5, 0x6e, 0xcd,
+	0x6d, 0xee, 0x3d, 0xf7, 0xca, 0x6c, 0xc5, 0x14, 0xbd, 0x72, 0x8a, 0xde, 0x40, 0xf0, 0xac, 0xff,
+	0xf9, 0xe9, 0xf9, 0x4e, 0xe5, 0xd7, 0xbf, 0x76, 0xdc, 0x09, 0xd7, 0xd3, 0xd9, 0xc8, 0x8b, 0x44,
+	0x5a, 0x96, 0x56, 0xfe, 0xbc, 0x52, 0x2c, 0xf6, 0xf5, 0x3c, 0x07, 0x65, 0x00, 0x2a, 0xb0, 0xcc,
+	0xf8, 0x7b, 0x54, 0x1b, 0x03, 0x90, 0xfa, 0xff, 0x9f, 0xa0, 0xe0, 0xc5, 0x1f, 0xa2, 0x86, 0x84,
+	0x88, 0xe7, 0x1c, 0x32, 0x4d, 0x1e, 0x99, 0x3e, 0x97, 0x06, 0xfc, 0x05, 0x7a, 0x9f, 0x41, 0x2e,
+	0x21, 0xa2, 0x1a, 0x58, 0xc8, 0x55, 0x38, 0x9e, 0x25, 0x63, 0x9e, 0x24, 0xc0, 0xc8, 0x46, 0xd7,
+	0x71, 0x37, 0xfb, 0x55, 0xe2, 0x04, 0xcf, 0x96, 0x21, 0x87, 0x6a, 0xb8, 0x08, 0xc0, 0xdf, 0xa1,
+	0xf7, 0x6e, 0xcf, 0xd2, 0x5e, 0x1e, 0xd9, 0xec, 0x3a, 0x6e, 0x6b, 0xef, 0x13, 0x6f, 0x85, 0x1e,
+	0xed, 0x4d, 0x7b, 0x27, 0x26, 0x38, 0xd8, 0xbe, 0x39, 0x75, 0x6b, 0xc5, 0x1f, 0x21, 0xb4, 0x50,
+	0x0f, 0x67, 0xa4, 0x51, 0xd6, 0x6d, 0x2d, 0x87, 0x0c, 0x7f, 0x8d, 0xea, 0x45, 0xa7, 0x04, 0x99,
+	0x4c, 0xbd, 0x7b, 0x32, 0x05, 0x16, 0x67, 0x13, 0x78, 0xdf, 0xce, 0x73, 0x08, 0x0c, 0x1c, 0xbf,
+	0x44, 0x4f, 0x16, 0x0d, 0xcb, 0x90, 0x32, 0x26, 0x41, 0x29, 0xd2, 0x34, 0xc9, 0xde, 0xbd, 0x72,
+	0x7c, 0x69, 0xed, 0xf8, 0x53, 0xd4, 0x8e, 0x24, 0x50, 0xfb, 0x06, 0x80, 0x4f, 0xa6, 0x9a, 0x6c,
+	0x75, 0x1d, 0xb7, 0x1e, 0xb4, 0x16, 0xe6, 0x03, 0x63, 0xc5, 0xaf, 0x51, 0xfb, 0xd6, 0x73, 0x21,
+	0x8f, 0xbb, 0x8e, 0xdb, 0xbc, 0xb7, 0xce, 0xc1, 0x15, 0xea, 0x40, 0x88, 0x78, 0x40, 0x93, 0x24,
+	0x68, 0x45, 0x37, 0x6c, 0x78, 0x8a, 0xb6, 0x73, 0x2a, 0x35, 0xa7, 0xc9, 0xe2, 0xaa, 0x52, 0xc8,
+	0xb4, 0x22, 0x2d, 0x23, 0x1f, 0xdf, 0x5b, 0xb3, 0x02, 0xbc, 0x63, 0x0b, 0x1c, 0x2e, 0x71, 0xfd,
+	0x7a, 0x21, 0xaa, 0xe0, 0x69, 0x7e, 0xc7, 0xa3, 0xf0, 0x11, 0xda, 0x1a, 0x03, 0x84, 0x2a, 0x9a,
+	0x02, 0x9b, 0x25, 0x40, 0xda, 0xa6, 0x05, 0x77, 0x6d, 0x86, 0x21, 0xc0, 0x49, 0x19, 0x1f, 0x34,
+	0xc7, 0xcb, 0xc3, 0xee, 0x2f, 0x55, 0xd4, 0xbc, 0xe6, 0xc4, 0x07, 0xa8, 0xa1, 0x34, 0x95, 0x3a,
+	0x2c, 0xa4, 0x6f, 0x9e, 0x67, 0xff, 0x65, 0x51, 0xca, 0x9f, 0xe7, 0x3b, 0xcf, 0xac, 0x9a, 0x15,
+	0x8b, 0x3d, 0x2e, 0xfc, 0x94, 0xea, 0xa9, 0x77, 0x98, 0xe9, 0xdf, 0x7f, 0x7b, 0x85, 0xac, 0xa3,
+	0x38, 0x05, 0x9b, 0x06, 0x3d, 0x04, 0xc3, 0x34, 0x4e, 0x84, 0x90, 0x86, 0xa9, 0xfa, 0x00, 0x26,
+	0x83, 0x2e, 0x98, 0x4e, 0x50, 0x9b, 0x41, 0x44, 0xe7, 0x61, 0x0e, 0x32, 0x1c, 0x25, 0x22, 0x8a,
+	0x49, 0xed, 0xbf, 0xf3, 0x3d, 0x36, 0x1c, 0xc7, 0x20, 0xfb, 0x05, 0x03, 0xfe, 0x18, 0x6d, 0xd9,
+	0x46, 0x4b, 0xc5, 0xd4, 0x8d, 0x62, 0x9a, 0xc6, 0x66, 0xe5, 0xb2, 0xfb, 0xa3, 0x83, 0xf0, 0xdd,
+	0xab, 0xf9, 0x77, 0x6d, 0x3a, 0x2b, 0xb4, 0x39, 0x40, 0x1b, 0x34, 0x15, 0xb3, 0x4c, 0x3f, 0x64,
+	0x04, 0x25, 0xb4, 0x7f, 0x74, 0x7a, 0xd1, 0x71, 0xce, 0x2e, 0x3a, 0xce, 0xdf, 0x17, 0x1d, 0xe7,
+	0xa7, 0xcb, 0x4e, 0xe5, 0xec, 0xb2, 0x53, 0xf9, 0xe3, 0xb2, 0x53, 0x79, 0xdd, 0xbb, 0xb6, 0x73,
+	0x56, 0xac, 0xef, 0x37, 0xfb, 0xfe, 0x5b, 0xfb, 0xcf, 0x64, 0x56, 0xd0, 0x68, 0xc3, 0x2c, 0xe4,
+	0xfd, 0x7f, 0x06, 0x00, 0xa1, 0xad, 0xfd, 0x83, 0xc5, 0x06, 0x00, 0x00,
 }
 
 func (m *DemandOrder) Marshal() (dAtA []byte, err error) {
@@ -243,6 +386,32 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeSchedule != nil {
+		{
+			size, err := m.FeeSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.PartialFulfillments) > 0 {
+		for iNdEx := len(m.PartialFulfillments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartialFulfillments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.CompletionHook != nil {
 		{
 			size, err := m.CompletionHook.MarshalToSizedBuffer(dAtA[:i])
@@ -346,6 +515,104 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.DecayPerBlock.Size()
+		i -= size
+		if _, err := m.DecayPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FloorFee.Size()
+		i -= size
+		if _, err := m.FloorFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StartFee.Size()
+		i -= size
+		if _, err := m.StartFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PartialFulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialFulfillment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartialFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDemandOrder(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDemandOrder(v)
 	base := offset
@@ -411,6 +678,49 @@ func (m *DemandOrder) Size() (n int) {
 		l = m.CompletionHook.Size()
 		n += 1 + l + sovDemandOrder(uint64(l))
 	}
+	if len(m.PartialFulfillments) > 0 {
+		for _, e := range m.PartialFulfillments {
+			l = e.Size()
+			n += 1 + l + sovDemandOrder(uint64(l))
+		}
+	}
+	if m.FeeSchedule != nil {
+		l = m.FeeSchedule.Size()
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartFee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.FloorFee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.DecayPerBlock.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovDemandOrder(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *PartialFulfillment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
 	return n
 }
 
@@ -790,6 +1100,363 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialFulfillments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialFulfillments = append(m.PartialFulfillments, PartialFulfillment{})
+			if err := m.PartialFulfillments[len(m.PartialFulfillments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSchedule == nil {
+				m.FeeSchedule = &FeeSchedule{}
+			}
+			if err := m.FeeSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialFulfillment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialFulfillment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialFulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDemandOrder(dAtA[iNdEx:])
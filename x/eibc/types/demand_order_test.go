@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+)
+
+func TestPartialPayouts(t *testing.T) {
+	a, b, c := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	tcs := []struct {
+		name              string
+		price             int64
+		fee               int64
+		fills             []int64
+		received          int64
+		expectPayouts     []int64
+		expectRemainder   int64
+		expectIsFulfilled bool
+	}{
+		{
+			name:              "completely filled",
+			price:             900,
+			fee:               100,
+			fills:             []int64{300, 600},
+			received:          1000,
+			expectPayouts:     []int64{333, 667},
+			expectRemainder:   0,
+			expectIsFulfilled: true,
+		},
+		{
+			name:            "partially filled - recipient gets the unfilled part",
+			price:           900,
+			fee:             100,
+			fills:           []int64{450},
+			received:        1000,
+			expectPayouts:   []int64{500},
+			expectRemainder: 500,
+		},
+		{
+			name:              "rounding dust goes to last fulfiller",
+			price:             3,
+			fee:               1,
+			fills:             []int64{1, 1, 1},
+			received:          4,
+			expectPayouts:     []int64{1, 1, 2},
+			expectRemainder:   0,
+			expectIsFulfilled: true,
+		},
+		{
+			name:            "received less than expected - payouts capped in order",
+			price:           900,
+			fee:             100,
+			fills:           []int64{450, 300},
+			received:        600,
+			expectPayouts:   []int64{500, 100},
+			expectRemainder: 0,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &DemandOrder{
+				Price: sdk.NewCoins(sdk.NewInt64Coin("adym", tc.price)),
+				Fee:   sdk.NewCoins(sdk.NewInt64Coin("adym", tc.fee)),
+			}
+			for i, f := range tc.fills {
+				o.PartialFulfillments = append(o.PartialFulfillments, PartialFulfillment{
+					FulfillerAddress: []string{a, b, c}[i],
+					Amount:           math.NewInt(f),
+				})
+			}
+			require.True(t, o.IsPartiallyFulfilled())
+			require.Equal(t, tc.expectIsFulfilled, o.IsFulfilled())
+
+			payouts, remainder := o.PartialPayouts(math.NewInt(tc.received))
+			require.Len(t, payouts, len(tc.expectPayouts))
+			for i, p := range tc.expectPayouts {
+				require.True(t, math.NewInt(p).Equal(payouts[i]), "payout %d: %s", i, payouts[i])
+			}
+			require.True(t, math.NewInt(tc.expectRemainder).Equal(remainder), "remainder: %s", remainder)
+		})
+	}
+}
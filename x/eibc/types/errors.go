@@ -15,6 +15,12 @@ var (
 	ErrEmptyPrice                  = gerrc.ErrInvalidArgument.Wrap("price must be greater than 0")
 	ErrDemandAlreadyFulfilled      = gerrc.ErrFailedPrecondition.Wrap("demand order already fulfilled")
 	ErrDemandOrderInactive         = gerrc.ErrInvalidArgument.Wrap("demand order inactive")
+	ErrDemandOrderPartiallyFilled  = gerrc.ErrFailedPrecondition.Wrap("demand order partially fulfilled")
+	ErrPartialFillNotSupported     = gerrc.ErrFailedPrecondition.Wrap("partial fulfillment not supported for demand order")
+	ErrPartialFillTooLarge         = gerrc.ErrInvalidArgument.Wrap("partial fulfillment amount exceeds unfilled price")
+	ErrInvalidPartialFulfillment   = gerrc.ErrInvalidArgument.Wrap("partial fulfillment amount")
+	ErrInvalidFulfillerAddress     = gerrc.ErrInvalidArgument.Wrap("fulfiller address")
+	ErrCancelWithCompletionHook    = gerrc.ErrFailedPrecondition.Wrap("demand order with completion hook cannot be cancelled")
 	ErrInvalidOrderID              = errorsmod.Register(ModuleName, 3, "invalid order ID")
 	ErrDemandOrderAlreadyExist     = errorsmod.Register(ModuleName, 4, "demand order already exists")
 	ErrDemandOrderDoesNotExist     = errorsmod.Register(ModuleName, 5, "demand order does not exist")
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/base64"
+
+	"cosmossdk.io/math"
 )
 
 func GetCreatedEvent(m *DemandOrder, proofHeight uint64, amount string) *EventDemandOrderCreated {
@@ -32,6 +34,19 @@ func GetFulfilledEvent(m *DemandOrder) *EventDemandOrderFulfilled {
 	}
 }
 
+func GetPartiallyFulfilledEvent(m *DemandOrder, fulfiller string, amount math.Int) *EventDemandOrderPartiallyFulfilled {
+	return &EventDemandOrderPartiallyFulfilled{
+		OrderId:     m.Id,
+		Price:       m.Price.String(),
+		Fee:         m.Fee.String(),
+		Fulfiller:   fulfiller,
+		Amount:      amount.String(),
+		Filled:      m.FilledAmount().String(),
+		IsFulfilled: m.IsFulfilled(),
+		PacketType:  m.Type.String(),
+	}
+}
+
 func GetFulfilledAuthorizedEvent(m *DemandOrder,
 	creationHeight uint64,
 	lpAddress, operatorAddress, operatorFee string,
@@ -70,3 +85,14 @@ func GetPacketStatusUpdatedEvent(m *DemandOrder) *EventDemandOrderPacketStatusUp
 		IsFulfilled:     m.IsFulfilled(),
 	}
 }
+
+func GetCancelledEvent(m *DemandOrder) *EventDemandOrderCancelled {
+	packetKey := base64.StdEncoding.EncodeToString([]byte(m.TrackingPacketKey))
+	return &EventDemandOrderCancelled{
+		OrderId:    m.Id,
+		PacketKey:  packetKey,
+		RollappId:  m.RollappId,
+		PacketType: m.Type.String(),
+		Recipient:  m.Recipient,
+	}
+}
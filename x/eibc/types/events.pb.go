@@ -566,6 +566,191 @@ func (m *EventDemandOrderFulfilledAuthorized) GetOperatorFee() string {
 	return ""
 }
 
+// EventDemandOrderPartiallyFulfilled is emitted when a share of the demand
+// order price is fronted by a fulfiller.
+type EventDemandOrderPartiallyFulfilled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// price is the price of the demand order.
+	Price string `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// fee is the fee of the demand order.
+	Fee string `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// fulfiller is the address of the fulfiller.
+	Fulfiller string `protobuf:"bytes,4,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the part of the price fronted by the fulfiller.
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// filled is the part of the price fronted so far by all fulfillers.
+	Filled string `protobuf:"bytes,6,opt,name=filled,proto3" json:"filled,omitempty"`
+	// is_fulfilled is the flag indicating whether the whole price is covered.
+	IsFulfilled bool `protobuf:"varint,7,opt,name=is_fulfilled,json=isFulfilled,proto3" json:"is_fulfilled,omitempty"`
+	// packet_type is the type of the packet.
+	PacketType string `protobuf:"bytes,8,opt,name=packet_type,json=packetType,proto3" json:"packet_type,omitempty"`
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) Reset()         { *m = EventDemandOrderPartiallyFulfilled{} }
+func (m *EventDemandOrderPartiallyFulfilled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderPartiallyFulfilled) ProtoMessage()    {}
+func (*EventDemandOrderPartiallyFulfilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{5}
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Merge(m, src)
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderPartiallyFulfilled proto.InternalMessageInfo
+
+func (m *EventDemandOrderPartiallyFulfilled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetFilled() string {
+	if m != nil {
+		return m.Filled
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetIsFulfilled() bool {
+	if m != nil {
+		return m.IsFulfilled
+	}
+	return false
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetPacketType() string {
+	if m != nil {
+		return m.PacketType
+	}
+	return ""
+}
+
+// EventDemandOrderPartialPayout is emitted when the funds of a finalized
+// partially fulfilled order are paid out.
+type EventDemandOrderPartialPayout struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// address is the address of the paid out account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount paid out.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// is_recipient is true if the account is the order recipient, which is paid
+	// the unfilled part of the order.
+	IsRecipient bool `protobuf:"varint,4,opt,name=is_recipient,json=isRecipient,proto3" json:"is_recipient,omitempty"`
+}
+
+func (m *EventDemandOrderPartialPayout) Reset()         { *m = EventDemandOrderPartialPayout{} }
+func (m *EventDemandOrderPartialPayout) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderPartialPayout) ProtoMessage()    {}
+func (*EventDemandOrderPartialPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{6}
+}
+func (m *EventDemandOrderPartialPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderPartialPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderPartialPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDemandOrderPartialPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderPartialPayout.Merge(m, src)
+}
+func (m *EventDemandOrderPartialPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderPartialPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderPartialPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderPartialPayout proto.InternalMessageInfo
+
+func (m *EventDemandOrderPartialPayout) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartialPayout) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartialPayout) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartialPayout) GetIsRecipient() bool {
+	if m != nil {
+		return m.IsRecipient
+	}
+	return false
+}
+
 type EventDemandOrderDeleted struct {
 	// order_id is the unique identifier of the demand order.
 	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -583,7 +768,7 @@ func (m *EventDemandOrderDeleted) Reset()         { *m = EventDemandOrderDeleted
 func (m *EventDemandOrderDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventDemandOrderDeleted) ProtoMessage()    {}
 func (*EventDemandOrderDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{5}
+	return fileDescriptor_87abe4479c806cf7, []int{7}
 }
 func (m *EventDemandOrderDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,6 +832,87 @@ func (m *EventDemandOrderDeleted) GetPacketType() string {
 	return ""
 }
 
+type EventDemandOrderCancelled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// packet_key is the base64 encoded key of the packet.
+	PacketKey string `protobuf:"bytes,2,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	// rollapp_id is the id of the rollapp.
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// packet_type is the type of the packet.
+	PacketType string `protobuf:"bytes,4,opt,name=packet_type,json=packetType,proto3" json:"packet_type,omitempty"`
+	// recipient is the address which gets the funds on finalization.
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventDemandOrderCancelled) Reset()         { *m = EventDemandOrderCancelled{} }
+func (m *EventDemandOrderCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderCancelled) ProtoMessage()    {}
+func (*EventDemandOrderCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{8}
+}
+func (m *EventDemandOrderCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDemandOrderCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderCancelled.Merge(m, src)
+}
+func (m *EventDemandOrderCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderCancelled proto.InternalMessageInfo
+
+func (m *EventDemandOrderCancelled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderCancelled) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
+	}
+	return ""
+}
+
+func (m *EventDemandOrderCancelled) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderCancelled) GetPacketType() string {
+	if m != nil {
+		return m.PacketType
+	}
+	return ""
+}
+
+func (m *EventDemandOrderCancelled) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 // normal fulfilled event will be emitted in same tx
 type EventMatchedOnDemandLP struct {
 	OrderId   string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -658,7 +924,7 @@ func (m *EventMatchedOnDemandLP) Reset()         { *m = EventMatchedOnDemandLP{}
 func (m *EventMatchedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventMatchedOnDemandLP) ProtoMessage()    {}
 func (*EventMatchedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{6}
+	return fileDescriptor_87abe4479c806cf7, []int{9}
 }
 func (m *EventMatchedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,6 +974,95 @@ func (m *EventMatchedOnDemandLP) GetFulfiller() string {
 	return ""
 }
 
+// EventOnDemandLPFillSettled is emitted when an order fulfilled by an
+// on-demand LP is finalized or reverted
+type EventOnDemandLPFillSettled struct {
+	LpId    uint64 `protobuf:"varint,1,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// principal fronted
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// fee earned, zero if reverted
+	Fee      string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Reverted bool   `protobuf:"varint,5,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	// realized apr of the lp after the settlement
+	Apr string `protobuf:"bytes,6,opt,name=apr,proto3" json:"apr,omitempty"`
+}
+
+func (m *EventOnDemandLPFillSettled) Reset()         { *m = EventOnDemandLPFillSettled{} }
+func (m *EventOnDemandLPFillSettled) String() string { return proto.CompactTextString(m) }
+func (*EventOnDemandLPFillSettled) ProtoMessage()    {}
+func (*EventOnDemandLPFillSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{10}
+}
+func (m *EventOnDemandLPFillSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOnDemandLPFillSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOnDemandLPFillSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOnDemandLPFillSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOnDemandLPFillSettled.Merge(m, src)
+}
+func (m *EventOnDemandLPFillSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOnDemandLPFillSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOnDemandLPFillSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOnDemandLPFillSettled proto.InternalMessageInfo
+
+func (m *EventOnDemandLPFillSettled) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+func (m *EventOnDemandLPFillSettled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventOnDemandLPFillSettled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventOnDemandLPFillSettled) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventOnDemandLPFillSettled) GetReverted() bool {
+	if m != nil {
+		return m.Reverted
+	}
+	return false
+}
+
+func (m *EventOnDemandLPFillSettled) GetApr() string {
+	if m != nil {
+		return m.Apr
+	}
+	return ""
+}
+
 type EventCreatedOnDemandLP struct {
 	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
@@ -717,7 +1072,7 @@ func (m *EventCreatedOnDemandLP) Reset()         { *m = EventCreatedOnDemandLP{}
 func (m *EventCreatedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedOnDemandLP) ProtoMessage()    {}
 func (*EventCreatedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{7}
+	return fileDescriptor_87abe4479c806cf7, []int{11}
 }
 func (m *EventCreatedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +1126,7 @@ func (m *EventDeletedOnDemandLP) Reset()         { *m = EventDeletedOnDemandLP{}
 func (m *EventDeletedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventDeletedOnDemandLP) ProtoMessage()    {}
 func (*EventDeletedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{8}
+	return fileDescriptor_87abe4479c806cf7, []int{12}
 }
 func (m *EventDeletedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -827,8 +1182,12 @@ func init() {
 	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
 	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
 	proto.RegisterType((*EventDemandOrderFulfilledAuthorized)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledAuthorized")
+	proto.RegisterType((*EventDemandOrderPartiallyFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPartiallyFulfilled")
+	proto.RegisterType((*EventDemandOrderPartialPayout)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPartialPayout")
 	proto.RegisterType((*EventDemandOrderDeleted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderDeleted")
+	proto.RegisterType((*EventDemandOrderCancelled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCancelled")
 	proto.RegisterType((*EventMatchedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventMatchedOnDemandLP")
+	proto.RegisterType((*EventOnDemandLPFillSettled)(nil), "dymensionxyz.dymension.eibc.EventOnDemandLPFillSettled")
 	proto.RegisterType((*EventCreatedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventCreatedOnDemandLP")
 	proto.RegisterType((*EventDeletedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventDeletedOnDemandLP")
 }
@@ -838,55 +1197,65 @@ func init() {
 }
 
 var fileDescriptor_87abe4479c806cf7 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0xdb, 0x4a,
-	0x10, 0xc7, 0xf9, 0x9f, 0x49, 0x08, 0x3c, 0x3f, 0x04, 0x26, 0x0f, 0xf2, 0x20, 0x08, 0xbd, 0xbc,
-	0x1e, 0x62, 0x51, 0x3e, 0x01, 0x94, 0xa6, 0x45, 0xb4, 0x82, 0xa6, 0xed, 0xa5, 0x97, 0xc8, 0xb1,
-	0x27, 0xc9, 0x0a, 0x67, 0xd7, 0xb2, 0x37, 0x40, 0xb8, 0xf7, 0xde, 0x4f, 0x53, 0xf5, 0x23, 0xf4,
-	0xc8, 0xb1, 0x47, 0x04, 0xe2, 0x7b, 0x54, 0xbb, 0x5e, 0x87, 0xc4, 0xe1, 0x4f, 0x85, 0x7a, 0xea,
-	0x2d, 0xf3, 0xdb, 0xf1, 0xcc, 0xec, 0xfc, 0x7e, 0x33, 0x1b, 0xa8, 0x39, 0xc3, 0x3e, 0xd2, 0x80,
-	0x30, 0x7a, 0x36, 0x3c, 0x37, 0x47, 0x86, 0x89, 0xa4, 0x6d, 0x9b, 0x78, 0x82, 0x94, 0x07, 0x75,
-	0xcf, 0x67, 0x9c, 0xe9, 0xff, 0x8c, 0x7b, 0xd6, 0x47, 0x46, 0x5d, 0x78, 0x96, 0x17, 0xba, 0xac,
-	0xcb, 0xa4, 0x9f, 0x29, 0x7e, 0x85, 0x9f, 0x94, 0x9f, 0xdd, 0x13, 0xdc, 0x66, 0xfd, 0x3e, 0xa3,
-	0x66, 0xc0, 0x2d, 0x3e, 0x50, 0xe1, 0xcb, 0x15, 0x9b, 0x05, 0x7d, 0x16, 0x98, 0x6d, 0x2b, 0x40,
-	0xf3, 0x64, 0xab, 0x8d, 0xdc, 0xda, 0x32, 0x6d, 0x46, 0x68, 0x78, 0x5e, 0xbd, 0x4c, 0xc0, 0xd2,
-	0x4b, 0x51, 0xcf, 0x1e, 0xf6, 0x2d, 0xea, 0x1c, 0xfa, 0x0e, 0xfa, 0x2f, 0x7c, 0xb4, 0x38, 0x3a,
-	0xfa, 0x32, 0xe4, 0x98, 0xb0, 0x5b, 0xc4, 0x31, 0xb4, 0x35, 0xad, 0x96, 0x6f, 0x66, 0xa5, 0xbd,
-	0xef, 0xe8, 0x0b, 0x90, 0xf6, 0x7c, 0x62, 0xa3, 0x91, 0x90, 0x78, 0x68, 0xe8, 0xf3, 0x90, 0xec,
-	0x20, 0x1a, 0x49, 0x89, 0x89, 0x9f, 0xfa, 0x26, 0x14, 0x49, 0xd0, 0xea, 0x0c, 0xdc, 0x0e, 0x71,
-	0x5d, 0x74, 0x8c, 0xd4, 0x9a, 0x56, 0xcb, 0xed, 0x26, 0x0c, 0xad, 0x59, 0x20, 0x41, 0x23, 0x82,
-	0xf5, 0x0d, 0x98, 0xf5, 0x2c, 0xfb, 0x18, 0x79, 0x2b, 0x2c, 0xde, 0x48, 0xcb, 0x10, 0xc5, 0x10,
-	0x7c, 0x2f, 0x31, 0x7d, 0x15, 0x40, 0x39, 0x1d, 0xe3, 0xd0, 0xc8, 0x48, 0x8f, 0x7c, 0x88, 0x1c,
-	0xe0, 0x50, 0x1c, 0xfb, 0xcc, 0x75, 0x2d, 0xcf, 0x13, 0xf5, 0x66, 0xc3, 0x63, 0x85, 0xec, 0x3b,
-	0xfa, 0x0a, 0xe4, 0x7d, 0xb4, 0x89, 0x47, 0x90, 0x72, 0x23, 0xa7, 0x4e, 0x23, 0x40, 0xff, 0x17,
-	0x0a, 0x2a, 0x36, 0x1f, 0x7a, 0x68, 0xe4, 0xe5, 0xb9, 0x4a, 0xf7, 0x61, 0xe8, 0xa1, 0xbe, 0x0e,
-	0x45, 0xcf, 0x67, 0xac, 0xd3, 0xea, 0x21, 0xe9, 0xf6, 0xb8, 0x01, 0x6b, 0x5a, 0x2d, 0xd5, 0x2c,
-	0x48, 0xec, 0xb5, 0x84, 0xf4, 0x45, 0xc8, 0x58, 0x7d, 0x36, 0xa0, 0xdc, 0x28, 0xc8, 0xcf, 0x95,
-	0x55, 0xfd, 0xaa, 0xc1, 0x46, 0xbc, 0xc5, 0x47, 0x63, 0x17, 0xfb, 0xe8, 0x39, 0x8f, 0xb5, 0xfb,
-	0x1d, 0xfc, 0x45, 0xf1, 0xb4, 0x35, 0xd9, 0x23, 0xd1, 0xfa, 0xd2, 0xf3, 0xcd, 0xfa, 0x3d, 0x02,
-	0x0a, 0xd5, 0x50, 0x0f, 0x73, 0x34, 0xe7, 0x28, 0x9e, 0x8e, 0x27, 0x15, 0x17, 0x9a, 0x60, 0x46,
-	0x90, 0x96, 0x9b, 0x60, 0xa5, 0x7a, 0xa3, 0x41, 0x39, 0x5e, 0x78, 0x03, 0xf1, 0x17, 0xea, 0x5d,
-	0x82, 0xac, 0xa8, 0x57, 0x88, 0x21, 0x14, 0x48, 0x86, 0xe2, 0x69, 0x03, 0xf1, 0x56, 0x37, 0xc9,
-	0x71, 0xdd, 0x4c, 0xd1, 0x9f, 0xba, 0x9b, 0xfe, 0x31, 0x7e, 0xd3, 0x71, 0x7e, 0xe3, 0x04, 0x65,
-	0x1e, 0x22, 0x28, 0x3b, 0x41, 0xd0, 0x8d, 0x06, 0xcb, 0x53, 0xf7, 0x1c, 0x69, 0xf3, 0x37, 0x4c,
-	0xc1, 0xfa, 0x5d, 0x53, 0xf0, 0x84, 0x09, 0x58, 0x81, 0x7c, 0x14, 0xc4, 0x57, 0x1a, 0xbd, 0x05,
-	0xe2, 0x1a, 0x86, 0xb8, 0x86, 0xab, 0x9f, 0x93, 0xd3, 0x42, 0x1c, 0x55, 0xb0, 0x33, 0xe0, 0x3d,
-	0xe6, 0x93, 0xf3, 0x3f, 0xe9, 0xc6, 0xfa, 0x7f, 0x30, 0x67, 0x8b, 0x65, 0x46, 0x18, 0x8d, 0x74,
-	0x51, 0x90, 0xba, 0x28, 0x45, 0xb0, 0x92, 0xc6, 0x2a, 0x80, 0xeb, 0xb5, 0x2c, 0xc7, 0xf1, 0x31,
-	0x08, 0x8c, 0x62, 0x98, 0xc8, 0xf5, 0x76, 0x42, 0x40, 0xff, 0x1f, 0xe6, 0x99, 0x87, 0xbe, 0xc5,
-	0x99, 0x3f, 0x72, 0x9a, 0x95, 0x4e, 0x73, 0x11, 0x1e, 0xb9, 0xae, 0x43, 0x71, 0xe4, 0x2a, 0x9a,
-	0x52, 0x92, 0x6e, 0x85, 0x08, 0x6b, 0x20, 0x56, 0xbf, 0x69, 0xd3, 0x3b, 0x77, 0x0f, 0x5d, 0x7c,
-	0x64, 0xa8, 0x26, 0xf7, 0x5f, 0x22, 0xbe, 0xff, 0xa6, 0xfa, 0x99, 0x7c, 0x74, 0x88, 0x52, 0xf1,
-	0x21, 0x8a, 0x35, 0x34, 0x3d, 0x25, 0xa1, 0x0e, 0x2c, 0xca, 0xca, 0xdf, 0x5a, 0xdc, 0xee, 0xa1,
-	0x73, 0x48, 0xc3, 0x2b, 0xbc, 0x39, 0x7a, 0xa8, 0xf0, 0xbf, 0x21, 0xed, 0xca, 0x7c, 0x09, 0xd9,
-	0xfb, 0x94, 0xab, 0xf6, 0xf1, 0x2d, 0xb3, 0xc9, 0x18, 0xb3, 0xd5, 0x57, 0x2a, 0x8f, 0x7a, 0x8a,
-	0xc6, 0xf2, 0x94, 0x20, 0xa1, 0x32, 0xa4, 0x9a, 0x09, 0x22, 0xbb, 0xd2, 0x19, 0x50, 0x27, 0x90,
-	0xbc, 0x44, 0x5d, 0x91, 0x88, 0x60, 0xa4, 0xda, 0x52, 0x81, 0x54, 0x7f, 0x9f, 0x1c, 0x48, 0x2c,
-	0x0f, 0x1f, 0xad, 0x80, 0x51, 0x55, 0xac, 0xb2, 0x76, 0x0f, 0xbe, 0x5f, 0x55, 0xb4, 0x8b, 0xab,
-	0x8a, 0x76, 0x79, 0x55, 0xd1, 0xbe, 0x5c, 0x57, 0x66, 0x2e, 0xae, 0x2b, 0x33, 0x3f, 0xae, 0x2b,
-	0x33, 0x9f, 0xb6, 0xba, 0x84, 0xf7, 0x06, 0x6d, 0xb1, 0x88, 0xcd, 0x7b, 0x5e, 0xec, 0x93, 0x6d,
-	0xf3, 0x2c, 0xfc, 0x4f, 0x20, 0xfa, 0x1d, 0xb4, 0x33, 0xf2, 0x51, 0xde, 0xfe, 0x19, 0x00, 0x00,
-	0xff, 0xff, 0xde, 0x6e, 0x60, 0x33, 0x3f, 0x08, 0x00, 0x00,
+	// 926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xf5, 0xaf, 0x91, 0x62, 0xa7, 0x6c, 0x90, 0x30, 0x6a, 0xac, 0xda, 0x0c, 0x82, 0xba,
+	0x3d, 0x88, 0x70, 0xf3, 0x04, 0xf9, 0xa9, 0xda, 0x20, 0x2d, 0xe2, 0x2a, 0xed, 0xa5, 0x17, 0x81,
+	0x22, 0x47, 0xd6, 0x22, 0x2b, 0x2e, 0xb1, 0x5c, 0xd9, 0x51, 0xee, 0x3d, 0xb7, 0x0f, 0xd1, 0x37,
+	0x28, 0x50, 0xf4, 0x11, 0x7a, 0xcc, 0xb1, 0xc7, 0xc0, 0x46, 0x1e, 0xa2, 0xb7, 0x62, 0x7f, 0x28,
+	0xf1, 0xc7, 0xb2, 0x8b, 0x34, 0xa7, 0xdc, 0x38, 0xdf, 0x0e, 0x77, 0xbf, 0x99, 0xf9, 0x66, 0x76,
+	0xe1, 0x20, 0x5c, 0xce, 0x31, 0x4a, 0x08, 0x8b, 0x5e, 0x2e, 0x5f, 0x79, 0x2b, 0xc3, 0x43, 0x32,
+	0x09, 0x3c, 0x3c, 0xc1, 0x48, 0x24, 0x83, 0x98, 0x33, 0xc1, 0xec, 0x4f, 0xb2, 0x9e, 0x83, 0x95,
+	0x31, 0x90, 0x9e, 0xbd, 0x1b, 0xc7, 0xec, 0x98, 0x29, 0x3f, 0x4f, 0x7e, 0xe9, 0x5f, 0x7a, 0x5f,
+	0x6c, 0xd8, 0x3c, 0x60, 0xf3, 0x39, 0x8b, 0xbc, 0x44, 0xf8, 0x62, 0x61, 0xb6, 0xef, 0xf5, 0x03,
+	0x96, 0xcc, 0x59, 0xe2, 0x4d, 0xfc, 0x04, 0xbd, 0x93, 0xc3, 0x09, 0x0a, 0xff, 0xd0, 0x0b, 0x18,
+	0x89, 0xf4, 0xba, 0xfb, 0xa6, 0x02, 0xb7, 0xbe, 0x92, 0x7c, 0x1e, 0xe3, 0xdc, 0x8f, 0xc2, 0x67,
+	0x3c, 0x44, 0xfe, 0x88, 0xa3, 0x2f, 0x30, 0xb4, 0x6f, 0x43, 0x8b, 0x49, 0x7b, 0x4c, 0x42, 0xc7,
+	0xda, 0xb3, 0x0e, 0xda, 0xa3, 0xa6, 0xb2, 0x9f, 0x84, 0xf6, 0x0d, 0xa8, 0xc7, 0x9c, 0x04, 0xe8,
+	0x54, 0x14, 0xae, 0x0d, 0xfb, 0x3a, 0x54, 0xa7, 0x88, 0x4e, 0x55, 0x61, 0xf2, 0xd3, 0xbe, 0x07,
+	0x5d, 0x92, 0x8c, 0xa7, 0x0b, 0x3a, 0x25, 0x94, 0x62, 0xe8, 0xd4, 0xf6, 0xac, 0x83, 0xd6, 0xc3,
+	0x8a, 0x63, 0x8d, 0x3a, 0x24, 0x19, 0xa6, 0xb0, 0x7d, 0x17, 0xae, 0xc5, 0x7e, 0xf0, 0x02, 0xc5,
+	0x58, 0x93, 0x77, 0xea, 0x6a, 0x8b, 0xae, 0x06, 0x9f, 0x2b, 0xcc, 0xde, 0x05, 0x30, 0x4e, 0x2f,
+	0x70, 0xe9, 0x34, 0x94, 0x47, 0x5b, 0x23, 0x4f, 0x71, 0x29, 0x97, 0x39, 0xa3, 0xd4, 0x8f, 0x63,
+	0xc9, 0xb7, 0xa9, 0x97, 0x0d, 0xf2, 0x24, 0xb4, 0xef, 0x40, 0x9b, 0x63, 0x40, 0x62, 0x82, 0x91,
+	0x70, 0x5a, 0x66, 0x35, 0x05, 0xec, 0x4f, 0xa1, 0x63, 0xf6, 0x16, 0xcb, 0x18, 0x9d, 0xb6, 0x5a,
+	0x37, 0xc7, 0xfd, 0xb0, 0x8c, 0xd1, 0xde, 0x87, 0x6e, 0xcc, 0x19, 0x9b, 0x8e, 0x67, 0x48, 0x8e,
+	0x67, 0xc2, 0x81, 0x3d, 0xeb, 0xa0, 0x36, 0xea, 0x28, 0xec, 0x1b, 0x05, 0xd9, 0x37, 0xa1, 0xe1,
+	0xcf, 0xd9, 0x22, 0x12, 0x4e, 0x47, 0xfd, 0x6e, 0x2c, 0xf7, 0x0f, 0x0b, 0xee, 0x16, 0x53, 0x7c,
+	0x94, 0x09, 0xec, 0xc7, 0x38, 0xbc, 0x2a, 0xdd, 0xdf, 0xc3, 0x47, 0x11, 0x9e, 0x8e, 0xf3, 0x39,
+	0x92, 0xa9, 0xdf, 0xfe, 0xf2, 0xde, 0x60, 0x83, 0x80, 0xb4, 0x1a, 0x06, 0xfa, 0x8c, 0xd1, 0x4e,
+	0x84, 0xa7, 0xd9, 0x43, 0xed, 0xfd, 0x42, 0x65, 0x64, 0xd1, 0x5a, 0xb9, 0xaa, 0xb8, 0x6f, 0x2d,
+	0xe8, 0x15, 0x89, 0x0f, 0x11, 0xff, 0x03, 0xdf, 0x5b, 0xd0, 0x94, 0x7c, 0xa5, 0x18, 0xb4, 0x40,
+	0x1a, 0x11, 0x9e, 0x0e, 0x11, 0xd7, 0xba, 0xa9, 0x66, 0x75, 0x53, 0x2a, 0x7f, 0xed, 0xe2, 0xf2,
+	0x67, 0xea, 0x5b, 0x2f, 0xd6, 0xb7, 0x58, 0xa0, 0xc6, 0x65, 0x05, 0x6a, 0xe6, 0x0a, 0xf4, 0xd6,
+	0x82, 0xdb, 0xa5, 0x38, 0x57, 0xda, 0x7c, 0x0f, 0x5d, 0xb0, 0x7f, 0x51, 0x17, 0xbc, 0x43, 0x07,
+	0xdc, 0x81, 0x76, 0xba, 0x09, 0x37, 0x1a, 0x5d, 0x03, 0x45, 0x0d, 0x43, 0x51, 0xc3, 0xee, 0xcf,
+	0xd5, 0xb2, 0x10, 0x57, 0x0c, 0x1e, 0x2c, 0xc4, 0x8c, 0x71, 0xf2, 0xea, 0x43, 0x8a, 0xd8, 0xfe,
+	0x0c, 0x76, 0x02, 0x39, 0xcc, 0x08, 0x8b, 0x52, 0x5d, 0x74, 0x94, 0x2e, 0xb6, 0x53, 0xd8, 0x48,
+	0x63, 0x17, 0x80, 0xc6, 0x63, 0x3f, 0x0c, 0x39, 0x26, 0x89, 0xd3, 0xd5, 0x07, 0xd1, 0xf8, 0x81,
+	0x06, 0xec, 0xcf, 0xe1, 0x3a, 0x8b, 0x91, 0xfb, 0x82, 0xf1, 0x95, 0xd3, 0x35, 0xe5, 0xb4, 0x93,
+	0xe2, 0xa9, 0xeb, 0x3e, 0x74, 0x57, 0xae, 0x32, 0x29, 0xdb, 0xca, 0xad, 0x93, 0x62, 0x43, 0x44,
+	0xf7, 0x1f, 0x0b, 0xdc, 0xf2, 0x40, 0xe0, 0x82, 0xf8, 0x94, 0x2e, 0xdf, 0xab, 0xf0, 0x72, 0xe9,
+	0xab, 0x15, 0xd3, 0xb7, 0xee, 0x87, 0x7a, 0xb6, 0x1f, 0x24, 0x6e, 0xca, 0xa6, 0x87, 0xac, 0xb1,
+	0x4a, 0x45, 0x6d, 0x96, 0x8b, 0x5a, 0xa8, 0x48, 0xab, 0xa4, 0xc1, 0x5f, 0x2c, 0xd8, 0xdd, 0x10,
+	0xfb, 0x91, 0xbf, 0x64, 0x0b, 0x71, 0x59, 0xd8, 0x0e, 0x34, 0xd3, 0xec, 0xeb, 0xc0, 0x53, 0x33,
+	0x13, 0x4a, 0x35, 0x17, 0x8a, 0xa6, 0xbc, 0x1e, 0xfc, 0x2b, 0x1d, 0x8e, 0x52, 0xc8, 0xfd, 0xd3,
+	0x2a, 0xdf, 0x80, 0x8f, 0x91, 0xe2, 0x15, 0x23, 0x2e, 0x7f, 0x1b, 0x55, 0x8a, 0xb7, 0x51, 0x49,
+	0xdd, 0xd5, 0x2b, 0x47, 0x5a, 0xad, 0x38, 0xd2, 0x0a, 0xc9, 0xac, 0x97, 0x92, 0xf9, 0xfb, 0x05,
+	0x83, 0xeb, 0x91, 0x1f, 0x05, 0x48, 0xe9, 0xff, 0x22, 0x9f, 0xe7, 0x55, 0xbd, 0x82, 0x57, 0xad,
+	0xd4, 0x76, 0xb9, 0xbb, 0xb6, 0x5e, 0xb8, 0x6b, 0xdd, 0x29, 0xdc, 0x54, 0xa4, 0xbf, 0xf3, 0x45,
+	0x30, 0xc3, 0xf0, 0x59, 0xa4, 0xd9, 0x7f, 0x7b, 0x74, 0x19, 0xe3, 0x8f, 0xa1, 0x4e, 0x15, 0x9b,
+	0x8a, 0xea, 0xdf, 0x1a, 0x35, 0x77, 0xfa, 0x5a, 0xde, 0xd5, 0x82, 0xbc, 0xdd, 0xdf, 0xd2, 0xeb,
+	0x6b, 0x7d, 0xc2, 0x90, 0x50, 0xfa, 0x1c, 0x85, 0xa0, 0x98, 0xd9, 0xd1, 0xca, 0xec, 0x98, 0x65,
+	0x50, 0xc9, 0x33, 0xd8, 0x24, 0x31, 0xd3, 0x75, 0xb5, 0x75, 0xd7, 0xf5, 0xa0, 0xc5, 0xf1, 0x04,
+	0xb9, 0x40, 0x7d, 0x4f, 0xb5, 0x46, 0x2b, 0x5b, 0x7a, 0xfb, 0x31, 0x37, 0x8d, 0x25, 0x3f, 0xdd,
+	0xaf, 0x4d, 0x3a, 0xcc, 0xab, 0x2b, 0x93, 0x8e, 0x6d, 0xa8, 0xac, 0xe8, 0x55, 0x88, 0xaa, 0xda,
+	0x74, 0x11, 0x85, 0x89, 0x1a, 0x41, 0x69, 0xd5, 0x14, 0x22, 0x87, 0x8f, 0x3b, 0x36, 0x1b, 0x19,
+	0xf1, 0xbe, 0xf3, 0x46, 0x32, 0x52, 0x8e, 0x7e, 0xc2, 0xa2, 0x34, 0x52, 0x6d, 0x3d, 0x7c, 0xfa,
+	0xd7, 0x59, 0xdf, 0x7a, 0x7d, 0xd6, 0xb7, 0xde, 0x9c, 0xf5, 0xad, 0x5f, 0xcf, 0xfb, 0x5b, 0xaf,
+	0xcf, 0xfb, 0x5b, 0x7f, 0x9f, 0xf7, 0xb7, 0x7e, 0x3a, 0x3c, 0x26, 0x62, 0xb6, 0x98, 0xc8, 0x37,
+	0x87, 0xb7, 0xe1, 0x71, 0x7a, 0x72, 0xdf, 0x7b, 0xa9, 0x9f, 0xbf, 0x52, 0x34, 0xc9, 0xa4, 0xa1,
+	0xde, 0x9f, 0xf7, 0xff, 0x1d, 0x00, 0xfa, 0xc1, 0x76, 0xd3, 0x2a, 0x0b, 0x00, 0x00,
 }
 
 func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
@@ -1281,7 +1650,7 @@ func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventDemandOrderPartiallyFulfilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1291,12 +1660,12 @@ func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDemandOrderDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPartiallyFulfilled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPartiallyFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1306,14 +1675,150 @@ func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.PacketType)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x42
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+	if m.IsFulfilled {
 		i--
-		dAtA[i] = 0x22
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Filled) > 0 {
+		i -= len(m.Filled)
+		copy(dAtA[i:], m.Filled)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Filled)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderPartialPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderPartialPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderPartialPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsRecipient {
+		i--
+		if m.IsRecipient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.PacketStatus) > 0 {
 		i -= len(m.PacketStatus)
@@ -1339,6 +1844,64 @@ func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDemandOrderCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventMatchedOnDemandLP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1381,6 +1944,72 @@ func (m *EventMatchedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOnDemandLPFillSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOnDemandLPFillSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOnDemandLPFillSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Apr) > 0 {
+		i -= len(m.Apr)
+		copy(dAtA[i:], m.Apr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Apr)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reverted {
+		i--
+		if m.Reverted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventCreatedOnDemandLP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1662,7 +2291,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Size() (n int) {
 	return n
 }
 
-func (m *EventDemandOrderDeleted) Size() (n int) {
+func (m *EventDemandOrderPartiallyFulfilled) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1672,18 +2301,29 @@ func (m *EventDemandOrderDeleted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.PacketKey)
+	l = len(m.Price)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.PacketStatus)
+	l = len(m.Fee)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.RollappId)
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Filled)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.IsFulfilled {
+		n += 2
+	}
 	l = len(m.PacketType)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -1691,7 +2331,7 @@ func (m *EventDemandOrderDeleted) Size() (n int) {
 	return n
 }
 
-func (m *EventMatchedOnDemandLP) Size() (n int) {
+func (m *EventDemandOrderPartialPayout) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1701,14 +2341,127 @@ func (m *EventMatchedOnDemandLP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.LpId != 0 {
-		n += 1 + sovEvents(uint64(m.LpId))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.Fulfiller)
+	l = len(m.Amount)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
+	if m.IsRecipient {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDemandOrderDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMatchedOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.LpId != 0 {
+		n += 1 + sovEvents(uint64(m.LpId))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOnDemandLPFillSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LpId != 0 {
+		n += 1 + sovEvents(uint64(m.LpId))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Reverted {
+		n += 2
+	}
+	l = len(m.Apr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
 }
 
 func (m *EventCreatedOnDemandLP) Size() (n int) {
@@ -1812,11 +2565,573 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+			}
+			m.NewPacketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1844,11 +3159,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1876,13 +3191,13 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var v int
+			m.ProofHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1892,15 +3207,14 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ProofHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1928,11 +3242,61 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1960,11 +3324,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1992,11 +3356,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2024,11 +3388,31 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2056,13 +3440,13 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
-			m.ProofHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2072,14 +3456,27 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2107,7 +3504,7 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2130,7 +3527,7 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2153,10 +3550,10 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2192,10 +3589,10 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.NewPacketStatus = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2205,16 +3602,29 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2224,65 +3634,47 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2310,11 +3702,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2342,11 +3734,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2374,11 +3766,30 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2406,11 +3817,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2438,30 +3849,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			m.ProofHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2489,7 +3881,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.OperatorFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2512,7 +3904,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderPartiallyFulfilled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,10 +3927,10 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2638,10 +4030,10 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2651,15 +4043,27 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2687,11 +4091,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filled", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2719,9 +4123,29 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.Filled = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
@@ -2774,7 +4198,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderPartialPayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2797,10 +4221,10 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderPartialPayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderPartialPayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2837,7 +4261,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2865,11 +4289,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2897,11 +4321,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRecipient", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2918,42 +4342,60 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.IsRecipient = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2981,11 +4423,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3002,41 +4444,22 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3064,11 +4487,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3096,11 +4519,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3128,7 +4551,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorFee = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3151,7 +4574,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3174,10 +4597,10 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3246,7 +4669,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3274,11 +4697,11 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3306,11 +4729,11 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3338,7 +4761,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3494,6 +4917,223 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOnDemandLPFillSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOnDemandLPFillSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOnDemandLPFillSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Apr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventCreatedOnDemandLP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
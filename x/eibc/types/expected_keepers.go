@@ -17,7 +17,7 @@ type AccountKeeper interface {
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
-	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins // TODO: remove, not used
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
@@ -25,8 +25,11 @@ type BankKeeper interface {
 type DelayedAckKeeper interface {
 	GetRollappPacket(ctx sdk.Context, rollappPacketKey string) (*commontypes.RollappPacket, error)
 	BridgingFee(ctx sdk.Context) (res math.LegacyDec)
+	BridgingFeeFromAmt(ctx sdk.Context, transferAmt math.Int) (res math.Int)
 	VerifyHeightFinalized(ctx sdk.Context, rollappID string, height uint64) error
 	ValidateCompletionHook(info commontypes.CompletionHookCall) error
+	UpdateRollappPacketTransferAddress(ctx sdk.Context, rollappPacketKey string, newRecipient string) error
+	MarkEIBCOrderCancelled(ctx sdk.Context, rollappPacketKey string) error
 }
 
 type RollappKeeper interface {
@@ -0,0 +1,65 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewFeeSchedule(startFee, floorFee, decayPerBlock math.Int, startHeight uint64) *FeeSchedule {
+	return &FeeSchedule{
+		StartFee:      startFee,
+		FloorFee:      floorFee,
+		DecayPerBlock: decayPerBlock,
+		StartHeight:   startHeight,
+	}
+}
+
+func (s FeeSchedule) Validate() error {
+	if s.StartFee.IsNil() || s.StartFee.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "start fee")
+	}
+	if s.FloorFee.IsNil() || s.FloorFee.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "floor fee")
+	}
+	if s.FloorFee.GT(s.StartFee) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "floor fee greater than start fee")
+	}
+	if s.DecayPerBlock.IsNil() || s.DecayPerBlock.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "decay per block")
+	}
+	if s.DecayPerBlock.GT(s.StartFee.Sub(s.FloorFee)) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "decay per block greater than start fee minus floor fee")
+	}
+	return nil
+}
+
+// FeeAt returns the scheduled fee at the given hub height.
+func (s FeeSchedule) FeeAt(height uint64) math.Int {
+	if height <= s.StartHeight || !s.DecayPerBlock.IsPositive() {
+		return s.StartFee
+	}
+	// the fee reaches the floor after ceil((start - floor) / decay) blocks, check it before
+	// multiplying so a huge decay can't overflow
+	span := s.StartFee.Sub(s.FloorFee)
+	floorBlocks := span.Add(s.DecayPerBlock).SubRaw(1).Quo(s.DecayPerBlock)
+	blocks := math.NewIntFromUint64(height - s.StartHeight)
+	if blocks.GTE(floorBlocks) {
+		return s.FloorFee
+	}
+	return s.StartFee.Sub(s.DecayPerBlock.Mul(blocks))
+}
+
+// ApplyFeeSchedule sets the fee (and price) of the order to the scheduled fee at the given height.
+// The sum of price and fee is kept constant. No-op if the order has no schedule or was (partially) fulfilled.
+func (m *DemandOrder) ApplyFeeSchedule(height uint64) {
+	if m.FeeSchedule == nil || m.IsFulfilled() || m.IsPartiallyFulfilled() {
+		return
+	}
+	denom := m.Denom()
+	total := m.PriceAmount().Add(m.GetFeeAmount())
+	fee := m.FeeSchedule.FeeAt(height)
+	m.Fee = sdk.NewCoins(sdk.NewCoin(denom, fee))
+	m.Price = sdk.NewCoins(sdk.NewCoin(denom, total.Sub(fee)))
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	stdmath "math"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeScheduleFeeAt(t *testing.T) {
+	s := NewFeeSchedule(math.NewInt(100), math.NewInt(40), math.NewInt(7), 10)
+	require.NoError(t, s.Validate())
+
+	tcs := []struct {
+		height uint64
+		expect int64
+	}{
+		{height: 5, expect: 100},
+		{height: 10, expect: 100},
+		{height: 11, expect: 93},
+		{height: 18, expect: 44},
+		{height: 19, expect: 40},
+		{height: 1000, expect: 40},
+	}
+	for _, tc := range tcs {
+		got := s.FeeAt(tc.height)
+		require.True(t, math.NewInt(tc.expect).Equal(got), "height: %d: got: %s", tc.height, got)
+	}
+}
+
+// a huge decay must not overflow
+func TestFeeScheduleFeeAtHugeDecay(t *testing.T) {
+	twoTo255 := math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 255))
+
+	s := NewFeeSchedule(twoTo255, math.ZeroInt(), twoTo255.SubRaw(1), 10)
+	require.NoError(t, s.Validate())
+	require.True(t, twoTo255.Equal(s.FeeAt(10)))
+	require.True(t, math.OneInt().Equal(s.FeeAt(11)))
+	require.True(t, math.ZeroInt().Equal(s.FeeAt(12)))
+	require.True(t, math.ZeroInt().Equal(s.FeeAt(stdmath.MaxUint64)))
+
+	// rejected by validation, still safe for the fee computation
+	s = NewFeeSchedule(math.NewInt(100), math.NewInt(40), twoTo255, 10)
+	require.Error(t, s.Validate())
+	require.True(t, math.NewInt(40).Equal(s.FeeAt(stdmath.MaxUint64)))
+}
+
+func TestFeeScheduleValidate(t *testing.T) {
+	require.Error(t, NewFeeSchedule(math.NewInt(10), math.NewInt(11), math.ZeroInt(), 1).Validate())
+	require.Error(t, NewFeeSchedule(math.NewInt(10), math.NewInt(-1), math.ZeroInt(), 1).Validate())
+	require.Error(t, NewFeeSchedule(math.NewInt(10), math.NewInt(5), math.NewInt(-1), 1).Validate())
+	require.Error(t, NewFeeSchedule(math.NewInt(10), math.NewInt(5), math.NewInt(6), 1).Validate())
+	require.NoError(t, NewFeeSchedule(math.NewInt(10), math.NewInt(5), math.NewInt(5), 1).Validate())
+	require.NoError(t, NewFeeSchedule(math.NewInt(10), math.NewInt(10), math.ZeroInt(), 1).Validate())
+}
+
+func TestApplyFeeSchedule(t *testing.T) {
+	newOrder := func() *DemandOrder {
+		return &DemandOrder{
+			Price:       sdk.NewCoins(sdk.NewInt64Coin("adym", 900)),
+			Fee:         sdk.NewCoins(sdk.NewInt64Coin("adym", 100)),
+			FeeSchedule: NewFeeSchedule(math.NewInt(100), math.NewInt(10), math.NewInt(20), 1),
+		}
+	}
+
+	o := newOrder()
+	o.ApplyFeeSchedule(3)
+	require.True(t, math.NewInt(60).Equal(o.GetFeeAmount()))
+	require.True(t, math.NewInt(940).Equal(o.PriceAmount()))
+
+	// applying at a later height uses the schedule, not the current fee
+	o.ApplyFeeSchedule(100)
+	require.True(t, math.NewInt(10).Equal(o.GetFeeAmount()))
+	require.True(t, math.NewInt(990).Equal(o.PriceAmount()))
+
+	// fulfilled orders keep their fee
+	o = newOrder()
+	o.FulfillerAddress = "foo"
+	o.ApplyFeeSchedule(100)
+	require.True(t, math.NewInt(100).Equal(o.GetFeeAmount()))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	context "context"
 	"slices"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -47,7 +48,7 @@ func (a FulfillOrderAuthorization) MsgTypeURL() string {
 
 // Accept implements Authorization.Accept.
 func (a FulfillOrderAuthorization) Accept(
-	_ context.Context,
+	ctx context.Context,
 	msg sdk.Msg,
 ) (authz.AcceptResponse, error) {
 	mFulfill, ok := msg.(*MsgFulfillOrderAuthorized)
@@ -120,6 +121,22 @@ func (a FulfillOrderAuthorization) Accept(
 		}
 	}
 
+	// Check if the spend limit of the current window is exhausted. The criteria is never removed
+	// as the limit resets after the window.
+	if !matchedCriteria.SpendLimit.IsZero() && 0 < matchedCriteria.SpendWindow {
+		now := sdk.UnwrapSDKContext(ctx).BlockTime()
+		if !matchedCriteria.SpendInWindow(now, mFulfill.Price) {
+			return authz.AcceptResponse{},
+				errorsmod.Wrapf(errors.ErrInsufficientFunds,
+					"spend limit exhausted for rollapp %s until %s", mFulfill.RollappId, matchedCriteria.WindowEnd())
+		}
+		return authz.AcceptResponse{
+			Accept:  true,
+			Delete:  false,
+			Updated: &a,
+		}, nil
+	}
+
 	// Check if spend limit is exhausted (spend_limit is now in matchedCriteria)
 	if !matchedCriteria.SpendLimit.IsZero() {
 		spendLeft, isNegative := matchedCriteria.SpendLimit.SafeSub(mFulfill.Price...)
@@ -160,6 +177,26 @@ func (a FulfillOrderAuthorization) Accept(
 	}, nil
 }
 
+// SpendInWindow adds amt to the amount spent in the current window, starting a new window if the
+// current one elapsed. Returns false, without spending, if the spend limit would be exceeded.
+func (c *RollappCriteria) SpendInWindow(now time.Time, amt sdk.Coins) bool {
+	if !now.Before(c.WindowEnd()) {
+		c.WindowStart = now
+		c.WindowSpent = sdk.NewCoins()
+	}
+	spent := c.WindowSpent.Add(amt...)
+	if !spent.IsAllLTE(c.SpendLimit) {
+		return false
+	}
+	c.WindowSpent = spent
+	return true
+}
+
+// WindowEnd returns the time at which the spent amount resets.
+func (c RollappCriteria) WindowEnd() time.Time {
+	return c.WindowStart.Add(c.SpendWindow)
+}
+
 func (a *FulfillOrderAuthorization) removeRollappCriteria(rollappId string) {
 	for i, criteria := range a.Rollapps {
 		if criteria.RollappId == rollappId {
@@ -207,6 +244,14 @@ func (a FulfillOrderAuthorization) ValidateBasic() error {
 			return errorsmod.Wrapf(errors.ErrInvalidCoins, "spend_limit is invalid")
 		}
 
+		// Validate SpendWindow
+		if criteria.SpendWindow < 0 {
+			return errorsmod.Wrapf(errors.ErrInvalidRequest, "spend_window must not be negative for rollapp_id %s", criteria.RollappId)
+		}
+		if criteria.WindowSpent != nil && !criteria.WindowSpent.IsValid() {
+			return errorsmod.Wrapf(errors.ErrInvalidCoins, "window_spent is invalid")
+		}
+
 		// Check for duplicates in Denoms
 		if hasDuplicates(criteria.Denoms) {
 			return errorsmod.Wrapf(errors.ErrInvalidRequest, "duplicate denoms in the list for rollapp_id %s", criteria.RollappId)
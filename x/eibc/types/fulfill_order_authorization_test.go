@@ -3,9 +3,11 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -395,6 +397,53 @@ func TestFulfillOrderAuthorization_Accept(t *testing.T) {
 	}
 }
 
+func TestFulfillOrderAuthorization_AcceptSpendWindow(t *testing.T) {
+	rollappID := "rollappa_1234-1"
+	price := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+	auth := FulfillOrderAuthorization{
+		Rollapps: []*RollappCriteria{
+			{
+				RollappId:        rollappID,
+				SpendLimit:       sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+				SpendWindow:      24 * time.Hour,
+				MinFeePercentage: math.LegacyZeroDec(),
+				OperatorFeeShare: math.LegacyZeroDec(),
+			},
+		},
+	}
+	msg := &MsgFulfillOrderAuthorized{
+		RollappId:        rollappID,
+		Price:            price,
+		Amount:           math.NewInt(50),
+		ExpectedFee:      "10",
+		OperatorFeeShare: math.LegacyZeroDec(),
+	}
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	accept := func(now time.Time) error {
+		resp, err := auth.Accept(sdk.Context{}.WithBlockTime(now), msg)
+		if err != nil {
+			return err
+		}
+		require.True(t, resp.Accept)
+		require.False(t, resp.Delete)
+		auth = *resp.Updated.(*FulfillOrderAuthorization)
+		return nil
+	}
+
+	require.NoError(t, accept(t0))
+	require.NoError(t, accept(t0.Add(time.Hour)))
+	// 120 > 100
+	require.ErrorIs(t, accept(t0.Add(2*time.Hour)), errors.ErrInsufficientFunds)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 80)), auth.Rollapps[0].WindowSpent)
+
+	// the window elapsed, the spent amount resets
+	require.NoError(t, accept(t0.Add(24*time.Hour)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), auth.Rollapps[0].WindowSpent)
+	require.Equal(t, t0.Add(24*time.Hour), auth.Rollapps[0].WindowStart)
+	require.Len(t, auth.Rollapps, 1)
+}
+
 func TestFulfillOrderAuthorization_ValidateBasic(t *testing.T) {
 	type validateBasicTestCase struct {
 		name          string
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,15 +22,47 @@ func (d OnDemandLP) MustAddr() sdk.AccAddress {
 	return a
 }
 
+// LPWildcard matches any rollapp or any denom in an lp target
+const LPWildcard = "*"
+
+func (t OnDemandLPTarget) Validate() error {
+	if t.Rollapp != LPWildcard {
+		if err := validateRollappID(t.Rollapp); err != nil {
+			return errorsmod.Wrap(err, "rollapp id")
+		}
+	}
+	if t.Denom != LPWildcard && sdk.ValidateDenom(t.Denom) != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "denom")
+	}
+	return nil
+}
+
+// AllTargets returns the rollapp and denom pairs the lp fills orders for, including the base rollapp and denom if set
+func (d OnDemandLP) AllTargets() []OnDemandLPTarget {
+	var ret []OnDemandLPTarget
+	if d.Rollapp != "" || d.Denom != "" {
+		ret = append(ret, OnDemandLPTarget{Rollapp: d.Rollapp, Denom: d.Denom})
+	}
+	return append(ret, d.Targets...)
+}
+
 func (d OnDemandLP) Validate() error {
 	if _, err := d.Addr(); err != nil {
 		return errorsmod.Wrap(err, "addr")
 	}
-	if err := validateRollappID(d.Rollapp); err != nil {
-		return errorsmod.Wrap(err, "rollapp id")
+	targets := d.AllTargets()
+	if len(targets) == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "no rollapp and denom")
 	}
-	if sdk.ValidateDenom(d.Denom) != nil {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "denom")
+	seen := make(map[OnDemandLPTarget]struct{}, len(targets))
+	for _, t := range targets {
+		if err := t.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "target: %s: %s", t.Rollapp, t.Denom)
+		}
+		if _, ok := seen[t]; ok {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "duplicate target: %s: %s", t.Rollapp, t.Denom)
+		}
+		seen[t] = struct{}{}
 	}
 	if d.MaxPrice.IsNil() || !d.MaxPrice.IsPositive() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "max price")
@@ -39,6 +73,9 @@ func (d OnDemandLP) Validate() error {
 	if d.SpendLimit.IsNil() || !d.SpendLimit.IsPositive() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend limit")
 	}
+	if d.SpendWindow < 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window")
+	}
 	return nil
 }
 
@@ -55,16 +92,97 @@ func (r OnDemandLPRecord) Validate() error {
 	if r.Spent.GT(r.Lp.SpendLimit) {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spent greater than spend limit")
 	}
+	if MaxLPInsufficientFundsFailures < r.InsufficientFundsFailures {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "insufficient funds failures greater than max")
+	}
 	return nil
 }
 
+// ResetSpendWindow resets the amount spent if the spend window of the lp elapsed. Returns true if it was reset.
+func (r *OnDemandLPRecord) ResetSpendWindow(now time.Time) bool {
+	if r.Lp.SpendWindow <= 0 || now.Before(r.WindowStart.Add(r.Lp.SpendWindow)) {
+		return false
+	}
+	r.WindowStart = now
+	r.Spent = math.ZeroInt()
+	return true
+}
+
+// MultiDenom returns true if the lp can fill orders in more than one denom
+func (d OnDemandLP) MultiDenom() bool {
+	denom := ""
+	for _, t := range d.AllTargets() {
+		if t.Denom == LPWildcard || (denom != "" && t.Denom != denom) {
+			return true
+		}
+		denom = t.Denom
+	}
+	return false
+}
+
+// MaxLPInsufficientFundsFailures is the number of consecutive fills an lp can fail for insufficient funds
+// before it is deleted
+const MaxLPInsufficientFundsFailures = 10
+
 func (r OnDemandLPRecord) MaxSpend() math.Int {
 	return math.MinInt(r.Lp.MaxPrice, r.Lp.SpendLimit.Sub(r.Spent))
 }
 
 func (r OnDemandLPRecord) Accepts(nowHeight uint64, o *DemandOrder) bool {
 	priceOK := o.PriceAmount().LTE(r.MaxSpend())
+	return priceOK && !o.IsPartiallyFulfilled() && r.acceptsTerms(nowHeight, o)
+}
+
+// AcceptsPartial returns the share of the unfilled price the LP is willing to front, or zero.
+func (r OnDemandLPRecord) AcceptsPartial(nowHeight uint64, o *DemandOrder) math.Int {
+	if !r.Lp.AllowPartial || !r.acceptsTerms(nowHeight, o) {
+		return math.ZeroInt()
+	}
+	return math.MaxInt(math.MinInt(o.UnfilledAmount(), r.MaxSpend()), math.ZeroInt())
+}
+
+func (r OnDemandLPRecord) acceptsTerms(nowHeight uint64, o *DemandOrder) bool {
 	feeOK := r.Lp.MinFee.LTE(o.GetFeePercent())
 	ageOK := r.Lp.OrderMinAgeBlocks <= nowHeight-o.CreationHeight
-	return priceOK && feeOK && ageOK
+	return feeOK && ageOK
+}
+
+// SecondsPerYear is used to annualize the realized rate of return of an lp
+const SecondsPerYear = 365 * 24 * 60 * 60
+
+func NewOnDemandLPStats(id uint64, fundsAddr string) OnDemandLPStats {
+	return OnDemandLPStats{
+		LpId:            id,
+		FundsAddr:       fundsAddr,
+		FeesEarned:      math.ZeroInt(),
+		PendingVolume:   math.ZeroInt(),
+		FinalizedVolume: math.ZeroInt(),
+		RevertedVolume:  math.ZeroInt(),
+		CapitalSeconds:  math.ZeroInt(),
+	}
+}
+
+// APR returns the realized annual rate of return, i.e. the fees earned minus the principal lost, per unit
+// of capital and time, annualized. Only settled fills are accounted.
+func (s OnDemandLPStats) APR() math.LegacyDec {
+	if !s.CapitalSeconds.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+	net := s.FeesEarned.Sub(s.RevertedVolume)
+	return math.LegacyNewDecFromInt(net).MulInt64(SecondsPerYear).QuoInt(s.CapitalSeconds)
+}
+
+// Settle accounts for the settlement of the fill at the given time.
+func (s *OnDemandLPStats) Settle(f OnDemandLPFill, now int64, reverted bool) {
+	s.PendingVolume = math.MaxInt(s.PendingVolume.Sub(f.Amount), math.ZeroInt())
+	locked := max(now-f.FillTime, 0)
+	s.CapitalSeconds = s.CapitalSeconds.Add(f.Amount.MulRaw(locked))
+	if reverted {
+		s.OrdersReverted++
+		s.RevertedVolume = s.RevertedVolume.Add(f.Amount)
+		return
+	}
+	s.OrdersFinalized++
+	s.FinalizedVolume = s.FinalizedVolume.Add(f.Amount)
+	s.FeesEarned = s.FeesEarned.Add(f.Fee)
 }
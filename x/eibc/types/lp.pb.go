@@ -10,16 +10,21 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/dymensionxyz/dymension/v3/x/common/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,30 +32,96 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OnDemandLPTarget is a rollapp and denom pair an on-demand LP fills orders
+// for. Either can be the wildcard "*", to match any rollapp or any denom.
+type OnDemandLPTarget struct {
+	Rollapp string `protobuf:"bytes,1,opt,name=rollapp,proto3" json:"rollapp,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *OnDemandLPTarget) Reset()         { *m = OnDemandLPTarget{} }
+func (m *OnDemandLPTarget) String() string { return proto.CompactTextString(m) }
+func (*OnDemandLPTarget) ProtoMessage()    {}
+func (*OnDemandLPTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{0}
+}
+func (m *OnDemandLPTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnDemandLPTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnDemandLPTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnDemandLPTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnDemandLPTarget.Merge(m, src)
+}
+func (m *OnDemandLPTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnDemandLPTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnDemandLPTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnDemandLPTarget proto.InternalMessageInfo
+
+func (m *OnDemandLPTarget) GetRollapp() string {
+	if m != nil {
+		return m.Rollapp
+	}
+	return ""
+}
+
+func (m *OnDemandLPTarget) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type OnDemandLP struct {
 	// where funds come from, bech32-encoded
 	FundsAddr string `protobuf:"bytes,1,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
-	Rollapp   string `protobuf:"bytes,2,opt,name=rollapp,proto3" json:"rollapp,omitempty"`
-	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// may be empty if targets are given
+	Rollapp string `protobuf:"bytes,2,opt,name=rollapp,proto3" json:"rollapp,omitempty"`
+	// may be empty if targets are given
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 	// will not fulfill if price is above this
 	MaxPrice cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=maxPrice,proto3,customtype=cosmossdk.io/math.Int" json:"maxPrice"`
 	// will not fulfill if fee is below this (percentage of price expressed in
 	// [0,1])
 	MinFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=minFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minFee"`
-	// will not fulfill if brings amt spent above limit
+	// will not fulfill if brings amt spent above limit. The limit is shared by
+	// all the rollapp and denom pairs of the lp
 	SpendLimit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=spendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"spendLimit"`
 	// will not fulfill orders which were created fewer than this blocks in the
 	// past e.g. compatibility check occurs at height 7, order existed since block
 	// 4,
 	//      then fulfill if this field is 3 or less
 	OrderMinAgeBlocks uint64 `protobuf:"varint,7,opt,name=orderMinAgeBlocks,proto3" json:"orderMinAgeBlocks,omitempty"`
+	// will fulfill a share of the order price (up to maxPrice and the remaining
+	// spend limit) if the whole price cannot be covered
+	AllowPartial bool `protobuf:"varint,8,opt,name=allowPartial,proto3" json:"allowPartial,omitempty"`
+	// if set, the amount spent resets after each window, so spendLimit is a
+	// limit per window rather than a lifetime cap
+	SpendWindow time.Duration `protobuf:"bytes,9,opt,name=spendWindow,proto3,stdduration" json:"spendWindow"`
+	// additional rollapp and denom pairs the lp fills orders for, sharing the
+	// same spend limit
+	Targets []OnDemandLPTarget `protobuf:"bytes,10,rep,name=targets,proto3" json:"targets"`
 }
 
 func (m *OnDemandLP) Reset()         { *m = OnDemandLP{} }
 func (m *OnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*OnDemandLP) ProtoMessage()    {}
 func (*OnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13de3de2ae42eb80, []int{0}
+	return fileDescriptor_13de3de2ae42eb80, []int{1}
 }
 func (m *OnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,18 +178,44 @@ func (m *OnDemandLP) GetOrderMinAgeBlocks() uint64 {
 	return 0
 }
 
+func (m *OnDemandLP) GetAllowPartial() bool {
+	if m != nil {
+		return m.AllowPartial
+	}
+	return false
+}
+
+func (m *OnDemandLP) GetSpendWindow() time.Duration {
+	if m != nil {
+		return m.SpendWindow
+	}
+	return 0
+}
+
+func (m *OnDemandLP) GetTargets() []OnDemandLPTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
 type OnDemandLPRecord struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// amt spent so far
 	Spent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
 	Lp    *OnDemandLP           `protobuf:"bytes,3,opt,name=lp,proto3" json:"lp,omitempty"`
+	// start of the current spend window, if the lp has a spend window
+	WindowStart time.Time `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// number of consecutive fills which failed for insufficient funds. The lp
+	// is deleted when it reaches the max, and it is reset on a successful fill
+	InsufficientFundsFailures uint32 `protobuf:"varint,5,opt,name=insufficient_funds_failures,json=insufficientFundsFailures,proto3" json:"insufficient_funds_failures,omitempty"`
 }
 
 func (m *OnDemandLPRecord) Reset()         { *m = OnDemandLPRecord{} }
 func (m *OnDemandLPRecord) String() string { return proto.CompactTextString(m) }
 func (*OnDemandLPRecord) ProtoMessage()    {}
 func (*OnDemandLPRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13de3de2ae42eb80, []int{1}
+	return fileDescriptor_13de3de2ae42eb80, []int{2}
 }
 func (m *OnDemandLPRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,9 +258,184 @@ func (m *OnDemandLPRecord) GetLp() *OnDemandLP {
 	return nil
 }
 
+func (m *OnDemandLPRecord) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *OnDemandLPRecord) GetInsufficientFundsFailures() uint32 {
+	if m != nil {
+		return m.InsufficientFundsFailures
+	}
+	return 0
+}
+
+// OnDemandLPStats is the fulfillment history of an on-demand LP. It is kept
+// after the LP is deleted.
+type OnDemandLPStats struct {
+	LpId      uint64 `protobuf:"varint,1,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
+	// number of orders (or shares of orders) fulfilled by the lp
+	OrdersFilled uint64 `protobuf:"varint,3,opt,name=orders_filled,json=ordersFilled,proto3" json:"orders_filled,omitempty"`
+	// number of fulfilled orders which were finalized
+	OrdersFinalized uint64 `protobuf:"varint,4,opt,name=orders_finalized,json=ordersFinalized,proto3" json:"orders_finalized,omitempty"`
+	// number of fulfilled orders which were reverted by a hard fork, or
+	// finalized with a failed transfer
+	OrdersReverted uint64 `protobuf:"varint,5,opt,name=orders_reverted,json=ordersReverted,proto3" json:"orders_reverted,omitempty"`
+	// fees earned on finalized orders
+	FeesEarned cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=fees_earned,json=feesEarned,proto3,customtype=cosmossdk.io/math.Int" json:"fees_earned"`
+	// principal fronted for orders not settled yet
+	PendingVolume cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=pending_volume,json=pendingVolume,proto3,customtype=cosmossdk.io/math.Int" json:"pending_volume"`
+	// principal fronted for finalized orders
+	FinalizedVolume cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=finalized_volume,json=finalizedVolume,proto3,customtype=cosmossdk.io/math.Int" json:"finalized_volume"`
+	// principal lost on reverted orders
+	RevertedVolume cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=reverted_volume,json=revertedVolume,proto3,customtype=cosmossdk.io/math.Int" json:"reverted_volume"`
+	// sum of principal times the seconds it was locked, for settled orders
+	CapitalSeconds cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=capital_seconds,json=capitalSeconds,proto3,customtype=cosmossdk.io/math.Int" json:"capital_seconds"`
+}
+
+func (m *OnDemandLPStats) Reset()         { *m = OnDemandLPStats{} }
+func (m *OnDemandLPStats) String() string { return proto.CompactTextString(m) }
+func (*OnDemandLPStats) ProtoMessage()    {}
+func (*OnDemandLPStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{3}
+}
+func (m *OnDemandLPStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnDemandLPStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnDemandLPStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnDemandLPStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnDemandLPStats.Merge(m, src)
+}
+func (m *OnDemandLPStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnDemandLPStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnDemandLPStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnDemandLPStats proto.InternalMessageInfo
+
+func (m *OnDemandLPStats) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+func (m *OnDemandLPStats) GetFundsAddr() string {
+	if m != nil {
+		return m.FundsAddr
+	}
+	return ""
+}
+
+func (m *OnDemandLPStats) GetOrdersFilled() uint64 {
+	if m != nil {
+		return m.OrdersFilled
+	}
+	return 0
+}
+
+func (m *OnDemandLPStats) GetOrdersFinalized() uint64 {
+	if m != nil {
+		return m.OrdersFinalized
+	}
+	return 0
+}
+
+func (m *OnDemandLPStats) GetOrdersReverted() uint64 {
+	if m != nil {
+		return m.OrdersReverted
+	}
+	return 0
+}
+
+// OnDemandLPFill is a fill by an on-demand LP which is not settled yet
+type OnDemandLPFill struct {
+	LpId    uint64 `protobuf:"varint,1,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// principal fronted
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// fee the lp gets on finalization
+	Fee cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+	// unix seconds
+	FillTime int64 `protobuf:"varint,5,opt,name=fill_time,json=fillTime,proto3" json:"fill_time,omitempty"`
+}
+
+func (m *OnDemandLPFill) Reset()         { *m = OnDemandLPFill{} }
+func (m *OnDemandLPFill) String() string { return proto.CompactTextString(m) }
+func (*OnDemandLPFill) ProtoMessage()    {}
+func (*OnDemandLPFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{4}
+}
+func (m *OnDemandLPFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnDemandLPFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnDemandLPFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnDemandLPFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnDemandLPFill.Merge(m, src)
+}
+func (m *OnDemandLPFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnDemandLPFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnDemandLPFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnDemandLPFill proto.InternalMessageInfo
+
+func (m *OnDemandLPFill) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+func (m *OnDemandLPFill) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OnDemandLPFill) GetFillTime() int64 {
+	if m != nil {
+		return m.FillTime
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterType((*OnDemandLPTarget)(nil), "dymensionxyz.dymension.eibc.OnDemandLPTarget")
 	proto.RegisterType((*OnDemandLP)(nil), "dymensionxyz.dymension.eibc.OnDemandLP")
 	proto.RegisterType((*OnDemandLPRecord)(nil), "dymensionxyz.dymension.eibc.OnDemandLPRecord")
+	proto.RegisterType((*OnDemandLPStats)(nil), "dymensionxyz.dymension.eibc.OnDemandLPStats")
+	proto.RegisterType((*OnDemandLPFill)(nil), "dymensionxyz.dymension.eibc.OnDemandLPFill")
 }
 
 func init() {
@@ -171,37 +443,101 @@ func init() {
 }
 
 var fileDescriptor_13de3de2ae42eb80 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x4a, 0x1b, 0x41,
-	0x18, 0xcf, 0x6e, 0x93, 0x58, 0xa7, 0x50, 0xec, 0x60, 0x61, 0xab, 0x74, 0x15, 0x29, 0x54, 0xda,
-	0xba, 0x43, 0xf4, 0xe0, 0x39, 0x41, 0x5a, 0xc4, 0x14, 0x65, 0x8f, 0xbd, 0x84, 0xd9, 0x99, 0xe9,
-	0x3a, 0x64, 0x67, 0xbe, 0x61, 0x67, 0x22, 0x49, 0x9f, 0xa2, 0x4f, 0xd0, 0xa7, 0xf0, 0x21, 0x3c,
-	0x8a, 0xa7, 0xd2, 0x83, 0x94, 0xe4, 0x05, 0xfa, 0x08, 0x25, 0x3b, 0xdb, 0x34, 0x20, 0x0a, 0xde,
-	0xf6, 0xf7, 0xfd, 0xfe, 0xec, 0x8f, 0x6f, 0xbf, 0x45, 0x6f, 0xf8, 0x44, 0x09, 0x6d, 0x25, 0xe8,
-	0xf1, 0xe4, 0x1b, 0x59, 0x00, 0x22, 0x64, 0xc6, 0x48, 0x61, 0x12, 0x53, 0x82, 0x03, 0xbc, 0xb9,
-	0xac, 0x4a, 0x16, 0x20, 0x99, 0xab, 0x36, 0xd6, 0x73, 0xc8, 0xa1, 0xd2, 0x91, 0xf9, 0x93, 0xb7,
-	0x6c, 0xbc, 0xbb, 0x27, 0x98, 0x81, 0x52, 0xa0, 0x89, 0x75, 0xd4, 0x8d, 0x6c, 0xad, 0xdd, 0x7f,
-	0x58, 0x5b, 0x42, 0x51, 0x50, 0x63, 0x06, 0x86, 0xb2, 0xa1, 0x70, 0xb5, 0x27, 0x66, 0x60, 0x15,
-	0x58, 0x92, 0x51, 0x2b, 0xc8, 0x45, 0x27, 0x13, 0x8e, 0x76, 0x08, 0x03, 0xa9, 0x6b, 0xfe, 0x95,
-	0xe7, 0x07, 0xbe, 0x98, 0x07, 0x9e, 0xda, 0xf9, 0x13, 0x22, 0x74, 0xaa, 0x8f, 0x84, 0xa2, 0x9a,
-	0xf7, 0xcf, 0xf0, 0x6b, 0x84, 0xbe, 0x8e, 0x34, 0xb7, 0x03, 0xca, 0x79, 0x19, 0x05, 0xdb, 0xc1,
-	0xee, 0x6a, 0xba, 0x5a, 0x4d, 0xba, 0x9c, 0x97, 0x38, 0x42, 0x2b, 0x75, 0x81, 0x28, 0xac, 0xb8,
-	0x7f, 0x10, 0xaf, 0xa3, 0x16, 0x17, 0x1a, 0x54, 0xf4, 0xa4, 0x9a, 0x7b, 0x80, 0x3f, 0xa1, 0xa7,
-	0x8a, 0x8e, 0xcf, 0x4a, 0xc9, 0x44, 0xd4, 0x9c, 0x13, 0xbd, 0xf7, 0x57, 0xb7, 0x5b, 0x8d, 0x5f,
-	0xb7, 0x5b, 0x2f, 0x7d, 0x0b, 0xcb, 0x87, 0x89, 0x04, 0xa2, 0xa8, 0x3b, 0x4f, 0x8e, 0xb5, 0xbb,
-	0xb9, 0xdc, 0x43, 0x75, 0xbd, 0x63, 0xed, 0xd2, 0x85, 0x19, 0x9f, 0xa2, 0xb6, 0x92, 0xfa, 0xa3,
-	0x10, 0x51, 0xab, 0x8a, 0x39, 0xac, 0x63, 0x36, 0xef, 0xc6, 0xf4, 0x45, 0x4e, 0xd9, 0xe4, 0x48,
-	0xb0, 0x9b, 0xcb, 0xbd, 0xb5, 0x3a, 0x6c, 0x31, 0x4b, 0xeb, 0x18, 0x7c, 0x82, 0x90, 0x35, 0x42,
-	0xf3, 0xbe, 0x54, 0xd2, 0x45, 0xed, 0xc7, 0x77, 0x5b, 0xb2, 0xe3, 0x0f, 0xe8, 0x05, 0x94, 0x5c,
-	0x94, 0x9f, 0xa5, 0xee, 0xe6, 0xa2, 0x57, 0x00, 0x1b, 0xda, 0x68, 0x65, 0x3b, 0xd8, 0x6d, 0xa6,
-	0x77, 0x89, 0x9d, 0x1f, 0x01, 0x5a, 0xfb, 0xbf, 0xf2, 0x54, 0x30, 0x28, 0x39, 0x7e, 0x8e, 0x42,
-	0xc9, 0xab, 0x85, 0x37, 0xd3, 0x50, 0x72, 0xdc, 0x45, 0xad, 0xf9, 0x0b, 0x9c, 0xdf, 0xf3, 0xe3,
-	0xaa, 0x79, 0x27, 0x3e, 0x44, 0x61, 0x61, 0xaa, 0xef, 0xf1, 0x6c, 0xff, 0x6d, 0xf2, 0xc0, 0xd5,
-	0x26, 0x4b, 0x6d, 0xc2, 0xc2, 0xf4, 0x4e, 0xae, 0xa6, 0x71, 0x70, 0x3d, 0x8d, 0x83, 0xdf, 0xd3,
-	0x38, 0xf8, 0x3e, 0x8b, 0x1b, 0xd7, 0xb3, 0xb8, 0xf1, 0x73, 0x16, 0x37, 0xbe, 0x74, 0x72, 0xe9,
-	0xce, 0x47, 0x59, 0xc2, 0x40, 0x91, 0x7b, 0xee, 0xf4, 0xe2, 0x80, 0x8c, 0xfd, 0x1f, 0xe3, 0x26,
-	0x46, 0xd8, 0xac, 0x5d, 0xdd, 0xd9, 0xc1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x5c, 0x11,
-	0xce, 0x5d, 0x03, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdd, 0x4e, 0x1b, 0x47,
+	0x14, 0xc7, 0x59, 0x7b, 0x01, 0xfb, 0x18, 0x0c, 0x9d, 0xa6, 0xd2, 0x02, 0xaa, 0xb1, 0xdc, 0x4a,
+	0x71, 0x3f, 0xd8, 0x15, 0xce, 0x45, 0xae, 0x5a, 0x09, 0x97, 0x10, 0xa1, 0x10, 0x05, 0x2d, 0x28,
+	0x95, 0x7a, 0xb3, 0x1a, 0xef, 0x8c, 0x9d, 0x11, 0xbb, 0x33, 0xab, 0x9d, 0x31, 0x1f, 0x79, 0x8a,
+	0x5c, 0xf6, 0x0d, 0x2a, 0xf5, 0x3a, 0x0f, 0x91, 0xcb, 0x28, 0x57, 0x55, 0x2f, 0xd2, 0x16, 0xfa,
+	0x20, 0xd5, 0xcc, 0xce, 0x1a, 0x17, 0x4a, 0x54, 0xe7, 0x8e, 0x73, 0xe6, 0xfc, 0x7f, 0x3a, 0x73,
+	0xf8, 0x9f, 0x59, 0xc3, 0x97, 0xe4, 0x22, 0xa5, 0x5c, 0x32, 0xc1, 0xcf, 0x2f, 0x5e, 0x06, 0x93,
+	0x20, 0xa0, 0x6c, 0x10, 0x07, 0x49, 0xe6, 0x67, 0xb9, 0x50, 0x02, 0x6d, 0x4c, 0x57, 0xf9, 0x93,
+	0xc0, 0xd7, 0x55, 0xeb, 0xf7, 0x46, 0x62, 0x24, 0x4c, 0x5d, 0xa0, 0xff, 0x2a, 0x24, 0xeb, 0x5f,
+	0xdf, 0x01, 0x8e, 0x45, 0x9a, 0x0a, 0x1e, 0x48, 0x85, 0xd5, 0x58, 0xda, 0xda, 0xde, 0x87, 0x6b,
+	0x73, 0x91, 0x24, 0x38, 0xcb, 0xa2, 0x0c, 0xc7, 0x27, 0x54, 0x59, 0x4d, 0x2b, 0x16, 0x32, 0x15,
+	0x32, 0x18, 0x60, 0x49, 0x83, 0xd3, 0xed, 0x01, 0x55, 0x78, 0x3b, 0x88, 0x05, 0xe3, 0xf6, 0x7c,
+	0xad, 0x38, 0x8f, 0x8a, 0xc6, 0x8a, 0xa0, 0x94, 0x8e, 0x84, 0x18, 0x25, 0x34, 0x30, 0xd1, 0x60,
+	0x3c, 0x0c, 0xc8, 0x38, 0xc7, 0x4a, 0xdf, 0xa7, 0x38, 0xdf, 0xbc, 0x79, 0xae, 0x58, 0x4a, 0xa5,
+	0xc2, 0xa9, 0x1d, 0x47, 0xa7, 0x0f, 0xab, 0xcf, 0xf8, 0x2e, 0x4d, 0x31, 0x27, 0x07, 0x87, 0xc7,
+	0x38, 0x1f, 0x51, 0x85, 0x3c, 0x58, 0xb4, 0x7d, 0x7a, 0x4e, 0xdb, 0xe9, 0xd6, 0xc3, 0x32, 0x44,
+	0xf7, 0x60, 0x9e, 0x50, 0x2e, 0x52, 0xaf, 0x62, 0xf2, 0x45, 0xd0, 0xf9, 0xd5, 0x05, 0xb8, 0x86,
+	0xa0, 0xcf, 0x01, 0x86, 0x63, 0x4e, 0x64, 0x84, 0x09, 0xc9, 0x2d, 0xa1, 0x6e, 0x32, 0x3b, 0x84,
+	0xe4, 0xd3, 0xf4, 0xca, 0x1d, 0xf4, 0xea, 0x14, 0x1d, 0x3d, 0x86, 0x5a, 0x8a, 0xcf, 0x0f, 0x73,
+	0x16, 0x53, 0xcf, 0xd5, 0x07, 0xfd, 0x6f, 0xde, 0xbc, 0xdf, 0x9c, 0xfb, 0xfd, 0xfd, 0xe6, 0x67,
+	0xc5, 0x28, 0x24, 0x39, 0xf1, 0x99, 0x08, 0x52, 0xac, 0x5e, 0xf8, 0xfb, 0x5c, 0xbd, 0x7b, 0xbd,
+	0x05, 0x76, 0x46, 0xfb, 0x5c, 0x85, 0x13, 0x31, 0x7a, 0x06, 0x0b, 0x29, 0xe3, 0x7b, 0x94, 0x7a,
+	0xf3, 0x06, 0xf3, 0xd0, 0x62, 0x36, 0x6e, 0x63, 0x0e, 0xe8, 0x08, 0xc7, 0x17, 0xbb, 0x34, 0x7e,
+	0xf7, 0x7a, 0x6b, 0xd5, 0xc2, 0x26, 0xb9, 0xd0, 0x62, 0xd0, 0x13, 0x00, 0x99, 0x51, 0x4e, 0x0e,
+	0x58, 0xca, 0x94, 0xb7, 0x30, 0x7b, 0x6f, 0x53, 0x72, 0xf4, 0x2d, 0x7c, 0x22, 0x72, 0x42, 0xf3,
+	0xa7, 0x8c, 0xef, 0x8c, 0x68, 0x3f, 0x11, 0xf1, 0x89, 0xf4, 0x16, 0xdb, 0x4e, 0xd7, 0x0d, 0x6f,
+	0x1f, 0xa0, 0x0e, 0x2c, 0xe1, 0x24, 0x11, 0x67, 0x87, 0x38, 0x57, 0x0c, 0x27, 0x5e, 0xad, 0xed,
+	0x74, 0x6b, 0xe1, 0xbf, 0x72, 0xe8, 0x11, 0x34, 0x0c, 0xff, 0x47, 0xc6, 0x89, 0x38, 0xf3, 0xea,
+	0x6d, 0xa7, 0xdb, 0xe8, 0xad, 0xf9, 0x85, 0x23, 0xfc, 0xd2, 0x11, 0xfe, 0xae, 0x75, 0x4c, 0xbf,
+	0xa6, 0x5b, 0xff, 0xf9, 0x8f, 0x4d, 0x27, 0x9c, 0xd6, 0xa1, 0xa7, 0xb0, 0xa8, 0x8c, 0x2f, 0xa4,
+	0x07, 0xed, 0x6a, 0xb7, 0xd1, 0xdb, 0xf2, 0x3f, 0xb0, 0x42, 0xfe, 0x4d, 0x37, 0xf5, 0x5d, 0x8d,
+	0x0d, 0x4b, 0x46, 0xe7, 0x97, 0xca, 0xb4, 0xe3, 0x42, 0x1a, 0x8b, 0x9c, 0xa0, 0x26, 0x54, 0x18,
+	0x31, 0x56, 0x71, 0xc3, 0x0a, 0x23, 0x68, 0x07, 0xe6, 0x75, 0x0b, 0xca, 0xab, 0xcc, 0x3e, 0xd4,
+	0x42, 0x89, 0x1e, 0x42, 0x25, 0xc9, 0x8c, 0x93, 0x1a, 0xbd, 0xfb, 0xff, 0xb3, 0xe3, 0xb0, 0x92,
+	0x64, 0xe8, 0x31, 0x2c, 0x9d, 0x99, 0x9b, 0x47, 0x52, 0xe1, 0x5c, 0x19, 0xcf, 0x35, 0x7a, 0xeb,
+	0xb7, 0xe6, 0x76, 0x5c, 0x6e, 0x52, 0x31, 0xb8, 0x57, 0x66, 0x70, 0x85, 0xf2, 0x48, 0x0b, 0xd1,
+	0xf7, 0xb0, 0xc1, 0xb8, 0x1c, 0x0f, 0x87, 0x2c, 0x66, 0x94, 0xab, 0xa8, 0x58, 0x8a, 0x21, 0x66,
+	0xc9, 0x38, 0xa7, 0xd2, 0x98, 0x70, 0x39, 0x5c, 0x9b, 0x2e, 0xd9, 0xd3, 0x15, 0x7b, 0xb6, 0xa0,
+	0xf3, 0xb7, 0x0b, 0x2b, 0xd7, 0xbd, 0x1d, 0x29, 0xac, 0x24, 0xfa, 0x14, 0xe6, 0x93, 0x2c, 0x9a,
+	0xcc, 0xca, 0x4d, 0xb2, 0x7d, 0x72, 0x63, 0xe1, 0x2a, 0x37, 0x17, 0xee, 0x0b, 0x58, 0x36, 0x06,
+	0x92, 0xd1, 0x90, 0x25, 0x09, 0x25, 0x66, 0x28, 0x6e, 0xb8, 0x54, 0x24, 0xf7, 0x4c, 0x0e, 0x7d,
+	0x05, 0xab, 0x93, 0x22, 0x8e, 0x13, 0xf6, 0x92, 0x12, 0x73, 0x73, 0x37, 0x5c, 0x29, 0xeb, 0x6c,
+	0x1a, 0xdd, 0x07, 0x9b, 0x8a, 0x72, 0x7a, 0x4a, 0x73, 0x45, 0x89, 0xb9, 0x8b, 0x1b, 0x36, 0x8b,
+	0x74, 0x68, 0xb3, 0xe8, 0x00, 0x1a, 0x43, 0x4a, 0x65, 0x44, 0x71, 0xce, 0x29, 0xf9, 0xa8, 0x05,
+	0xd1, 0xfa, 0x47, 0x46, 0x8e, 0x42, 0x68, 0x6a, 0x57, 0x32, 0x3e, 0x8a, 0x4e, 0x45, 0x32, 0x4e,
+	0xa9, 0xb7, 0x38, 0x3b, 0x70, 0xd9, 0x22, 0x9e, 0x1b, 0x02, 0x7a, 0x0e, 0xab, 0x93, 0xeb, 0x96,
+	0xd4, 0xda, 0xec, 0xd4, 0x95, 0x09, 0xc4, 0x72, 0x8f, 0x61, 0xa5, 0x9c, 0x4d, 0x89, 0xad, 0xcf,
+	0x8e, 0x6d, 0x96, 0x8c, 0x6b, 0x6a, 0x8c, 0x33, 0xa6, 0x70, 0x12, 0x49, 0x1a, 0x0b, 0x4e, 0xf4,
+	0x46, 0xce, 0x4e, 0xb5, 0x8c, 0xa3, 0x02, 0xd1, 0xf9, 0xcb, 0x81, 0xe6, 0xb5, 0xcd, 0xb4, 0x1d,
+	0xfe, 0xdb, 0x65, 0x6b, 0x50, 0x33, 0xff, 0x5f, 0x9d, 0xb7, 0x0f, 0xb7, 0x89, 0xf7, 0x09, 0xfa,
+	0x01, 0x16, 0x70, 0x2a, 0xc6, 0x5c, 0x79, 0xd5, 0xd9, 0xfb, 0xb1, 0x52, 0xf4, 0x1d, 0x54, 0x87,
+	0xf4, 0xa3, 0x9e, 0x78, 0xad, 0x43, 0x1b, 0x50, 0xd7, 0xf6, 0x8e, 0xf4, 0x07, 0xce, 0xf8, 0xb1,
+	0x1a, 0xd6, 0x74, 0x42, 0xaf, 0x69, 0xff, 0xc9, 0x9b, 0xcb, 0x96, 0xf3, 0xf6, 0xb2, 0xe5, 0xfc,
+	0x79, 0xd9, 0x72, 0x5e, 0x5d, 0xb5, 0xe6, 0xde, 0x5e, 0xb5, 0xe6, 0x7e, 0xbb, 0x6a, 0xcd, 0xfd,
+	0xb4, 0x3d, 0x62, 0xea, 0xc5, 0x78, 0xe0, 0xc7, 0x22, 0x0d, 0xee, 0xf8, 0x74, 0x9f, 0x3e, 0x08,
+	0xce, 0x8b, 0x1f, 0x11, 0xea, 0x22, 0xa3, 0x72, 0xb0, 0x60, 0x9e, 0x80, 0x07, 0xff, 0x0c, 0x00,
+	0x81, 0x1f, 0xde, 0xee, 0x70, 0x08, 0x00, 0x00,
+}
+
+func (m *OnDemandLPTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnDemandLPTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnDemandLPTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rollapp) > 0 {
+		i -= len(m.Rollapp)
+		copy(dAtA[i:], m.Rollapp)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.Rollapp)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *OnDemandLP) Marshal() (dAtA []byte, err error) {
@@ -224,6 +560,38 @@ func (m *OnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SpendWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLp(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.AllowPartial {
+		i--
+		if m.AllowPartial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.OrderMinAgeBlocks != 0 {
 		i = encodeVarintLp(dAtA, i, uint64(m.OrderMinAgeBlocks))
 		i--
@@ -303,6 +671,19 @@ func (m *OnDemandLPRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InsufficientFundsFailures != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.InsufficientFundsFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLp(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
 	if m.Lp != nil {
 		{
 			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
@@ -321,84 +702,994 @@ func (m *OnDemandLPRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintLp(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Id != 0 {
-		i = encodeVarintLp(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintLp(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLp(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *OnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FundsAddr)
-	if l > 0 {
-		n += 1 + l + sovLp(uint64(l))
-	}
-	l = len(m.Rollapp)
-	if l > 0 {
-		n += 1 + l + sovLp(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovLp(uint64(l))
-	}
-	l = m.MaxPrice.Size()
-	n += 1 + l + sovLp(uint64(l))
-	l = m.MinFee.Size()
-	n += 1 + l + sovLp(uint64(l))
-	l = m.SpendLimit.Size()
-	n += 1 + l + sovLp(uint64(l))
-	if m.OrderMinAgeBlocks != 0 {
-		n += 1 + sovLp(uint64(m.OrderMinAgeBlocks))
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OnDemandLPStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnDemandLPStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnDemandLPStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CapitalSeconds.Size()
+		i -= size
+		if _, err := m.CapitalSeconds.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RevertedVolume.Size()
+		i -= size
+		if _, err := m.RevertedVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FinalizedVolume.Size()
+		i -= size
+		if _, err := m.FinalizedVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PendingVolume.Size()
+		i -= size
+		if _, err := m.PendingVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeesEarned.Size()
+		i -= size
+		if _, err := m.FeesEarned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.OrdersReverted != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.OrdersReverted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrdersFinalized != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.OrdersFinalized))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OrdersFilled != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.OrdersFilled))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FundsAddr) > 0 {
+		i -= len(m.FundsAddr)
+		copy(dAtA[i:], m.FundsAddr)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.FundsAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LpId != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OnDemandLPFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnDemandLPFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnDemandLPFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FillTime != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.FillTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LpId != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OnDemandLPTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	return n
+}
+
+func (m *OnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.OrderMinAgeBlocks != 0 {
+		n += 1 + sovLp(uint64(m.OrderMinAgeBlocks))
+	}
+	if m.AllowPartial {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindow)
+	n += 1 + l + sovLp(uint64(l))
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovLp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OnDemandLPRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLp(uint64(m.Id))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.Lp != nil {
+		l = m.Lp.Size()
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovLp(uint64(l))
+	if m.InsufficientFundsFailures != 0 {
+		n += 1 + sovLp(uint64(m.InsufficientFundsFailures))
+	}
+	return n
+}
+
+func (m *OnDemandLPStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LpId != 0 {
+		n += 1 + sovLp(uint64(m.LpId))
+	}
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	if m.OrdersFilled != 0 {
+		n += 1 + sovLp(uint64(m.OrdersFilled))
+	}
+	if m.OrdersFinalized != 0 {
+		n += 1 + sovLp(uint64(m.OrdersFinalized))
+	}
+	if m.OrdersReverted != 0 {
+		n += 1 + sovLp(uint64(m.OrdersReverted))
+	}
+	l = m.FeesEarned.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.PendingVolume.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.FinalizedVolume.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.RevertedVolume.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.CapitalSeconds.Size()
+	n += 1 + l + sovLp(uint64(l))
+	return n
+}
+
+func (m *OnDemandLPFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LpId != 0 {
+		n += 1 + sovLp(uint64(m.LpId))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.FillTime != 0 {
+		n += 1 + sovLp(uint64(m.FillTime))
+	}
+	return n
+}
+
+func sovLp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLp(x uint64) (n int) {
+	return sovLp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OnDemandLPTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDemandLPTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDemandLPTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDemandLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderMinAgeBlocks", wireType)
+			}
+			m.OrderMinAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderMinAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowPartial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowPartial = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SpendWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, OnDemandLPTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDemandLPRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDemandLPRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lp == nil {
+				m.Lp = &OnDemandLP{}
+			}
+			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsufficientFundsFailures", wireType)
+			}
+			m.InsufficientFundsFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsufficientFundsFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *OnDemandLPRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLp(uint64(m.Id))
-	}
-	l = m.Spent.Size()
-	n += 1 + l + sovLp(uint64(l))
-	if m.Lp != nil {
-		l = m.Lp.Size()
-		n += 1 + l + sovLp(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovLp(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLp(x uint64) (n int) {
-	return sovLp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *OnDemandLPStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -421,13 +1712,32 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnDemandLPStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnDemandLPStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
 			}
@@ -459,11 +1769,11 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			}
 			m.FundsAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersFilled", wireType)
 			}
-			var stringLen uint64
+			m.OrdersFilled = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLp
@@ -473,27 +1783,52 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrdersFilled |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLp
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersFinalized", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLp
+			m.OrdersFinalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersFinalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersReverted", wireType)
 			}
-			m.Rollapp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.OrdersReverted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersReverted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -521,11 +1856,13 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.FeesEarned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -553,13 +1890,13 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -587,13 +1924,13 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalizedVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedVolume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -621,15 +1958,15 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RevertedVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderMinAgeBlocks", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapitalSeconds", wireType)
 			}
-			m.OrderMinAgeBlocks = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLp
@@ -639,11 +1976,26 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderMinAgeBlocks |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CapitalSeconds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
@@ -665,7 +2017,7 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
+func (m *OnDemandLPFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -688,17 +2040,17 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnDemandLPRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnDemandLPFill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnDemandLPRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnDemandLPFill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
 			}
-			m.Id = 0
+			m.LpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLp
@@ -708,14 +2060,14 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.LpId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -743,15 +2095,13 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLp
@@ -761,28 +2111,79 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLp
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLp
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lp == nil {
-				m.Lp = &OnDemandLP{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillTime", wireType)
+			}
+			m.FillTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FillTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var (
+	// DefaultOrderBookFeeBounds are the lower bounds of the fee buckets: 0, 0.1%, 0.5%, 1%, 2%, 5%
+	DefaultOrderBookFeeBounds = []math.LegacyDec{
+		math.LegacyZeroDec(),
+		math.LegacyNewDecWithPrec(1, 3),
+		math.LegacyNewDecWithPrec(5, 3),
+		math.LegacyNewDecWithPrec(1, 2),
+		math.LegacyNewDecWithPrec(2, 2),
+		math.LegacyNewDecWithPrec(5, 2),
+	}
+	// DefaultOrderBookAgeBounds are the lower bounds of the age buckets, in blocks
+	DefaultOrderBookAgeBounds = []uint64{0, 10, 100, 1000, 10000}
+)
+
+// ValidateOrderBookBounds checks the bucket bounds are ascending and non-negative
+func ValidateOrderBookBounds(feeBounds []math.LegacyDec, ageBounds []uint64) error {
+	for i, b := range feeBounds {
+		if b.IsNil() || b.IsNegative() {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "fee bound: %d", i)
+		}
+		if 0 < i && b.LTE(feeBounds[i-1]) {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "fee bounds not ascending")
+		}
+	}
+	for i := 1; i < len(ageBounds); i++ {
+		if ageBounds[i] <= ageBounds[i-1] {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "age bounds not ascending")
+		}
+	}
+	return nil
+}
+
+func NewOrderBook(rollappID, denom string, feeBounds []math.LegacyDec, ageBounds []uint64) *OrderBook {
+	b := &OrderBook{
+		RollappId:      rollappID,
+		Denom:          denom,
+		PageTotalPrice: math.ZeroInt(),
+		PageTotalFee:   math.ZeroInt(),
+	}
+	for _, f := range feeBounds {
+		b.FeeBuckets = append(b.FeeBuckets, OrderBookFeeBucket{MinFee: f, PageTotalPrice: math.ZeroInt()})
+	}
+	for _, a := range ageBounds {
+		b.AgeBuckets = append(b.AgeBuckets, OrderBookAgeBucket{MinAgeBlocks: a, PageTotalPrice: math.ZeroInt()})
+	}
+	return b
+}
+
+// Add accounts for the unfilled part of the order, which is nowHeight-creationHeight blocks old.
+// Orders below the lowest bucket bound are only counted in the totals.
+func (b *OrderBook) Add(o *DemandOrder, nowHeight uint64) {
+	price := o.UnfilledAmount()
+	b.PageOrders++
+	b.PageTotalPrice = b.PageTotalPrice.Add(price)
+	b.PageTotalFee = b.PageTotalFee.Add(o.PartialFeeShare(price))
+
+	fee := o.GetFeePercent()
+	// the last bucket with a lower bound not above the fee
+	i := sort.Search(len(b.FeeBuckets), func(i int) bool { return fee.LT(b.FeeBuckets[i].MinFee) }) - 1
+	if 0 <= i {
+		b.FeeBuckets[i].PageOrders++
+		b.FeeBuckets[i].PageTotalPrice = b.FeeBuckets[i].PageTotalPrice.Add(price)
+	}
+
+	var age uint64
+	if o.CreationHeight < nowHeight {
+		age = nowHeight - o.CreationHeight
+	}
+	j := sort.Search(len(b.AgeBuckets), func(i int) bool { return age < b.AgeBuckets[i].MinAgeBlocks }) - 1
+	if 0 <= j {
+		b.AgeBuckets[j].PageOrders++
+		b.AgeBuckets[j].PageTotalPrice = b.AgeBuckets[j].PageTotalPrice.Add(price)
+	}
+}
@@ -11,20 +11,32 @@ const (
 	defaultEpochIdentifier = "hour"
 	defaultTimeoutFee      = "0.0015"
 	defaultErrAckFee       = "0.0015"
+	// DefaultMatcherMaxOrders is the default number of orders scanned by the end block matcher
+	DefaultMatcherMaxOrders uint64 = 20
+	// DefaultMatcherGasBudget is the default gas the end block matcher can consume per block
+	DefaultMatcherGasBudget uint64 = 5_000_000
 )
 
 // NewParams creates a new Params instance
-func NewParams(epochIdentifier string, timeoutFee math.LegacyDec, errAckFee math.LegacyDec) Params {
+func NewParams(epochIdentifier string, timeoutFee math.LegacyDec, errAckFee math.LegacyDec, matcherMaxOrders, matcherGasBudget uint64) Params {
 	return Params{
-		EpochIdentifier: epochIdentifier,
-		TimeoutFee:      timeoutFee,
-		ErrackFee:       errAckFee,
+		EpochIdentifier:  epochIdentifier,
+		TimeoutFee:       timeoutFee,
+		ErrackFee:        errAckFee,
+		MatcherMaxOrders: matcherMaxOrders,
+		MatcherGasBudget: matcherGasBudget,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(defaultEpochIdentifier, math.LegacyMustNewDecFromStr(defaultTimeoutFee), math.LegacyMustNewDecFromStr(defaultErrAckFee))
+	return NewParams(
+		defaultEpochIdentifier,
+		math.LegacyMustNewDecFromStr(defaultTimeoutFee),
+		math.LegacyMustNewDecFromStr(defaultErrAckFee),
+		DefaultMatcherMaxOrders,
+		DefaultMatcherGasBudget,
+	)
 }
 
 // Validate validates the set of params
@@ -38,6 +50,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateErrAckFee(p.ErrackFee); err != nil {
 		return fmt.Errorf("error acknowledgement fee: %w", err)
 	}
+	if p.MatcherMaxOrders != 0 && p.MatcherGasBudget == 0 {
+		return fmt.Errorf("matcher gas budget must be positive if the matcher is enabled")
+	}
 	return nil
 }
 
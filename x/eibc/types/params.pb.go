@@ -30,6 +30,12 @@ type Params struct {
 	EpochIdentifier string                      `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
 	TimeoutFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"timeout_fee" yaml:"timeout_fee"`
 	ErrackFee       cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"errack_fee" yaml:"errack_fee"`
+	// matcher_max_orders is the max number of pending orders the end block
+	// matcher scans for on-demand LPs each block. Zero disables the matcher.
+	MatcherMaxOrders uint64 `protobuf:"varint,4,opt,name=matcher_max_orders,json=matcherMaxOrders,proto3" json:"matcher_max_orders,omitempty" yaml:"matcher_max_orders"`
+	// matcher_gas_budget is the max gas the end block matcher can consume each
+	// block. Matching stops once it is exceeded.
+	MatcherGasBudget uint64 `protobuf:"varint,5,opt,name=matcher_gas_budget,json=matcherGasBudget,proto3" json:"matcher_gas_budget,omitempty" yaml:"matcher_gas_budget"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -71,6 +77,20 @@ func (m *Params) GetEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetMatcherMaxOrders() uint64 {
+	if m != nil {
+		return m.MatcherMaxOrders
+	}
+	return 0
+}
+
+func (m *Params) GetMatcherGasBudget() uint64 {
+	if m != nil {
+		return m.MatcherGasBudget
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.eibc.Params")
 }
@@ -80,28 +100,32 @@ func init() {
 }
 
 var fileDescriptor_fa18b53f607a3f90 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75, 0xfa,
-	0x20, 0x16, 0x44, 0x8b, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2,
-	0x81, 0x48, 0x29, 0x6d, 0x66, 0xe2, 0x62, 0x0b, 0x00, 0x1b, 0x2f, 0xe4, 0xc6, 0x25, 0x90, 0x5a,
-	0x90, 0x9f, 0x9c, 0x11, 0x9f, 0x99, 0x92, 0x9a, 0x57, 0x92, 0x99, 0x96, 0x99, 0x5a, 0x24, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xfd, 0xe9, 0x9e, 0xbc, 0x78, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0xba, 0x0a, 0xa5, 0x20, 0x7e, 0xb0, 0x90, 0x27, 0x5c, 0x44, 0x28, 0x8f, 0x8b, 0xbb, 0x24,
-	0x33, 0x37, 0x35, 0xbf, 0xb4, 0x24, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x09, 0x6c, 0x84, 0xef, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b, 0x43, 0x6c, 0x2f, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7,
-	0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x49, 0x4d, 0xfe, 0x74,
-	0x4f, 0x5e, 0x08, 0x62, 0x0b, 0x92, 0x7e, 0xa5, 0x4b, 0x5b, 0x74, 0x05, 0xa0, 0x4e, 0x86, 0xab,
-	0x0c, 0xe2, 0x82, 0xaa, 0x70, 0x4b, 0x4d, 0x15, 0xca, 0xe6, 0xe2, 0x4a, 0x2d, 0x2a, 0x4a, 0x4c,
-	0xce, 0x06, 0x5b, 0xc7, 0x0c, 0xb6, 0xce, 0x87, 0x38, 0xeb, 0x04, 0xa1, 0x9e, 0x82, 0x6b, 0xc7,
-	0x6e, 0x1b, 0x27, 0x44, 0x81, 0x5b, 0x6a, 0xaa, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xde,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x23, 0x4a, 0xcb, 0x8c, 0xf5, 0x2b, 0x20, 0xf1, 0x5a,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x09, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd1, 0xfa, 0x51, 0xb8, 0x03, 0x02, 0x00, 0x00,
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0xb7, 0x2e, 0xec, 0x78, 0xb0, 0x06, 0xc1, 0xec, 0x16, 0x93, 0x25, 0xa7, 0x5e,
+	0x4c, 0x90, 0xbd, 0xed, 0x31, 0xc8, 0x8a, 0xec, 0x16, 0xa5, 0x47, 0x2f, 0x61, 0x32, 0xf9, 0x9a,
+	0x0c, 0x75, 0x32, 0x61, 0x66, 0x2a, 0x89, 0x4f, 0xe1, 0xd1, 0xa3, 0x0f, 0xe1, 0x43, 0xf4, 0x22,
+	0x14, 0x4f, 0xe2, 0x21, 0x48, 0xfb, 0x06, 0x7d, 0x02, 0xe9, 0x4c, 0x48, 0x8b, 0x5a, 0xd8, 0x5b,
+	0xbe, 0xff, 0xf7, 0xfb, 0xfe, 0xff, 0x0c, 0xfc, 0xd1, 0x38, 0x6b, 0x18, 0x94, 0x92, 0xf2, 0xb2,
+	0x6e, 0x3e, 0x45, 0xfd, 0x10, 0x01, 0x4d, 0x49, 0x54, 0x61, 0x81, 0x99, 0x0c, 0x2b, 0xc1, 0x15,
+	0x77, 0x46, 0x87, 0x64, 0xd8, 0x0f, 0xe1, 0x8e, 0xbc, 0x78, 0x9a, 0xf3, 0x9c, 0x6b, 0x2e, 0xda,
+	0x7d, 0x99, 0x93, 0x8b, 0x73, 0xc2, 0x25, 0xe3, 0x32, 0x31, 0x0b, 0x33, 0x98, 0x55, 0xf0, 0xfd,
+	0x04, 0x9d, 0xbe, 0xd3, 0xf6, 0xce, 0x0d, 0x1a, 0x42, 0xc5, 0x49, 0x91, 0xd0, 0x0c, 0x4a, 0x45,
+	0x67, 0x14, 0x84, 0x6b, 0x5f, 0xda, 0xe3, 0xb3, 0x78, 0xb4, 0x6d, 0xfd, 0x67, 0x0d, 0x66, 0x1f,
+	0xae, 0x83, 0xbf, 0x89, 0x60, 0xfa, 0x58, 0x4b, 0x6f, 0x7a, 0xc5, 0x29, 0xd1, 0x23, 0x45, 0x19,
+	0xf0, 0x85, 0x4a, 0x66, 0x00, 0xee, 0x03, 0x6d, 0x31, 0x59, 0xb6, 0xbe, 0xf5, 0xab, 0xf5, 0x47,
+	0x26, 0x5d, 0x66, 0xf3, 0x90, 0xf2, 0x88, 0x61, 0x55, 0x84, 0x77, 0x90, 0x63, 0xd2, 0xbc, 0x02,
+	0xb2, 0x6d, 0x7d, 0xc7, 0xa4, 0x1c, 0xdc, 0x07, 0x3f, 0xbe, 0xbd, 0x18, 0x76, 0xbf, 0xdc, 0x93,
+	0x53, 0xd4, 0x11, 0x37, 0x00, 0xce, 0x1c, 0x21, 0x10, 0x02, 0x93, 0xb9, 0x8e, 0x3b, 0xd1, 0x71,
+	0x77, 0xf7, 0x8b, 0x7b, 0xd2, 0x3d, 0xaa, 0x3f, 0xff, 0x7f, 0xda, 0x99, 0x01, 0x76, 0x61, 0xb7,
+	0xc8, 0x61, 0x58, 0x91, 0x02, 0x44, 0xc2, 0x70, 0x9d, 0x70, 0x91, 0x81, 0x90, 0xee, 0xe0, 0xd2,
+	0x1e, 0x0f, 0xe2, 0xe7, 0xdb, 0xd6, 0x3f, 0x37, 0x8e, 0xff, 0x32, 0xc1, 0x74, 0xd8, 0x89, 0x13,
+	0x5c, 0xbf, 0xd5, 0xd2, 0xa1, 0x59, 0x8e, 0x65, 0x92, 0x2e, 0xb2, 0x1c, 0x94, 0xfb, 0xf0, 0x98,
+	0xd9, 0x9e, 0xd9, 0x9b, 0xbd, 0xc6, 0x32, 0xd6, 0xd2, 0xf5, 0xe0, 0xcb, 0x57, 0xdf, 0x8a, 0x6f,
+	0x97, 0x6b, 0xcf, 0x5e, 0xad, 0x3d, 0xfb, 0xf7, 0xda, 0xb3, 0x3f, 0x6f, 0x3c, 0x6b, 0xb5, 0xf1,
+	0xac, 0x9f, 0x1b, 0xcf, 0x7a, 0xff, 0x32, 0xa7, 0xaa, 0x58, 0xa4, 0x21, 0xe1, 0x2c, 0x3a, 0x52,
+	0xb6, 0x8f, 0x57, 0x51, 0x6d, 0x1a, 0xa7, 0x9a, 0x0a, 0x64, 0x7a, 0xaa, 0x3b, 0x72, 0xf5, 0x67,
+	0x00, 0x82, 0x3e, 0x14, 0x9b, 0x9d, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +148,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MatcherGasBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MatcherGasBudget))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MatcherMaxOrders != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MatcherMaxOrders))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ErrackFee.Size()
 		i -= size
@@ -179,6 +213,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.ErrackFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MatcherMaxOrders != 0 {
+		n += 1 + sovParams(uint64(m.MatcherMaxOrders))
+	}
+	if m.MatcherGasBudget != 0 {
+		n += 1 + sovParams(uint64(m.MatcherGasBudget))
+	}
 	return n
 }
 
@@ -317,6 +357,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatcherMaxOrders", wireType)
+			}
+			m.MatcherMaxOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatcherMaxOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatcherGasBudget", wireType)
+			}
+			m.MatcherGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatcherGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
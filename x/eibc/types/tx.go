@@ -18,8 +18,10 @@ import (
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgFulfillOrder{}
+	_ sdk.Msg = &MsgFulfillOrderPartial{}
 	_ sdk.Msg = &MsgFulfillOrderAuthorized{}
 	_ sdk.Msg = &MsgUpdateDemandOrder{}
+	_ sdk.Msg = &MsgCancelDemandOrder{}
 	_ sdk.Msg = &MsgTryFulfillOnDemand{}
 	_ sdk.Msg = &MsgCreateOnDemandLP{}
 	_ sdk.Msg = &MsgDeleteOnDemandLP{}
@@ -45,6 +47,30 @@ func (msg *MsgFulfillOrder) GetFulfillerBech32Address() []byte {
 	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
 }
 
+func NewMsgFulfillOrderPartial(fulfillerAddress, orderId, expectedFee string, amount math.Int) *MsgFulfillOrderPartial {
+	return &MsgFulfillOrderPartial{
+		FulfillerAddress: fulfillerAddress,
+		OrderId:          orderId,
+		ExpectedFee:      expectedFee,
+		Amount:           amount,
+	}
+}
+
+func (msg *MsgFulfillOrderPartial) ValidateBasic() error {
+	err := validateCommon(msg.OrderId, msg.ExpectedFee, msg.FulfillerAddress)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is invalid")
+	}
+	return nil
+}
+
+func (msg *MsgFulfillOrderPartial) GetFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
+}
+
 func NewMsgFulfillOrderAuthorized(
 	orderId,
 	rollappId,
@@ -113,19 +139,86 @@ func NewMsgUpdateDemandOrder(ownerAddr, orderId, newFee string) *MsgUpdateDemand
 	}
 }
 
+// NewMsgUpdateDemandOrderWithSchedule returns a msg setting a fee schedule starting at newFee and decaying
+// by decayPerBlock each block down to floorFee.
+func NewMsgUpdateDemandOrderWithSchedule(ownerAddr, orderId, newFee, floorFee, decayPerBlock string) *MsgUpdateDemandOrder {
+	msg := NewMsgUpdateDemandOrder(ownerAddr, orderId, newFee)
+	msg.FloorFee = floorFee
+	msg.DecayPerBlock = decayPerBlock
+	return msg
+}
+
 func (m *MsgUpdateDemandOrder) ValidateBasic() error {
 	err := validateCommon(m.OrderId, m.NewFee, m.OwnerAddress)
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	if m.HasFeeSchedule() {
+		if _, err := m.FeeSchedule(0); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	} else if m.DecayPerBlock != "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "decay per block set without floor fee")
+	}
+
 	return nil
 }
 
+// HasFeeSchedule returns true if the msg sets a fee schedule rather than a fixed fee.
+func (m *MsgUpdateDemandOrder) HasFeeSchedule() bool {
+	return m.FloorFee != ""
+}
+
+// FeeSchedule returns the fee schedule set by the msg, starting at the given height.
+func (m *MsgUpdateDemandOrder) FeeSchedule(startHeight uint64) (*FeeSchedule, error) {
+	startFee, ok := math.NewIntFromString(m.NewFee)
+	if !ok {
+		return nil, fmt.Errorf("parse new fee: %s", m.NewFee)
+	}
+	floorFee, ok := math.NewIntFromString(m.FloorFee)
+	if !ok {
+		return nil, fmt.Errorf("parse floor fee: %s", m.FloorFee)
+	}
+	decay := math.ZeroInt()
+	if m.DecayPerBlock != "" {
+		decay, ok = math.NewIntFromString(m.DecayPerBlock)
+		if !ok {
+			return nil, fmt.Errorf("parse decay per block: %s", m.DecayPerBlock)
+		}
+	}
+	s := NewFeeSchedule(startFee, floorFee, decay, startHeight)
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 func (m *MsgUpdateDemandOrder) GetSignerAddr() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(m.OwnerAddress)
 }
 
+func NewMsgCancelDemandOrder(ownerAddr, orderId string) *MsgCancelDemandOrder {
+	return &MsgCancelDemandOrder{
+		OrderId:      orderId,
+		OwnerAddress: ownerAddr,
+	}
+}
+
+func (m *MsgCancelDemandOrder) ValidateBasic() error {
+	if !isValidOrderId(m.OrderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", m.OrderId)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.OwnerAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "owner address: %s", err)
+	}
+	return nil
+}
+
+func (m *MsgCancelDemandOrder) GetSignerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(m.OwnerAddress)
+}
+
 func isValidOrderId(orderId string) bool {
 	hashBytes, err := hex.DecodeString(orderId)
 	if err != nil {
@@ -227,6 +227,113 @@ func (m *MsgFulfillOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFulfillOrderResponse proto.InternalMessageInfo
 
+// MsgFulfillOrderPartial defines the FulfillOrderPartial request type.
+// The fulfiller fronts only a share of the order price and receives a pro-rata
+// share of the fee when the underlying packet is finalized.
+type MsgFulfillOrderPartial struct {
+	// fulfiller_address is the bech32-encoded address of the account which the
+	// message was sent from.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// order_id is the unique identifier of the order to be partially fulfilled.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_fee is the nominal fee set in the order.
+	ExpectedFee string `protobuf:"bytes,3,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// amount is the part of the price to front. It must not exceed the
+	// remaining unfilled part of the price.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgFulfillOrderPartial) Reset()         { *m = MsgFulfillOrderPartial{} }
+func (m *MsgFulfillOrderPartial) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartial) ProtoMessage()    {}
+func (*MsgFulfillOrderPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{4}
+}
+func (m *MsgFulfillOrderPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartial.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderPartial proto.InternalMessageInfo
+
+func (m *MsgFulfillOrderPartial) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderPartial) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderPartial) GetExpectedFee() string {
+	if m != nil {
+		return m.ExpectedFee
+	}
+	return ""
+}
+
+// MsgFulfillOrderPartialResponse defines the FulfillOrderPartial response type.
+type MsgFulfillOrderPartialResponse struct {
+}
+
+func (m *MsgFulfillOrderPartialResponse) Reset()         { *m = MsgFulfillOrderPartialResponse{} }
+func (m *MsgFulfillOrderPartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartialResponse) ProtoMessage()    {}
+func (*MsgFulfillOrderPartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{5}
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderPartialResponse proto.InternalMessageInfo
+
 // MsgFulfillOrderAuthorized defines the FulfillOrderAuthorized request type.
 type MsgFulfillOrderAuthorized struct {
 	// order_id is the unique identifier of the order to be fulfilled.
@@ -261,7 +368,7 @@ func (m *MsgFulfillOrderAuthorized) Reset()         { *m = MsgFulfillOrderAuthor
 func (m *MsgFulfillOrderAuthorized) String() string { return proto.CompactTextString(m) }
 func (*MsgFulfillOrderAuthorized) ProtoMessage()    {}
 func (*MsgFulfillOrderAuthorized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{4}
+	return fileDescriptor_47537f11f512b254, []int{6}
 }
 func (m *MsgFulfillOrderAuthorized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +453,7 @@ func (m *MsgFulfillOrderAuthorizedResponse) Reset()         { *m = MsgFulfillOrd
 func (m *MsgFulfillOrderAuthorizedResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFulfillOrderAuthorizedResponse) ProtoMessage()    {}
 func (*MsgFulfillOrderAuthorizedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{5}
+	return fileDescriptor_47537f11f512b254, []int{7}
 }
 func (m *MsgFulfillOrderAuthorizedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,15 +488,22 @@ type MsgUpdateDemandOrder struct {
 	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 	// order_id is the unique identifier of the order to be updated.
 	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	// new_fee is the new fee amount to be set in the order.
+	// new_fee is the new fee amount to be set in the order. If floor_fee is set,
+	// it is the start fee of the new fee schedule.
 	NewFee string `protobuf:"bytes,3,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+	// floor_fee is the optional floor fee of a new fee schedule starting at the
+	// current height. If empty, the order fee is fixed to new_fee.
+	FloorFee string `protobuf:"bytes,4,opt,name=floor_fee,json=floorFee,proto3" json:"floor_fee,omitempty"`
+	// decay_per_block is the fee decrease per hub block of the new fee schedule.
+	// Only used if floor_fee is set.
+	DecayPerBlock string `protobuf:"bytes,5,opt,name=decay_per_block,json=decayPerBlock,proto3" json:"decay_per_block,omitempty"`
 }
 
 func (m *MsgUpdateDemandOrder) Reset()         { *m = MsgUpdateDemandOrder{} }
 func (m *MsgUpdateDemandOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrder) ProtoMessage()    {}
 func (*MsgUpdateDemandOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{6}
+	return fileDescriptor_47537f11f512b254, []int{8}
 }
 func (m *MsgUpdateDemandOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,6 +553,20 @@ func (m *MsgUpdateDemandOrder) GetNewFee() string {
 	return ""
 }
 
+func (m *MsgUpdateDemandOrder) GetFloorFee() string {
+	if m != nil {
+		return m.FloorFee
+	}
+	return ""
+}
+
+func (m *MsgUpdateDemandOrder) GetDecayPerBlock() string {
+	if m != nil {
+		return m.DecayPerBlock
+	}
+	return ""
+}
+
 type MsgUpdateDemandOrderResponse struct {
 }
 
@@ -446,7 +574,7 @@ func (m *MsgUpdateDemandOrderResponse) Reset()         { *m = MsgUpdateDemandOrd
 func (m *MsgUpdateDemandOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrderResponse) ProtoMessage()    {}
 func (*MsgUpdateDemandOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{7}
+	return fileDescriptor_47537f11f512b254, []int{9}
 }
 func (m *MsgUpdateDemandOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,6 +603,100 @@ func (m *MsgUpdateDemandOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateDemandOrderResponse proto.InternalMessageInfo
 
+// MsgCancelDemandOrder removes a demand order which was not fulfilled from the
+// eIBC market. The funds are transferred when the packet is finalized, as if
+// the order never existed.
+type MsgCancelDemandOrder struct {
+	// owner_address is the bech32-encoded address of the account owns the order.
+	// This is expected to be the address of the order recipient.
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// order_id is the unique identifier of the order to be cancelled.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgCancelDemandOrder) Reset()         { *m = MsgCancelDemandOrder{} }
+func (m *MsgCancelDemandOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDemandOrder) ProtoMessage()    {}
+func (*MsgCancelDemandOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{10}
+}
+func (m *MsgCancelDemandOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDemandOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDemandOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDemandOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDemandOrder.Merge(m, src)
+}
+func (m *MsgCancelDemandOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDemandOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDemandOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDemandOrder proto.InternalMessageInfo
+
+func (m *MsgCancelDemandOrder) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelDemandOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type MsgCancelDemandOrderResponse struct {
+}
+
+func (m *MsgCancelDemandOrderResponse) Reset()         { *m = MsgCancelDemandOrderResponse{} }
+func (m *MsgCancelDemandOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDemandOrderResponse) ProtoMessage()    {}
+func (*MsgCancelDemandOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{11}
+}
+func (m *MsgCancelDemandOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDemandOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDemandOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDemandOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDemandOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelDemandOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDemandOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDemandOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDemandOrderResponse proto.InternalMessageInfo
+
 // try find an on-demand-fulfiller to fulfill the order immediately
 type MsgTryFulfillOnDemand struct {
 	Signer  string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
@@ -487,7 +709,7 @@ func (m *MsgTryFulfillOnDemand) Reset()         { *m = MsgTryFulfillOnDemand{} }
 func (m *MsgTryFulfillOnDemand) String() string { return proto.CompactTextString(m) }
 func (*MsgTryFulfillOnDemand) ProtoMessage()    {}
 func (*MsgTryFulfillOnDemand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{8}
+	return fileDescriptor_47537f11f512b254, []int{12}
 }
 func (m *MsgTryFulfillOnDemand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +766,7 @@ func (m *MsgTryFulfillOnDemandResponse) Reset()         { *m = MsgTryFulfillOnDe
 func (m *MsgTryFulfillOnDemandResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTryFulfillOnDemandResponse) ProtoMessage()    {}
 func (*MsgTryFulfillOnDemandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{9}
+	return fileDescriptor_47537f11f512b254, []int{13}
 }
 func (m *MsgTryFulfillOnDemandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +804,7 @@ func (m *MsgCreateOnDemandLP) Reset()         { *m = MsgCreateOnDemandLP{} }
 func (m *MsgCreateOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOnDemandLP) ProtoMessage()    {}
 func (*MsgCreateOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{10}
+	return fileDescriptor_47537f11f512b254, []int{14}
 }
 func (m *MsgCreateOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +855,7 @@ func (m *MsgCreateOnDemandLPResponse) Reset()         { *m = MsgCreateOnDemandLP
 func (m *MsgCreateOnDemandLPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOnDemandLPResponse) ProtoMessage()    {}
 func (*MsgCreateOnDemandLPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{11}
+	return fileDescriptor_47537f11f512b254, []int{15}
 }
 func (m *MsgCreateOnDemandLPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +900,7 @@ func (m *MsgDeleteOnDemandLP) Reset()         { *m = MsgDeleteOnDemandLP{} }
 func (m *MsgDeleteOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOnDemandLP) ProtoMessage()    {}
 func (*MsgDeleteOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{12}
+	return fileDescriptor_47537f11f512b254, []int{16}
 }
 func (m *MsgDeleteOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,7 +950,7 @@ func (m *MsgDeleteOnDemandLPResponse) Reset()         { *m = MsgDeleteOnDemandLP
 func (m *MsgDeleteOnDemandLPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOnDemandLPResponse) ProtoMessage()    {}
 func (*MsgDeleteOnDemandLPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{13}
+	return fileDescriptor_47537f11f512b254, []int{17}
 }
 func (m *MsgDeleteOnDemandLPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,10 +984,14 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
 	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
+	proto.RegisterType((*MsgFulfillOrderPartial)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartial")
+	proto.RegisterType((*MsgFulfillOrderPartialResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartialResponse")
 	proto.RegisterType((*MsgFulfillOrderAuthorized)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorized")
 	proto.RegisterType((*MsgFulfillOrderAuthorizedResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorizedResponse")
 	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
 	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
+	proto.RegisterType((*MsgCancelDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgCancelDemandOrder")
+	proto.RegisterType((*MsgCancelDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgCancelDemandOrderResponse")
 	proto.RegisterType((*MsgTryFulfillOnDemand)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemand")
 	proto.RegisterType((*MsgTryFulfillOnDemandResponse)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemandResponse")
 	proto.RegisterType((*MsgCreateOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLP")
@@ -779,71 +1005,78 @@ func init() {
 }
 
 var fileDescriptor_47537f11f512b254 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0xc7, 0x45, 0x4b, 0x96, 0xad, 0x63, 0x7f, 0x89, 0xc2, 0x28, 0xb6, 0x24, 0x7f, 0x96, 0x1d,
-	0xb9, 0x40, 0x85, 0xb4, 0x26, 0x2d, 0xa7, 0x48, 0x5b, 0x2d, 0x0a, 0xc4, 0x11, 0x8c, 0x1a, 0xb5,
-	0x51, 0x83, 0x69, 0xbb, 0x28, 0x0a, 0x08, 0x94, 0x78, 0x4c, 0x13, 0xa1, 0x38, 0x04, 0x67, 0x7c,
-	0x51, 0x16, 0x45, 0xd0, 0x00, 0x5d, 0x17, 0x45, 0x1f, 0xa0, 0x40, 0x77, 0x5e, 0x65, 0x91, 0x87,
-	0xc8, 0xaa, 0x08, 0xb2, 0x2a, 0xba, 0x88, 0x0b, 0x7b, 0x91, 0xd7, 0x28, 0x86, 0x1c, 0x51, 0xa4,
-	0xae, 0x51, 0x57, 0xe4, 0xcc, 0xb9, 0xcc, 0xef, 0xcf, 0x39, 0x67, 0x86, 0xf0, 0x81, 0xd1, 0x69,
-	0xa3, 0x43, 0x2d, 0xe2, 0x9c, 0x77, 0x9e, 0xaa, 0xe1, 0x40, 0x45, 0xab, 0xd9, 0x52, 0xd9, 0xb9,
-	0xe2, 0x7a, 0x84, 0x11, 0x79, 0x25, 0xea, 0xa5, 0x84, 0x03, 0x85, 0x7b, 0x15, 0x97, 0x5b, 0x84,
-	0xb6, 0x09, 0x55, 0xdb, 0xd4, 0x54, 0x4f, 0xab, 0xfc, 0x11, 0x44, 0x15, 0x0b, 0x81, 0xa1, 0xe1,
-	0x8f, 0xd4, 0x60, 0x20, 0x4c, 0x39, 0x93, 0x98, 0x24, 0x98, 0xe7, 0x6f, 0x62, 0xb6, 0x24, 0x32,
-	0x35, 0x75, 0x8a, 0xea, 0x69, 0xb5, 0x89, 0x4c, 0xaf, 0xaa, 0x2d, 0x62, 0x39, 0xc2, 0x3e, 0x16,
-	0xd6, 0x76, 0x85, 0x57, 0x65, 0x9c, 0x97, 0xab, 0x7b, 0x7a, 0x5b, 0x50, 0x94, 0xff, 0x90, 0xe0,
-	0xe6, 0x01, 0x35, 0xbf, 0x75, 0x0d, 0x9d, 0xe1, 0xa1, 0x6f, 0x91, 0x1f, 0x40, 0x46, 0x3f, 0x61,
-	0xc7, 0xc4, 0xb3, 0x58, 0x27, 0x2f, 0xad, 0x4b, 0x95, 0xcc, 0x4e, 0xfe, 0xcd, 0xcb, 0xcd, 0x9c,
-	0xc0, 0x7f, 0x68, 0x18, 0x1e, 0x52, 0xfa, 0x98, 0x79, 0x96, 0x63, 0x6a, 0x3d, 0x57, 0xf9, 0x4b,
-	0x00, 0x07, 0xcf, 0x1a, 0x41, 0xfe, 0xfc, 0xcc, 0xba, 0x54, 0x59, 0xd8, 0xde, 0x50, 0xc6, 0x7c,
-	0x37, 0x25, 0x58, 0x70, 0x27, 0xf5, 0xea, 0xed, 0x5a, 0x42, 0xcb, 0x38, 0x78, 0x16, 0x4c, 0xd4,
-	0x6e, 0xfc, 0xf4, 0xee, 0xc5, 0xbd, 0x5e, 0xe6, 0x72, 0x01, 0x96, 0xfb, 0x20, 0x35, 0xa4, 0x2e,
-	0x71, 0x28, 0x96, 0x7f, 0x0b, 0x04, 0xec, 0x9e, 0xd8, 0x47, 0x96, 0x6d, 0x7f, 0xed, 0x19, 0xe8,
-	0xc9, 0x1f, 0xc1, 0xad, 0xa3, 0x60, 0x8c, 0x5e, 0x43, 0x0f, 0x70, 0x03, 0x21, 0x5a, 0x36, 0x34,
-	0x08, 0x19, 0x72, 0x01, 0xe6, 0x09, 0x8f, 0x6a, 0x58, 0x86, 0xcf, 0x9c, 0xd1, 0xe6, 0xfc, 0xf1,
-	0x9e, 0x21, 0xdf, 0x85, 0x45, 0x3c, 0x77, 0xb1, 0xc5, 0xd0, 0x68, 0x1c, 0x21, 0xe6, 0x93, 0xbe,
-	0x79, 0xa1, 0x3b, 0xb7, 0x8b, 0x58, 0x5b, 0xe2, 0xa4, 0x83, 0xab, 0x09, 0xe2, 0x28, 0x55, 0x48,
-	0xfc, 0x67, 0x0a, 0x0a, 0x7d, 0xb6, 0x87, 0x81, 0xd2, 0xa7, 0x68, 0xc4, 0x70, 0xa4, 0x38, 0xce,
-	0x2a, 0x80, 0x47, 0x6c, 0x5b, 0x77, 0xdd, 0x1e, 0x6b, 0x46, 0xcc, 0xec, 0x19, 0xb2, 0x0e, 0xb3,
-	0xae, 0x67, 0xb5, 0x38, 0x66, 0xb2, 0xb2, 0xb0, 0x5d, 0x50, 0xc4, 0x7e, 0xf1, 0x52, 0x52, 0x44,
-	0x29, 0x29, 0x8f, 0x88, 0xe5, 0xec, 0x6c, 0xf1, 0xef, 0x7d, 0x71, 0xb9, 0x56, 0x31, 0x2d, 0x76,
-	0x7c, 0xd2, 0x54, 0x5a, 0xa4, 0x2d, 0x6a, 0x53, 0x3c, 0x36, 0xa9, 0xf1, 0x44, 0x65, 0x1d, 0x17,
-	0xa9, 0x1f, 0x40, 0xb5, 0x20, 0xb3, 0xfc, 0x03, 0xa4, 0xf5, 0x36, 0x39, 0x71, 0x58, 0x3e, 0xe5,
-	0x97, 0x45, 0x9d, 0x27, 0xfa, 0xfb, 0xed, 0xda, 0x9d, 0x20, 0x8c, 0x1a, 0x4f, 0x14, 0x8b, 0xa8,
-	0x6d, 0x9d, 0x1d, 0x2b, 0x7b, 0x0e, 0xbb, 0xb8, 0x1c, 0x61, 0x78, 0xf3, 0x72, 0x13, 0x04, 0xdc,
-	0x9e, 0xc3, 0x34, 0x91, 0x93, 0xeb, 0xb3, 0xdd, 0x70, 0xbf, 0x66, 0x03, 0x7d, 0xb6, 0xdb, 0xdd,
-	0xa8, 0x2d, 0xc8, 0x11, 0x17, 0x3d, 0x9d, 0x11, 0x8f, 0xef, 0x46, 0xe8, 0x98, 0xf6, 0x1d, 0xe5,
-	0xae, 0x6d, 0x17, 0xb1, 0x1b, 0xd1, 0xbf, 0x7f, 0x73, 0x03, 0xfb, 0x27, 0xff, 0x08, 0x72, 0x2c,
-	0x29, 0x3d, 0xd6, 0x3d, 0xcc, 0xcf, 0xfb, 0xea, 0x0e, 0x85, 0xba, 0x95, 0x41, 0x11, 0xfb, 0x68,
-	0xea, 0xad, 0x4e, 0x1d, 0x5b, 0x17, 0x97, 0x63, 0xcd, 0x11, 0xa5, 0x75, 0x6c, 0x69, 0xd9, 0x08,
-	0xe4, 0x63, 0xbe, 0x92, 0x5c, 0x85, 0x1c, 0x45, 0xc6, 0x6c, 0x6c, 0xa3, 0xc3, 0x1a, 0xa7, 0xba,
-	0x6d, 0xf1, 0x1a, 0x37, 0xf2, 0x99, 0x75, 0xa9, 0x32, 0xaf, 0xdd, 0xee, 0xd9, 0xbe, 0xeb, 0x9a,
-	0x6a, 0x37, 0x79, 0xc9, 0x45, 0xbe, 0x54, 0x79, 0x03, 0xee, 0x8e, 0xac, 0xa7, 0xb0, 0xea, 0x9e,
-	0x4b, 0x90, 0x0b, 0x7b, 0xa8, 0x8e, 0x6d, 0xdd, 0x31, 0x82, 0x66, 0xd9, 0x80, 0xff, 0x91, 0x33,
-	0x67, 0xa0, 0x51, 0x16, 0xfd, 0xc9, 0xf7, 0x68, 0x92, 0x65, 0x98, 0xe3, 0x5d, 0xdf, 0xeb, 0x8f,
-	0xb4, 0x83, 0x67, 0xbc, 0x35, 0x64, 0xce, 0x19, 0xcf, 0x5d, 0x2e, 0xc1, 0xff, 0x87, 0x41, 0x84,
-	0x94, 0x16, 0xdc, 0x39, 0xa0, 0xe6, 0x37, 0x5e, 0xa7, 0xab, 0xc6, 0x09, 0xbc, 0xe4, 0x25, 0x48,
-	0x53, 0xcb, 0x74, 0xd0, 0x13, 0xcb, 0x8b, 0xd1, 0xb8, 0x76, 0xc9, 0x42, 0xd2, 0x73, 0x4c, 0x1f,
-	0x2a, 0xa9, 0xf1, 0xd7, 0xda, 0x02, 0x27, 0x12, 0x91, 0xe5, 0x35, 0x58, 0x1d, 0xba, 0x54, 0xc8,
-	0x42, 0xe1, 0xf6, 0x01, 0x35, 0x1f, 0x79, 0xa8, 0x33, 0xec, 0x1a, 0xf7, 0x0f, 0x23, 0x24, 0xc9,
-	0x18, 0xc9, 0xa7, 0x30, 0x63, 0xbb, 0xe2, 0xd4, 0xfb, 0x70, 0xec, 0xa9, 0xd7, 0x4b, 0xa6, 0xcd,
-	0xd8, 0x6e, 0x9c, 0x6a, 0x13, 0x56, 0x86, 0x2c, 0xda, 0x65, 0x92, 0x6f, 0xc0, 0x8c, 0x10, 0x9a,
-	0xd2, 0x66, 0x2c, 0xa3, 0xbc, 0xef, 0x33, 0xd6, 0xd1, 0xc6, 0x11, 0x8c, 0x52, 0x8c, 0x31, 0x0b,
-	0x49, 0xcb, 0xe0, 0x47, 0x73, 0xb2, 0x92, 0xd2, 0xf8, 0x6b, 0x7c, 0xf1, 0x55, 0x7f, 0xf1, 0xfe,
-	0x6c, 0xdd, 0xc5, 0xb7, 0x7f, 0x9f, 0x83, 0xe4, 0x01, 0x35, 0x65, 0x0f, 0x16, 0x63, 0xf7, 0xc5,
-	0xc7, 0x63, 0xd5, 0xf6, 0x1d, 0xdc, 0xc5, 0x4f, 0xa6, 0xf1, 0x0e, 0x85, 0xff, 0x2c, 0x81, 0x3c,
-	0xa4, 0x2c, 0xb6, 0x27, 0x25, 0x1b, 0x8c, 0x29, 0xd6, 0xa6, 0x8f, 0x09, 0x6b, 0x22, 0x21, 0x33,
-	0x58, 0x8c, 0xdd, 0x35, 0x13, 0xc5, 0x47, 0xbd, 0x27, 0x8b, 0x1f, 0x7a, 0x63, 0x24, 0xe4, 0x5f,
-	0x25, 0x58, 0x1a, 0x71, 0x61, 0x3c, 0x98, 0x26, 0x65, 0x2f, 0xae, 0xf8, 0xc5, 0x7f, 0x8b, 0x8b,
-	0x40, 0x3d, 0x97, 0xe0, 0xd6, 0xe0, 0x79, 0x52, 0x7d, 0xbf, 0xfd, 0x8d, 0x84, 0x14, 0x3f, 0x9f,
-	0x3a, 0x24, 0x42, 0xf1, 0x4c, 0x82, 0xec, 0x40, 0x93, 0x6e, 0x4d, 0xca, 0xd8, 0x1f, 0x51, 0xfc,
-	0x6c, 0xda, 0x88, 0x3e, 0x84, 0x81, 0x1e, 0x9c, 0x88, 0xd0, 0x1f, 0x31, 0x19, 0x61, 0x54, 0x67,
-	0x96, 0x13, 0xc5, 0xd9, 0x67, 0xef, 0x5e, 0xdc, 0x93, 0x76, 0xbe, 0x7a, 0x75, 0x55, 0x92, 0x5e,
-	0x5f, 0x95, 0xa4, 0x7f, 0xae, 0x4a, 0xd2, 0x2f, 0xd7, 0xa5, 0xc4, 0xeb, 0xeb, 0x52, 0xe2, 0xaf,
-	0xeb, 0x52, 0xe2, 0xfb, 0x6a, 0xe4, 0xae, 0x1f, 0xf1, 0x77, 0x78, 0x7a, 0x5f, 0x3d, 0x17, 0x7f,
-	0xbd, 0xfc, 0xea, 0x6f, 0xa6, 0xfd, 0x5f, 0xc4, 0xfb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x33, 0xd9, 0xee, 0x21, 0x0b, 0x00, 0x00,
+	// 1134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x4f, 0xdb, 0xd6,
+	0x17, 0xc7, 0x84, 0x52, 0x72, 0xa0, 0x85, 0x1a, 0x0a, 0xc1, 0x7c, 0x09, 0x34, 0x7c, 0xb5, 0x45,
+	0xed, 0xb0, 0x09, 0x54, 0x5d, 0x9b, 0x49, 0x93, 0x0a, 0x08, 0x0d, 0x0d, 0x34, 0xe4, 0x6e, 0x7b,
+	0x98, 0x26, 0x45, 0x8e, 0x7d, 0x30, 0x1e, 0x8e, 0xaf, 0x75, 0x7d, 0xf9, 0x91, 0x3e, 0x4c, 0xd5,
+	0x26, 0x4d, 0xda, 0xdb, 0x34, 0xed, 0x3f, 0xd8, 0x1b, 0x4f, 0xd5, 0xd4, 0x3f, 0x82, 0xa7, 0xa9,
+	0xea, 0xd3, 0xb4, 0x87, 0x52, 0xc1, 0x43, 0xff, 0x8d, 0xe9, 0xda, 0x37, 0x8e, 0x13, 0x87, 0xd0,
+	0x54, 0xd3, 0x9e, 0x92, 0x7b, 0xcf, 0xf9, 0x9c, 0xf3, 0xf9, 0xdc, 0x73, 0xee, 0x0f, 0xc3, 0xff,
+	0xad, 0x7a, 0x0d, 0xbd, 0xc0, 0x21, 0xde, 0x71, 0xfd, 0xa9, 0x16, 0x0f, 0x34, 0x74, 0xaa, 0xa6,
+	0xc6, 0x8e, 0x55, 0x9f, 0x12, 0x46, 0xe4, 0x99, 0xa4, 0x97, 0x1a, 0x0f, 0x54, 0xee, 0xa5, 0x4c,
+	0x99, 0x24, 0xa8, 0x91, 0x40, 0xab, 0x05, 0xb6, 0x76, 0x58, 0xe2, 0x3f, 0x11, 0x4a, 0x99, 0x8e,
+	0x0c, 0x95, 0x70, 0xa4, 0x45, 0x03, 0x61, 0x9a, 0xb0, 0x89, 0x4d, 0xa2, 0x79, 0xfe, 0x4f, 0xcc,
+	0xe6, 0x45, 0xa4, 0xaa, 0x11, 0xa0, 0x76, 0x58, 0xaa, 0x22, 0x33, 0x4a, 0x9a, 0x49, 0x1c, 0x4f,
+	0xd8, 0xbb, 0x92, 0x75, 0x7d, 0xe1, 0x55, 0xec, 0xe6, 0xe5, 0x1b, 0xd4, 0xa8, 0x09, 0x16, 0x85,
+	0xdf, 0x25, 0x18, 0xdd, 0x0e, 0xec, 0xaf, 0x7c, 0xcb, 0x60, 0xb8, 0x13, 0x5a, 0xe4, 0x07, 0x90,
+	0x35, 0x0e, 0xd8, 0x1e, 0xa1, 0x0e, 0xab, 0xe7, 0xa4, 0x79, 0xa9, 0x98, 0x5d, 0xcd, 0xbd, 0x7a,
+	0xb1, 0x38, 0x21, 0xe8, 0x3f, 0xb6, 0x2c, 0x8a, 0x41, 0xf0, 0x84, 0x51, 0xc7, 0xb3, 0xf5, 0xa6,
+	0xab, 0xfc, 0x19, 0x80, 0x87, 0x47, 0x95, 0x28, 0x7e, 0xae, 0x7f, 0x5e, 0x2a, 0x0e, 0x2f, 0x2f,
+	0xa8, 0x5d, 0xd6, 0x4d, 0x8d, 0x12, 0xae, 0x0e, 0x9c, 0xbe, 0x9e, 0xeb, 0xd3, 0xb3, 0x1e, 0x1e,
+	0x45, 0x13, 0xe5, 0x9b, 0x3f, 0xbc, 0x7d, 0x7e, 0xb7, 0x19, 0xb9, 0x30, 0x0d, 0x53, 0x6d, 0x24,
+	0x75, 0x0c, 0x7c, 0xe2, 0x05, 0x58, 0xf8, 0x2d, 0x12, 0xb0, 0x71, 0xe0, 0xee, 0x3a, 0xae, 0xfb,
+	0x05, 0xb5, 0x90, 0xca, 0xf7, 0xe0, 0xd6, 0x6e, 0x34, 0x46, 0x5a, 0x31, 0x22, 0xba, 0x91, 0x10,
+	0x7d, 0x2c, 0x36, 0x08, 0x19, 0xf2, 0x34, 0x0c, 0x11, 0x8e, 0xaa, 0x38, 0x56, 0xc8, 0x39, 0xab,
+	0x5f, 0x0f, 0xc7, 0x9b, 0x96, 0x7c, 0x07, 0x46, 0xf0, 0xd8, 0x47, 0x93, 0xa1, 0x55, 0xd9, 0x45,
+	0xcc, 0x65, 0x42, 0xf3, 0x70, 0x63, 0x6e, 0x03, 0xb1, 0x3c, 0xc9, 0x99, 0xa6, 0xb3, 0x09, 0xc6,
+	0x49, 0x56, 0x31, 0xe3, 0x37, 0x12, 0x4c, 0xb6, 0xd9, 0x76, 0x0c, 0xca, 0x1c, 0xc3, 0xfd, 0x0f,
+	0x89, 0xcb, 0x6b, 0x30, 0x68, 0xd4, 0xc8, 0x81, 0xc7, 0x72, 0x03, 0x61, 0x85, 0xef, 0xf1, 0x1a,
+	0xfc, 0xfd, 0x7a, 0xee, 0x76, 0x54, 0xe5, 0xc0, 0xda, 0x57, 0x1d, 0xa2, 0xd5, 0x0c, 0xb6, 0xa7,
+	0x6e, 0x7a, 0xec, 0xd5, 0x8b, 0x45, 0x10, 0xe5, 0xdf, 0xf4, 0x98, 0x2e, 0xa0, 0x97, 0xaa, 0x9f,
+	0x87, 0x7c, 0x67, 0x85, 0xf1, 0x22, 0xfc, 0x39, 0x00, 0xd3, 0x6d, 0x2e, 0x8f, 0xa3, 0x72, 0x3f,
+	0x45, 0xab, 0x45, 0x9a, 0xd4, 0x2a, 0x6d, 0x16, 0x80, 0x12, 0xd7, 0x35, 0x7c, 0xbf, 0xa9, 0x3b,
+	0x2b, 0x66, 0x36, 0x2d, 0xd9, 0x80, 0x6b, 0x3e, 0x75, 0x4c, 0x2e, 0x39, 0x53, 0x1c, 0x5e, 0x9e,
+	0x56, 0x05, 0x6b, 0xbe, 0x9f, 0x54, 0xb1, 0x9f, 0xd4, 0x35, 0xe2, 0x78, 0xab, 0x4b, 0x5c, 0xf0,
+	0xc9, 0xd9, 0x5c, 0xd1, 0x76, 0xd8, 0xde, 0x41, 0x55, 0x35, 0x49, 0x4d, 0x6c, 0x50, 0xf1, 0xb3,
+	0x18, 0x58, 0xfb, 0x1a, 0xab, 0xfb, 0x18, 0x84, 0x80, 0x40, 0x8f, 0x22, 0xcb, 0xdf, 0xb6, 0xad,
+	0xdc, 0x7a, 0xd7, 0x95, 0x3b, 0x39, 0xeb, 0x69, 0x49, 0xb9, 0x3e, 0xd7, 0x8f, 0x6b, 0x7f, 0x2d,
+	0xd2, 0xe7, 0xfa, 0x8d, 0xa2, 0x2f, 0xc1, 0x04, 0xf1, 0x91, 0x1a, 0x8c, 0x50, 0x5e, 0xd9, 0xd8,
+	0x71, 0x30, 0x74, 0x94, 0x1b, 0xb6, 0x0d, 0xc4, 0x06, 0xa2, 0xbd, 0x17, 0xae, 0xa7, 0x7b, 0xe1,
+	0x7b, 0x90, 0x5b, 0x82, 0x06, 0x7b, 0x06, 0xc5, 0xdc, 0x50, 0xa8, 0x6e, 0x47, 0xa8, 0x9b, 0x49,
+	0x8b, 0xd8, 0x42, 0xdb, 0x30, 0xeb, 0xeb, 0x68, 0x9e, 0x9c, 0x75, 0x35, 0x27, 0x94, 0xae, 0xa3,
+	0xa9, 0x8f, 0x25, 0x48, 0x3e, 0xe1, 0x99, 0xe4, 0x12, 0x4c, 0x04, 0xc8, 0x98, 0x8b, 0x35, 0xf4,
+	0x58, 0xe5, 0xd0, 0x70, 0x1d, 0xbe, 0xd1, 0xad, 0x5c, 0x76, 0x5e, 0x2a, 0x0e, 0xe9, 0xe3, 0x4d,
+	0xdb, 0xd7, 0x0d, 0x53, 0x79, 0x94, 0x77, 0x5e, 0x62, 0xa5, 0x0a, 0x0b, 0x70, 0xe7, 0xd2, 0x7e,
+	0x8a, 0xbb, 0xee, 0x54, 0x82, 0x89, 0xf8, 0x20, 0x59, 0xc7, 0x9a, 0xe1, 0x59, 0xd1, 0x89, 0xb1,
+	0x00, 0x37, 0xc8, 0x91, 0x97, 0xda, 0x74, 0x23, 0xe1, 0xe4, 0x3b, 0x6c, 0xb8, 0x29, 0xb8, 0xce,
+	0x8f, 0xbe, 0xe6, 0x5e, 0x1b, 0xf4, 0xf0, 0x88, 0x2f, 0xed, 0x0c, 0x64, 0x77, 0x5d, 0x12, 0xad,
+	0x6b, 0xd4, 0x2f, 0xfa, 0x50, 0x38, 0xc1, 0x8d, 0x1f, 0xc0, 0xa8, 0x85, 0xa6, 0x51, 0xaf, 0xf8,
+	0x48, 0x2b, 0x55, 0x97, 0x98, 0xfb, 0xa2, 0xe0, 0x37, 0xc2, 0xe9, 0x1d, 0xa4, 0xab, 0x7c, 0xb2,
+	0x2c, 0x73, 0xb1, 0xad, 0x04, 0x0b, 0x79, 0xf8, 0x5f, 0x27, 0x25, 0xb1, 0xd4, 0xef, 0x42, 0xa5,
+	0x6b, 0x86, 0x67, 0xa2, 0xfb, 0x2f, 0x2a, 0xed, 0xc2, 0x25, 0x95, 0x2b, 0xe6, 0xe2, 0xc0, 0xed,
+	0xed, 0xc0, 0xfe, 0x92, 0xd6, 0x1b, 0xe5, 0xf1, 0x22, 0x2f, 0x79, 0x12, 0x06, 0x03, 0xc7, 0xf6,
+	0x90, 0x8a, 0x2c, 0x62, 0xd4, 0x6d, 0xff, 0x8f, 0x41, 0x86, 0x7a, 0x76, 0xb8, 0xca, 0x19, 0x9d,
+	0xff, 0x2d, 0x0f, 0x73, 0x46, 0x02, 0x59, 0x98, 0x83, 0xd9, 0x8e, 0xa9, 0x62, 0x2e, 0x01, 0x8c,
+	0x73, 0xae, 0x14, 0x0d, 0x86, 0x0d, 0xe3, 0xd6, 0x4e, 0x82, 0x49, 0xa6, 0x85, 0xc9, 0xc7, 0xd0,
+	0xef, 0xfa, 0xe2, 0x2e, 0xfb, 0xb0, 0xeb, 0x5d, 0xd6, 0x0c, 0xa6, 0xf7, 0xbb, 0x7e, 0x2b, 0xab,
+	0x45, 0x98, 0xe9, 0x90, 0xb4, 0xc1, 0x49, 0xbe, 0x09, 0xfd, 0x42, 0xe8, 0x80, 0xde, 0xef, 0x58,
+	0x85, 0xad, 0x90, 0xe3, 0x3a, 0xba, 0x78, 0x09, 0x47, 0xa9, 0x85, 0xe3, 0x18, 0x64, 0x1c, 0x8b,
+	0x5f, 0xb8, 0x99, 0xe2, 0x80, 0xce, 0xff, 0xb6, 0x26, 0x9f, 0x85, 0x99, 0x0e, 0xd1, 0x1a, 0xc9,
+	0x97, 0xff, 0xc8, 0x42, 0x66, 0x3b, 0xb0, 0x65, 0x0a, 0x23, 0x2d, 0xaf, 0x80, 0x8f, 0xba, 0xaa,
+	0x6d, 0xbb, 0x8e, 0x95, 0xfb, 0xbd, 0x78, 0xc7, 0xc2, 0x7f, 0x92, 0x40, 0xee, 0xd0, 0x16, 0xcb,
+	0x57, 0x05, 0x4b, 0x63, 0x94, 0x72, 0xef, 0x98, 0xb8, 0x27, 0xfa, 0x64, 0x06, 0x23, 0x2d, 0x2f,
+	0x88, 0x2b, 0xc5, 0x27, 0xbd, 0x95, 0xfb, 0xbd, 0x78, 0x27, 0xb2, 0xfe, 0x2c, 0xc1, 0x78, 0xa7,
+	0x67, 0xc0, 0x4a, 0x2f, 0xf1, 0x04, 0x48, 0xf9, 0xe4, 0x3d, 0x40, 0x09, 0x2e, 0xbf, 0x4a, 0x30,
+	0x79, 0xc9, 0x6d, 0xfc, 0xa0, 0x97, 0xc8, 0x4d, 0x9c, 0xf2, 0xe9, 0xfb, 0xe1, 0x12, 0xa4, 0x7e,
+	0x94, 0xe0, 0x56, 0xfa, 0xb0, 0x2e, 0xbd, 0x5b, 0xaf, 0x25, 0x20, 0xca, 0xa3, 0x9e, 0x21, 0x6d,
+	0x2c, 0xd2, 0x07, 0xe9, 0x95, 0x2c, 0x52, 0x10, 0xe5, 0x51, 0xcf, 0x90, 0x04, 0x8b, 0x67, 0x12,
+	0x8c, 0xa5, 0x8e, 0xad, 0xa5, 0x2b, 0x23, 0xb6, 0x21, 0x94, 0x87, 0xbd, 0x22, 0xda, 0x28, 0xa4,
+	0x4e, 0xa5, 0x2b, 0x29, 0xb4, 0x23, 0x94, 0x87, 0xbd, 0x22, 0x9a, 0x14, 0x94, 0x6b, 0xcf, 0xde,
+	0x3e, 0xbf, 0x2b, 0xad, 0x7e, 0x7e, 0x7a, 0x9e, 0x97, 0x5e, 0x9e, 0xe7, 0xa5, 0x37, 0xe7, 0x79,
+	0xe9, 0x97, 0x8b, 0x7c, 0xdf, 0xcb, 0x8b, 0x7c, 0xdf, 0x5f, 0x17, 0xf9, 0xbe, 0x6f, 0x4a, 0x89,
+	0xe7, 0xdc, 0x25, 0x5f, 0x41, 0x87, 0x2b, 0xda, 0xb1, 0xf8, 0xba, 0xe3, 0xaf, 0xbb, 0xea, 0x60,
+	0xf8, 0x29, 0xb4, 0xf2, 0xcf, 0x00, 0xf6, 0x6d, 0x3c, 0x55, 0x09, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -862,8 +1095,10 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error)
 	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
+	FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error)
 	FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error)
 	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
+	CancelDemandOrder(ctx context.Context, in *MsgCancelDemandOrder, opts ...grpc.CallOption) (*MsgCancelDemandOrderResponse, error)
 	CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error)
 	DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error)
 }
@@ -903,6 +1138,15 @@ func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts
 	return out, nil
 }
 
+func (c *msgClient) FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error) {
+	out := new(MsgFulfillOrderPartialResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error) {
 	out := new(MsgFulfillOrderAuthorizedResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized", in, out, opts...)
@@ -921,6 +1165,15 @@ func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOr
 	return out, nil
 }
 
+func (c *msgClient) CancelDemandOrder(ctx context.Context, in *MsgCancelDemandOrder, opts ...grpc.CallOption) (*MsgCancelDemandOrderResponse, error) {
+	out := new(MsgCancelDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CancelDemandOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error) {
 	out := new(MsgCreateOnDemandLPResponse)
 	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP", in, out, opts...)
@@ -945,8 +1198,10 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	TryFulfillOnDemand(context.Context, *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error)
 	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
+	FulfillOrderPartial(context.Context, *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error)
 	FulfillOrderAuthorized(context.Context, *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error)
 	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
+	CancelDemandOrder(context.Context, *MsgCancelDemandOrder) (*MsgCancelDemandOrderResponse, error)
 	CreateOnDemandLP(context.Context, *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error)
 	DeleteOnDemandLP(context.Context, *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error)
 }
@@ -964,12 +1219,18 @@ func (*UnimplementedMsgServer) TryFulfillOnDemand(ctx context.Context, req *MsgT
 func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
 }
+func (*UnimplementedMsgServer) FulfillOrderPartial(ctx context.Context, req *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderPartial not implemented")
+}
 func (*UnimplementedMsgServer) FulfillOrderAuthorized(ctx context.Context, req *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAuthorized not implemented")
 }
 func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
 }
+func (*UnimplementedMsgServer) CancelDemandOrder(ctx context.Context, req *MsgCancelDemandOrder) (*MsgCancelDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDemandOrder not implemented")
+}
 func (*UnimplementedMsgServer) CreateOnDemandLP(ctx context.Context, req *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOnDemandLP not implemented")
 }
@@ -1035,6 +1296,24 @@ func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FulfillOrderPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderPartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, req.(*MsgFulfillOrderPartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_FulfillOrderAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgFulfillOrderAuthorized)
 	if err := dec(in); err != nil {
@@ -1071,6 +1350,24 @@ func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDemandOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDemandOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CancelDemandOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDemandOrder(ctx, req.(*MsgCancelDemandOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateOnDemandLP)
 	if err := dec(in); err != nil {
@@ -1123,6 +1420,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FulfillOrder",
 			Handler:    _Msg_FulfillOrder_Handler,
 		},
+		{
+			MethodName: "FulfillOrderPartial",
+			Handler:    _Msg_FulfillOrderPartial_Handler,
+		},
 		{
 			MethodName: "FulfillOrderAuthorized",
 			Handler:    _Msg_FulfillOrderAuthorized_Handler,
@@ -1131,6 +1432,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDemandOrder",
 			Handler:    _Msg_UpdateDemandOrder_Handler,
 		},
+		{
+			MethodName: "CancelDemandOrder",
+			Handler:    _Msg_CancelDemandOrder_Handler,
+		},
 		{
 			MethodName: "CreateOnDemandLP",
 			Handler:    _Msg_CreateOnDemandLP_Handler,
@@ -1274,7 +1579,7 @@ func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
+func (m *MsgFulfillOrderPartial) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1284,67 +1589,144 @@ func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFulfillOrderPartial) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFulfillOrderPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SettlementValidated {
-		i--
-		if m.SettlementValidated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
 	{
-		size := m.OperatorFeeShare.Size()
+		size := m.Amount.Size()
 		i -= size
-		if _, err := m.OperatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x22
 	if len(m.ExpectedFee) > 0 {
 		i -= len(m.ExpectedFee)
 		copy(dAtA[i:], m.ExpectedFee)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1a
 	}
-	if len(m.OperatorFeeAddress) > 0 {
-		i -= len(m.OperatorFeeAddress)
-		copy(dAtA[i:], m.OperatorFeeAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementValidated {
+		i--
+		if m.SettlementValidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.OperatorFeeShare.Size()
+		i -= size
+		if _, err := m.OperatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OperatorFeeAddress) > 0 {
+		i -= len(m.OperatorFeeAddress)
+		copy(dAtA[i:], m.OperatorFeeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Price) > 0 {
 		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1419,6 +1801,20 @@ func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DecayPerBlock) > 0 {
+		i -= len(m.DecayPerBlock)
+		copy(dAtA[i:], m.DecayPerBlock)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DecayPerBlock)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FloorFee) > 0 {
+		i -= len(m.FloorFee)
+		copy(dAtA[i:], m.FloorFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FloorFee)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.NewFee) > 0 {
 		i -= len(m.NewFee)
 		copy(dAtA[i:], m.NewFee)
@@ -1466,6 +1862,66 @@ func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelDemandOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDemandOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgTryFulfillOnDemand) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1737,6 +2193,38 @@ func (m *MsgFulfillOrderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFulfillOrderPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFulfillOrderPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgFulfillOrderAuthorized) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1806,6 +2294,14 @@ func (m *MsgUpdateDemandOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.FloorFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DecayPerBlock)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1818,6 +2314,32 @@ func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelDemandOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgTryFulfillOnDemand) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2272,7 +2794,7 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+func (m *MsgFulfillOrderPartial) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2295,15 +2817,15 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2331,11 +2853,11 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2363,13 +2885,13 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2379,25 +2901,23 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = append(m.Price, types.Coin{})
-			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2433,26 +2953,258 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			intStringLen := int(stringLen)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
@@ -2779,6 +3531,70 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.NewFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecayPerBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2850,6 +3666,170 @@ func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelDemandOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDemandOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -214,8 +214,9 @@ func (suite *KeeperTestSuite) CreateDefaultRollappFrom(addr sdk.AccAddress) stri
 		InitialSequencer: addr.String(),
 		MinSequencerBond: rollapptypes.DefaultMinSequencerBondGlobalCoin,
 
-		Alias:  strings.ToLower(tmrand.Str(7)),
-		VmType: rollapptypes.Rollapp_EVM,
+		Alias:    strings.ToLower(tmrand.Str(7)),
+		VmType:   rollapptypes.Rollapp_EVM,
+		DaScheme: rollapptypes.DASchemeMock,
 		GenesisInfo: &rollapptypes.GenesisInfo{
 			Bech32Prefix:    strings.ToLower(tmrand.Str(3)),
 			GenesisChecksum: "checksum",
@@ -245,4 +246,9 @@ func (suite *KeeperTestSuite) TransferRollappOwnership(currentOwner, newOwner sd
 	})
 	suite.Require().NoError(err)
 	suite.Require().NotNil(resp)
+	_, err = rollappMsgServer.AcceptOwnership(suite.Ctx, &rollapptypes.MsgAcceptOwnership{
+		NewOwner:  newOwner.String(),
+		RollappId: rollappID,
+	})
+	suite.Require().NoError(err)
 }
@@ -126,6 +126,16 @@ func TestParseBondingCurve(t *testing.T) {
 		{"Negative values M", "-1.2,0.4,0", true},
 		{"Negative values N", "1.2,-0.4,0", true},
 		{"Negative values C", "1.2,0.4,-1", true},
+		{"Valid power curve with family", "power:1.2,0.4,0", false},
+		{"Valid exponential curve", "exponential:2,0.01,1", false},
+		{"Exponential invalid params count", "exponential:2,0.01", true},
+		{"Exponential zero K", "exponential:2,0,1", true},
+		{"Valid logistic curve", "logistic:10,0.01,500,1", false},
+		{"Logistic invalid X0", "logistic:10,0.01,invalid,1", true},
+		{"Valid piecewise curve", "piecewise:0/0.1,1000/0.5,3000/1.5", false},
+		{"Piecewise invalid breakpoint", "piecewise:0/0.1,1000", true},
+		{"Piecewise decreasing price", "piecewise:0/0.5,1000/0.1", true},
+		{"Unknown family", "quadratic:1,2,3", true},
 	}
 
 	for _, tt := range tests {
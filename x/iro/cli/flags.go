@@ -18,6 +18,16 @@ const (
 	FlagVestingDuration                        = "vesting-duration"
 	FlagVestingStartTimeAfterSettlement        = "vesting-start-time"
 	FlagTradingDisabled                        = "trading-disabled"
+	FlagPresaleMerkleRoot                      = "presale-root"
+	FlagPresaleDuration                        = "presale-duration"
+	FlagPresaleCap                             = "presale-cap"
+	FlagPresaleProof                           = "presale-proof"
+	FlagMaxBuyPerAccount                       = "max-buy-per-account"
+	FlagMaxBuyPerBlock                         = "max-buy-per-block"
+	FlagRampInitialMaxBuy                      = "ramp-initial-max-buy"
+	FlagRampDuration                           = "ramp-duration"
+	FlagBuyerVestingCliff                      = "buyer-vesting-cliff"
+	FlagBuyerVestingDuration                   = "buyer-vesting-duration"
 )
 
 // FIXME: add plan duration
@@ -41,6 +51,24 @@ func FlagSetCreatePlan() *flag.FlagSet {
 	fs.Float64(FlagLiquidityPart, defaultLiquidityPart, "The part of the total liquidity to allocate to the plan.")
 	fs.Duration(FlagVestingDuration, defaultVestingDuration, "The duration of the vesting period.")
 	fs.Duration(FlagVestingStartTimeAfterSettlement, defaultVestingStartTime, "The start time of the vesting period after the plan is settled.")
+	fs.String(FlagPresaleMerkleRoot, "", "The hex merkle root of the presale allowlist. Empty for no presale.")
+	fs.Duration(FlagPresaleDuration, 0, "The duration of the presale from the start time of the plan.")
+	fs.String(FlagMaxBuyPerAccount, "", "The maximum amount of tokens an account can buy in total. Empty for no limit.")
+	fs.String(FlagMaxBuyPerBlock, "", "The maximum amount of tokens that can be bought in a block. Empty for no limit.")
+	fs.String(FlagRampInitialMaxBuy, "", "The maximum amount of tokens in a single buy when the trading starts. Empty for no limit.")
+	fs.Duration(FlagRampDuration, 0, "The duration over which the max buy relaxes to the max amount to sell.")
+	fs.Duration(FlagBuyerVestingCliff, 0, "The duration after the settlement before which the claimed tokens don't vest.")
+	fs.Duration(FlagBuyerVestingDuration, 0, "The duration after the settlement over which the claimed tokens vest. Zero for no vesting.")
+
+	return fs
+}
+
+// FlagSetPresaleProof returns flags for proving the buyer is in the presale allowlist.
+func FlagSetPresaleProof() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagPresaleCap, "", "The presale cap of the buyer in the allowlist.")
+	fs.StringSlice(FlagPresaleProof, nil, "The comma-separated hex sibling hashes from the buyer leaf to the presale merkle root.")
 
 	return fs
 }
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdQuerySpotPrice(),
 		CmdQueryCost(),
 		CmdQueryClaimed(),
+		CmdQueryClaimable(),
 	)
 
 	return iroQueryCmd
@@ -223,3 +224,28 @@ func CmdQueryClaimed() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryClaimable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable [plan-id] [claimer]",
+		Short: "Query the claimable and locked amounts of a claimer for a specific plan",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryClaimable(cmd.Context(), &types.QueryClaimableRequest{PlanId: args[0], Claimer: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
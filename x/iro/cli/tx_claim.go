@@ -68,3 +68,33 @@ func CmdClaimVested() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdRefund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund [plan-id]",
+		Short: "Refund the IRO tokens for the raised liquidity after the plan failed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planID := args[0]
+
+			msg := types.MsgRefund{
+				Claimer: clientCtx.GetFromAddress().String(),
+				PlanId:  planID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
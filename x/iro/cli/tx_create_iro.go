@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -30,6 +31,10 @@ Parameters:
 
 Required Flags:
   --curve           : The bonding curve parameters in the format "M,N,C" where the curve is defined as p(x) = M * x^N + C.
+                      Other curve families are prefixed by their name:
+                        "exponential:M,K,C"         for p(x) = M * e^(K * x) + C
+                        "logistic:M,K,X0,C"         for p(x) = M / (1 + e^(-K * (x - X0))) + C
+                        "piecewise:S0/P0,S1/P1,..." for the price interpolated linearly between (supply, price) breakpoints
 
 Optional Flags:
   --start-time      : The time when the IRO will start. Can be Unix timestamp or RFC3339 format (e.g., "2023-10-01T00:00:00Z").
@@ -46,11 +51,27 @@ Optional Flags:
                       Default: 0m
   --trading-disabled: Disables trading for the plan. Will require MsgEnableTrading to be executed later on.
                       Default: false
+  --presale-root    : The hex merkle root of the presale allowlist. Only the allowlisted buyers can buy during the presale.
+                      Default: no presale
+  --presale-duration: The duration of the presale from the start time of the plan (e.g., "6h").
+  --max-buy-per-account: The maximum amount of tokens an account can buy in total.
+                      Default: no limit
+  --max-buy-per-block: The maximum amount of tokens that can be bought in a block by all the accounts.
+                      Default: no limit
+  --ramp-initial-max-buy: The maximum amount of tokens in a single buy when the trading starts.
+                      It relaxes linearly to the max amount to sell over the ramp duration.
+                      Default: no limit
+  --ramp-duration   : The duration of the ramp from the start time of the plan (e.g., "6h").
+  --buyer-vesting-duration: The duration after settlement over which the tokens claimed by the buyers vest linearly.
+                      Default: no vesting
+  --buyer-vesting-cliff: The duration after settlement before which the claimed tokens don't vest.
+                      Default: 0m
 
 Examples:
   dymd tx iro create-iro myrollapp1 1000000000 24h --curve "1.2,0.4,0" --from mykey
   dymd tx iro create-iro myrollapp2 500000000 30m --curve "1.5,0.5,100" --start-time "2023-10-01T00:00:00Z" --incentives-start 24h --incentives-epochs 3000 --from mykey
   dymd tx iro create-iro myrollapp3 2000000000 48h --curve "1.3,0.3,50" --trading-disabled=true --from mykey
+  dymd tx iro create-iro myrollapp4 1000000000 24h --curve "logistic:1,0.000001,500000000,0.01" --from mykey
 `,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -131,6 +152,35 @@ Examples:
 				return err
 			}
 
+			presaleRootStr, err := cmd.Flags().GetString(FlagPresaleMerkleRoot)
+			if err != nil {
+				return err
+			}
+			presaleRoot, err := hex.DecodeString(presaleRootStr)
+			if err != nil {
+				return fmt.Errorf("invalid presale merkle root: %w", err)
+			}
+
+			presaleDuration, err := cmd.Flags().GetDuration(FlagPresaleDuration)
+			if err != nil {
+				return err
+			}
+
+			tradingLimits, err := parseTradingLimits(cmd)
+			if err != nil {
+				return err
+			}
+
+			buyerVestingCliff, err := cmd.Flags().GetDuration(FlagBuyerVestingCliff)
+			if err != nil {
+				return err
+			}
+
+			buyerVestingDuration, err := cmd.Flags().GetDuration(FlagBuyerVestingDuration)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -152,6 +202,15 @@ Examples:
 				VestingDuration:                 vestingDuration,
 				VestingStartTimeAfterSettlement: vestingStartTimeAfterSettlement,
 				TradingEnabled:                  !tradingDisabled,
+				Presale: types.Presale{
+					MerkleRoot: presaleRoot,
+					Duration:   presaleDuration,
+				},
+				TradingLimits: tradingLimits,
+				BuyerVesting: types.BuyerVesting{
+					Cliff:    buyerVestingCliff,
+					Duration: buyerVestingDuration,
+				},
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -167,31 +226,116 @@ Examples:
 	return cmd
 }
 
+// parseTradingLimits parses the trading limits flags. An empty limit is disabled.
+func parseTradingLimits(cmd *cobra.Command) (types.TradingLimits, error) {
+	var limits types.TradingLimits
+
+	flagLimits := []struct {
+		flag  string
+		limit *math.Int
+	}{
+		{FlagMaxBuyPerAccount, &limits.MaxPerAccount},
+		{FlagMaxBuyPerBlock, &limits.MaxPerBlock},
+		{FlagRampInitialMaxBuy, &limits.RampInitialMaxBuy},
+	}
+	for _, fl := range flagLimits {
+		limitStr, err := cmd.Flags().GetString(fl.flag)
+		if err != nil {
+			return limits, err
+		}
+		*fl.limit = math.ZeroInt()
+		if limitStr == "" {
+			continue
+		}
+		limit, ok := math.NewIntFromString(limitStr)
+		if !ok {
+			return limits, fmt.Errorf("invalid %s: %s", fl.flag, limitStr)
+		}
+		*fl.limit = limit
+	}
+
+	rampDuration, err := cmd.Flags().GetDuration(FlagRampDuration)
+	if err != nil {
+		return limits, err
+	}
+	limits.RampDuration = rampDuration
+
+	return limits, nil
+}
+
 // ParseBondingCurve parses the bonding curve string into a BondingCurve struct
-// expected format: "M,N,C" for p(x) = M * x^N + C
+// expected formats:
+//
+//	"M,N,C" for p(x) = M * x^N + C
+//	"exponential:M,K,C" for p(x) = M * e^(K * x) + C
+//	"logistic:M,K,X0,C" for p(x) = M / (1 + e^(-K * (x - X0))) + C
+//	"piecewise:S0/P0,S1/P1,..." for the price interpolated between the (supply, price) breakpoints
 func ParseBondingCurve(curveStr string) (types.BondingCurve, error) {
 	var curve types.BondingCurve
 
-	curveParams := strings.Split(curveStr, ",")
-	if len(curveParams) != 3 {
-		return curve, errors.New("invalid bonding curve parameters")
+	family, paramsStr, found := strings.Cut(curveStr, ":")
+	if !found {
+		family, paramsStr = "power", curveStr
 	}
-
-	M, err := math.LegacyNewDecFromStr(curveParams[0])
-	if err != nil {
-		return curve, errors.New("invalid M parameter")
+	curveParams := strings.Split(paramsStr, ",")
+
+	switch family {
+	case "power":
+		params, err := parseCurveParams(curveParams, "M", "N", "C")
+		if err != nil {
+			return curve, err
+		}
+		curve = types.NewBondingCurve(params[0], params[1], params[2], 18, 18)
+	case "exponential":
+		params, err := parseCurveParams(curveParams, "M", "K", "C")
+		if err != nil {
+			return curve, err
+		}
+		curve = types.NewExponentialBondingCurve(params[0], params[1], params[2], 18, 18)
+	case "logistic":
+		params, err := parseCurveParams(curveParams, "M", "K", "X0", "C")
+		if err != nil {
+			return curve, err
+		}
+		curve = types.NewLogisticBondingCurve(params[0], params[1], params[2], params[3], 18, 18)
+	case "piecewise":
+		breakpoints := make([]types.Breakpoint, 0, len(curveParams))
+		for _, bpStr := range curveParams {
+			supplyStr, priceStr, ok := strings.Cut(bpStr, "/")
+			if !ok {
+				return curve, fmt.Errorf("invalid breakpoint: %s", bpStr)
+			}
+			supply, err := math.LegacyNewDecFromStr(supplyStr)
+			if err != nil {
+				return curve, fmt.Errorf("invalid breakpoint supply: %s", bpStr)
+			}
+			price, err := math.LegacyNewDecFromStr(priceStr)
+			if err != nil {
+				return curve, fmt.Errorf("invalid breakpoint price: %s", bpStr)
+			}
+			breakpoints = append(breakpoints, types.Breakpoint{Supply: supply, Price: price})
+		}
+		curve = types.NewPiecewiseLinearBondingCurve(breakpoints, 18, 18)
+	default:
+		return curve, fmt.Errorf("invalid bonding curve family: %s", family)
 	}
 
-	N, err := math.LegacyNewDecFromStr(curveParams[1])
-	if err != nil {
-		return curve, errors.New("invalid N parameter")
-	}
+	return curve, curve.ValidateBasic()
+}
 
-	C, err := math.LegacyNewDecFromStr(curveParams[2])
-	if err != nil {
-		return curve, errors.New("invalid C parameter")
+// parseCurveParams parses the decimal curve parameters, in the order of the names
+func parseCurveParams(curveParams []string, names ...string) ([]math.LegacyDec, error) {
+	if len(curveParams) != len(names) {
+		return nil, errors.New("invalid bonding curve parameters")
 	}
 
-	curve = types.NewBondingCurve(M, N, C, 18, 18)
-	return curve, curve.ValidateBasic()
+	params := make([]math.LegacyDec, len(names))
+	for i, name := range names {
+		param, err := math.LegacyNewDecFromStr(curveParams[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s parameter", name)
+		}
+		params[i] = param
+	}
+	return params, nil
 }
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -56,11 +57,16 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 
 			var msg sdk.Msg
 			if isBuy {
+				presaleProof, err := parsePresaleProof(cmd)
+				if err != nil {
+					return err
+				}
 				msg = &types.MsgBuy{
 					Buyer:         clientCtx.GetFromAddress().String(),
 					PlanId:        planID,
 					Amount:        amount,
 					MaxCostAmount: expectedAmount,
+					PresaleProof:  presaleProof,
 				}
 			} else {
 				msg = &types.MsgSell{
@@ -75,10 +81,46 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 		},
 	}
 
+	if isBuy {
+		cmd.Flags().AddFlagSet(FlagSetPresaleProof())
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parsePresaleProof parses the presale proof flags. Returns nil if the presale cap is not set.
+func parsePresaleProof(cmd *cobra.Command) (*types.PresaleProof, error) {
+	capStr, err := cmd.Flags().GetString(FlagPresaleCap)
+	if err != nil {
+		return nil, err
+	}
+	if capStr == "" {
+		return nil, nil
+	}
+
+	presaleCap, ok := math.NewIntFromString(capStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid presale cap: %s", capStr)
+	}
+
+	proofStrs, err := cmd.Flags().GetStringSlice(FlagPresaleProof)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := make([][]byte, 0, len(proofStrs))
+	for _, s := range proofStrs {
+		sibling, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid presale proof: %s: %w", s, err)
+		}
+		proof = append(proof, sibling)
+	}
+
+	return &types.PresaleProof{Cap: presaleCap, Proof: proof}, nil
+}
+
 func CmdEnableTrading() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "enable-trading [plan-id]",
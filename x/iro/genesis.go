@@ -22,11 +22,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	lastPlanId := uint64(0)
 	for _, plan := range genState.Plans {
 		k.SetPlan(ctx, plan)
+		if err := k.UpdateFailureDeadline(ctx, plan); err != nil {
+			panic(err)
+		}
 		if plan.Id > lastPlanId {
 			lastPlanId = plan.Id
 		}
 	}
 	k.SetLastPlanId(ctx, lastPlanId)
+
+	for _, allowance := range genState.PresaleAllowances {
+		k.SetPresaleAllowance(ctx, allowance)
+	}
+
+	for _, purchase := range genState.AccountPurchases {
+		k.SetAccountPurchase(ctx, purchase)
+	}
+
+	for _, claim := range genState.BuyerVestingClaims {
+		k.SetBuyerVestingClaim(ctx, claim)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -34,6 +49,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.GenesisState{}
 	genesis.Params = k.GetParams(ctx)
 	genesis.Plans = append(genesis.Plans, k.GetAllPlans(ctx, false)...)
+	genesis.PresaleAllowances = k.GetAllPresaleAllowances(ctx)
+	genesis.AccountPurchases = k.GetAllAccountPurchases(ctx)
+	genesis.BuyerVestingClaims = k.GetAllBuyerVestingClaims(ctx)
 
 	return &genesis
 }
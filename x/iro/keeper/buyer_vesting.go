@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetBuyerVestingClaim stores the vesting of the tokens claimed by a buyer in a plan
+func (k Keeper) SetBuyerVestingClaim(ctx sdk.Context, claim types.BuyerVestingClaim) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&claim)
+	store.Set(types.BuyerVestingClaimKey(claim.PlanId, claim.Claimer), b)
+}
+
+// GetBuyerVestingClaim returns the vesting of the tokens claimed by a buyer in a plan.
+// It's tracked only for the plans with buyer vesting.
+func (k Keeper) GetBuyerVestingClaim(ctx sdk.Context, planId string, claimer sdk.AccAddress) types.BuyerVestingClaim {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BuyerVestingClaimKey(planId, claimer.String()))
+	if b == nil {
+		return types.NewBuyerVestingClaim(planId, claimer)
+	}
+
+	var val types.BuyerVestingClaim
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllBuyerVestingClaims returns the vesting of the tokens claimed by the buyers in all the plans
+func (k Keeper) GetAllBuyerVestingClaims(ctx sdk.Context) (list []types.BuyerVestingClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BuyerVestingClaimKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BuyerVestingClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// lockedBuyerVestingClaims returns the amount of the claims of a plan not released yet.
+// The locked amount is kept in the module account until it vests.
+func (k Keeper) lockedBuyerVestingClaims(ctx sdk.Context, plan types.Plan) math.Int {
+	planPrefix := types.BuyerVestingClaimKey(fmt.Sprintf("%d", plan.Id), "")
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), planPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	locked := math.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BuyerVestingClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		locked = locked.Add(val.Locked())
+	}
+	return locked
+}
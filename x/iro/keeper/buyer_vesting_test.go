@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// TestBuyerVesting tests that the claims release only the vested tokens, with a cliff and a linear vesting
+// from the settlement, and the query exposes the claimable and locked amounts.
+func (s *KeeperTestSuite) TestBuyerVesting() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	rollappDenom := "test_rollapp_denom"
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+
+	vesting := types.BuyerVesting{
+		Cliff:    time.Hour,
+		Duration: 4 * time.Hour,
+	}
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, types.WithBuyerVesting(vesting))
+	s.Require().NoError(err)
+
+	claimer := sample.Acc()
+	holder := sample.Acc()
+	soldAmt := math.NewInt(1_000).MulRaw(1e18)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	s.BuySomeTokens(planId, claimer, soldAmt)
+	s.BuySomeTokens(planId, holder, soldAmt)
+
+	// the claimable amounts are known only after the settlement
+	_, err = k.QueryClaimable(s.Ctx, &types.QueryClaimableRequest{PlanId: planId, Claimer: claimer.String()})
+	s.Require().Error(err)
+
+	// settle, the vesting starts
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, amt)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	settleTime := s.Ctx.BlockTime()
+	s.Require().True(settleTime.Equal(k.MustGetPlan(s.Ctx, planId).BuyerVesting.StartTime))
+
+	requireClaimable := func(addr sdk.AccAddress, claimable, locked math.Int) {
+		res, err := k.QueryClaimable(s.Ctx, &types.QueryClaimableRequest{PlanId: planId, Claimer: addr.String()})
+		s.Require().NoError(err)
+		s.Require().True(claimable.Equal(res.ClaimableAmount), "claimable: expected %s, got %s", claimable, res.ClaimableAmount)
+		s.Require().True(locked.Equal(res.LockedAmount), "locked: expected %s, got %s", locked, res.LockedAmount)
+	}
+	requireClaimed := func(expected math.Int) {
+		balance := s.App.BankKeeper.GetBalance(s.Ctx, claimer, rollappDenom)
+		s.Require().True(expected.Equal(balance.Amount), "claimed: expected %s, got %s", expected, balance.Amount)
+	}
+	inv := keeper.InvariantAccounting(*k)
+
+	// before the cliff, the IRO tokens are exchanged but nothing is released
+	requireClaimable(claimer, math.ZeroInt(), soldAmt)
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().NoError(err)
+	requireClaimed(math.ZeroInt())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, claimer, types.IRODenom(rollappId)).IsZero())
+	requireClaimable(claimer, math.ZeroInt(), soldAmt)
+	s.Require().NoError(inv(s.Ctx))
+
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(30 * time.Minute))
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().ErrorIs(err, types.ErrNoTokensToClaim)
+
+	// after the cliff, the tokens vest linearly from the settlement
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(2 * time.Hour))
+	half := soldAmt.QuoRaw(2)
+	requireClaimable(claimer, half, half)
+	requireClaimable(holder, half, half)
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().NoError(err)
+	requireClaimed(half)
+	requireClaimable(claimer, math.ZeroInt(), half)
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().ErrorIs(err, types.ErrNoTokensToClaim)
+	s.Require().NoError(inv(s.Ctx))
+
+	// the claims are exported
+	genesis := iro.ExportGenesis(s.Ctx, *k)
+	s.Require().Len(genesis.BuyerVestingClaims, 1)
+	s.Require().NoError(genesis.Validate())
+
+	// at the end of the vesting, everything is released
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(4 * time.Hour))
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().NoError(err)
+	requireClaimed(soldAmt)
+	requireClaimable(claimer, math.ZeroInt(), math.ZeroInt())
+
+	err = k.Claim(s.Ctx, planId, holder)
+	s.Require().NoError(err)
+	s.Require().Equal(soldAmt, s.App.BankKeeper.GetBalance(s.Ctx, holder, rollappDenom).Amount)
+	s.Require().NoError(inv(s.Ctx))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
@@ -13,6 +14,8 @@ import (
 //
 // This function allows a user to claim their RA tokens by burning their FUT tokens.
 // It burns *all* the FUT tokens the claimer has, and sends the equivalent amount of RA tokens to the claimer.
+// If the plan has buyer vesting, only the vested part of the RA tokens is sent, and the rest is kept
+// in the module account until it vests.
 func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) error {
 	plan, found := k.GetPlan(ctx, planId)
 	if !found {
@@ -24,36 +27,54 @@ func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) er
 	}
 
 	availableTokens := k.BK.GetBalance(ctx, claimer, plan.TotalAllocation.Denom)
-	if availableTokens.IsZero() {
-		return types.ErrNoTokensToClaim
+	if availableTokens.IsPositive() {
+		// Burn all the FUT tokens the user have
+		err := k.BK.SendCoinsFromAccountToModule(ctx, claimer, types.ModuleName, sdk.NewCoins(availableTokens))
+		if err != nil {
+			return err
+		}
+		err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
+		if err != nil {
+			return err
+		}
+
+		// Update the plan
+		plan.ClaimedAmt = plan.ClaimedAmt.Add(availableTokens.Amount)
+		k.SetPlan(ctx, plan)
 	}
 
-	// Burn all the FUT tokens the user have
-	err := k.BK.SendCoinsFromAccountToModule(ctx, claimer, types.ModuleName, sdk.NewCoins(availableTokens))
-	if err != nil {
-		return err
-	}
-	err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
-	if err != nil {
-		return err
+	// The RA tokens to give the user in return (same amount as the FUT token, as it vests)
+	released := availableTokens.Amount
+	locked := math.ZeroInt()
+	if plan.BuyerVesting.IsEnabled() {
+		claim := k.GetBuyerVestingClaim(ctx, planId, claimer)
+		claim.Amount = claim.Amount.Add(availableTokens.Amount)
+		released = plan.BuyerVesting.VestedAmt(claim.Amount, ctx.BlockTime()).Sub(claim.Released)
+		claim.Released = claim.Released.Add(released)
+		locked = claim.Locked()
+		if claim.Amount.IsPositive() {
+			k.SetBuyerVestingClaim(ctx, claim)
+		}
 	}
 
-	// Give the user the RA token in return (same amount as the FUT token)
-	err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, sdk.NewCoins(sdk.NewCoin(plan.SettledDenom, availableTokens.Amount)))
-	if err != nil {
-		return err
+	if availableTokens.IsZero() && released.IsZero() {
+		return types.ErrNoTokensToClaim
 	}
 
-	// Update the plan
-	plan.ClaimedAmt = plan.ClaimedAmt.Add(availableTokens.Amount)
-	k.SetPlan(ctx, plan)
+	if released.IsPositive() {
+		err := k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, sdk.NewCoins(sdk.NewCoin(plan.SettledDenom, released)))
+		if err != nil {
+			return err
+		}
+	}
 
 	// Emit event
-	err = uevent.EmitTypedEvent(ctx, &types.EventClaim{
+	err := uevent.EmitTypedEvent(ctx, &types.EventClaim{
 		Claimer:   claimer.String(),
 		PlanId:    planId,
 		RollappId: plan.RollappId,
-		Claim:     sdk.NewCoin(plan.SettledDenom, availableTokens.Amount),
+		Claim:     sdk.NewCoin(plan.SettledDenom, released),
+		Locked:    sdk.NewCoin(plan.SettledDenom, locked),
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// FailPlan fails a plan which is not settled, called by the governance
+func (k Keeper) FailPlan(ctx sdk.Context, planId string) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if plan.IsSettled() {
+		return errorsmod.Wrapf(types.ErrPlanSettled, "planId: %d", plan.Id)
+	}
+
+	if plan.Failed {
+		return errorsmod.Wrapf(types.ErrPlanFailed, "planId: %d", plan.Id)
+	}
+
+	return k.markFailed(ctx, plan)
+}
+
+// FailExpiredPlans marks the plans which were not settled before the failure deadline as failed, called in EndBlock
+func (k Keeper) FailExpiredPlans(ctx sdk.Context) error {
+	deadline := k.GetParams(ctx).PlanFailureDeadline
+	if deadline == 0 {
+		return nil
+	}
+	// the deadline of the plans which started trading until then is due
+	rng := collections.NewPrefixUntilPairRange[time.Time, uint64](ctx.BlockTime().Add(-deadline))
+	it, err := k.plansByFailureDeadline.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	due, err := it.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range due {
+		plan, found := k.GetPlan(ctx, fmt.Sprintf("%d", key.K2()))
+		if !found {
+			return errorsmod.Wrapf(types.ErrPlanNotFound, "planId: %d", key.K2())
+		}
+		if err := k.markFailed(ctx, plan); err != nil {
+			return errorsmod.Wrapf(err, "mark failed: planId: %d", plan.Id)
+		}
+	}
+	return nil
+}
+
+// UpdateFailureDeadline indexes the plan by its failure deadline while it can fail: from when trading is
+// enabled, until it is settled or failed. The pre launch time of a plan does not change once trading is enabled.
+func (k Keeper) UpdateFailureDeadline(ctx sdk.Context, plan types.Plan) error {
+	key := collections.Join(plan.PreLaunchTime, plan.Id)
+	if plan.TradingEnabled && !plan.IsSettled() && !plan.Failed {
+		return k.plansByFailureDeadline.Set(ctx, key)
+	}
+	return k.plansByFailureDeadline.Remove(ctx, key)
+}
+
+// markFailed marks the plan as failed, and burns the unsold IRO tokens.
+// The raised liquidity stays in the plan's module account for the refunds.
+func (k Keeper) markFailed(ctx sdk.Context, plan types.Plan) error {
+	unsold := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.GetIRODenom())
+	err := k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unsold))
+	if err != nil {
+		return err
+	}
+
+	plan.Failed = true
+	k.SetPlan(ctx, plan)
+	if err := k.UpdateFailureDeadline(ctx, plan); err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventPlanFailed{
+		PlanId:    fmt.Sprintf("%d", plan.Id),
+		RollappId: plan.RollappId,
+		Raised:    k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom),
+	})
+}
+
+// Refund redeems the IRO tokens for the raised liquidity after the plan failed
+//
+// It burns *all* the IRO tokens the claimer has, and sends the pro-rata share of the raised liquidity
+// to the claimer, without taker fee.
+func (k Keeper) Refund(ctx sdk.Context, planId string, claimer sdk.AccAddress) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if !plan.Failed {
+		return errorsmod.Wrapf(types.ErrPlanNotFailed, "planId: %d", plan.Id)
+	}
+
+	availableTokens := k.BK.GetBalance(ctx, claimer, plan.GetIRODenom())
+	if availableTokens.IsZero() {
+		return types.ErrNoTokensToClaim
+	}
+
+	// the outstanding IRO tokens share the raised liquidity, including the creation fee's cost
+	outstanding := plan.SoldAmt.Sub(plan.ClaimedAmt)
+	raised := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom)
+	refund := sdk.NewCoin(plan.LiquidityDenom, raised.Amount.Mul(availableTokens.Amount).Quo(outstanding))
+
+	// Burn all the IRO tokens the user have
+	err := k.BK.SendCoinsFromAccountToModule(ctx, claimer, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return err
+	}
+	err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return err
+	}
+
+	err = k.BK.SendCoins(ctx, plan.GetAddress(), claimer, sdk.NewCoins(refund))
+	if err != nil {
+		return err
+	}
+
+	plan.SoldAmt = plan.SoldAmt.Sub(availableTokens.Amount)
+	k.SetPlan(ctx, plan)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRefund{
+		Claimer:   claimer.String(),
+		PlanId:    planId,
+		RollappId: plan.RollappId,
+		Burned:    availableTokens,
+		Refund:    refund,
+	})
+}
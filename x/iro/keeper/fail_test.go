@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// TestRefundAfterDeadline tests that a plan which is not settled before the failure deadline
+// is failed in EndBlock, which halts the trading and the settlement, and the buyers are refunded pro-rata.
+func (s *KeeperTestSuite) TestRefundAfterDeadline() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+	deadline := k.GetParams(s.Ctx).PlanFailureDeadline
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	s.Require().NoError(err)
+	plan := k.MustGetPlan(s.Ctx, planId)
+
+	buyer1 := sample.Acc()
+	buyer2 := sample.Acc()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	s.BuySomeTokens(planId, buyer1, math.NewInt(1_000).MulRaw(1e18))
+	s.BuySomeTokens(planId, buyer2, math.NewInt(1_500).MulRaw(1e18))
+
+	// before the deadline, the plan is not failed
+	s.Ctx = s.Ctx.WithBlockTime(plan.PreLaunchTime.Add(deadline - time.Second))
+	err = k.Refund(s.Ctx, planId, buyer1)
+	s.Require().ErrorIs(err, types.ErrPlanNotFailed)
+
+	// after the deadline, the plan is failed in EndBlock, and the unsold tokens are burned
+	s.Ctx = s.Ctx.WithBlockTime(plan.PreLaunchTime.Add(deadline))
+	err = k.FailExpiredPlans(s.Ctx)
+	s.Require().NoError(err)
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(plan.Failed)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, k.AK.GetModuleAddress(types.ModuleName), plan.GetIRODenom()).IsZero())
+
+	// trading is halted
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1).MulRaw(1e18), math.NewInt(1_000_000_000).MulRaw(1e18))
+	s.Require().ErrorIs(err, types.ErrPlanFailed)
+
+	// the settlement releases the rollapp tokens to the owner, without settling the plan
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin("rollappdenom", amt)))
+	err = k.Settle(s.Ctx, rollappId, "rollappdenom")
+	s.Require().NoError(err)
+	s.Require().False(k.MustGetPlan(s.Ctx, planId).IsSettled())
+	owner := sdk.MustAccAddressFromBech32(rollapp.Owner)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, owner, "rollappdenom").Amount.Equal(amt))
+
+	raised := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), plan.LiquidityDenom).Amount
+	outstanding := plan.SoldAmt.Sub(plan.ClaimedAmt)
+	buyer1Tokens := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, plan.GetIRODenom()).Amount
+	buyer1Liquidity := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, plan.LiquidityDenom).Amount
+
+	err = k.Refund(s.Ctx, planId, buyer1)
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, buyer1, plan.GetIRODenom()).IsZero())
+
+	// refund is pro-rata, without taker fee
+	expected := raised.Mul(buyer1Tokens).Quo(outstanding)
+	refund := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, plan.LiquidityDenom).Amount.Sub(buyer1Liquidity)
+	s.Require().Equal(expected, refund)
+
+	// nothing left to refund
+	err = k.Refund(s.Ctx, planId, buyer1)
+	s.Require().ErrorIs(err, types.ErrNoTokensToClaim)
+
+	// the last holders take the rest of the raised liquidity
+	err = k.Refund(s.Ctx, planId, buyer2)
+	s.Require().NoError(err)
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(plan.SoldAmt.Equal(plan.ClaimedAmt))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), plan.LiquidityDenom).IsZero())
+}
+
+// TestFailPlan tests the governance can fail a plan which is not settled.
+func (s *KeeperTestSuite) TestFailPlan() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+	govModule := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	s.Require().NoError(err)
+
+	buyer := sample.Acc()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	s.BuySomeTokens(planId, buyer, math.NewInt(1_000).MulRaw(1e18))
+
+	// only the gov module can fail a plan
+	_, err = s.msgServer.FailPlan(s.Ctx, &types.MsgFailPlan{Authority: buyer.String(), PlanId: planId})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.FailPlan(s.Ctx, &types.MsgFailPlan{Authority: govModule, PlanId: planId})
+	s.Require().NoError(err)
+	_, err = s.msgServer.FailPlan(s.Ctx, &types.MsgFailPlan{Authority: govModule, PlanId: planId})
+	s.Require().ErrorIs(err, types.ErrPlanFailed)
+
+	// trading is halted, and the buyer can be refunded right away
+	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(1).MulRaw(1e18), math.NewInt(1_000_000_000).MulRaw(1e18))
+	s.Require().ErrorIs(err, types.ErrPlanFailed)
+
+	_, err = s.msgServer.Refund(s.Ctx, &types.MsgRefund{Claimer: buyer.String(), PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, buyer, types.IRODenom(rollappId)).IsZero())
+
+	// a settled plan can't fail
+	rollappId2 := s.CreateDefaultRollapp()
+	rollapp2 := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId2)
+	s.FundAcc(sdk.MustAccAddressFromBech32(rollapp2.Owner), sdk.NewCoins(sdk.NewCoin("adym", k.GetParams(s.Ctx).CreationFee.MulRaw(10))))
+	planId2, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp2, curve, incentives, liquidityPart, time.Hour, 0)
+	s.Require().NoError(err)
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin("rollapp2denom", amt)))
+	err = k.Settle(s.Ctx, rollappId2, "rollapp2denom")
+	s.Require().NoError(err)
+	_, err = s.msgServer.FailPlan(s.Ctx, &types.MsgFailPlan{Authority: govModule, PlanId: planId2})
+	s.Require().ErrorIs(err, types.ErrPlanSettled)
+}
+
+// TestFailExpiredPlansIndex tests only the plans which can fail are failed at their deadline: the plans
+// which enabled trading, and are not settled or failed yet.
+func (s *KeeperTestSuite) TestFailExpiredPlansIndex() {
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+	deadline := k.GetParams(s.Ctx).PlanFailureDeadline
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+
+	createPlan := func(tradingEnabled bool) (string, string) {
+		rollappId := s.CreateDefaultRollapp()
+		rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+		s.FundAcc(sdk.MustAccAddressFromBech32(rollapp.Owner), sdk.NewCoins(sdk.NewCoin("adym", k.GetParams(s.Ctx).CreationFee.MulRaw(10))))
+		planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, tradingEnabled, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+		s.Require().NoError(err)
+		return rollappId, planId
+	}
+
+	_, expiring := createPlan(true)
+	settledRollapp, settled := createPlan(true)
+	disabledRollapp, disabled := createPlan(false)
+
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin("settleddenom", amt)))
+	err := k.Settle(s.Ctx, settledRollapp, "settleddenom")
+	s.Require().NoError(err)
+
+	// only the trading plan which is not settled fails at its deadline
+	s.Ctx = s.Ctx.WithBlockTime(k.MustGetPlan(s.Ctx, expiring).PreLaunchTime.Add(deadline))
+	err = k.FailExpiredPlans(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(k.MustGetPlan(s.Ctx, expiring).Failed)
+	s.Require().False(k.MustGetPlan(s.Ctx, settled).Failed)
+	s.Require().False(k.MustGetPlan(s.Ctx, disabled).Failed)
+
+	// the failed plan is not failed again
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = k.FailExpiredPlans(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(s.Ctx.EventManager().Events())
+
+	// the deadline of the other plan starts when its trading is enabled
+	owner := sdk.MustAccAddressFromBech32(s.App.RollappKeeper.MustGetRollapp(s.Ctx, disabledRollapp).Owner)
+	err = k.EnableTrading(s.Ctx, disabled, owner)
+	s.Require().NoError(err)
+	preLaunchTime := k.MustGetPlan(s.Ctx, disabled).PreLaunchTime
+
+	s.Ctx = s.Ctx.WithBlockTime(preLaunchTime.Add(deadline - time.Second))
+	err = k.FailExpiredPlans(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().False(k.MustGetPlan(s.Ctx, disabled).Failed)
+
+	s.Ctx = s.Ctx.WithBlockTime(preLaunchTime.Add(deadline))
+	err = k.FailExpiredPlans(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(k.MustGetPlan(s.Ctx, disabled).Failed)
+}
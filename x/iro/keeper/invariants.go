@@ -82,6 +82,14 @@ func InvariantAccounting(k Keeper) uinv.Func {
 		var errs []error
 
 		for _, plan := range plans {
+			if plan.Failed {
+				// unsold IRO are burned on failure
+				iroBalance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.GetIRODenom())
+				if !iroBalance.IsZero() {
+					errs = append(errs, fmt.Errorf("iro tokens left in module, failed: planID: %d, balance: %s", plan.Id, iroBalance))
+				}
+			}
+
 			if plan.IsSettled() {
 				// module should have no more IRO
 				iroBalance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.GetIRODenom())
@@ -89,8 +97,8 @@ func InvariantAccounting(k Keeper) uinv.Func {
 					errs = append(errs, fmt.Errorf("iro tokens left in module, settled: planID: %d, balance: %s", plan.Id, iroBalance))
 				}
 
-				// Check if module has enough RA tokens to cover the claimable amount
-				claimable := plan.SoldAmt.Sub(plan.ClaimedAmt)
+				// Check if module has enough RA tokens to cover the claimable amount, including the claims locked by the buyer vesting
+				claimable := plan.SoldAmt.Sub(plan.ClaimedAmt).Add(k.lockedBuyerVestingClaims(ctx, plan))
 				moduleBal := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.SettledDenom)
 				if moduleBal.Amount.LT(claimable) {
 					errs = append(errs, fmt.Errorf("insufficient RA tokens: planID: %d, required: %s, available: %s",
@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -27,6 +30,9 @@ type Keeper struct {
 	pm types.PoolManagerKeeper
 	ik types.IncentivesKeeper
 	tk types.TxFeesKeeper
+
+	// (pre launch time, plan id) of the plans which can still fail, ordered by their failure deadline
+	plansByFailureDeadline collections.KeySet[collections.Pair[time.Time, uint64]]
 }
 
 func NewKeeper(
@@ -42,6 +48,8 @@ func NewKeeper(
 	pm types.PoolManagerKeeper,
 	tk types.TxFeesKeeper,
 ) *Keeper {
+	sb := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
+
 	return &Keeper{
 		cdc:       cdc,
 		storeKey:  storeKey,
@@ -54,6 +62,12 @@ func NewKeeper(
 		ik:        ik,
 		pm:        pm,
 		tk:        tk,
+		plansByFailureDeadline: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.PlansByFailureDeadlineKeyPrefix),
+			"plansByFailureDeadline",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
 	}
 }
 
@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -41,6 +44,13 @@ func (m msgServer) Buy(ctx context.Context, req *types.MsgBuy) (*types.MsgBuyRes
 		return nil, err
 	}
 
+	if req.PresaleProof != nil {
+		err = m.Keeper.RegisterPresaleAllowance(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, *req.PresaleProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = m.Keeper.Buy(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Amount, req.MaxCostAmount)
 	if err != nil {
 		return nil, err
@@ -56,6 +66,13 @@ func (m msgServer) BuyExactSpend(ctx context.Context, req *types.MsgBuyExactSpen
 		return nil, err
 	}
 
+	if req.PresaleProof != nil {
+		err = m.Keeper.RegisterPresaleAllowance(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, *req.PresaleProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = m.Keeper.BuyExactSpend(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Spend, req.MinOutTokensAmount)
 	if err != nil {
 		return nil, err
@@ -98,3 +115,28 @@ func (m msgServer) ClaimVested(ctx context.Context, req *types.MsgClaimVested) (
 
 	return &types.MsgClaimVestedResponse{}, nil
 }
+
+// FailPlan implements types.MsgServer.
+func (m msgServer) FailPlan(goCtx context.Context, req *types.MsgFailPlan) (*types.MsgFailPlanResponse, error) {
+	if req.Authority != m.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "only the gov module can fail plans")
+	}
+
+	err := m.Keeper.FailPlan(sdk.UnwrapSDKContext(goCtx), req.PlanId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFailPlanResponse{}, nil
+}
+
+// Refund implements types.MsgServer.
+func (m msgServer) Refund(ctx context.Context, req *types.MsgRefund) (*types.MsgRefundResponse, error) {
+	claimerAddr := sdk.MustAccAddressFromBech32(req.Claimer)
+	err := m.Keeper.Refund(sdk.UnwrapSDKContext(ctx), req.PlanId, claimerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundResponse{}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetPresaleAllowance stores the presale allowance of a buyer
+func (k Keeper) SetPresaleAllowance(ctx sdk.Context, allowance types.PresaleAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&allowance)
+	store.Set(types.PresaleAllowanceKey(allowance.PlanId, allowance.Buyer), b)
+}
+
+// GetPresaleAllowance returns the presale allowance of a buyer in a plan
+func (k Keeper) GetPresaleAllowance(ctx sdk.Context, planId string, buyer sdk.AccAddress) (val types.PresaleAllowance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PresaleAllowanceKey(planId, buyer.String()))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPresaleAllowances returns the presale allowances of all the plans
+func (k Keeper) GetAllPresaleAllowances(ctx sdk.Context) (list []types.PresaleAllowance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PresaleAllowanceKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PresaleAllowance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RegisterPresaleAllowance verifies the buyer is in the presale allowlist of the plan and stores its allowance.
+// The allowance is registered once, further proofs of the same buyer are ignored.
+func (k Keeper) RegisterPresaleAllowance(ctx sdk.Context, planId string, buyer sdk.AccAddress, proof types.PresaleProof) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if !plan.Presale.IsEnabled() {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "plan has no presale: planId: %s", planId)
+	}
+
+	if _, found := k.GetPresaleAllowance(ctx, planId, buyer); found {
+		return nil
+	}
+
+	leaf := types.PresaleLeaf(buyer, proof.Cap)
+	if !types.VerifyPresaleProof(plan.Presale.MerkleRoot, leaf, proof.Proof) {
+		return errorsmod.Wrapf(types.ErrNotInPresaleAllowlist, "invalid proof: buyer: %s, cap: %s", buyer, proof.Cap)
+	}
+
+	k.SetPresaleAllowance(ctx, types.NewPresaleAllowance(planId, buyer, proof.Cap))
+	return nil
+}
+
+// chargePresaleAllowance deducts the bought amount from the presale allowance of the buyer.
+// It's a no-op if the plan is not in presale. The owner of the rollapp is not limited.
+func (k Keeper) chargePresaleAllowance(ctx sdk.Context, plan types.Plan, buyer sdk.AccAddress, amount math.Int) error {
+	if !plan.IsInPresale(ctx.BlockTime()) {
+		return nil
+	}
+
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	if owner.Equals(buyer) {
+		return nil
+	}
+
+	planId := fmt.Sprintf("%d", plan.Id)
+	allowance, found := k.GetPresaleAllowance(ctx, planId, buyer)
+	if !found {
+		return errorsmod.Wrapf(types.ErrNotInPresaleAllowlist, "buyer: %s", buyer)
+	}
+
+	purchased := allowance.Purchased.Add(amount)
+	if purchased.GT(allowance.Cap) {
+		return errorsmod.Wrapf(types.ErrPresaleCapExceeded, "cap: %s, purchased: %s, amount: %s", allowance.Cap, allowance.Purchased, amount)
+	}
+
+	allowance.Purchased = purchased
+	k.SetPresaleAllowance(ctx, allowance)
+	return nil
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// TestPresale tests that only the allowlisted buyers can buy during the presale, each up to its cap,
+// and the public trading starts after the presale.
+func (s *KeeperTestSuite) TestPresale() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+	maxCost := math.NewInt(1_000_000_000).MulRaw(1e18)
+
+	// the allowlist
+	buyer1, cap1 := sample.Acc(), math.NewInt(1_000).MulRaw(1e18)
+	buyer2, cap2 := sample.Acc(), math.NewInt(500).MulRaw(1e18)
+	buyer3, cap3 := sample.Acc(), math.NewInt(100).MulRaw(1e18)
+	leaves := [][]byte{
+		types.PresaleLeaf(buyer1, cap1),
+		types.PresaleLeaf(buyer2, cap2),
+		types.PresaleLeaf(buyer3, cap3),
+	}
+	presale := types.Presale{
+		MerkleRoot: types.PresaleMerkleRoot(leaves),
+		Duration:   10 * time.Minute,
+	}
+	proof1 := &types.PresaleProof{Cap: cap1, Proof: types.PresaleMerkleProof(leaves, 0)}
+	proof2 := &types.PresaleProof{Cap: cap2, Proof: types.PresaleMerkleProof(leaves, 1)}
+	public := sample.Acc()
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, types.WithPresale(presale))
+	s.Require().NoError(err)
+	plan := k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(startTime.Add(presale.Duration).Equal(plan.PresaleEndTime()))
+
+	for _, buyer := range []sdk.AccAddress{buyer1, buyer2, buyer3, public} {
+		s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18))))
+	}
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	s.Require().True(plan.IsInPresale(s.Ctx.BlockTime()))
+
+	// the public buyer can't buy during the presale
+	err = k.Buy(s.Ctx, planId, public, math.NewInt(1).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrNotInPresaleAllowlist)
+
+	// the allowlisted buyer must present a proof first
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrNotInPresaleAllowlist)
+
+	// a proof of another buyer, or with another cap, is rejected
+	_, err = s.msgServer.Buy(s.Ctx, &types.MsgBuy{
+		Buyer:         public.String(),
+		PlanId:        planId,
+		Amount:        math.NewInt(1).MulRaw(1e18),
+		MaxCostAmount: maxCost,
+		PresaleProof:  proof1,
+	})
+	s.Require().ErrorIs(err, types.ErrNotInPresaleAllowlist)
+	_, err = s.msgServer.Buy(s.Ctx, &types.MsgBuy{
+		Buyer:         buyer1.String(),
+		PlanId:        planId,
+		Amount:        math.NewInt(1).MulRaw(1e18),
+		MaxCostAmount: maxCost,
+		PresaleProof:  &types.PresaleProof{Cap: cap1.MulRaw(2), Proof: proof1.Proof},
+	})
+	s.Require().ErrorIs(err, types.ErrNotInPresaleAllowlist)
+
+	// the allowlisted buyer buys with a proof, up to its cap
+	_, err = s.msgServer.Buy(s.Ctx, &types.MsgBuy{
+		Buyer:         buyer1.String(),
+		PlanId:        planId,
+		Amount:        math.NewInt(600).MulRaw(1e18),
+		MaxCostAmount: maxCost,
+		PresaleProof:  proof1,
+	})
+	s.Require().NoError(err)
+
+	// the allowance is registered, further buys don't need the proof
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(400).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrPresaleCapExceeded)
+
+	allowance, found := k.GetPresaleAllowance(s.Ctx, planId, buyer1)
+	s.Require().True(found)
+	s.Require().Equal(cap1, allowance.Purchased)
+
+	// selling doesn't restore the allowance
+	err = k.Sell(s.Ctx, planId, buyer1, math.NewInt(100).MulRaw(1e18), math.NewInt(1))
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrPresaleCapExceeded)
+
+	// the cap applies to the tokens bought with exact spend
+	_, err = s.msgServer.BuyExactSpend(s.Ctx, &types.MsgBuyExactSpend{
+		Buyer:              buyer2.String(),
+		PlanId:             planId,
+		Spend:              math.NewInt(10_000).MulRaw(1e18),
+		MinOutTokensAmount: math.NewInt(1),
+		PresaleProof:       proof2,
+	})
+	s.Require().ErrorIs(err, types.ErrPresaleCapExceeded)
+	_, err = s.msgServer.BuyExactSpend(s.Ctx, &types.MsgBuyExactSpend{
+		Buyer:              buyer2.String(),
+		PlanId:             planId,
+		Spend:              math.NewInt(1).MulRaw(1e18),
+		MinOutTokensAmount: math.NewInt(1),
+		PresaleProof:       proof2,
+	})
+	s.Require().NoError(err)
+
+	// after the presale, the trading is public
+	s.Ctx = s.Ctx.WithBlockTime(plan.PresaleEndTime())
+	s.Require().False(plan.IsInPresale(s.Ctx.BlockTime()))
+	err = k.Buy(s.Ctx, planId, public, math.NewInt(1_000).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1_000).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+
+	// the allowances are exported
+	genesis := iro.ExportGenesis(s.Ctx, *k)
+	s.Require().Len(genesis.PresaleAllowances, 2)
+	s.Require().NoError(genesis.Validate())
+}
+
+// TestPresaleNotEnabled tests that proofs are rejected for a plan without presale
+func (s *KeeperTestSuite) TestPresaleNotEnabled() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0)
+	s.Require().NoError(err)
+
+	buyer := sample.Acc()
+	leaf := types.PresaleLeaf(buyer, math.NewInt(1_000).MulRaw(1e18))
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	err = k.RegisterPresaleAllowance(s.Ctx, planId, buyer, types.PresaleProof{Cap: math.NewInt(1_000).MulRaw(1e18), Proof: [][]byte{leaf}})
+	s.Require().Error(err)
+
+	// the public trading starts at the start time
+	s.BuySomeTokens(planId, buyer, math.NewInt(1_000).MulRaw(1e18))
+}
@@ -97,7 +97,12 @@ func (k Keeper) QueryPlan(goCtx context.Context, req *types.QueryPlanRequest) (*
 		return nil, status.Error(codes.NotFound, "plan not found")
 	}
 
-	return &types.QueryPlanResponse{Plan: &plan}, nil
+	currentMaxBuy, ok := plan.MaxBuy(ctx.BlockTime())
+	if !ok {
+		currentMaxBuy = math.ZeroInt()
+	}
+
+	return &types.QueryPlanResponse{Plan: &plan, CurrentMaxBuy: currentMaxBuy}, nil
 }
 
 // QueryPlanByRollapp implements types.QueryServer.
@@ -170,3 +175,35 @@ func (k Keeper) QueryVesting(goCtx context.Context, req *types.QueryVestingReque
 	}
 	return response, nil
 }
+
+// QueryClaimable implements types.QueryServer.
+func (k Keeper) QueryClaimable(goCtx context.Context, req *types.QueryClaimableRequest) (*types.QueryClaimableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimer, err := sdk.AccAddressFromBech32(req.Claimer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid claimer address")
+	}
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	if !plan.IsSettled() {
+		return nil, status.Error(codes.FailedPrecondition, "plan not settled")
+	}
+
+	// the IRO tokens not claimed yet vest as if they were claimed
+	claim := k.GetBuyerVestingClaim(ctx, req.PlanId, claimer)
+	total := claim.Amount.Add(k.BK.GetBalance(ctx, claimer, plan.TotalAllocation.Denom).Amount)
+	vested := plan.BuyerVesting.VestedAmt(total, ctx.BlockTime())
+
+	return &types.QueryClaimableResponse{
+		ClaimableAmount: vested.Sub(claim.Released),
+		LockedAmount:    total.Sub(vested),
+	}, nil
+}
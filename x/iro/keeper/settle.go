@@ -25,6 +25,7 @@ func (k Keeper) AfterTransfersEnabled(ctx sdk.Context, rollappId, rollappIBCDeno
 // Settle settles the iro plan with the given rollappId
 //
 // This function performs the following steps:
+// - Releases the rollapp tokens to the owner if the plan failed, without settling it.
 // - Validates that the "TotalAllocation.Amount" of the RA token are available in the module account.
 // - Burns any unsold FUT tokens in the module account.
 // - Marks the plan as settled, allowing users to claim tokens.
@@ -40,6 +41,11 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 		return errorsmod.Wrapf(errors.Join(gerrc.ErrInternal, types.ErrPlanSettled), "rollappId: %s", rollappId)
 	}
 
+	// the raised liquidity of a failed plan is reserved for the refunds, so the rollapp can't launch with it
+	if plan.Failed {
+		return k.releaseFailedPlanAllocation(ctx, plan, rollappIBCDenom)
+	}
+
 	// validate the required funds are available in the module account
 	// funds expected as it's validated in the genesis transfer handler
 	balance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), rollappIBCDenom)
@@ -63,9 +69,15 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 	plan.VestingPlan.StartTime = ctx.BlockHeader().Time.Add(plan.VestingPlan.StartTimeAfterSettlement)
 	plan.VestingPlan.EndTime = plan.VestingPlan.StartTime.Add(plan.VestingPlan.VestingDuration)
 
+	// start the vesting schedule for the buyers' claims
+	plan.BuyerVesting.StartTime = ctx.BlockHeader().Time
+
 	// mark the plan as `settled`, allowing users to claim tokens
 	plan.SettledDenom = rollappIBCDenom
 	k.SetPlan(ctx, plan)
+	if err := k.UpdateFailureDeadline(ctx, plan); err != nil {
+		return err
+	}
 
 	// uses the raised liquidity and unsold tokens to bootstrap the rollapp's liquidity pool
 	poolID, gaugeID, err := k.bootstrapLiquidityPool(ctx, plan, poolTokens)
@@ -152,3 +164,22 @@ func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan, poolTok
 
 	return poolID, gaugeID, nil
 }
+
+// releaseFailedPlanAllocation sends the rollapp tokens of a failed plan to the rollapp owner.
+// The plan stays failed and unsettled, so the genesis bridge completes without bootstrapping a pool.
+func (k Keeper) releaseFailedPlanAllocation(ctx sdk.Context, plan types.Plan, rollappIBCDenom string) error {
+	allocation := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), rollappIBCDenom)
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	if !allocation.IsZero() {
+		err := k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(allocation))
+		if err != nil {
+			return errorsmod.Wrap(err, "send allocation to owner")
+		}
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventPlanAllocationReleased{
+		PlanId:     fmt.Sprintf("%d", plan.Id),
+		RollappId:  plan.RollappId,
+		Owner:      owner.String(),
+		Allocation: allocation,
+	})
+}
@@ -41,8 +41,15 @@ func (k Keeper) EnableTrading(ctx sdk.Context, planId string, submitter sdk.AccA
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "plan already settled")
 	}
 
+	if plan.Failed {
+		return errorsmod.Wrapf(types.ErrPlanFailed, "planId: %d", plan.Id)
+	}
+
 	plan.EnableTradingWithStartTime(ctx.BlockTime())
 	k.SetPlan(ctx, plan)
+	if err := k.UpdateFailureDeadline(ctx, plan); err != nil {
+		return err
+	}
 
 	k.rk.SetPreLaunchTime(ctx, &rollapp, plan.PreLaunchTime)
 	return nil
@@ -60,6 +67,18 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 		return types.ErrInsufficientTokens
 	}
 
+	// validate the trading limits
+	err = k.chargeTradingLimits(ctx, *plan, buyer, amountTokensToBuy)
+	if err != nil {
+		return err
+	}
+
+	// validate the buyer allowance during the presale
+	err = k.chargePresaleAllowance(ctx, *plan, buyer, amountTokensToBuy)
+	if err != nil {
+		return err
+	}
+
 	// Calculate costAmt for buying amountTokensToBuy over the price curve
 	costAmt := plan.BondingCurve.Cost(plan.SoldAmt, plan.SoldAmt.Add(amountTokensToBuy))
 	costPlusTakerFeeAmt, takerFeeAmt, err := k.ApplyTakerFee(costAmt, k.GetParams(ctx).TakerFee, true)
@@ -143,6 +162,18 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 		return types.ErrInsufficientTokens
 	}
 
+	// validate the trading limits
+	err = k.chargeTradingLimits(ctx, *plan, buyer, tokensOutAmt)
+	if err != nil {
+		return err
+	}
+
+	// validate the buyer allowance during the presale
+	err = k.chargePresaleAllowance(ctx, *plan, buyer, tokensOutAmt)
+	if err != nil {
+		return err
+	}
+
 	// Charge taker fee
 	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
@@ -249,6 +280,7 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 // GetTradeableIRO returns the tradeable IRO plan
 // - plan must exist
 // - plan must not be settled
+// - plan must not be failed
 // - plan must have started (unless the trader is the owner)
 func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string, trader sdk.AccAddress) (*types.Plan, error) {
 	plan, found := k.GetPlan(ctx, planId)
@@ -260,6 +292,10 @@ func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string, trader sdk.AccAd
 		return nil, errorsmod.Wrapf(types.ErrPlanSettled, "planId: %d", plan.Id)
 	}
 
+	if plan.Failed {
+		return nil, errorsmod.Wrapf(types.ErrPlanFailed, "planId: %d", plan.Id)
+	}
+
 	// Validate start time started (unless the trader is the owner)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
 	if owner.Equals(trader) {
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetAccountPurchase stores the amount of tokens an account bought in a plan
+func (k Keeper) SetAccountPurchase(ctx sdk.Context, purchase types.AccountPurchase) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&purchase)
+	store.Set(types.AccountPurchaseKey(purchase.PlanId, purchase.Buyer), b)
+}
+
+// GetAccountPurchase returns the amount of tokens an account bought in a plan.
+// It's tracked only for the plans with a max per account limit.
+func (k Keeper) GetAccountPurchase(ctx sdk.Context, planId string, buyer sdk.AccAddress) types.AccountPurchase {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.AccountPurchaseKey(planId, buyer.String()))
+	if b == nil {
+		return types.NewAccountPurchase(planId, buyer)
+	}
+
+	var val types.AccountPurchase
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllAccountPurchases returns the amounts bought by the accounts in all the plans
+func (k Keeper) GetAllAccountPurchases(ctx sdk.Context) (list []types.AccountPurchase) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPurchaseKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccountPurchase
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// setBlockPurchase stores the amount of tokens bought in a plan in the current block
+func (k Keeper) setBlockPurchase(ctx sdk.Context, planId string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.BlockPurchase{Height: ctx.BlockHeight(), Amount: amount})
+	store.Set(types.BlockPurchaseKey(planId), b)
+}
+
+// getBlockPurchase returns the amount of tokens bought in a plan in the current block.
+// Only the last block is stored, so it's not exported in the genesis.
+func (k Keeper) getBlockPurchase(ctx sdk.Context, planId string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BlockPurchaseKey(planId))
+	if b == nil {
+		return math.ZeroInt()
+	}
+
+	var val types.BlockPurchase
+	k.cdc.MustUnmarshal(b, &val)
+	if val.Height != ctx.BlockHeight() {
+		return math.ZeroInt()
+	}
+	return val.Amount
+}
+
+// chargeTradingLimits validates the buy against the trading limits of the plan, and accounts for it.
+// The owner of the rollapp is not limited.
+func (k Keeper) chargeTradingLimits(ctx sdk.Context, plan types.Plan, buyer sdk.AccAddress, amount math.Int) error {
+	limits := plan.TradingLimits
+	if !limits.HasMaxPerAccount() && !limits.HasMaxPerBlock() && !limits.HasRamp() {
+		return nil
+	}
+
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	if owner.Equals(buyer) {
+		return nil
+	}
+
+	if maxBuy, ok := plan.MaxBuy(ctx.BlockTime()); ok && amount.GT(maxBuy) {
+		return errorsmod.Wrapf(types.ErrTradingLimitExceeded, "max buy: %s, amount: %s", maxBuy, amount)
+	}
+
+	planId := fmt.Sprintf("%d", plan.Id)
+
+	accountPurchase := k.GetAccountPurchase(ctx, planId, buyer)
+	accountPurchase.Amount = accountPurchase.Amount.Add(amount)
+	if limits.HasMaxPerAccount() && accountPurchase.Amount.GT(limits.MaxPerAccount) {
+		return errorsmod.Wrapf(types.ErrTradingLimitExceeded, "max per account: %s, purchased: %s", limits.MaxPerAccount, accountPurchase.Amount)
+	}
+
+	blockPurchase := k.getBlockPurchase(ctx, planId).Add(amount)
+	if limits.HasMaxPerBlock() && blockPurchase.GT(limits.MaxPerBlock) {
+		return errorsmod.Wrapf(types.ErrTradingLimitExceeded, "max per block: %s, purchased: %s", limits.MaxPerBlock, blockPurchase)
+	}
+
+	if limits.HasMaxPerAccount() {
+		k.SetAccountPurchase(ctx, accountPurchase)
+	}
+	if limits.HasMaxPerBlock() {
+		k.setBlockPurchase(ctx, planId, blockPurchase)
+	}
+	return nil
+}
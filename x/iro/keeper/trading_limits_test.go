@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// TestTradingLimits tests the max purchase per account, per block and the ramping max buy
+func (s *KeeperTestSuite) TestTradingLimits() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+	maxCost := math.NewInt(1_000_000_000).MulRaw(1e18)
+
+	limits := types.TradingLimits{
+		MaxPerAccount:     math.NewInt(3_000).MulRaw(1e18),
+		MaxPerBlock:       math.NewInt(4_000).MulRaw(1e18),
+		RampInitialMaxBuy: math.NewInt(1_000).MulRaw(1e18),
+		RampDuration:      10 * time.Minute,
+	}
+
+	startTime := time.Now()
+	amt := math.NewInt(1_000_000).MulRaw(1e18)
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, "adym", amt, time.Hour, startTime, true, rollapp, curve, incentives, liquidityPart, time.Hour, 0, types.WithTradingLimits(limits))
+	s.Require().NoError(err)
+
+	buyer1 := sample.Acc()
+	buyer2 := sample.Acc()
+	buyer3 := sample.Acc()
+	for _, buyer := range []sdk.AccAddress{buyer1, buyer2, buyer3} {
+		s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(100_000).MulRaw(1e18))))
+	}
+
+	// at the start, a single buy is limited by the ramp
+	s.Ctx = s.Ctx.WithBlockTime(startTime).WithBlockHeight(100)
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1_001).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded)
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1_000).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+
+	// the query exposes the current max buy
+	res, err := k.QueryPlan(s.Ctx, &types.QueryPlanRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().Equal(limits.RampInitialMaxBuy, res.CurrentMaxBuy)
+	s.Require().Equal(limits.MaxPerAccount, res.Plan.TradingLimits.MaxPerAccount)
+
+	// the ramp relaxes over time
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(limits.RampDuration)).WithBlockHeight(101)
+	res, err = k.QueryPlan(s.Ctx, &types.QueryPlanRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().True(res.CurrentMaxBuy.IsZero())
+
+	// the max per account applies across the buys
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(2_000).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded)
+	_, err = s.msgServer.BuyExactSpend(s.Ctx, &types.MsgBuyExactSpend{
+		Buyer:              buyer1.String(),
+		PlanId:             planId,
+		Spend:              math.NewInt(1).MulRaw(1e18),
+		MinOutTokensAmount: math.NewInt(1),
+	})
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded)
+
+	// the max per block applies across the accounts
+	err = k.Buy(s.Ctx, planId, buyer2, math.NewInt(2_001).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded) // 2k bought by buyer1 in this block
+	err = k.Buy(s.Ctx, planId, buyer2, math.NewInt(2_000).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, buyer3, math.NewInt(1).MulRaw(1e18), maxCost)
+	s.Require().ErrorIs(err, types.ErrTradingLimitExceeded)
+
+	// the next block has a fresh per block limit
+	s.Ctx = s.Ctx.WithBlockHeight(102)
+	err = k.Buy(s.Ctx, planId, buyer3, math.NewInt(1_000).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+
+	// the owner is not limited
+	owner := s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId)
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(1_000_000).MulRaw(1e18))))
+	err = k.Buy(s.Ctx, planId, owner, math.NewInt(5_000).MulRaw(1e18), maxCost)
+	s.Require().NoError(err)
+
+	// the purchases are exported
+	genesis := iro.ExportGenesis(s.Ctx, *k)
+	s.Require().Len(genesis.AccountPurchases, 3)
+	s.Require().NoError(genesis.Validate())
+}
@@ -79,11 +79,5 @@ func generateRandomBondingCurve(r *rand.Rand, allocatedAmount math.Int, liquidit
 		liquidityPart,
 	)
 
-	return types.BondingCurve{
-		M:                      m,
-		N:                      n,
-		C:                      math.LegacyZeroDec(),
-		RollappDenomDecimals:   18,
-		LiquidityDenomDecimals: 18,
-	}
+	return types.NewBondingCurve(m, n, math.LegacyZeroDec(), 18, 18)
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -24,6 +25,11 @@ M (multiplier) controls the overall steepness of the curve. A higher M value res
 N (exponent) shapes the curve's trajectory. When N > 1, the curve becomes convex, accelerating price growth at higher supply levels, which can create strong incentives for early adoption. When 0 < N < 1, the curve is concave, slowing price growth as supply increases, which can promote more stable long-term growth.
 
 C (constant) sets the starting price when supply is zero, effectively establishing a price floor and influencing the token's initial accessibility.
+
+Other curve families are supported as well, see CurveFunc:
+- exponential: P = M * e^(K * x) + C, the price grows by a constant rate K
+- piecewise-linear: P is interpolated linearly between configured breakpoints, and is constant after the last one
+- logistic: P = M / (1 + e^(-K * (x - X0))) + C, the price rises around the midpoint X0 and flattens at M + C
 */
 
 const (
@@ -34,6 +40,19 @@ const (
 	epsilonPrecision = 12  // approximation precision decimal places (10^12)
 )
 
+// Curve is the interface of the bonding curves used by the IRO plans.
+// The inputs and outputs are in the base denomination.
+type Curve interface {
+	// SpotPrice returns the spot price at the supply x
+	SpotPrice(x math.Int) math.LegacyDec
+	// Cost returns the cost to purchase the tokens from the supply x to x1
+	Cost(x, x1 math.Int) math.Int
+	// TokensForExactInAmount returns the number of tokens that can be bought for spendAmt, from the supply currX
+	TokensForExactInAmount(currX, spendAmt math.Int) (math.Int, error)
+}
+
+var _ Curve = BondingCurve{}
+
 /*
 The bonding curve implementation based on decimal representation of the X (rollapp's tokens) and Y (liquidity) values.
 we use scaling functions to convert between the decimal scale and the base denomination.
@@ -41,22 +60,58 @@ we use scaling functions to convert between the decimal scale and the base denom
 
 func NewBondingCurve(m, n, c math.LegacyDec, rollappDenomDecimals, liquidityDenomDecimals uint64) BondingCurve {
 	return BondingCurve{
+		CurveType:              CurveTypePower,
 		M:                      m,
 		N:                      n,
 		C:                      c,
+		K:                      math.LegacyZeroDec(),
+		X0:                     math.LegacyZeroDec(),
 		RollappDenomDecimals:   rollappDenomDecimals,
 		LiquidityDenomDecimals: liquidityDenomDecimals,
 	}
 }
 
+// NewExponentialBondingCurve returns a curve with the price M * e^(K * x) + C
+func NewExponentialBondingCurve(m, k, c math.LegacyDec, rollappDenomDecimals, liquidityDenomDecimals uint64) BondingCurve {
+	curve := NewBondingCurve(m, math.LegacyZeroDec(), c, rollappDenomDecimals, liquidityDenomDecimals)
+	curve.CurveType = CurveTypeExponential
+	curve.K = k
+	return curve
+}
+
+// NewPiecewiseLinearBondingCurve returns a curve with the price interpolated linearly between the breakpoints
+func NewPiecewiseLinearBondingCurve(breakpoints []Breakpoint, rollappDenomDecimals, liquidityDenomDecimals uint64) BondingCurve {
+	curve := NewBondingCurve(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec(), rollappDenomDecimals, liquidityDenomDecimals)
+	curve.CurveType = CurveTypePiecewiseLinear
+	curve.Breakpoints = breakpoints
+	return curve
+}
+
+// NewLogisticBondingCurve returns a curve with the price M / (1 + e^(-K * (x - X0))) + C
+func NewLogisticBondingCurve(m, k, x0, c math.LegacyDec, rollappDenomDecimals, liquidityDenomDecimals uint64) BondingCurve {
+	curve := NewBondingCurve(m, math.LegacyZeroDec(), c, rollappDenomDecimals, liquidityDenomDecimals)
+	curve.CurveType = CurveTypeLogistic
+	curve.K = k
+	curve.X0 = x0
+	return curve
+}
+
 func DefaultBondingCurve() BondingCurve {
 	// linear bonding curve as default
-	return BondingCurve{
-		M:                      math.LegacyMustNewDecFromStr("0.005"),
-		N:                      math.LegacyOneDec(),
-		C:                      math.LegacyZeroDec(),
-		RollappDenomDecimals:   18,
-		LiquidityDenomDecimals: 18,
+	return NewBondingCurve(math.LegacyMustNewDecFromStr("0.005"), math.LegacyOneDec(), math.LegacyZeroDec(), 18, 18)
+}
+
+// Func returns the curve family, in the decimal representation
+func (lbc BondingCurve) Func() CurveFunc {
+	switch lbc.CurveType {
+	case CurveTypeExponential:
+		return ExponentialCurve{M: lbc.M, K: lbc.K, C: lbc.C}
+	case CurveTypePiecewiseLinear:
+		return PiecewiseLinearCurve{Breakpoints: lbc.Breakpoints}
+	case CurveTypeLogistic:
+		return LogisticCurve{M: lbc.M, K: lbc.K, X0: lbc.X0, C: lbc.C}
+	default:
+		return PowerCurve{M: lbc.M, N: lbc.N, C: lbc.C}
 	}
 }
 
@@ -70,6 +125,23 @@ func (lbc BondingCurve) LiquidityDecimals() int64 {
 
 // ValidateBasic checks if the bonding curve is valid
 func (lbc BondingCurve) ValidateBasic() error {
+	if _, ok := CurveType_name[int32(lbc.CurveType)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "curve type: %d", lbc.CurveType)
+	}
+
+	if err := lbc.Func().ValidateBasic(); err != nil {
+		return err
+	}
+
+	if lbc.RollappDenomDecimals == 0 || lbc.LiquidityDenomDecimals == 0 {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "rollapp_basedenom_decimals: %d, liquidity_denom_decimals: %d", lbc.RollappDenomDecimals, lbc.LiquidityDenomDecimals)
+	}
+
+	return nil
+}
+
+// ValidateBasic checks if the power curve is valid
+func (lbc PowerCurve) ValidateBasic() error {
 	if lbc.M.IsNegative() {
 		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %d", lbc.M)
 	}
@@ -93,10 +165,6 @@ func (lbc BondingCurve) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidBondingCurve, "N must have at most %d decimal places", MaxNPrecision)
 	}
 
-	if lbc.RollappDenomDecimals == 0 || lbc.LiquidityDenomDecimals == 0 {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "rollapp_basedenom_decimals: %d, liquidity_denom_decimals: %d", lbc.RollappDenomDecimals, lbc.LiquidityDenomDecimals)
-	}
-
 	return nil
 }
 
@@ -158,6 +226,9 @@ func (lbc BondingCurve) TokensForExactInAmount(currX, spendAmt math.Int) (math.I
 // Calculate the number of tokens that can be bought with a given amount of liquidity tokens
 // inputs validated and scaled by caller
 func (lbc BondingCurve) TokensApproximation(startingX, spendTokens math.LegacyDec) (math.LegacyDec, int, error) {
+	if lbc.CurveType != CurveTypePower {
+		return lbc.tokensApproximationBracketed(startingX, spendTokens)
+	}
 	// Define the function we're trying to solve: f(x) = Integral(startingX + x) - Integral(startingX) - spendAmt
 	f := func(x math.LegacyDec) math.LegacyDec {
 		newX := startingX.Add(x)
@@ -205,8 +276,66 @@ func (lbc BondingCurve) TokensApproximation(startingX, spendTokens math.LegacyDe
 	return math.LegacyDec{}, maxIterations, errors.New("solution did not converge")
 }
 
+// tokensApproximationBracketed is the Newton-Raphson method, falling back to bisection when a step leaves the
+// bracket of the solution. Unlike the power curve, the price of the other families can grow fast enough for
+// the plain method to overshoot.
+func (lbc BondingCurve) tokensApproximationBracketed(startingX, spendTokens math.LegacyDec) (math.LegacyDec, int, error) {
+	f := func(x math.LegacyDec) math.LegacyDec {
+		return lbc.integral(startingX.Add(x)).Sub(lbc.integral(startingX)).Sub(spendTokens)
+	}
+
+	// find the upper bound of the solution, assuming 1 DYM = 1 token for the initial guess
+	lo := math.LegacyZeroDec()
+	hi := math.LegacyMaxDec(spendTokens, math.LegacyOneDec())
+	for i := 0; f(hi).IsNegative(); i++ {
+		if i == maxIterations {
+			return math.LegacyDec{}, i, errors.New("solution is not bounded")
+		}
+		lo = hi
+		hi = hi.MulInt64(2)
+	}
+
+	epsilonDec := math.LegacyNewDecWithPrec(1, epsilonPrecision)
+	x := hi
+	for i := 0; i < maxIterations; i++ {
+		fx := f(x)
+		if fx.Abs().LT(epsilonDec) {
+			return x, i, nil
+		}
+		if fx.IsPositive() {
+			hi = x
+		} else {
+			lo = x
+		}
+
+		// Newton-Raphson step, or bisection if it leaves the bracket
+		next := lo.Add(hi).QuoInt64(2)
+		if price := lbc.spotPriceInternal(startingX.Add(x)); price.IsPositive() {
+			if step := x.Sub(fx.Quo(price)); step.GT(lo) && step.LT(hi) {
+				next = step
+			}
+		}
+
+		if next.Sub(x).Abs().LT(epsilonDec.Mul(next.Abs())) {
+			return next, i, nil
+		}
+		x = next
+	}
+	return math.LegacyDec{}, maxIterations, errors.New("solution did not converge")
+}
+
 // spotPriceInternal returns the spot price at x
 func (lbc BondingCurve) spotPriceInternal(x math.LegacyDec) math.LegacyDec {
+	return lbc.Func().SpotPrice(x)
+}
+
+// integral returns the cost to purchase the tokens from zero supply to x
+func (lbc BondingCurve) integral(x math.LegacyDec) math.LegacyDec {
+	return lbc.Func().Integral(x)
+}
+
+// SpotPrice returns the spot price of the power curve at x
+func (lbc PowerCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
 	xDec := osmomath.BigDecFromSDKDec(x)
 	nDec := osmomath.BigDecFromSDKDec(lbc.N)
 	mDec := osmomath.BigDecFromSDKDec(lbc.M)
@@ -224,7 +353,7 @@ func (lbc BondingCurve) spotPriceInternal(x math.LegacyDec) math.LegacyDec {
 // The integral of y = M * x^N + C is:
 //
 //	Cost = (M / (N + 1)) * x^(N + 1) + C * x.
-func (lbc BondingCurve) integral(x math.LegacyDec) math.LegacyDec {
+func (lbc PowerCurve) Integral(x math.LegacyDec) math.LegacyDec {
 	xDec := osmomath.BigDecFromSDKDec(x)
 	mDec := osmomath.BigDecFromSDKDec(lbc.M)
 	cDec := osmomath.BigDecFromSDKDec(lbc.C)
@@ -308,9 +437,22 @@ func checkPrecision(d math.LegacyDec) bool {
 
 // String returns a human readable string representation of the bonding curve
 func (lbc BondingCurve) Stringify() string {
-	return fmt.Sprintf("M=%s N=%s C=%s",
-		lbc.M.String(),
-		lbc.N.String(),
-		lbc.C.String(),
-	)
+	switch lbc.CurveType {
+	case CurveTypeExponential:
+		return fmt.Sprintf("exponential M=%s K=%s C=%s", lbc.M, lbc.K, lbc.C)
+	case CurveTypePiecewiseLinear:
+		points := make([]string, 0, len(lbc.Breakpoints))
+		for _, b := range lbc.Breakpoints {
+			points = append(points, fmt.Sprintf("%s:%s", b.Supply, b.Price))
+		}
+		return fmt.Sprintf("piecewise-linear %s", strings.Join(points, " "))
+	case CurveTypeLogistic:
+		return fmt.Sprintf("logistic M=%s K=%s X0=%s C=%s", lbc.M, lbc.K, lbc.X0, lbc.C)
+	default:
+		return fmt.Sprintf("M=%s N=%s C=%s",
+			lbc.M.String(),
+			lbc.N.String(),
+			lbc.C.String(),
+		)
+	}
 }
@@ -0,0 +1,239 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+const (
+	MaxBreakpoints = 16 // Maximum allowed number of breakpoints for the piecewise-linear curve
+
+	// maxExponent bounds the exponents of e, as e^40 is already beyond the precision of the decimals.
+	// The exponential curve's price is constant beyond it, the logistic curve is saturated.
+	maxExponent = 40
+)
+
+var (
+	maxExponentDec = osmomath.NewBigDec(maxExponent)
+	log2E          = osmomath.MustNewDecFromStr("1.442695040888963407359924681001892137") // log2(e)
+)
+
+// CurveFunc is a bonding curve family, in the decimal representation.
+// The price must be non-decreasing with the supply.
+type CurveFunc interface {
+	// SpotPrice returns the price at the supply x
+	SpotPrice(x math.LegacyDec) math.LegacyDec
+	// Integral returns the cost to purchase the tokens from zero supply to x
+	Integral(x math.LegacyDec) math.LegacyDec
+	// ValidateBasic checks the curve parameters
+	ValidateBasic() error
+}
+
+var (
+	_ CurveFunc = PowerCurve{}
+	_ CurveFunc = ExponentialCurve{}
+	_ CurveFunc = PiecewiseLinearCurve{}
+	_ CurveFunc = LogisticCurve{}
+)
+
+// PowerCurve is the price M * x^N + C
+type PowerCurve struct {
+	M, N, C math.LegacyDec
+}
+
+/* ------------------------------- exponential ------------------------------ */
+
+// ExponentialCurve is the price M * e^(K * x) + C
+type ExponentialCurve struct {
+	M, K, C math.LegacyDec
+}
+
+func (e ExponentialCurve) ValidateBasic() error {
+	if !e.M.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %s", e.M)
+	}
+	if !e.K.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "k: %s", e.K)
+	}
+	if e.C.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", e.C)
+	}
+	return nil
+}
+
+// SpotPrice returns M * e^(K * x) + C
+func (e ExponentialCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
+	kx := osmomath.BigDecFromSDKDec(e.K).Mul(osmomath.BigDecFromSDKDec(x))
+	price := osmomath.BigDecFromSDKDec(e.M).Mul(exp(osmomath.MinDec(kx, maxExponentDec)))
+	return price.SDKDec().Add(e.C)
+}
+
+// The integral of y = M * e^(K * x) + C from 0 is:
+//
+//	Cost = (M / K) * (e^(K * x) - 1) + C * x
+//
+// Beyond the max exponent, the price is constant.
+func (e ExponentialCurve) Integral(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	mDec := osmomath.BigDecFromSDKDec(e.M)
+	kDec := osmomath.BigDecFromSDKDec(e.K)
+	cDec := osmomath.BigDecFromSDKDec(e.C)
+
+	kx := kDec.Mul(xDec)
+	saturated := osmomath.ZeroDec()
+	if kx.GT(maxExponentDec) {
+		saturated = kx.Sub(maxExponentDec).Quo(kDec) // supply beyond the max exponent
+		kx = maxExponentDec
+	}
+
+	expKx := exp(kx)
+	integral := mDec.Quo(kDec).Mul(expKx.Sub(osmomath.OneDec())).
+		Add(mDec.Mul(expKx).Mul(saturated)).
+		Add(cDec.Mul(xDec))
+	return integral.SDKDec()
+}
+
+/* ---------------------------- piecewise-linear ---------------------------- */
+
+// PiecewiseLinearCurve is the price interpolated linearly between the breakpoints, constant after the last one
+type PiecewiseLinearCurve struct {
+	Breakpoints []Breakpoint
+}
+
+// ValidateBasic checks the breakpoints start at zero supply, with increasing supply and non-decreasing price
+func (p PiecewiseLinearCurve) ValidateBasic() error {
+	if len(p.Breakpoints) < 2 || len(p.Breakpoints) > MaxBreakpoints {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "breakpoints: %d: must be between 2 and %d", len(p.Breakpoints), MaxBreakpoints)
+	}
+	if !p.Breakpoints[0].Supply.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "first breakpoint supply must be zero: %s", p.Breakpoints[0].Supply)
+	}
+	if p.Breakpoints[0].Price.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "price: %s", p.Breakpoints[0].Price)
+	}
+	for i := 1; i < len(p.Breakpoints); i++ {
+		prev, curr := p.Breakpoints[i-1], p.Breakpoints[i]
+		if !curr.Supply.GT(prev.Supply) {
+			return errorsmod.Wrapf(ErrInvalidBondingCurve, "breakpoint %d: supply must be increasing: %s", i, curr.Supply)
+		}
+		if curr.Price.LT(prev.Price) {
+			return errorsmod.Wrapf(ErrInvalidBondingCurve, "breakpoint %d: price must be non-decreasing: %s", i, curr.Price)
+		}
+	}
+	if !p.Breakpoints[len(p.Breakpoints)-1].Price.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "last breakpoint price must be positive")
+	}
+	return nil
+}
+
+// segment returns the index of the breakpoint starting the segment of x, and the price at x
+func (p PiecewiseLinearCurve) segment(x osmomath.BigDec) (int, osmomath.BigDec) {
+	last := len(p.Breakpoints) - 1
+	for i := 0; i < last; i++ {
+		next := p.Breakpoints[i+1]
+		if x.LT(osmomath.BigDecFromSDKDec(next.Supply)) {
+			s0 := osmomath.BigDecFromSDKDec(p.Breakpoints[i].Supply)
+			p0 := osmomath.BigDecFromSDKDec(p.Breakpoints[i].Price)
+			p1 := osmomath.BigDecFromSDKDec(next.Price)
+			s1 := osmomath.BigDecFromSDKDec(next.Supply)
+			// p0 + (p1 - p0) * (x - s0) / (s1 - s0)
+			return i, p0.Add(p1.Sub(p0).Mul(x.Sub(s0)).Quo(s1.Sub(s0)))
+		}
+	}
+	return last, osmomath.BigDecFromSDKDec(p.Breakpoints[last].Price)
+}
+
+func (p PiecewiseLinearCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
+	_, price := p.segment(osmomath.BigDecFromSDKDec(x))
+	return price.SDKDec()
+}
+
+// Integral sums the trapezoids of the segments up to x
+func (p PiecewiseLinearCurve) Integral(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	seg, price := p.segment(xDec)
+
+	integral := osmomath.ZeroDec()
+	for i := 0; i < seg; i++ {
+		s0 := osmomath.BigDecFromSDKDec(p.Breakpoints[i].Supply)
+		s1 := osmomath.BigDecFromSDKDec(p.Breakpoints[i+1].Supply)
+		p0 := osmomath.BigDecFromSDKDec(p.Breakpoints[i].Price)
+		p1 := osmomath.BigDecFromSDKDec(p.Breakpoints[i+1].Price)
+		integral = integral.Add(s1.Sub(s0).Mul(p0.Add(p1)).QuoInt64(2))
+	}
+
+	// the partial segment, constant after the last breakpoint
+	s0 := osmomath.BigDecFromSDKDec(p.Breakpoints[seg].Supply)
+	p0 := osmomath.BigDecFromSDKDec(p.Breakpoints[seg].Price)
+	integral = integral.Add(xDec.Sub(s0).Mul(p0.Add(price)).QuoInt64(2))
+	return integral.SDKDec()
+}
+
+/* -------------------------------- logistic -------------------------------- */
+
+// LogisticCurve is the price M / (1 + e^(-K * (x - X0))) + C
+type LogisticCurve struct {
+	M, K, X0, C math.LegacyDec
+}
+
+func (l LogisticCurve) ValidateBasic() error {
+	if !l.M.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %s", l.M)
+	}
+	if !l.K.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "k: %s", l.K)
+	}
+	if l.X0.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "x0: %s", l.X0)
+	}
+	if l.C.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", l.C)
+	}
+	return nil
+}
+
+// z returns K * (x - X0)
+func (l LogisticCurve) z(x math.LegacyDec) osmomath.BigDec {
+	return osmomath.BigDecFromSDKDec(l.K).Mul(osmomath.BigDecFromSDKDec(x.Sub(l.X0)))
+}
+
+// SpotPrice returns M / (1 + e^(-z)) + C
+func (l LogisticCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
+	sigmoid := osmomath.OneDec().Quo(osmomath.OneDec().Add(exp(l.z(x).Neg())))
+	return osmomath.BigDecFromSDKDec(l.M).Mul(sigmoid).SDKDec().Add(l.C)
+}
+
+// The integral of y = M / (1 + e^(-z)) + C from 0 is:
+//
+//	Cost = (M / K) * (ln(1 + e^z(x)) - ln(1 + e^z(0))) + C * x
+func (l LogisticCurve) Integral(x math.LegacyDec) math.LegacyDec {
+	mDec := osmomath.BigDecFromSDKDec(l.M)
+	kDec := osmomath.BigDecFromSDKDec(l.K)
+	cDec := osmomath.BigDecFromSDKDec(l.C)
+
+	sp := softplus(l.z(x)).Sub(softplus(l.z(math.LegacyZeroDec())))
+	integral := mDec.Quo(kDec).Mul(sp).Add(cDec.Mul(osmomath.BigDecFromSDKDec(x)))
+	return integral.SDKDec()
+}
+
+/* ---------------------------- helper functions ---------------------------- */
+
+// exp returns e^y, y is bounded by the max exponent
+func exp(y osmomath.BigDec) osmomath.BigDec {
+	if y.IsNegative() {
+		return osmomath.OneDec().Quo(exp(y.Neg()))
+	}
+	return osmomath.Exp2(osmomath.MinDec(y, maxExponentDec).Mul(log2E))
+}
+
+// softplus returns ln(1 + e^z). Beyond the max exponent, it's z or 0 within the precision.
+func softplus(z osmomath.BigDec) osmomath.BigDec {
+	if z.GT(maxExponentDec) {
+		return z
+	}
+	if z.LT(maxExponentDec.Neg()) {
+		return osmomath.ZeroDec()
+	}
+	return osmomath.OneDec().Add(exp(z)).Ln()
+}
@@ -0,0 +1,247 @@
+package types_test
+
+import (
+	fmt "fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestBondingCurveFamilies_ValidateBasic(t *testing.T) {
+	d := math.LegacyMustNewDecFromStr
+	bp := func(supply, price string) types.Breakpoint {
+		return types.Breakpoint{Supply: d(supply), Price: d(price)}
+	}
+
+	tests := []struct {
+		name      string
+		curve     types.BondingCurve
+		expectErr bool
+	}{
+		{"Valid exponential", types.NewExponentialBondingCurve(d("2"), d("0.01"), d("1"), 18, 18), false},
+		{"Exponential zero M", types.NewExponentialBondingCurve(d("0"), d("0.01"), d("1"), 18, 18), true},
+		{"Exponential zero K", types.NewExponentialBondingCurve(d("2"), d("0"), d("1"), 18, 18), true},
+		{"Exponential negative C", types.NewExponentialBondingCurve(d("2"), d("0.01"), d("-1"), 18, 18), true},
+		{"Valid piecewise-linear", types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{bp("0", "0.1"), bp("1000", "0.5")}, 18, 18), false},
+		{"Piecewise-linear single breakpoint", types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{bp("0", "0.1")}, 18, 18), true},
+		{"Piecewise-linear not from zero", types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{bp("1", "0.1"), bp("1000", "0.5")}, 18, 18), true},
+		{"Piecewise-linear supply not increasing", types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{bp("0", "0.1"), bp("1000", "0.5"), bp("1000", "0.6")}, 18, 18), true},
+		{"Piecewise-linear price decreasing", types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{bp("0", "0.1"), bp("1000", "0.5"), bp("2000", "0.4")}, 18, 18), true},
+		{"Piecewise-linear zero price", types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{bp("0", "0"), bp("1000", "0")}, 18, 18), true},
+		{"Valid logistic", types.NewLogisticBondingCurve(d("10"), d("0.01"), d("500"), d("1"), 18, 18), false},
+		{"Logistic zero M", types.NewLogisticBondingCurve(d("0"), d("0.01"), d("500"), d("1"), 18, 18), true},
+		{"Logistic zero K", types.NewLogisticBondingCurve(d("10"), d("0"), d("500"), d("1"), 18, 18), true},
+		{"Logistic negative X0", types.NewLogisticBondingCurve(d("10"), d("0.01"), d("-500"), d("1"), 18, 18), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.curve.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBondingCurve_Exponential(t *testing.T) {
+	// y = 2*e^(0.01x) + 1
+	// integral of y = 200*(e^(0.01x) - 1) + x
+	curve := types.NewExponentialBondingCurve(
+		math.LegacyMustNewDecFromStr("2"),
+		math.LegacyMustNewDecFromStr("0.01"),
+		math.LegacyMustNewDecFromStr("1"),
+		18, 18,
+	)
+
+	x1 := math.NewInt(0).MulRaw(1e18)
+	x2 := math.NewInt(100).MulRaw(1e18)
+	x3 := math.NewInt(200).MulRaw(1e18)
+
+	spotPrice1 := math.LegacyMustNewDecFromStr("3")                    // 2*e^0 + 1
+	spotPrice2 := math.LegacyMustNewDecFromStr("6.436563656918090470") // 2*e + 1
+	spotPrice3 := math.LegacyMustNewDecFromStr("15.77811219786130045") // 2*e^2 + 1
+
+	integral2 := math.LegacyMustNewDecFromStr("443.6563656918090470").MulInt64(1e18).TruncateInt() // 200*(e - 1) + 100
+	integral3 := math.LegacyMustNewDecFromStr("1477.811219786130045").MulInt64(1e18).TruncateInt() // 200*(e^2 - 1) + 200
+	cost2to3 := math.LegacyMustNewDecFromStr("1034.154854094320998").MulInt64(1e18).TruncateInt()  // integral3 - integral2
+
+	approxEqualInt(t, math.ZeroInt(), curve.Cost(math.ZeroInt(), x1))
+	approxEqualInt(t, integral2, curve.Cost(math.ZeroInt(), x2))
+	approxEqualInt(t, integral3, curve.Cost(math.ZeroInt(), x3))
+
+	approxEqualDec(t, spotPrice1, curve.SpotPrice(x1))
+	approxEqualDec(t, spotPrice2, curve.SpotPrice(x2))
+	approxEqualDec(t, spotPrice3, curve.SpotPrice(x3))
+
+	approxEqualInt(t, integral2, curve.Cost(x1, x2))
+	approxEqualInt(t, cost2to3, curve.Cost(x2, x3))
+}
+
+// the exponential price is constant beyond the max exponent, and the cost follows it
+func TestBondingCurve_ExponentialSaturated(t *testing.T) {
+	curve := types.NewExponentialBondingCurve(
+		math.LegacyMustNewDecFromStr("0.000001"),
+		math.LegacyMustNewDecFromStr("1"),
+		math.LegacyZeroDec(),
+		18, 18,
+	)
+
+	x1 := math.NewInt(40).MulRaw(1e18)
+	x2 := math.NewInt(50).MulRaw(1e18)
+
+	require.Equal(t, curve.SpotPrice(x1), curve.SpotPrice(x2))
+	expected := curve.SpotPrice(x1).MulInt64(10).MulInt64(1e18).TruncateInt()
+	approxEqualInt(t, expected, curve.Cost(x1, x2))
+}
+
+func TestBondingCurve_PiecewiseLinear(t *testing.T) {
+	// 0.1 at 0, 0.5 at 1000, 1.5 at 3000, and constant after
+	curve := types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{
+		{Supply: math.LegacyMustNewDecFromStr("0"), Price: math.LegacyMustNewDecFromStr("0.1")},
+		{Supply: math.LegacyMustNewDecFromStr("1000"), Price: math.LegacyMustNewDecFromStr("0.5")},
+		{Supply: math.LegacyMustNewDecFromStr("3000"), Price: math.LegacyMustNewDecFromStr("1.5")},
+	}, 18, 18)
+
+	tests := []struct {
+		x        int64
+		price    string
+		integral int64
+	}{
+		{0, "0.1", 0},
+		{500, "0.3", 100},       // 500*(0.1+0.3)/2
+		{1000, "0.5", 300},      // 1000*(0.1+0.5)/2
+		{2000, "1.0", 1050},     // 300 + 1000*(0.5+1.0)/2
+		{3000, "1.5", 2300},     // 300 + 2000*(0.5+1.5)/2
+		{5000, "1.5", 5300},     // 2300 + 2000*1.5
+		{100000, "1.5", 147800}, // 2300 + 97000*1.5
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("x=%d", tc.x), func(t *testing.T) {
+			x := math.NewInt(tc.x).MulRaw(1e18)
+			approxEqualDec(t, math.LegacyMustNewDecFromStr(tc.price), curve.SpotPrice(x))
+			approxEqualInt(t, math.NewInt(tc.integral).MulRaw(1e18), curve.Cost(math.ZeroInt(), x))
+		})
+	}
+
+	// across the breakpoints: 2300 - 100
+	approxEqualInt(t, math.NewInt(2200).MulRaw(1e18), curve.Cost(math.NewInt(500).MulRaw(1e18), math.NewInt(3000).MulRaw(1e18)))
+}
+
+func TestBondingCurve_Logistic(t *testing.T) {
+	// y = 10 / (1 + e^(-0.01(x - 500))) + 1
+	// integral of y = 1000*(ln(1 + e^(0.01(x - 500))) - ln(1 + e^-5)) + x
+	curve := types.NewLogisticBondingCurve(
+		math.LegacyMustNewDecFromStr("10"),
+		math.LegacyMustNewDecFromStr("0.01"),
+		math.LegacyMustNewDecFromStr("500"),
+		math.LegacyMustNewDecFromStr("1"),
+		18, 18,
+	)
+
+	tests := []struct {
+		x        int64
+		price    string
+		integral string
+	}{
+		{0, "1.066928509242848556", "0"},
+		{500, "6", "1186.431832070827241"}, // the midpoint
+		{1000, "10.933071490757151444", "6000"},
+		{3000, "10.999999999861120561", "27993.284651524769875"}, // close to the cap M + C
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("x=%d", tc.x), func(t *testing.T) {
+			x := math.NewInt(tc.x).MulRaw(1e18)
+			approxEqualDec(t, math.LegacyMustNewDecFromStr(tc.price), curve.SpotPrice(x))
+			approxEqualInt(t, math.LegacyMustNewDecFromStr(tc.integral).MulInt64(1e18).TruncateInt(), curve.Cost(math.ZeroInt(), x))
+		})
+	}
+
+	// 6000 - 1186.431832070827241
+	cost := math.LegacyMustNewDecFromStr("4813.568167929172759").MulInt64(1e18).TruncateInt()
+	approxEqualInt(t, cost, curve.Cost(math.NewInt(500).MulRaw(1e18), math.NewInt(1000).MulRaw(1e18)))
+}
+
+func familyCurves() []struct {
+	name  string
+	curve types.BondingCurve
+} {
+	return []struct {
+		name  string
+		curve types.BondingCurve
+	}{
+		{"Exponential", types.NewExponentialBondingCurve(
+			math.LegacyMustNewDecFromStr("0.001"),
+			math.LegacyMustNewDecFromStr("0.00001"),
+			math.LegacyZeroDec(),
+			18, 18,
+		)},
+		{"Piecewise-linear", types.NewPiecewiseLinearBondingCurve([]types.Breakpoint{
+			{Supply: math.LegacyMustNewDecFromStr("0"), Price: math.LegacyMustNewDecFromStr("0.001")},
+			{Supply: math.LegacyMustNewDecFromStr("10000"), Price: math.LegacyMustNewDecFromStr("0.01")},
+			{Supply: math.LegacyMustNewDecFromStr("500000"), Price: math.LegacyMustNewDecFromStr("0.5")},
+			{Supply: math.LegacyMustNewDecFromStr("1000000"), Price: math.LegacyMustNewDecFromStr("0.6")},
+		}, 18, 18)},
+		{"Logistic", types.NewLogisticBondingCurve(
+			math.LegacyMustNewDecFromStr("1"),
+			math.LegacyMustNewDecFromStr("0.0001"),
+			math.LegacyMustNewDecFromStr("50000"),
+			math.LegacyMustNewDecFromStr("0.001"),
+			18, 18,
+		)},
+	}
+}
+
+// TestTokensForDYMFamilies ensures the cost and the tokens for exact spend are inverses of each other,
+// the same as TestTokensForDYM for the power curve.
+func TestTokensForDYMFamilies(t *testing.T) {
+	startingPoints := []string{"1", "100", "1000", "10000", "100000"}
+	xTokens := []string{"0.01", "0.1", "0.5", "1", "10", "1000", "10000", "100000", "1000000"}
+
+	for _, curve := range familyCurves() {
+		t.Run(curve.name, func(t *testing.T) {
+			for _, start := range startingPoints {
+				startingX := math.LegacyMustNewDecFromStr(start).MulInt64(1e18).TruncateInt()
+
+				for _, xToken := range xTokens {
+					x := math.LegacyMustNewDecFromStr(xToken).MulInt64(1e18).TruncateInt()
+					cost := curve.curve.Cost(startingX, startingX.Add(x))
+
+					t.Run(fmt.Sprintf("Start=%s, X=%s", start, xToken), func(t *testing.T) {
+						tokens, err := curve.curve.TokensForExactInAmount(startingX, cost)
+						require.NoError(t, err)
+						approxEqualInt(t, x, tokens)
+					})
+				}
+			}
+		})
+	}
+}
+
+// TestFindEquilibriumFamilies ensures the pool bootstrapped at the equilibrium has the last spot price
+func TestFindEquilibriumFamilies(t *testing.T) {
+	z := math.NewInt(1_000_000).MulRaw(1e18)
+
+	for _, curve := range familyCurves() {
+		for _, r := range []string{"0.4", "0.9", "1"} {
+			t.Run(fmt.Sprintf("%s r=%s", curve.name, r), func(t *testing.T) {
+				rDec := math.LegacyMustNewDecFromStr(r)
+				eq := types.FindEquilibrium(curve.curve, z, rDec)
+				require.True(t, eq.IsPositive())
+				require.True(t, eq.LT(z))
+
+				bootstrapFunds := curve.curve.Cost(math.ZeroInt(), eq).ToLegacyDec().Mul(rDec).TruncateInt()
+				unsoldValue := curve.curve.SpotPrice(eq).MulInt(z.Sub(eq)).TruncateInt()
+				err := approxEqualRatio(bootstrapFunds, unsoldValue, 0.001) // 0.1%
+				require.NoError(t, err)
+			})
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsEnabled returns true if the tokens claimed by the buyers vest
+func (v BuyerVesting) IsEnabled() bool {
+	return v.Duration > 0
+}
+
+func (v BuyerVesting) ValidateBasic() error {
+	if v.Cliff < 0 {
+		return fmt.Errorf("cliff must be non-negative: %v", v.Cliff)
+	}
+	if v.Duration < 0 {
+		return fmt.Errorf("duration must be non-negative: %v", v.Duration)
+	}
+	if v.Cliff > v.Duration {
+		return fmt.Errorf("cliff must not exceed the duration: cliff %v, duration %v", v.Cliff, v.Duration)
+	}
+	return nil
+}
+
+// VestedAmt returns the vested part of the total amount at the time.
+// Nothing is vested before the cliff, then the total vests linearly from the start time to the end of the duration.
+func (v BuyerVesting) VestedAmt(total math.Int, currTime time.Time) math.Int {
+	if !v.IsEnabled() {
+		return total
+	}
+
+	elapsed := currTime.Sub(v.StartTime)
+
+	// cliff not reached
+	if elapsed < v.Cliff {
+		return math.ZeroInt()
+	}
+
+	// ended
+	if elapsed >= v.Duration {
+		return total
+	}
+
+	s := math.LegacyNewDec(elapsed.Nanoseconds()).Quo(math.LegacyNewDec(v.Duration.Nanoseconds()))
+	return s.Mul(math.LegacyNewDecFromInt(total)).TruncateInt()
+}
+
+func NewBuyerVestingClaim(planId string, claimer sdk.AccAddress) BuyerVestingClaim {
+	return BuyerVestingClaim{
+		PlanId:   planId,
+		Claimer:  claimer.String(),
+		Amount:   math.ZeroInt(),
+		Released: math.ZeroInt(),
+	}
+}
+
+// Locked returns the amount of the claim not released yet
+func (c BuyerVestingClaim) Locked() math.Int {
+	return c.Amount.Sub(c.Released)
+}
+
+func (c BuyerVestingClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.Claimer); err != nil {
+		return fmt.Errorf("invalid claimer address: %w", err)
+	}
+	if c.Amount.IsNil() || c.Amount.IsNegative() {
+		return fmt.Errorf("amount must be non-negative: %v", c.Amount)
+	}
+	if c.Released.IsNil() || c.Released.IsNegative() {
+		return fmt.Errorf("released amount must be non-negative: %v", c.Released)
+	}
+	if c.Released.GT(c.Amount) {
+		return fmt.Errorf("released amount exceeds the amount: released %s, amount %s", c.Released, c.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestBuyerVesting_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		vesting   types.BuyerVesting
+		expectErr bool
+	}{
+		{"No vesting", types.BuyerVesting{}, false},
+		{"Linear vesting", types.BuyerVesting{Duration: time.Hour}, false},
+		{"Cliff and linear vesting", types.BuyerVesting{Cliff: time.Hour, Duration: 2 * time.Hour}, false},
+		{"Cliff only", types.BuyerVesting{Cliff: time.Hour, Duration: time.Hour}, false},
+		{"Negative cliff", types.BuyerVesting{Cliff: -time.Hour, Duration: time.Hour}, true},
+		{"Negative duration", types.BuyerVesting{Duration: -time.Hour}, true},
+		{"Cliff exceeds duration", types.BuyerVesting{Cliff: 2 * time.Hour, Duration: time.Hour}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.vesting.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBuyerVestingVestedAmt(t *testing.T) {
+	start := time.Now()
+	vesting := types.BuyerVesting{
+		Cliff:     time.Hour,
+		Duration:  10 * time.Hour,
+		StartTime: start,
+	}
+	total := math.NewInt(10_000)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected math.Int
+	}{
+		{"Before the start", start.Add(-time.Hour), math.ZeroInt()},
+		{"At the start", start, math.ZeroInt()},
+		{"Before the cliff", start.Add(59 * time.Minute), math.ZeroInt()},
+		{"At the cliff", start.Add(time.Hour), math.NewInt(1_000)},
+		{"Middle of the vesting", start.Add(5 * time.Hour), math.NewInt(5_000)},
+		{"End of the vesting", start.Add(10 * time.Hour), total},
+		{"After the vesting", start.Add(20 * time.Hour), total},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, vesting.VestedAmt(total, tt.now))
+		})
+	}
+
+	// everything is vested without buyer vesting
+	require.Equal(t, total, types.BuyerVesting{}.VestedAmt(total, start))
+}
@@ -16,6 +16,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBuyExactSpend{}, "iro/BuyExactSpend", nil)
 	cdc.RegisterConcrete(&MsgEnableTrading{}, "iro/EnableTrading", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "iro/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgFailPlan{}, "iro/FailPlan", nil)
+	cdc.RegisterConcrete(&MsgRefund{}, "iro/Refund", nil)
 	cdc.RegisterConcrete(Params{}, "iro/Params", nil)
 }
 
@@ -30,6 +32,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreatePlan{},
 		&MsgBuyExactSpend{},
 		&MsgUpdateParams{},
+		&MsgFailPlan{},
+		&MsgRefund{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,4 +28,9 @@ var (
 	ErrInsufficientTokens           = errorsmod.Register(ModuleName, 1118, "insufficient tokens")
 	ErrRollappGenesisInfoNotSet     = errorsmod.Register(ModuleName, 1119, "rollapp genesis info not set")
 	ErrInvalidIncentivePlanParams   = errorsmod.Register(ModuleName, 1120, "invalid incentive plan params")
+	ErrPlanFailed                   = errorsmod.Register(ModuleName, 1121, "plan is failed")
+	ErrPlanNotFailed                = errorsmod.Register(ModuleName, 1122, "plan is not failed")
+	ErrNotInPresaleAllowlist        = errorsmod.Register(ModuleName, 1123, "buyer is not in the presale allowlist")
+	ErrPresaleCapExceeded           = errorsmod.Register(ModuleName, 1124, "presale cap exceeded")
+	ErrTradingLimitExceeded         = errorsmod.Register(ModuleName, 1125, "trading limit exceeded")
 )
@@ -322,6 +322,8 @@ type EventClaim struct {
 	PlanId    string     `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 	RollappId string     `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 	Claim     types.Coin `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim"`
+	// The tokens of the claimer still locked by the buyer vesting.
+	Locked types.Coin `protobuf:"bytes,5,opt,name=locked,proto3" json:"locked"`
 }
 
 func (m *EventClaim) Reset()         { *m = EventClaim{} }
@@ -385,6 +387,13 @@ func (m *EventClaim) GetClaim() types.Coin {
 	return types.Coin{}
 }
 
+func (m *EventClaim) GetLocked() types.Coin {
+	if m != nil {
+		return m.Locked
+	}
+	return types.Coin{}
+}
+
 type EventClaimVested struct {
 	Claimer   string     `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
 	PlanId    string     `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -539,6 +548,214 @@ func (m *EventSettle) GetGaugeId() uint64 {
 	return 0
 }
 
+type EventPlanFailed struct {
+	PlanId    string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// The liquidity raised by the plan, available for the refunds.
+	Raised types.Coin `protobuf:"bytes,3,opt,name=raised,proto3" json:"raised"`
+}
+
+func (m *EventPlanFailed) Reset()         { *m = EventPlanFailed{} }
+func (m *EventPlanFailed) String() string { return proto.CompactTextString(m) }
+func (*EventPlanFailed) ProtoMessage()    {}
+func (*EventPlanFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{7}
+}
+func (m *EventPlanFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPlanFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPlanFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPlanFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPlanFailed.Merge(m, src)
+}
+func (m *EventPlanFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPlanFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPlanFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPlanFailed proto.InternalMessageInfo
+
+func (m *EventPlanFailed) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventPlanFailed) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventPlanFailed) GetRaised() types.Coin {
+	if m != nil {
+		return m.Raised
+	}
+	return types.Coin{}
+}
+
+// EventPlanAllocationReleased is emitted when the rollapp tokens of a failed
+// plan are sent to the rollapp owner, on the genesis bridge completion.
+type EventPlanAllocationReleased struct {
+	PlanId     string     `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId  string     `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Owner      string     `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Allocation types.Coin `protobuf:"bytes,4,opt,name=allocation,proto3" json:"allocation"`
+}
+
+func (m *EventPlanAllocationReleased) Reset()         { *m = EventPlanAllocationReleased{} }
+func (m *EventPlanAllocationReleased) String() string { return proto.CompactTextString(m) }
+func (*EventPlanAllocationReleased) ProtoMessage()    {}
+func (*EventPlanAllocationReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{8}
+}
+func (m *EventPlanAllocationReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPlanAllocationReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPlanAllocationReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPlanAllocationReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPlanAllocationReleased.Merge(m, src)
+}
+func (m *EventPlanAllocationReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPlanAllocationReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPlanAllocationReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPlanAllocationReleased proto.InternalMessageInfo
+
+func (m *EventPlanAllocationReleased) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventPlanAllocationReleased) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventPlanAllocationReleased) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventPlanAllocationReleased) GetAllocation() types.Coin {
+	if m != nil {
+		return m.Allocation
+	}
+	return types.Coin{}
+}
+
+type EventRefund struct {
+	Claimer   string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	PlanId    string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// The IRO tokens burned.
+	Burned types.Coin `protobuf:"bytes,4,opt,name=burned,proto3" json:"burned"`
+	Refund types.Coin `protobuf:"bytes,5,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *EventRefund) Reset()         { *m = EventRefund{} }
+func (m *EventRefund) String() string { return proto.CompactTextString(m) }
+func (*EventRefund) ProtoMessage()    {}
+func (*EventRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{9}
+}
+func (m *EventRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRefund.Merge(m, src)
+}
+func (m *EventRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRefund proto.InternalMessageInfo
+
+func (m *EventRefund) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *EventRefund) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventRefund) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRefund) GetBurned() types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return types.Coin{}
+}
+
+func (m *EventRefund) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "dymensionxyz.dymension.iro.EventUpdateParams")
 	proto.RegisterType((*EventNewIROPlan)(nil), "dymensionxyz.dymension.iro.EventNewIROPlan")
@@ -547,6 +764,9 @@ func init() {
 	proto.RegisterType((*EventClaim)(nil), "dymensionxyz.dymension.iro.EventClaim")
 	proto.RegisterType((*EventClaimVested)(nil), "dymensionxyz.dymension.iro.EventClaimVested")
 	proto.RegisterType((*EventSettle)(nil), "dymensionxyz.dymension.iro.EventSettle")
+	proto.RegisterType((*EventPlanFailed)(nil), "dymensionxyz.dymension.iro.EventPlanFailed")
+	proto.RegisterType((*EventPlanAllocationReleased)(nil), "dymensionxyz.dymension.iro.EventPlanAllocationReleased")
+	proto.RegisterType((*EventRefund)(nil), "dymensionxyz.dymension.iro.EventRefund")
 }
 
 func init() {
@@ -554,54 +774,61 @@ func init() {
 }
 
 var fileDescriptor_9d7833031285167c = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4d, 0x4f, 0xd4, 0x5c,
-	0x14, 0x9e, 0x0e, 0xf3, 0xd5, 0xc3, 0xcb, 0xfb, 0xd1, 0xf0, 0xe6, 0x1d, 0x20, 0xef, 0x40, 0x26,
-	0x26, 0x92, 0x18, 0x5a, 0x3e, 0xa2, 0xc6, 0xe8, 0x86, 0x19, 0x94, 0xd4, 0x18, 0x25, 0x25, 0xb2,
-	0x70, 0x33, 0xb9, 0xd3, 0x1e, 0x4a, 0x43, 0x7b, 0x6f, 0xd3, 0xde, 0x0e, 0x8c, 0x89, 0xff, 0xc1,
-	0x7f, 0xe2, 0x86, 0x1f, 0xc1, 0x92, 0xb0, 0x32, 0x26, 0x12, 0x03, 0x3b, 0x97, 0x2e, 0xdc, 0x6a,
-	0xee, 0xed, 0x1d, 0x20, 0x1a, 0x60, 0x64, 0x61, 0x74, 0x37, 0xa7, 0xe7, 0x39, 0xcf, 0x79, 0xce,
-	0x73, 0xcf, 0x9d, 0x0b, 0x37, 0xbd, 0x7e, 0x84, 0x34, 0x0d, 0x18, 0xdd, 0xed, 0xbf, 0xb4, 0x4e,
-	0x03, 0x2b, 0x48, 0x98, 0x85, 0x3d, 0xa4, 0x3c, 0x35, 0xe3, 0x84, 0x71, 0x66, 0x4c, 0x9e, 0x07,
-	0x9a, 0xa7, 0x81, 0x19, 0x24, 0x6c, 0x72, 0xdc, 0x67, 0x3e, 0x93, 0x30, 0x4b, 0xfc, 0xca, 0x2b,
-	0x26, 0x27, 0x5c, 0x96, 0x46, 0x2c, 0xed, 0xe4, 0x89, 0x3c, 0x50, 0xa9, 0x69, 0x9f, 0x31, 0x3f,
-	0x44, 0x4b, 0x46, 0xdd, 0x6c, 0xd3, 0xe2, 0x41, 0x84, 0x29, 0x27, 0x51, 0xac, 0x00, 0x8d, 0x1c,
-	0x6e, 0x75, 0x49, 0x8a, 0x56, 0x6f, 0xa1, 0x8b, 0x9c, 0x2c, 0x58, 0x2e, 0x0b, 0xa8, 0xca, 0xdf,
-	0xb8, 0x44, 0x76, 0x90, 0x0c, 0x14, 0x5c, 0x36, 0x5c, 0x4c, 0x12, 0x12, 0x29, 0x3d, 0xcd, 0xf7,
-	0x1a, 0xfc, 0xf3, 0x50, 0x4c, 0xfb, 0x3c, 0xf6, 0x08, 0xc7, 0x35, 0x99, 0x33, 0xee, 0x80, 0x4e,
-	0x32, 0xbe, 0xc5, 0x92, 0x80, 0xf7, 0xeb, 0xda, 0x8c, 0x36, 0xab, 0xb7, 0xea, 0x87, 0x7b, 0x73,
-	0xe3, 0x6a, 0x94, 0x65, 0xcf, 0x4b, 0x30, 0x4d, 0xd7, 0x79, 0x12, 0x50, 0xdf, 0x39, 0x83, 0x1a,
-	0xab, 0x00, 0x14, 0x77, 0x3a, 0x79, 0x87, 0x7a, 0x71, 0x46, 0x9b, 0x1d, 0x5d, 0x6c, 0x9a, 0x17,
-	0xfb, 0x67, 0xe6, 0xfd, 0x5a, 0xa5, 0xfd, 0xa3, 0xe9, 0x82, 0xa3, 0x53, 0xdc, 0x51, 0x02, 0x56,
-	0x01, 0x58, 0xe8, 0x0d, 0x88, 0x46, 0x7e, 0x94, 0x88, 0x85, 0x5e, 0xfe, 0xa1, 0xf9, 0x0a, 0xfe,
-	0x92, 0xe3, 0x3d, 0xc5, 0x1d, 0xdb, 0x79, 0xb6, 0x16, 0x12, 0x6a, 0x2c, 0x42, 0xd5, 0x4d, 0x90,
-	0x70, 0x96, 0x5c, 0x39, 0xda, 0x00, 0x68, 0xfc, 0x07, 0xd5, 0x38, 0x24, 0xb4, 0x13, 0x78, 0x72,
-	0x2a, 0xdd, 0xa9, 0x88, 0xd0, 0xf6, 0x8c, 0xff, 0x01, 0x12, 0x16, 0x86, 0x24, 0x8e, 0x45, 0x6e,
-	0x44, 0xe6, 0x74, 0xf5, 0xc5, 0xf6, 0x9a, 0x9f, 0x8b, 0x50, 0x93, 0xfd, 0x5b, 0x59, 0xdf, 0x30,
-	0xa1, 0xdc, 0xcd, 0xfa, 0x78, 0x75, 0xdb, 0x1c, 0x76, 0xdd, 0xa6, 0xc6, 0x5d, 0xa8, 0x90, 0x88,
-	0x65, 0x94, 0xd7, 0x4b, 0xd2, 0xb8, 0x09, 0x53, 0x75, 0x11, 0x3b, 0x65, 0xaa, 0x9d, 0x32, 0xdb,
-	0x2c, 0xa0, 0xca, 0x2f, 0x05, 0x37, 0x96, 0xa0, 0xe4, 0xb2, 0x94, 0xd7, 0xcb, 0xc3, 0x95, 0x49,
-	0xb0, 0xf1, 0x00, 0x74, 0x4e, 0xb6, 0x31, 0xe9, 0x6c, 0x22, 0xd6, 0x2b, 0xc3, 0x55, 0xd6, 0x64,
-	0xc5, 0x23, 0x44, 0x63, 0x03, 0xc6, 0xdc, 0x90, 0xa5, 0x01, 0xf5, 0x3b, 0x71, 0x12, 0xb8, 0x58,
-	0xaf, 0x4a, 0x6f, 0x16, 0x04, 0xec, 0xdd, 0xd1, 0xf4, 0x54, 0x4e, 0x94, 0x7a, 0xdb, 0x66, 0xc0,
-	0xac, 0x88, 0xf0, 0x2d, 0xf3, 0x09, 0xfa, 0xc4, 0xed, 0xaf, 0xa0, 0x7b, 0xb8, 0x37, 0x07, 0xaa,
-	0xcf, 0x0a, 0xba, 0xce, 0x1f, 0x8a, 0x67, 0x4d, 0xd0, 0x34, 0xbf, 0x14, 0x41, 0x97, 0xc6, 0xaf,
-	0x63, 0x18, 0x1a, 0xf3, 0x50, 0x49, 0x31, 0x0c, 0x87, 0xb0, 0x5e, 0xe1, 0x7e, 0xbe, 0xf7, 0xf7,
-	0xa0, 0x9a, 0x88, 0xbf, 0x9d, 0x0c, 0x87, 0xb5, 0x7f, 0x80, 0xff, 0x45, 0x4f, 0xe0, 0x8d, 0x06,
-	0x20, 0x4f, 0xa0, 0x1d, 0x92, 0x20, 0x92, 0xb7, 0x4e, 0xfc, 0xc0, 0x61, 0x6e, 0x5d, 0x0e, 0xbc,
-	0xf6, 0x21, 0xdc, 0x86, 0xb2, 0xa4, 0x18, 0xf6, 0x0c, 0x72, 0x74, 0xf3, 0x93, 0x06, 0x7f, 0x9f,
-	0x29, 0xde, 0xc0, 0x94, 0xa3, 0xf7, 0x1b, 0xe8, 0x36, 0xee, 0x43, 0x2d, 0xa3, 0x3d, 0x29, 0x77,
-	0xd8, 0xdd, 0x39, 0x2d, 0x68, 0x7e, 0xd4, 0x60, 0x54, 0x5d, 0x14, 0xce, 0x43, 0x3c, 0xaf, 0x5d,
-	0xbb, 0x44, 0x7b, 0xf1, 0x5b, 0xed, 0x53, 0xa0, 0xdb, 0xad, 0x76, 0xc7, 0x43, 0xca, 0x22, 0x35,
-	0x59, 0xcd, 0x6e, 0xb5, 0x57, 0x44, 0x2c, 0x49, 0x19, 0x0b, 0x45, 0xa1, 0x18, 0xad, 0xe4, 0x54,
-	0x44, 0x68, 0x7b, 0xc6, 0x04, 0xd4, 0x7c, 0x92, 0xf9, 0x28, 0x32, 0x65, 0x99, 0xa9, 0xca, 0xd8,
-	0xf6, 0x0c, 0x07, 0xfe, 0x14, 0x12, 0xc5, 0x5e, 0xaa, 0x1b, 0x55, 0x91, 0xfe, 0xdf, 0x52, 0x8b,
-	0xf9, 0xef, 0xf7, 0x8b, 0x69, 0x53, 0x7e, 0x6e, 0x25, 0x6d, 0xca, 0x9d, 0x31, 0x45, 0xb1, 0x2c,
-	0x19, 0x5a, 0x8f, 0xf7, 0x8f, 0x1b, 0xda, 0xc1, 0x71, 0x43, 0xfb, 0x70, 0xdc, 0xd0, 0x5e, 0x9f,
-	0x34, 0x0a, 0x07, 0x27, 0x8d, 0xc2, 0xdb, 0x93, 0x46, 0xe1, 0xc5, 0xbc, 0x1f, 0xf0, 0xad, 0xac,
-	0x6b, 0xba, 0x2c, 0xb2, 0x2e, 0x78, 0x3b, 0x7b, 0x4b, 0xd6, 0xae, 0x7c, 0x40, 0x79, 0x3f, 0xc6,
-	0xb4, 0x5b, 0x91, 0x0f, 0xe8, 0xd2, 0xd7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0x0c, 0x07, 0x86,
-	0x48, 0x08, 0x00, 0x00,
+	// 852 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x5f, 0x6b, 0x1d, 0x45,
+	0x14, 0xcf, 0x26, 0x37, 0xf7, 0xcf, 0xa9, 0xf5, 0xcf, 0x52, 0x71, 0x93, 0xe0, 0x4d, 0x59, 0x04,
+	0x0b, 0xd2, 0xdd, 0xa6, 0x41, 0x83, 0x28, 0x48, 0x6e, 0x62, 0xcb, 0x8a, 0x68, 0xd8, 0x62, 0x1f,
+	0x7c, 0xb9, 0xcc, 0xdd, 0x39, 0xd9, 0x0c, 0x99, 0x9d, 0x59, 0x66, 0x67, 0x93, 0x5e, 0xc1, 0x17,
+	0x3f, 0x81, 0x1f, 0xa6, 0x9f, 0x41, 0xfa, 0x58, 0xfa, 0x24, 0x82, 0x45, 0x92, 0x17, 0xd1, 0x37,
+	0x1f, 0x7c, 0x55, 0x66, 0x76, 0xee, 0x6d, 0x50, 0x9a, 0xac, 0x11, 0x8a, 0xbe, 0xed, 0xd9, 0xf3,
+	0x3b, 0xe7, 0xfc, 0x7e, 0xe7, 0x9c, 0xd9, 0x1d, 0x78, 0x9b, 0x4e, 0x0b, 0x14, 0x15, 0x93, 0xe2,
+	0xc1, 0xf4, 0xab, 0x78, 0x6e, 0xc4, 0x4c, 0xc9, 0x18, 0x8f, 0x50, 0xe8, 0x2a, 0x2a, 0x95, 0xd4,
+	0xd2, 0x5f, 0x3d, 0x0b, 0x8c, 0xe6, 0x46, 0xc4, 0x94, 0x5c, 0xbd, 0x96, 0xcb, 0x5c, 0x5a, 0x58,
+	0x6c, 0x9e, 0x9a, 0x88, 0xd5, 0x95, 0x4c, 0x56, 0x85, 0xac, 0xc6, 0x8d, 0xa3, 0x31, 0x9c, 0x6b,
+	0x3d, 0x97, 0x32, 0xe7, 0x18, 0x5b, 0x6b, 0x52, 0xef, 0xc7, 0x9a, 0x15, 0x58, 0x69, 0x52, 0x94,
+	0x0e, 0x30, 0x6c, 0xe0, 0xf1, 0x84, 0x54, 0x18, 0x1f, 0x6d, 0x4c, 0x50, 0x93, 0x8d, 0x38, 0x93,
+	0x4c, 0x38, 0xff, 0x5b, 0xe7, 0xd0, 0x66, 0x6a, 0xc6, 0xe0, 0x3c, 0x71, 0x25, 0x51, 0xa4, 0x70,
+	0x7c, 0xc2, 0x1f, 0x3d, 0x78, 0xed, 0x63, 0xa3, 0xf6, 0x8b, 0x92, 0x12, 0x8d, 0x7b, 0xd6, 0xe7,
+	0xbf, 0x07, 0x03, 0x52, 0xeb, 0x03, 0xa9, 0x98, 0x9e, 0x06, 0xde, 0x75, 0xef, 0xc6, 0x60, 0x14,
+	0x3c, 0x79, 0x78, 0xf3, 0x9a, 0x93, 0xb2, 0x4d, 0xa9, 0xc2, 0xaa, 0xba, 0xa7, 0x15, 0x13, 0x79,
+	0xfa, 0x0c, 0xea, 0xdf, 0x05, 0x10, 0x78, 0x3c, 0x6e, 0x2a, 0x04, 0x8b, 0xd7, 0xbd, 0x1b, 0x57,
+	0x6e, 0x87, 0xd1, 0xf3, 0xfb, 0x17, 0x35, 0xf5, 0x46, 0x9d, 0x47, 0x4f, 0xd7, 0x17, 0xd2, 0x81,
+	0xc0, 0x63, 0x47, 0xe0, 0x2e, 0x80, 0xe4, 0x74, 0x96, 0x68, 0xe9, 0x9f, 0x26, 0x92, 0x9c, 0x36,
+	0x2f, 0xc2, 0xaf, 0xe1, 0x15, 0x2b, 0xef, 0x33, 0x3c, 0x4e, 0xd2, 0xcf, 0xf7, 0x38, 0x11, 0xfe,
+	0x6d, 0xe8, 0x65, 0x0a, 0x89, 0x96, 0xea, 0x42, 0x69, 0x33, 0xa0, 0xff, 0x06, 0xf4, 0x4a, 0x4e,
+	0xc4, 0x98, 0x51, 0xab, 0x6a, 0x90, 0x76, 0x8d, 0x99, 0x50, 0xff, 0x4d, 0x00, 0x25, 0x39, 0x27,
+	0x65, 0x69, 0x7c, 0x4b, 0xd6, 0x37, 0x70, 0x6f, 0x12, 0x1a, 0xfe, 0xbe, 0x08, 0x7d, 0x5b, 0x7f,
+	0x54, 0x4f, 0xfd, 0x08, 0x96, 0x27, 0xf5, 0x14, 0x2f, 0x2e, 0xdb, 0xc0, 0x2e, 0x5b, 0xd4, 0xdf,
+	0x82, 0x2e, 0x29, 0x64, 0x2d, 0x74, 0xd0, 0xb1, 0x8d, 0x5b, 0x89, 0x5c, 0x15, 0xb3, 0x53, 0x91,
+	0xdb, 0xa9, 0x68, 0x47, 0x32, 0xe1, 0xfa, 0xe5, 0xe0, 0xfe, 0x26, 0x74, 0x32, 0x59, 0xe9, 0x60,
+	0xb9, 0x5d, 0x98, 0x05, 0xfb, 0x1f, 0xc2, 0x40, 0x93, 0x43, 0x54, 0xe3, 0x7d, 0xc4, 0xa0, 0xdb,
+	0x2e, 0xb2, 0x6f, 0x23, 0xee, 0x20, 0xfa, 0xf7, 0xe1, 0x6a, 0xc6, 0x65, 0xc5, 0x44, 0x3e, 0x2e,
+	0x15, 0xcb, 0x30, 0xe8, 0xd9, 0xde, 0x6c, 0x18, 0xd8, 0x0f, 0x4f, 0xd7, 0xd7, 0x9a, 0x44, 0x15,
+	0x3d, 0x8c, 0x98, 0x8c, 0x0b, 0xa2, 0x0f, 0xa2, 0x4f, 0x31, 0x27, 0xd9, 0x74, 0x17, 0xb3, 0x27,
+	0x0f, 0x6f, 0x82, 0xab, 0xb3, 0x8b, 0x59, 0xfa, 0x92, 0xcb, 0xb3, 0x67, 0xd2, 0x84, 0x7f, 0x2c,
+	0xc2, 0xc0, 0x36, 0xfe, 0x1e, 0x72, 0xee, 0xdf, 0x82, 0x6e, 0x85, 0x9c, 0xb7, 0x68, 0xbd, 0xc3,
+	0xbd, 0xf8, 0xde, 0xbf, 0x0f, 0x3d, 0x65, 0x3e, 0x3b, 0x35, 0xb6, 0x6d, 0xff, 0x0c, 0xff, 0x1f,
+	0x9d, 0xc0, 0xcf, 0x1e, 0x80, 0x9d, 0xc0, 0x0e, 0x27, 0xac, 0xb0, 0xa7, 0xce, 0x3c, 0x60, 0x9b,
+	0x53, 0xd7, 0x00, 0x2f, 0x3d, 0x84, 0x77, 0x61, 0xd9, 0xa6, 0x68, 0x3b, 0x83, 0x06, 0x6d, 0x66,
+	0xc7, 0x65, 0x76, 0x88, 0xb4, 0xed, 0x04, 0x1c, 0x3c, 0xfc, 0xcd, 0x83, 0x57, 0x9f, 0x49, 0xbd,
+	0x8f, 0x95, 0x46, 0xfa, 0x7f, 0x10, 0xfc, 0x01, 0xf4, 0x6b, 0x71, 0x64, 0xe9, 0xb6, 0x95, 0x3c,
+	0x0f, 0x08, 0x7f, 0xf1, 0xe0, 0x8a, 0x3b, 0x61, 0x5a, 0x73, 0x3c, 0xcb, 0xdd, 0x3b, 0x87, 0xfb,
+	0xe2, 0x5f, 0xb9, 0xaf, 0xc1, 0x20, 0x19, 0xed, 0x8c, 0x29, 0x0a, 0x59, 0x38, 0x65, 0xfd, 0x64,
+	0xb4, 0xb3, 0x6b, 0x6c, 0x9b, 0x54, 0x4a, 0x6e, 0x02, 0x8d, 0xb4, 0x4e, 0xda, 0x35, 0x66, 0x42,
+	0xfd, 0x15, 0xe8, 0xe7, 0xa4, 0xce, 0x71, 0xcc, 0x1a, 0xea, 0x9d, 0xb4, 0x67, 0xed, 0x84, 0xfa,
+	0x29, 0xbc, 0x6c, 0x28, 0x9a, 0x85, 0x76, 0x47, 0xb1, 0x6b, 0xfb, 0xff, 0x8e, 0xdb, 0xe8, 0xd7,
+	0xff, 0xbe, 0xd1, 0x89, 0xd0, 0x67, 0x76, 0x39, 0x11, 0x3a, 0xbd, 0xea, 0x52, 0x6c, 0xdb, 0x0c,
+	0xe1, 0x37, 0x9e, 0xfb, 0x8f, 0x98, 0x3f, 0xc8, 0x1d, 0xc2, 0x38, 0xd2, 0x4b, 0x0b, 0xde, 0x82,
+	0xae, 0x22, 0xac, 0x42, 0x1a, 0x2c, 0xb5, 0xeb, 0xb9, 0x83, 0x87, 0xdf, 0x79, 0xb0, 0x36, 0x27,
+	0xb1, 0xcd, 0xb9, 0xcc, 0x88, 0x66, 0x52, 0xa4, 0xc8, 0x91, 0x54, 0xff, 0x82, 0x50, 0x04, 0xcb,
+	0xf2, 0x58, 0xa0, 0x0a, 0x96, 0x2e, 0xd8, 0xd3, 0x06, 0xe6, 0x7f, 0x04, 0x40, 0xe6, 0xd5, 0xdb,
+	0xae, 0xdc, 0x99, 0x90, 0xf0, 0xd7, 0xd9, 0xea, 0xa4, 0xb8, 0x5f, 0x8b, 0x17, 0x7b, 0x54, 0xb6,
+	0xa0, 0x3b, 0xa9, 0x95, 0x40, 0xda, 0xfa, 0x03, 0xdd, 0xc0, 0xed, 0xd8, 0x2c, 0xdd, 0xd6, 0x5f,
+	0x87, 0x06, 0x3e, 0xfa, 0xe4, 0xd1, 0xc9, 0xd0, 0x7b, 0x7c, 0x32, 0xf4, 0x7e, 0x3a, 0x19, 0x7a,
+	0xdf, 0x9e, 0x0e, 0x17, 0x1e, 0x9f, 0x0e, 0x17, 0xbe, 0x3f, 0x1d, 0x2e, 0x7c, 0x79, 0x2b, 0x67,
+	0xfa, 0xa0, 0x9e, 0x44, 0x99, 0x2c, 0xe2, 0xe7, 0x5c, 0xd8, 0x8e, 0x36, 0xe3, 0x07, 0xf6, 0xd6,
+	0xa6, 0xa7, 0x25, 0x56, 0x93, 0xae, 0xbd, 0xb5, 0x6d, 0xfe, 0x39, 0x00, 0xb7, 0xf7, 0xb5, 0x51,
+	0xbd, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -886,6 +1113,16 @@ func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1048,76 +1285,241 @@ func (m *EventSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventPlanFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.NewParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.OldParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+
+func (m *EventPlanFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNewIROPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventPlanFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Raised.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBuy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventPlanAllocationReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPlanAllocationReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPlanAllocationReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.Cost.Size()
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Burned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.OldParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventNewIROPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBuy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Cost.Size()
 	n += 1 + l + sovEvents(uint64(l))
 	l = m.TakerFee.Size()
 	n += 1 + l + sovEvents(uint64(l))
@@ -1175,6 +1577,8 @@ func (m *EventClaim) Size() (n int) {
 	}
 	l = m.Claim.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
@@ -1232,6 +1636,73 @@ func (m *EventSettle) Size() (n int) {
 	return n
 }
 
+func (m *EventPlanFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Raised.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventPlanAllocationReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Allocation.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Burned.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1779,14 +2250,717 @@ func (m *EventBuy) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSell) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaimVested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1811,7 +2985,7 @@ func (m *EventBuy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSell) Unmarshal(dAtA []byte) error {
+func (m *EventSettle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1834,15 +3008,15 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSettle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSettle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1870,11 +3044,11 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1902,11 +3076,11 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1934,13 +3108,13 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.IBCDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1950,30 +3124,16 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
 			}
-			var msglen int
+			m.GaugeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1983,61 +3143,14 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GaugeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosingPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2065,7 +3178,7 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2090,7 +3203,7 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClaim) Unmarshal(dAtA []byte) error {
+func (m *EventPlanFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2113,45 +3226,13 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPlanFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPlanFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Claimer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
@@ -2183,7 +3264,7 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
@@ -2215,9 +3296,9 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Raised", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2244,7 +3325,7 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Raised.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2269,7 +3350,7 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
+func (m *EventPlanAllocationReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2292,15 +3373,15 @@ func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaimVested: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPlanAllocationReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPlanAllocationReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2328,11 +3409,11 @@ func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimer = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2360,11 +3441,11 @@ func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2392,44 +3473,11 @@ func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2456,7 +3504,7 @@ func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2481,7 +3529,7 @@ func (m *EventClaimVested) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSettle) Unmarshal(dAtA []byte) error {
+func (m *EventRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2504,15 +3552,15 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSettle: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSettle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2540,11 +3588,11 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2572,11 +3620,11 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2604,13 +3652,13 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCDenom = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2620,35 +3668,30 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.GaugeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GaugeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2658,23 +3701,22 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31,5 +31,44 @@ func (gs GenesisState) Validate() error {
 		ids[plan.Id] = true
 	}
 
+	allowances := make(map[string]bool)
+	for _, allowance := range gs.PresaleAllowances {
+		if err := allowance.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(PresaleAllowanceKey(allowance.PlanId, allowance.Buyer))
+		if _, found := allowances[key]; found {
+			return fmt.Errorf("duplicate presale allowance: plan ID %s, buyer %s", allowance.PlanId, allowance.Buyer)
+		}
+		allowances[key] = true
+	}
+
+	purchases := make(map[string]bool)
+	for _, purchase := range gs.AccountPurchases {
+		if err := purchase.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(AccountPurchaseKey(purchase.PlanId, purchase.Buyer))
+		if _, found := purchases[key]; found {
+			return fmt.Errorf("duplicate account purchase: plan ID %s, buyer %s", purchase.PlanId, purchase.Buyer)
+		}
+		purchases[key] = true
+	}
+
+	claims := make(map[string]bool)
+	for _, claim := range gs.BuyerVestingClaims {
+		if err := claim.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := string(BuyerVestingClaimKey(claim.PlanId, claim.Claimer))
+		if _, found := claims[key]; found {
+			return fmt.Errorf("duplicate buyer vesting claim: plan ID %s, claimer %s", claim.PlanId, claim.Claimer)
+		}
+		claims[key] = true
+	}
+
 	return gs.Params.ValidateBasic()
 }
@@ -30,6 +30,13 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// VoterInfos hold information about voters.
 	Plans []Plan `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
+	// PresaleAllowances hold the allowances of the presale buyers.
+	PresaleAllowances []PresaleAllowance `protobuf:"bytes,3,rep,name=presale_allowances,json=presaleAllowances,proto3" json:"presale_allowances"`
+	// AccountPurchases hold the amounts bought by the accounts in the plans with
+	// a max per account limit.
+	AccountPurchases []AccountPurchase `protobuf:"bytes,4,rep,name=account_purchases,json=accountPurchases,proto3" json:"account_purchases"`
+	// BuyerVestingClaims hold the vesting of the tokens claimed by the buyers.
+	BuyerVestingClaims []BuyerVestingClaim `protobuf:"bytes,5,rep,name=buyer_vesting_claims,json=buyerVestingClaims,proto3" json:"buyer_vesting_claims"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +86,27 @@ func (m *GenesisState) GetPlans() []Plan {
 	return nil
 }
 
+func (m *GenesisState) GetPresaleAllowances() []PresaleAllowance {
+	if m != nil {
+		return m.PresaleAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountPurchases() []AccountPurchase {
+	if m != nil {
+		return m.AccountPurchases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBuyerVestingClaims() []BuyerVestingClaim {
+	if m != nil {
+		return m.BuyerVestingClaims
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.iro.GenesisState")
 }
@@ -88,23 +116,30 @@ func init() {
 }
 
 var fileDescriptor_7c6c6e7791476d37 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x33, 0x8b, 0xf2, 0xf5,
-	0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x90,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x99, 0x45, 0xf9, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x65,
-	0xfa, 0x20, 0x16, 0x44, 0x87, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02,
-	0xc2, 0x81, 0x4a, 0xa9, 0xe3, 0xb1, 0xb6, 0x20, 0xb1, 0x28, 0x31, 0x17, 0xa6, 0x50, 0x05, 0x8f,
-	0xc2, 0xcc, 0x22, 0xa8, 0x4d, 0x4a, 0x7d, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xd7, 0x06, 0x97, 0x24,
-	0x96, 0xa4, 0x0a, 0x39, 0x70, 0xb1, 0x41, 0x8c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52,
-	0xd2, 0xc3, 0xed, 0x7a, 0xbd, 0x00, 0xb0, 0x4a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0,
-	0xfa, 0x84, 0x6c, 0xb8, 0x58, 0x0b, 0x72, 0x12, 0xf3, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8,
-	0x8d, 0x14, 0xf0, 0x1a, 0x90, 0x93, 0x98, 0x07, 0xd5, 0x0e, 0xd1, 0xe4, 0xe4, 0x75, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0xfc, 0x56, 0x66, 0xac, 0x5f, 0x01, 0xf6, 0x60, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x8f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xad, 0x9d,
-	0xb8, 0xab, 0x01, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0xc6, 0x5b, 0xf9, 0x33, 0x14, 0x07, 0xb9, 0x30, 0x54, 0x86, 0x4a, 0x88, 0x89, 0x24, 0x6a,
+	0x6b, 0x60, 0x75, 0x10, 0x1c, 0x4c, 0x9c, 0x88, 0x26, 0x0e, 0x0e, 0x36, 0xd7, 0x7a, 0x29, 0x97,
+	0xb4, 0x77, 0xcd, 0xbd, 0x2d, 0x52, 0x3f, 0x85, 0x1f, 0xc7, 0x8f, 0xc0, 0xc8, 0xe8, 0x64, 0x0c,
+	0x7c, 0x11, 0xc3, 0xdd, 0x41, 0x94, 0x84, 0x6e, 0x7d, 0xfb, 0x3e, 0xbf, 0xe7, 0x77, 0xc3, 0x6b,
+	0xf5, 0x5e, 0x8b, 0x84, 0x30, 0xa0, 0x9c, 0xcd, 0x8a, 0x77, 0x6f, 0x3b, 0x78, 0x54, 0x70, 0x2f,
+	0x22, 0x8c, 0x00, 0x05, 0x37, 0x15, 0x3c, 0xe3, 0xa8, 0xfd, 0x37, 0xe9, 0x6e, 0x07, 0x97, 0x0a,
+	0xde, 0x6e, 0x45, 0x3c, 0xe2, 0x32, 0xe6, 0xad, 0xbf, 0x14, 0xd1, 0x3e, 0x0e, 0x39, 0x24, 0x1c,
+	0x7c, 0xb5, 0x50, 0x83, 0x5e, 0x9d, 0x95, 0x68, 0x53, 0x2c, 0x70, 0xb2, 0x09, 0x9e, 0x96, 0x04,
+	0xa9, 0xd0, 0xa6, 0xee, 0x67, 0xc5, 0x3a, 0xbc, 0x53, 0xaf, 0x7d, 0xcc, 0x70, 0x46, 0xd0, 0x8d,
+	0x55, 0x57, 0x35, 0xb6, 0xd9, 0x31, 0x7b, 0x8d, 0x7e, 0xd7, 0xdd, 0xff, 0x7a, 0x77, 0x2c, 0x93,
+	0xa3, 0xea, 0xfc, 0xfb, 0xc4, 0x78, 0xd0, 0x1c, 0xba, 0xb6, 0x6a, 0x69, 0x8c, 0x19, 0xd8, 0x07,
+	0x9d, 0x4a, 0xaf, 0xd1, 0xef, 0x94, 0x16, 0xc4, 0x98, 0x69, 0x5c, 0x41, 0x08, 0x5b, 0x28, 0x15,
+	0x04, 0x70, 0x4c, 0x7c, 0x1c, 0xc7, 0xfc, 0x0d, 0xb3, 0x90, 0x80, 0x5d, 0x91, 0x55, 0x17, 0xa5,
+	0x55, 0x8a, 0x1a, 0x6e, 0x20, 0x5d, 0xdb, 0x4c, 0x77, 0xfe, 0x03, 0x7a, 0xb1, 0x9a, 0x38, 0x0c,
+	0x79, 0xce, 0x32, 0x3f, 0xcd, 0x45, 0x38, 0xc1, 0x40, 0xc0, 0xae, 0x4a, 0xc3, 0x79, 0x99, 0x61,
+	0xa8, 0xa0, 0xb1, 0x66, 0xb4, 0xe0, 0x08, 0xff, 0xff, 0x0d, 0x88, 0x58, 0xad, 0x20, 0x2f, 0x88,
+	0xf0, 0xa7, 0x04, 0x32, 0xca, 0x22, 0x3f, 0x8c, 0x31, 0x4d, 0xc0, 0xae, 0x49, 0xc5, 0x65, 0x99,
+	0x62, 0xb4, 0xe6, 0x9e, 0x14, 0x76, 0xbb, 0xa6, 0xb4, 0x04, 0x05, 0xbb, 0x0b, 0x18, 0xdd, 0xcf,
+	0x97, 0x8e, 0xb9, 0x58, 0x3a, 0xe6, 0xcf, 0xd2, 0x31, 0x3f, 0x56, 0x8e, 0xb1, 0x58, 0x39, 0xc6,
+	0xd7, 0xca, 0x31, 0x9e, 0xaf, 0x22, 0x9a, 0x4d, 0xf2, 0xc0, 0x0d, 0x79, 0xe2, 0xed, 0xb9, 0x82,
+	0xe9, 0xc0, 0x9b, 0xc9, 0x53, 0xc8, 0x8a, 0x94, 0x40, 0x50, 0x97, 0xd7, 0x30, 0xf8, 0x1d, 0x00,
+	0x0d, 0x8e, 0xce, 0x58, 0xd5, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +162,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BuyerVestingClaims) > 0 {
+		for iNdEx := len(m.BuyerVestingClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerVestingClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AccountPurchases) > 0 {
+		for iNdEx := len(m.AccountPurchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountPurchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PresaleAllowances) > 0 {
+		for iNdEx := len(m.PresaleAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PresaleAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Plans) > 0 {
 		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +256,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PresaleAllowances) > 0 {
+		for _, e := range m.PresaleAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountPurchases) > 0 {
+		for _, e := range m.AccountPurchases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BuyerVestingClaims) > 0 {
+		for _, e := range m.BuyerVestingClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +379,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresaleAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PresaleAllowances = append(m.PresaleAllowances, PresaleAllowance{})
+			if err := m.PresaleAllowances[len(m.PresaleAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPurchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountPurchases = append(m.AccountPurchases, AccountPurchase{})
+			if err := m.AccountPurchases[len(m.AccountPurchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerVestingClaims = append(m.BuyerVestingClaims, BuyerVestingClaim{})
+			if err := m.BuyerVestingClaims[len(m.BuyerVestingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
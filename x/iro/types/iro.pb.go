@@ -31,9 +31,87 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CurveType is the family of the bonding curve.
+type CurveType int32
+
+const (
+	// price = M * x^N + C
+	CurveTypePower CurveType = 0
+	// price = M * e^(K * x) + C
+	CurveTypeExponential CurveType = 1
+	// price is interpolated linearly between the breakpoints, and is constant
+	// after the last one
+	CurveTypePiecewiseLinear CurveType = 2
+	// price = M / (1 + e^(-K * (x - X0))) + C
+	CurveTypeLogistic CurveType = 3
+)
+
+var CurveType_name = map[int32]string{
+	0: "CURVE_TYPE_POWER",
+	1: "CURVE_TYPE_EXPONENTIAL",
+	2: "CURVE_TYPE_PIECEWISE_LINEAR",
+	3: "CURVE_TYPE_LOGISTIC",
+}
+
+var CurveType_value = map[string]int32{
+	"CURVE_TYPE_POWER":            0,
+	"CURVE_TYPE_EXPONENTIAL":      1,
+	"CURVE_TYPE_PIECEWISE_LINEAR": 2,
+	"CURVE_TYPE_LOGISTIC":         3,
+}
+
+func (x CurveType) String() string {
+	return proto.EnumName(CurveType_name, int32(x))
+}
+
+func (CurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{0}
+}
+
+// Breakpoint is a point of a piecewise-linear bonding curve.
+type Breakpoint struct {
+	// The supply, in decimal representation.
+	Supply cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=supply,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"supply"`
+	// The price at the supply.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *Breakpoint) Reset()         { *m = Breakpoint{} }
+func (m *Breakpoint) String() string { return proto.CompactTextString(m) }
+func (*Breakpoint) ProtoMessage()    {}
+func (*Breakpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{0}
+}
+func (m *Breakpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Breakpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Breakpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Breakpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Breakpoint.Merge(m, src)
+}
+func (m *Breakpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Breakpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Breakpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Breakpoint proto.InternalMessageInfo
+
 // Bonding curve represents a bonding curve in the IRO module.
-// BondingCurve represents a bonding curve with parameters M, N, and C.
-// The price of the token is calculated as follows:
+// BondingCurve represents a bonding curve of the given family. By default,
+// with parameters M, N, and C, the price of the token is calculated as follows:
 // price = M * x^N + C
 type BondingCurve struct {
 	M                      cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=M,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"M"`
@@ -41,13 +119,22 @@ type BondingCurve struct {
 	C                      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=C,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"C"`
 	RollappDenomDecimals   uint64                      `protobuf:"varint,4,opt,name=rollapp_denom_decimals,json=rollappDenomDecimals,proto3" json:"rollapp_denom_decimals,omitempty"`
 	LiquidityDenomDecimals uint64                      `protobuf:"varint,5,opt,name=liquidity_denom_decimals,json=liquidityDenomDecimals,proto3" json:"liquidity_denom_decimals,omitempty"`
+	// The family of the curve. Defaults to the power curve.
+	CurveType CurveType `protobuf:"varint,6,opt,name=curve_type,json=curveType,proto3,enum=dymensionxyz.dymension.iro.CurveType" json:"curve_type,omitempty"`
+	// The growth rate of the exponential curve, or the steepness of the
+	// logistic curve.
+	K cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=K,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"K"`
+	// The supply at the midpoint of the logistic curve.
+	X0 cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=X0,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"X0"`
+	// The breakpoints of the piecewise-linear curve, sorted by supply.
+	Breakpoints []Breakpoint `protobuf:"bytes,9,rep,name=breakpoints,proto3" json:"breakpoints"`
 }
 
 func (m *BondingCurve) Reset()         { *m = BondingCurve{} }
 func (m *BondingCurve) String() string { return proto.CompactTextString(m) }
 func (*BondingCurve) ProtoMessage()    {}
 func (*BondingCurve) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{0}
+	return fileDescriptor_e7d27cc6b5064d3f, []int{1}
 }
 func (m *BondingCurve) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,6 +177,20 @@ func (m *BondingCurve) GetLiquidityDenomDecimals() uint64 {
 	return 0
 }
 
+func (m *BondingCurve) GetCurveType() CurveType {
+	if m != nil {
+		return m.CurveType
+	}
+	return CurveTypePower
+}
+
+func (m *BondingCurve) GetBreakpoints() []Breakpoint {
+	if m != nil {
+		return m.Breakpoints
+	}
+	return nil
+}
+
 // Plan represents a plan in the IRO module.
 type Plan struct {
 	// The ID of the plan.
@@ -128,13 +229,24 @@ type Plan struct {
 	IroPlanDuration time.Duration `protobuf:"bytes,16,opt,name=iro_plan_duration,json=iroPlanDuration,proto3,stdduration" json:"iro_plan_duration"`
 	// the denom used for raising liquidity
 	LiquidityDenom string `protobuf:"bytes,17,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
+	// If set, the plan failed: the rollapp did not launch before the failure
+	// deadline, or the governance failed the plan. The trading is halted, and
+	// the IRO tokens can be refunded pro-rata for the raised liquidity.
+	Failed bool `protobuf:"varint,18,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The optional allowlisted presale at the start of the plan.
+	Presale Presale `protobuf:"bytes,19,opt,name=presale,proto3" json:"presale"`
+	// The optional limits on the buys, against sniping at the start of the
+	// trading.
+	TradingLimits TradingLimits `protobuf:"bytes,20,opt,name=trading_limits,json=tradingLimits,proto3" json:"trading_limits"`
+	// The optional vesting of the tokens claimed by the buyers.
+	BuyerVesting BuyerVesting `protobuf:"bytes,21,opt,name=buyer_vesting,json=buyerVesting,proto3" json:"buyer_vesting"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{1}
+	return fileDescriptor_e7d27cc6b5064d3f, []int{2}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,27 +366,59 @@ func (m *Plan) GetLiquidityDenom() string {
 	return ""
 }
 
-type IncentivePlanParams struct {
-	// start_time_after_settlement is the time after IRO settlement when the
-	// distribution of the remaining tokens as incentives will start
-	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
-	// num_epochs_paid_over is the number of total epochs distribution will be
-	// completed over
-	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+func (m *Plan) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
 }
 
-func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
-func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
-func (*IncentivePlanParams) ProtoMessage()    {}
-func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{2}
+func (m *Plan) GetPresale() Presale {
+	if m != nil {
+		return m.Presale
+	}
+	return Presale{}
 }
-func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+
+func (m *Plan) GetTradingLimits() TradingLimits {
+	if m != nil {
+		return m.TradingLimits
+	}
+	return TradingLimits{}
+}
+
+func (m *Plan) GetBuyerVesting() BuyerVesting {
+	if m != nil {
+		return m.BuyerVesting
+	}
+	return BuyerVesting{}
+}
+
+// BuyerVesting is the vesting of the tokens claimed by the buyers after the
+// settlement: nothing is vested before the cliff, then the tokens vest
+// linearly from the settlement to the end of the duration.
+type BuyerVesting struct {
+	// The duration after the settlement before which nothing is vested.
+	Cliff time.Duration `protobuf:"bytes,1,opt,name=cliff,proto3,stdduration" json:"cliff"`
+	// The duration after the settlement over which the tokens vest. Zero
+	// disables the buyer vesting.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// The vesting start time (set on IRO settlement)
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *BuyerVesting) Reset()         { *m = BuyerVesting{} }
+func (m *BuyerVesting) String() string { return proto.CompactTextString(m) }
+func (*BuyerVesting) ProtoMessage()    {}
+func (*BuyerVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{3}
+}
+func (m *BuyerVesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuyerVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuyerVesting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -284,55 +428,62 @@ func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+func (m *BuyerVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerVesting.Merge(m, src)
 }
-func (m *IncentivePlanParams) XXX_Size() int {
+func (m *BuyerVesting) XXX_Size() int {
 	return m.Size()
 }
-func (m *IncentivePlanParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+func (m *BuyerVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerVesting.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+var xxx_messageInfo_BuyerVesting proto.InternalMessageInfo
 
-func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+func (m *BuyerVesting) GetCliff() time.Duration {
 	if m != nil {
-		return m.StartTimeAfterSettlement
+		return m.Cliff
 	}
 	return 0
 }
 
-func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+func (m *BuyerVesting) GetDuration() time.Duration {
 	if m != nil {
-		return m.NumEpochsPaidOver
+		return m.Duration
 	}
 	return 0
 }
 
-type IROVestingPlan struct {
-	Amount                   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
-	Claimed                  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
-	VestingDuration          time.Duration         `protobuf:"bytes,3,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
-	StartTimeAfterSettlement time.Duration         `protobuf:"bytes,4,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
-	// Vesting start time (set on IRO settlement)
-	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
-	// Vesting end time (set on IRO settlement)
-	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+func (m *BuyerVesting) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-func (m *IROVestingPlan) Reset()         { *m = IROVestingPlan{} }
-func (m *IROVestingPlan) String() string { return proto.CompactTextString(m) }
-func (*IROVestingPlan) ProtoMessage()    {}
-func (*IROVestingPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{3}
+// BuyerVestingClaim is the vesting of the tokens claimed by a buyer in a plan
+// with buyer vesting.
+type BuyerVestingClaim struct {
+	PlanId  string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// The amount of tokens the claimer exchanged the IRO tokens for.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// The amount of vested tokens released to the claimer so far.
+	Released cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=released,proto3,customtype=cosmossdk.io/math.Int" json:"released"`
 }
-func (m *IROVestingPlan) XXX_Unmarshal(b []byte) error {
+
+func (m *BuyerVestingClaim) Reset()         { *m = BuyerVestingClaim{} }
+func (m *BuyerVestingClaim) String() string { return proto.CompactTextString(m) }
+func (*BuyerVestingClaim) ProtoMessage()    {}
+func (*BuyerVestingClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{4}
+}
+func (m *BuyerVestingClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuyerVestingClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IROVestingPlan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuyerVestingClaim.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -342,426 +493,609 @@ func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *IROVestingPlan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IROVestingPlan.Merge(m, src)
+func (m *BuyerVestingClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerVestingClaim.Merge(m, src)
 }
-func (m *IROVestingPlan) XXX_Size() int {
+func (m *BuyerVestingClaim) XXX_Size() int {
 	return m.Size()
 }
-func (m *IROVestingPlan) XXX_DiscardUnknown() {
-	xxx_messageInfo_IROVestingPlan.DiscardUnknown(m)
+func (m *BuyerVestingClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerVestingClaim.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IROVestingPlan proto.InternalMessageInfo
+var xxx_messageInfo_BuyerVestingClaim proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
-	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
-	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
-	proto.RegisterType((*IROVestingPlan)(nil), "dymensionxyz.dymension.iro.IROVestingPlan")
+func (m *BuyerVestingClaim) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+func (m *BuyerVestingClaim) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
 }
 
-var fileDescriptor_e7d27cc6b5064d3f = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3b, 0x6f, 0x1b, 0x47,
-	0x17, 0xd5, 0x4a, 0xb4, 0x1e, 0x23, 0x89, 0x94, 0xc6, 0xb2, 0xbf, 0xb5, 0x8c, 0x8f, 0x14, 0xe8,
-	0x00, 0x16, 0x12, 0x78, 0x37, 0xb2, 0x53, 0x04, 0x6e, 0x0c, 0x8a, 0x92, 0x01, 0x19, 0xb2, 0x25,
-	0xac, 0x8c, 0xc0, 0x48, 0xb3, 0x18, 0xee, 0x8c, 0xa9, 0x41, 0xe6, 0xb1, 0x99, 0x9d, 0x25, 0xc4,
-	0xb4, 0x69, 0x52, 0xba, 0x4c, 0x99, 0x3a, 0xb5, 0xff, 0x40, 0x3a, 0x97, 0x86, 0xab, 0x20, 0x85,
-	0x12, 0x48, 0xff, 0x20, 0x4d, 0xda, 0x60, 0x1e, 0xa4, 0x24, 0x3a, 0x96, 0x43, 0x21, 0x05, 0x01,
-	0xce, 0xbd, 0x73, 0xce, 0xdd, 0xb9, 0xf7, 0x9c, 0xd9, 0x05, 0x9f, 0xe0, 0x3e, 0x27, 0xa2, 0xa0,
-	0x52, 0x1c, 0xf5, 0xbf, 0x8b, 0x87, 0x8b, 0x98, 0x2a, 0x69, 0x7e, 0x51, 0xae, 0xa4, 0x96, 0x70,
-	0xf5, 0xfc, 0xae, 0x68, 0xb8, 0x88, 0xa8, 0x92, 0xab, 0x2b, 0x5d, 0xd9, 0x95, 0x76, 0x5b, 0x6c,
-	0xfe, 0x39, 0xc4, 0x6a, 0xa3, 0x2b, 0x65, 0x97, 0x91, 0xd8, 0xae, 0x3a, 0xe5, 0xcb, 0x58, 0x53,
-	0x4e, 0x0a, 0x8d, 0x78, 0xee, 0x37, 0xd4, 0x47, 0x37, 0xe0, 0x52, 0x21, 0x6d, 0x48, 0x7d, 0x3e,
-	0x93, 0x05, 0x97, 0x45, 0xdc, 0x41, 0x05, 0x89, 0x7b, 0x1b, 0x1d, 0xa2, 0xd1, 0x46, 0x9c, 0x49,
-	0x3a, 0xc8, 0xdf, 0x72, 0xf9, 0xd4, 0x55, 0x76, 0x0b, 0x9f, 0xba, 0x7b, 0xc9, 0x99, 0x72, 0xa4,
-	0x10, 0xf7, 0x1b, 0x9b, 0xbf, 0x4c, 0x82, 0x85, 0x4d, 0x29, 0x30, 0x15, 0xdd, 0x76, 0xa9, 0x7a,
-	0x04, 0x3e, 0x02, 0xc1, 0xd3, 0x30, 0x58, 0x0b, 0xd6, 0xe7, 0x36, 0x37, 0xde, 0x1c, 0x37, 0x26,
-	0x7e, 0x3b, 0x6e, 0xdc, 0x76, 0xd4, 0x05, 0xfe, 0x26, 0xa2, 0x32, 0xe6, 0x48, 0x1f, 0x46, 0xbb,
-	0xa4, 0x8b, 0xb2, 0xfe, 0x16, 0xc9, 0xde, 0xbd, 0xbe, 0x07, 0x7c, 0xe5, 0x2d, 0x92, 0x25, 0xc1,
-	0x53, 0x43, 0xf0, 0x2c, 0x9c, 0xbc, 0x32, 0xc1, 0x33, 0x43, 0xd0, 0x0e, 0xa7, 0xae, 0x4c, 0xd0,
-	0x86, 0x5f, 0x80, 0x9b, 0x4a, 0x32, 0x86, 0xf2, 0x3c, 0xc5, 0x44, 0x48, 0x9e, 0x62, 0x92, 0x51,
-	0x8e, 0x58, 0x11, 0x56, 0xd6, 0x82, 0xf5, 0x4a, 0xb2, 0xe2, 0xb3, 0x5b, 0x26, 0xb9, 0xe5, 0x73,
-	0xf0, 0x4b, 0x10, 0x32, 0xfa, 0x6d, 0x49, 0x31, 0xd5, 0xfd, 0x51, 0xdc, 0x35, 0x8b, 0xbb, 0x39,
-	0xcc, 0x5f, 0x40, 0x36, 0xbf, 0x9f, 0x03, 0x95, 0x7d, 0x86, 0x04, 0xac, 0x82, 0x49, 0x8a, 0x6d,
-	0xf3, 0x2a, 0xc9, 0x24, 0xc5, 0xf0, 0xff, 0x00, 0x0c, 0x1e, 0x84, 0x62, 0xd7, 0x93, 0x64, 0xce,
-	0x47, 0x76, 0x30, 0x7c, 0x0c, 0x20, 0x97, 0xb8, 0x64, 0x24, 0x45, 0x59, 0x96, 0x22, 0x8c, 0x15,
-	0x29, 0x0a, 0x7f, 0xf2, 0xf0, 0xdd, 0xeb, 0x7b, 0x2b, 0xfe, 0x58, 0x2d, 0x97, 0x39, 0xd0, 0x8a,
-	0x8a, 0x6e, 0xb2, 0xe4, 0x30, 0xad, 0x2c, 0xf3, 0x71, 0xf8, 0x04, 0x2c, 0x69, 0xa9, 0x11, 0x4b,
-	0x11, 0x63, 0x32, 0xb3, 0x0a, 0xb2, 0x27, 0x9d, 0xbf, 0x7f, 0x2b, 0xf2, 0x14, 0x46, 0x42, 0x91,
-	0x97, 0x50, 0xd4, 0x96, 0x54, 0x6c, 0x56, 0x4c, 0x6b, 0x93, 0x9a, 0x05, 0xb6, 0x86, 0x38, 0x78,
-	0x00, 0x16, 0x3b, 0x4e, 0x0e, 0x69, 0x66, 0xf4, 0x60, 0x8f, 0x3e, 0x7f, 0x7f, 0x3d, 0xfa, 0xb0,
-	0xfc, 0xa3, 0xf3, 0xfa, 0xf1, 0xbc, 0x0b, 0x9d, 0xf3, 0x9a, 0xba, 0x03, 0x16, 0x0b, 0xa2, 0x35,
-	0x23, 0xd8, 0x35, 0x36, 0x9c, 0xb6, 0xad, 0x58, 0xf0, 0x41, 0xdb, 0x4d, 0xd8, 0x06, 0xa0, 0xd0,
-	0x48, 0xe9, 0xd4, 0xd8, 0x24, 0x9c, 0xb1, 0x65, 0x57, 0x23, 0x67, 0x91, 0x68, 0x60, 0x91, 0xe8,
-	0xf9, 0xc0, 0x43, 0x9b, 0xb3, 0xa6, 0xd0, 0xab, 0xdf, 0x1b, 0x41, 0x32, 0x67, 0x71, 0x26, 0x03,
-	0x77, 0x41, 0x2d, 0x57, 0x24, 0x65, 0xa8, 0x14, 0xd9, 0xa1, 0x63, 0x9a, 0x1d, 0x83, 0x69, 0x31,
-	0x57, 0x64, 0xd7, 0x62, 0x2d, 0xdb, 0x63, 0x30, 0x5b, 0x48, 0x86, 0x53, 0xc4, 0x75, 0x38, 0x67,
-	0xc7, 0xf2, 0x99, 0x17, 0xe4, 0x8d, 0xf7, 0x05, 0xb9, 0x23, 0xf4, 0x39, 0x29, 0xee, 0x08, 0x9d,
-	0xcc, 0x18, 0x70, 0x8b, 0x6b, 0xb8, 0x0b, 0xe6, 0x33, 0x86, 0x28, 0x27, 0x8e, 0x0a, 0x8c, 0x4f,
-	0x05, 0x3c, 0xde, 0xb0, 0x51, 0x70, 0x83, 0x8a, 0x8c, 0x08, 0x4d, 0x7b, 0x24, 0xcd, 0x19, 0x12,
-	0xa9, 0x73, 0x74, 0x38, 0x6f, 0x4f, 0x1a, 0x5f, 0x36, 0xaa, 0x9d, 0x01, 0xd0, 0xe8, 0x75, 0xdf,
-	0xc2, 0xfc, 0xc4, 0xae, 0xd3, 0xf7, 0x53, 0xf0, 0x05, 0x80, 0x1c, 0x1d, 0xa5, 0x88, 0xcb, 0x52,
-	0xe8, 0x54, 0xcb, 0xb4, 0x20, 0x8c, 0x85, 0x0b, 0xe3, 0x3f, 0x7f, 0x8d, 0xa3, 0xa3, 0x96, 0x65,
-	0x79, 0x2e, 0x0f, 0x08, 0x63, 0xf0, 0x05, 0xa8, 0x9e, 0xb9, 0x2d, 0x47, 0x4a, 0x87, 0x8b, 0x57,
-	0x75, 0xfc, 0xe2, 0x90, 0x68, 0x1f, 0x29, 0x0d, 0x0f, 0xc0, 0x42, 0x8f, 0x14, 0xda, 0x28, 0xd8,
-	0x34, 0x27, 0xac, 0xda, 0xae, 0x7c, 0x7a, 0x69, 0x57, 0x92, 0xbd, 0xaf, 0x1c, 0xc4, 0x9c, 0xdd,
-	0x37, 0x64, 0xbe, 0x77, 0x16, 0x82, 0x77, 0x41, 0x4d, 0x2b, 0x64, 0x6d, 0x41, 0x04, 0xea, 0x30,
-	0x82, 0xc3, 0xda, 0x5a, 0xb0, 0x3e, 0x9b, 0x54, 0x7d, 0x78, 0xdb, 0x45, 0xe1, 0x1e, 0x58, 0xa6,
-	0x4a, 0xba, 0xb1, 0x0c, 0xae, 0xf3, 0x70, 0xc9, 0x9b, 0x71, 0x54, 0x82, 0x5b, 0x7e, 0x83, 0x53,
-	0xe0, 0x8f, 0x46, 0x81, 0x35, 0xaa, 0xa4, 0xa9, 0x38, 0x48, 0x99, 0xca, 0x23, 0xd7, 0x52, 0xb8,
-	0x6c, 0xdd, 0x53, 0xbd, 0x78, 0x1b, 0x35, 0x7f, 0x0e, 0xc0, 0xf5, 0x7f, 0x18, 0x2f, 0xec, 0x80,
-	0xdb, 0x67, 0xbe, 0x4a, 0xd1, 0x4b, 0x4d, 0x54, 0xea, 0x8c, 0xc7, 0x89, 0xd0, 0xf6, 0xb6, 0xfa,
-	0x97, 0xcf, 0x16, 0x0e, 0x7d, 0xd6, 0x32, 0x2c, 0x07, 0x43, 0x12, 0x18, 0x83, 0x15, 0x51, 0xf2,
-	0x94, 0xe4, 0x32, 0x3b, 0x2c, 0xd2, 0x1c, 0x51, 0x9c, 0xca, 0x1e, 0x51, 0xf6, 0xca, 0xab, 0x24,
-	0xcb, 0xa2, 0xe4, 0xdb, 0x36, 0xb5, 0x8f, 0x28, 0xde, 0xeb, 0x11, 0xd5, 0xfc, 0x6b, 0x0a, 0x54,
-	0x2f, 0x76, 0x1d, 0xb6, 0xc1, 0xb4, 0xd3, 0x99, 0x7f, 0xfb, 0x8c, 0xa5, 0x2f, 0x0f, 0x85, 0xdb,
-	0x60, 0xc6, 0x3b, 0xc5, 0xbf, 0x82, 0xc6, 0x33, 0xac, 0xc7, 0x42, 0x0a, 0x96, 0x06, 0x1a, 0x1a,
-	0x0e, 0x71, 0xea, 0x63, 0x8d, 0xba, 0x63, 0x4a, 0xfd, 0x79, 0xdc, 0xf8, 0x5f, 0x1f, 0x71, 0xf6,
-	0xb0, 0x39, 0x4a, 0xd0, 0x74, 0xf3, 0xf5, 0xe1, 0xe1, 0x7c, 0x3f, 0x32, 0x9e, 0xca, 0x7f, 0x31,
-	0x9e, 0x8b, 0x57, 0xeb, 0xb5, 0xab, 0x5d, 0xad, 0x8f, 0xc0, 0x2c, 0x11, 0xd8, 0x51, 0x4c, 0x8f,
-	0x41, 0x31, 0x43, 0x04, 0x36, 0xf1, 0x87, 0x95, 0x1f, 0x7e, 0x6a, 0x4c, 0x6c, 0x3e, 0x79, 0x73,
-	0x52, 0x0f, 0xde, 0x9e, 0xd4, 0x83, 0x3f, 0x4e, 0xea, 0xc1, 0xab, 0xd3, 0xfa, 0xc4, 0xdb, 0xd3,
-	0xfa, 0xc4, 0xaf, 0xa7, 0xf5, 0x89, 0xaf, 0x3f, 0xef, 0x52, 0x7d, 0x58, 0x76, 0xa2, 0x4c, 0xf2,
-	0xf8, 0x03, 0x9f, 0x2f, 0xbd, 0x07, 0xf1, 0x91, 0xfd, 0x86, 0xd1, 0xfd, 0x9c, 0x14, 0x9d, 0x69,
-	0x5b, 0xf8, 0xc1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0x30, 0x64, 0x27, 0xc2, 0x09, 0x00,
-	0x00,
+// TradingLimits limits the buys of a plan. A zero limit is disabled. The
+// owner of the rollapp is not limited.
+type TradingLimits struct {
+	// The maximum amount of tokens an account can buy in total.
+	MaxPerAccount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_per_account,json=maxPerAccount,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_account"`
+	// The maximum amount of tokens that can be bought in a block, by all the
+	// accounts.
+	MaxPerBlock cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_per_block,json=maxPerBlock,proto3,customtype=cosmossdk.io/math.Int" json:"max_per_block"`
+	// The maximum amount of tokens in a single buy when the trading starts. It
+	// relaxes linearly to the max amount to sell over the ramp duration.
+	RampInitialMaxBuy cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=ramp_initial_max_buy,json=rampInitialMaxBuy,proto3,customtype=cosmossdk.io/math.Int" json:"ramp_initial_max_buy"`
+	// The duration of the ramp, from the start time of the plan.
+	RampDuration time.Duration `protobuf:"bytes,4,opt,name=ramp_duration,json=rampDuration,proto3,stdduration" json:"ramp_duration"`
 }
 
-func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TradingLimits) Reset()         { *m = TradingLimits{} }
+func (m *TradingLimits) String() string { return proto.CompactTextString(m) }
+func (*TradingLimits) ProtoMessage()    {}
+func (*TradingLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{5}
+}
+func (m *TradingLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TradingLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingLimits.Merge(m, src)
+}
+func (m *TradingLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingLimits.DiscardUnknown(m)
 }
 
-func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LiquidityDenomDecimals != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.LiquidityDenomDecimals))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.RollappDenomDecimals != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.RollappDenomDecimals))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.C.Size()
-		i -= size
-		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+var xxx_messageInfo_TradingLimits proto.InternalMessageInfo
+
+func (m *TradingLimits) GetRampDuration() time.Duration {
+	if m != nil {
+		return m.RampDuration
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.N.Size()
-		i -= size
-		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// AccountPurchase is the amount of tokens an account bought in a plan, for
+// the max per account limit.
+type AccountPurchase struct {
+	PlanId string                `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Buyer  string                `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *AccountPurchase) Reset()         { *m = AccountPurchase{} }
+func (m *AccountPurchase) String() string { return proto.CompactTextString(m) }
+func (*AccountPurchase) ProtoMessage()    {}
+func (*AccountPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{6}
+}
+func (m *AccountPurchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPurchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.M.Size()
-		i -= size
-		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+}
+func (m *AccountPurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPurchase.Merge(m, src)
+}
+func (m *AccountPurchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPurchase proto.InternalMessageInfo
+
+func (m *AccountPurchase) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Plan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountPurchase) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlockPurchase is the amount of tokens bought in a plan in a block, for the
+// max per block limit.
+type BlockPurchase struct {
+	Height int64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidityDenom) > 0 {
-		i -= len(m.LiquidityDenom)
-		copy(dAtA[i:], m.LiquidityDenom)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.LiquidityDenom)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintIro(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.TradingEnabled {
-		i--
-		if m.TradingEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	{
-		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
+func (m *BlockPurchase) Reset()         { *m = BlockPurchase{} }
+func (m *BlockPurchase) String() string { return proto.CompactTextString(m) }
+func (*BlockPurchase) ProtoMessage()    {}
+func (*BlockPurchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{7}
+}
+func (m *BlockPurchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockPurchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockPurchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.LiquidityPart.Size()
-		i -= size
-		if _, err := m.LiquidityPart.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MaxAmountToSell.Size()
-		i -= size
-		if _, err := m.MaxAmountToSell.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+}
+func (m *BlockPurchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockPurchase.Merge(m, src)
+}
+func (m *BlockPurchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockPurchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockPurchase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockPurchase proto.InternalMessageInfo
+
+func (m *BlockPurchase) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// Presale is an allowlisted phase before the public trading of the plan.
+// During the presale, only the allowlisted buyers can buy, each up to an
+// individual cap. The allowlist is committed as a merkle root, the buyers
+// present the proofs of their leaves.
+type Presale struct {
+	// The merkle root of the allowlist. Each leaf commits to the buyer address
+	// and its cap. Empty if the plan has no presale.
+	MerkleRoot []byte `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// The duration of the presale, from the start time of the plan.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *Presale) Reset()         { *m = Presale{} }
+func (m *Presale) String() string { return proto.CompactTextString(m) }
+func (*Presale) ProtoMessage()    {}
+func (*Presale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{8}
+}
+func (m *Presale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Presale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Presale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.ClaimedAmt.Size()
-		i -= size
-		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.SoldAmt.Size()
-		i -= size
-		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintIro(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err5 != nil {
-		return 0, err5
+		return b[:n], nil
 	}
-	i -= n5
-	i = encodeVarintIro(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.SettledDenom) > 0 {
-		i -= len(m.SettledDenom)
-		copy(dAtA[i:], m.SettledDenom)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *Presale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Presale.Merge(m, src)
+}
+func (m *Presale) XXX_Size() int {
+	return m.Size()
+}
+func (m *Presale) XXX_DiscardUnknown() {
+	xxx_messageInfo_Presale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Presale proto.InternalMessageInfo
+
+func (m *Presale) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	{
-		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Presale) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// PresaleProof proves the buyer is in the presale allowlist of a plan.
+type PresaleProof struct {
+	// The maximum amount of tokens the buyer can buy in the presale.
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+	// The sibling hashes from the leaf of the buyer to the merkle root.
+	Proof [][]byte `protobuf:"bytes,2,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *PresaleProof) Reset()         { *m = PresaleProof{} }
+func (m *PresaleProof) String() string { return proto.CompactTextString(m) }
+func (*PresaleProof) ProtoMessage()    {}
+func (*PresaleProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{9}
+}
+func (m *PresaleProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PresaleProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PresaleProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ModuleAccAddress) > 0 {
-		i -= len(m.ModuleAccAddress)
-		copy(dAtA[i:], m.ModuleAccAddress)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PresaleProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresaleProof.Merge(m, src)
+}
+func (m *PresaleProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *PresaleProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresaleProof.DiscardUnknown(m)
 }
 
-func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PresaleProof proto.InternalMessageInfo
+
+func (m *PresaleProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PresaleAllowance is the presale allowance of a buyer that presented a valid
+// proof.
+type PresaleAllowance struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Buyer  string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// The maximum amount of tokens the buyer can buy in the presale.
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+	// The amount of tokens the buyer bought in the presale so far.
+	Purchased cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=purchased,proto3,customtype=cosmossdk.io/math.Int" json:"purchased"`
 }
 
-func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumEpochsPaidOver != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
-		i--
-		dAtA[i] = 0x10
+func (m *PresaleAllowance) Reset()         { *m = PresaleAllowance{} }
+func (m *PresaleAllowance) String() string { return proto.CompactTextString(m) }
+func (*PresaleAllowance) ProtoMessage()    {}
+func (*PresaleAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{10}
+}
+func (m *PresaleAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PresaleAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PresaleAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
-	if err8 != nil {
-		return 0, err8
+}
+func (m *PresaleAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PresaleAllowance.Merge(m, src)
+}
+func (m *PresaleAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PresaleAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PresaleAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PresaleAllowance proto.InternalMessageInfo
+
+func (m *PresaleAllowance) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	i -= n8
-	i = encodeVarintIro(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *IROVestingPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PresaleAllowance) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IROVestingPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IncentivePlanParams struct {
+	// start_time_after_settlement is the time after IRO settlement when the
+	// distribution of the remaining tokens as incentives will start
+	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
+	// num_epochs_paid_over is the number of total epochs distribution will be
+	// completed over
+	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
 }
 
-func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintIro(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintIro(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x2a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
-	if err11 != nil {
-		return 0, err11
+func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
+func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
+func (*IncentivePlanParams) ProtoMessage()    {}
+func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{11}
+}
+func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n11
-	i = encodeVarintIro(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x22
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
-	if err12 != nil {
-		return 0, err12
+}
+func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+}
+func (m *IncentivePlanParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivePlanParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+
+func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+	if m != nil {
+		return m.StartTimeAfterSettlement
 	}
-	i -= n12
-	i = encodeVarintIro(dAtA, i, uint64(n12))
-	i--
-	dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+	if m != nil {
+		return m.NumEpochsPaidOver
+	}
+	return 0
+}
+
+type IROVestingPlan struct {
+	Amount                   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Claimed                  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	VestingDuration          time.Duration         `protobuf:"bytes,3,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	StartTimeAfterSettlement time.Duration         `protobuf:"bytes,4,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
+	// Vesting start time (set on IRO settlement)
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Vesting end time (set on IRO settlement)
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *IROVestingPlan) Reset()         { *m = IROVestingPlan{} }
+func (m *IROVestingPlan) String() string { return proto.CompactTextString(m) }
+func (*IROVestingPlan) ProtoMessage()    {}
+func (*IROVestingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{12}
+}
+func (m *IROVestingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IROVestingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IROVestingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IROVestingPlan.Merge(m, src)
+}
+func (m *IROVestingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *IROVestingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_IROVestingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IROVestingPlan proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.iro.CurveType", CurveType_name, CurveType_value)
+	proto.RegisterType((*Breakpoint)(nil), "dymensionxyz.dymension.iro.Breakpoint")
+	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
+	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
+	proto.RegisterType((*BuyerVesting)(nil), "dymensionxyz.dymension.iro.BuyerVesting")
+	proto.RegisterType((*BuyerVestingClaim)(nil), "dymensionxyz.dymension.iro.BuyerVestingClaim")
+	proto.RegisterType((*TradingLimits)(nil), "dymensionxyz.dymension.iro.TradingLimits")
+	proto.RegisterType((*AccountPurchase)(nil), "dymensionxyz.dymension.iro.AccountPurchase")
+	proto.RegisterType((*BlockPurchase)(nil), "dymensionxyz.dymension.iro.BlockPurchase")
+	proto.RegisterType((*Presale)(nil), "dymensionxyz.dymension.iro.Presale")
+	proto.RegisterType((*PresaleProof)(nil), "dymensionxyz.dymension.iro.PresaleProof")
+	proto.RegisterType((*PresaleAllowance)(nil), "dymensionxyz.dymension.iro.PresaleAllowance")
+	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
+	proto.RegisterType((*IROVestingPlan)(nil), "dymensionxyz.dymension.iro.IROVestingPlan")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+}
+
+var fileDescriptor_e7d27cc6b5064d3f = []byte{
+	// 1682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0xd4, 0x0f, 0x3e, 0x92, 0x12, 0x35, 0x96, 0x9d, 0x0d, 0xfd, 0xfd, 0x4a, 0x04,
+	0xdd, 0x36, 0x6a, 0x8a, 0x90, 0xb6, 0xd2, 0x43, 0x1b, 0x20, 0x30, 0x28, 0x8a, 0x71, 0x99, 0xd0,
+	0x12, 0xb1, 0x52, 0x1d, 0xb5, 0x28, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x81, 0x76, 0x77, 0xb6, 0xb3,
+	0x43, 0x59, 0xec, 0x5f, 0x10, 0x18, 0x28, 0x90, 0x63, 0x2f, 0x46, 0x03, 0xf4, 0xd6, 0x73, 0xfe,
+	0x88, 0xdc, 0x1a, 0xe4, 0x54, 0xb4, 0xa8, 0x1b, 0xd8, 0xe8, 0xb5, 0x87, 0x5e, 0x7a, 0x2d, 0xe6,
+	0xc7, 0xae, 0x68, 0xa5, 0x96, 0x25, 0xd6, 0x07, 0x01, 0x9a, 0x79, 0xef, 0xf3, 0x79, 0x33, 0xef,
+	0x7d, 0xde, 0xdb, 0x5d, 0xc2, 0xf7, 0xfc, 0x49, 0x48, 0xa2, 0x84, 0xb2, 0xe8, 0x6c, 0xf2, 0x9b,
+	0x56, 0xb6, 0x68, 0x51, 0xce, 0xe4, 0x5f, 0x33, 0xe6, 0x4c, 0x30, 0x54, 0x9b, 0xf6, 0x6a, 0x66,
+	0x8b, 0x26, 0xe5, 0xac, 0xb6, 0x36, 0x62, 0x23, 0xa6, 0xdc, 0x5a, 0xf2, 0x3f, 0x8d, 0xa8, 0x6d,
+	0x8c, 0x18, 0x1b, 0x05, 0xa4, 0xa5, 0x56, 0xc3, 0xf1, 0x51, 0x4b, 0xd0, 0x90, 0x24, 0x02, 0x87,
+	0xb1, 0x71, 0x58, 0xbf, 0xe8, 0xe0, 0x8f, 0x39, 0x16, 0x92, 0xd4, 0xd8, 0x3d, 0x96, 0x84, 0x2c,
+	0x69, 0x0d, 0x71, 0x42, 0x5a, 0xa7, 0xf7, 0x86, 0x44, 0xe0, 0x7b, 0x2d, 0x8f, 0xd1, 0xd4, 0xfe,
+	0xb6, 0xb6, 0xbb, 0x3a, 0xb2, 0x5e, 0x18, 0xd3, 0x3b, 0x97, 0xdc, 0x29, 0xc6, 0x1c, 0x87, 0xc6,
+	0xb1, 0xf1, 0x85, 0x05, 0xb0, 0xcd, 0x09, 0x3e, 0x89, 0x19, 0x8d, 0x04, 0xea, 0xc1, 0x42, 0x32,
+	0x8e, 0xe3, 0x60, 0x62, 0x5b, 0x75, 0x6b, 0xb3, 0xb8, 0x7d, 0xef, 0xab, 0x67, 0x1b, 0x73, 0x7f,
+	0x79, 0xb6, 0x71, 0x5b, 0xb3, 0x27, 0xfe, 0x49, 0x93, 0xb2, 0x56, 0x88, 0xc5, 0x71, 0xb3, 0x4f,
+	0x46, 0xd8, 0x9b, 0xec, 0x10, 0xef, 0x9b, 0x2f, 0xdf, 0x03, 0x13, 0x7c, 0x87, 0x78, 0x8e, 0x21,
+	0x40, 0x0f, 0x60, 0x3e, 0xe6, 0xd4, 0x23, 0x76, 0x6e, 0x56, 0x26, 0x8d, 0x6f, 0xfc, 0xb5, 0x00,
+	0xe5, 0x6d, 0x16, 0xf9, 0x34, 0x1a, 0x75, 0xc6, 0xfc, 0x94, 0xa0, 0xfb, 0x60, 0x3d, 0x9c, 0xfd,
+	0x7c, 0xd6, 0x43, 0x49, 0xb0, 0x3b, 0xfb, 0xb1, 0xac, 0x5d, 0x49, 0xd0, 0xb1, 0xf3, 0x33, 0x13,
+	0x74, 0xd0, 0x8f, 0xe1, 0x16, 0x67, 0x41, 0x80, 0xe3, 0xd8, 0xf5, 0x49, 0xc4, 0x42, 0xd7, 0x27,
+	0x1e, 0x0d, 0x71, 0x90, 0xd8, 0x85, 0xba, 0xb5, 0x59, 0x70, 0xd6, 0x8c, 0x75, 0x47, 0x1a, 0x77,
+	0x8c, 0x0d, 0xfd, 0x04, 0xec, 0x80, 0xfe, 0x7a, 0x4c, 0x7d, 0x2a, 0x26, 0x17, 0x71, 0xf3, 0x0a,
+	0x77, 0x2b, 0xb3, 0xbf, 0x8c, 0xdc, 0x01, 0xf0, 0x64, 0xee, 0x5c, 0x31, 0x89, 0x89, 0xbd, 0x50,
+	0xb7, 0x36, 0x97, 0xb7, 0xbe, 0xdf, 0x7c, 0xb5, 0xa4, 0x9b, 0x2a, 0xd3, 0x07, 0x93, 0x98, 0x38,
+	0x45, 0x2f, 0xfd, 0x57, 0x5e, 0xfb, 0x13, 0x7b, 0x71, 0xe6, 0x6b, 0x7f, 0x82, 0xda, 0x90, 0x3b,
+	0xbc, 0x6b, 0x2f, 0xcd, 0xca, 0x90, 0x3b, 0xbc, 0x8b, 0x76, 0xa1, 0x34, 0xcc, 0xf4, 0x9a, 0xd8,
+	0xc5, 0x7a, 0x7e, 0xb3, 0xb4, 0xf5, 0x83, 0xcb, 0xae, 0x72, 0x2e, 0xef, 0xed, 0x82, 0x8c, 0xe9,
+	0x4c, 0x13, 0x34, 0x7e, 0x5b, 0x82, 0xc2, 0x20, 0xc0, 0x11, 0x5a, 0x86, 0x1c, 0xf5, 0x95, 0xac,
+	0x0a, 0x4e, 0x8e, 0xfa, 0xe8, 0xff, 0x01, 0xd2, 0x12, 0x51, 0x5f, 0xab, 0xc5, 0x29, 0x9a, 0x9d,
+	0x9e, 0x8f, 0x3e, 0x02, 0x14, 0x32, 0x7f, 0x1c, 0x10, 0x17, 0x7b, 0x9e, 0x8b, 0x7d, 0x9f, 0x93,
+	0x24, 0x31, 0x9a, 0xb0, 0xbf, 0xf9, 0xf2, 0xbd, 0x35, 0x73, 0xee, 0xb6, 0xb6, 0xec, 0x0b, 0x4e,
+	0xa3, 0x91, 0x53, 0xd5, 0x98, 0xb6, 0xe7, 0x99, 0x7d, 0xf4, 0x31, 0x54, 0x05, 0x13, 0x38, 0x70,
+	0x71, 0x10, 0x30, 0x4f, 0xb5, 0xbf, 0xd2, 0x40, 0x69, 0xeb, 0xed, 0xa6, 0xa1, 0x90, 0xfd, 0xdf,
+	0x34, 0xfd, 0xdf, 0xec, 0x30, 0x1a, 0x99, 0x7b, 0xac, 0x28, 0x60, 0x3b, 0xc3, 0xa1, 0x7d, 0xa8,
+	0x0c, 0x75, 0xa3, 0xb8, 0xaa, 0x68, 0x4a, 0x14, 0xa5, 0xad, 0xcd, 0x4b, 0xb3, 0x33, 0xd5, 0x59,
+	0x86, 0xb7, 0x3c, 0x9c, 0xee, 0xb6, 0x3b, 0x50, 0x49, 0x88, 0x10, 0x01, 0xf1, 0xb5, 0xe4, 0x94,
+	0x7a, 0x8a, 0x4e, 0xd9, 0x6c, 0x2a, 0x9d, 0xa1, 0x0e, 0x40, 0x22, 0x30, 0x17, 0xae, 0x9c, 0x71,
+	0x4a, 0x22, 0xa5, 0xad, 0x5a, 0x53, 0xcf, 0xb7, 0x66, 0x3a, 0xdf, 0x9a, 0x07, 0xe9, 0x00, 0xdc,
+	0x5e, 0x92, 0x81, 0x3e, 0xff, 0xfb, 0x86, 0xe5, 0x14, 0x15, 0x4e, 0x5a, 0x50, 0x1f, 0x56, 0x62,
+	0x4e, 0xdc, 0x00, 0x8f, 0x23, 0xef, 0x58, 0x33, 0x2d, 0x5d, 0x83, 0xa9, 0x12, 0x73, 0xd2, 0x57,
+	0x58, 0xc5, 0xf6, 0x11, 0x2c, 0x25, 0x2c, 0xf0, 0x5d, 0x1c, 0x0a, 0xbb, 0xa8, 0xca, 0xf2, 0x23,
+	0xa3, 0xb8, 0x9b, 0xdf, 0x55, 0x5c, 0x2f, 0x12, 0x53, 0x5a, 0xeb, 0x45, 0xc2, 0x59, 0x94, 0xe0,
+	0x76, 0x28, 0x50, 0x1f, 0x4a, 0x5e, 0x80, 0x69, 0x48, 0x34, 0x15, 0x5c, 0x9f, 0x0a, 0x0c, 0x5e,
+	0xb2, 0x51, 0xb8, 0x49, 0x23, 0x8f, 0x44, 0x82, 0x9e, 0x12, 0x37, 0x0e, 0x70, 0xe4, 0xea, 0x71,
+	0x6c, 0x97, 0xd4, 0x4d, 0x5b, 0x97, 0x95, 0xaa, 0x97, 0x02, 0xa5, 0x5e, 0x07, 0x0a, 0x66, 0x2a,
+	0x76, 0x83, 0x7e, 0xd7, 0x84, 0x0e, 0x01, 0x85, 0xf8, 0xcc, 0xc5, 0x21, 0x1b, 0x47, 0xc2, 0x15,
+	0xcc, 0x4d, 0x48, 0x10, 0xd8, 0xe5, 0xeb, 0x9f, 0x7f, 0x25, 0xc4, 0x67, 0x6d, 0xc5, 0x72, 0xc0,
+	0xf6, 0x49, 0x10, 0xa0, 0x43, 0x58, 0x3e, 0x9f, 0x43, 0x31, 0xe6, 0xc2, 0xae, 0xcc, 0xda, 0xd2,
+	0x95, 0x8c, 0x68, 0x80, 0xb9, 0x40, 0xfb, 0x50, 0x3e, 0x25, 0x89, 0x90, 0x0a, 0x96, 0xc9, 0xb1,
+	0x97, 0x55, 0x56, 0xde, 0xbd, 0x34, 0x2b, 0xce, 0xde, 0x23, 0x0d, 0x91, 0x77, 0x4f, 0x5b, 0xfc,
+	0xf4, 0x7c, 0x0b, 0xbd, 0x03, 0x2b, 0x82, 0x63, 0xd5, 0x16, 0x24, 0xc2, 0xc3, 0x80, 0xf8, 0xf6,
+	0x4a, 0xdd, 0xda, 0x5c, 0x72, 0x96, 0xcd, 0x76, 0x57, 0xef, 0xa2, 0x3d, 0x58, 0xa5, 0x9c, 0xe9,
+	0xb2, 0xa4, 0xcf, 0x62, 0xbb, 0x6a, 0x9a, 0xf1, 0xa2, 0x04, 0x77, 0x8c, 0x83, 0x56, 0xe0, 0xef,
+	0xa4, 0x02, 0x57, 0x28, 0x67, 0x32, 0x62, 0x6a, 0x92, 0x91, 0x2f, 0x0c, 0x6c, 0x7b, 0x55, 0x75,
+	0xcf, 0xf2, 0xcb, 0x73, 0x1a, 0xdd, 0x82, 0x85, 0x23, 0x4c, 0xe5, 0xc9, 0x90, 0x3a, 0x99, 0x59,
+	0xa1, 0x0e, 0x2c, 0xc6, 0x9c, 0x24, 0x38, 0x20, 0xf6, 0x0d, 0x75, 0x8e, 0x3b, 0x97, 0xa5, 0x62,
+	0xa0, 0x5d, 0x4d, 0x0e, 0x52, 0x24, 0x7a, 0x04, 0xe9, 0x45, 0xdd, 0x80, 0x86, 0x54, 0x24, 0xf6,
+	0x9a, 0xe2, 0xfa, 0xe1, 0x65, 0x5c, 0x07, 0x1a, 0xd1, 0x57, 0x00, 0xc3, 0x58, 0x11, 0xd3, 0x9b,
+	0x6a, 0xdc, 0x8c, 0x27, 0x84, 0xbb, 0x26, 0xd9, 0xf6, 0xcd, 0x2b, 0x8c, 0x1b, 0x09, 0x30, 0xf5,
+	0xca, 0xc6, 0xcd, 0xd4, 0x5e, 0xe3, 0x4f, 0x16, 0x94, 0xa7, 0x9d, 0xd0, 0x4f, 0x61, 0xde, 0x0b,
+	0xe8, 0xd1, 0x91, 0x6d, 0x5d, 0xbd, 0x10, 0x1a, 0x81, 0xee, 0xc3, 0x52, 0x56, 0xc6, 0xdc, 0xd5,
+	0xd1, 0x19, 0xe8, 0xc2, 0x58, 0xcb, 0xcf, 0x34, 0xd6, 0x1a, 0xff, 0xb4, 0x60, 0x75, 0xfa, 0x46,
+	0x1d, 0x39, 0x0d, 0xd0, 0x5b, 0xb0, 0xa8, 0x74, 0x66, 0x9e, 0x39, 0x45, 0x67, 0x41, 0x2e, 0x7b,
+	0x3e, 0xda, 0x82, 0x45, 0x3d, 0x2f, 0xb8, 0x9d, 0x7b, 0xcd, 0xd3, 0x24, 0x75, 0x44, 0x1d, 0x58,
+	0xd0, 0x6d, 0x6e, 0xe7, 0xaf, 0xdf, 0xde, 0x06, 0x8a, 0x1e, 0xc0, 0x12, 0x27, 0x01, 0xc1, 0x09,
+	0xf1, 0xed, 0xc2, 0xf5, 0x69, 0x32, 0x70, 0xe3, 0x6f, 0x39, 0xa8, 0x1c, 0x5c, 0x50, 0x8a, 0x9c,
+	0x21, 0x6e, 0x4c, 0xb8, 0x7c, 0x5a, 0xaa, 0x83, 0x5a, 0xd7, 0x8f, 0x50, 0x09, 0xf1, 0xd9, 0x80,
+	0xf0, 0xb6, 0x66, 0x40, 0x7b, 0x50, 0x49, 0x49, 0x87, 0x01, 0xf3, 0x4e, 0xec, 0xdc, 0xf5, 0x29,
+	0x4b, 0x9a, 0x72, 0x5b, 0xe2, 0xd1, 0xaf, 0x60, 0x8d, 0xe3, 0x30, 0x76, 0x69, 0x44, 0x05, 0xc5,
+	0x81, 0x2b, 0xd9, 0x87, 0xe3, 0xc9, 0x2c, 0x39, 0x5d, 0x95, 0x44, 0x3d, 0xcd, 0xf3, 0x10, 0x9f,
+	0x6d, 0x8f, 0x27, 0xe8, 0x67, 0x50, 0x51, 0xec, 0x99, 0x22, 0x0b, 0x57, 0x57, 0x64, 0x59, 0x22,
+	0xd3, 0xfd, 0xc6, 0xef, 0x2d, 0x58, 0x31, 0x49, 0x18, 0x8c, 0xb9, 0x77, 0x8c, 0x13, 0xf2, 0x6a,
+	0x39, 0x35, 0x61, 0x5e, 0xf5, 0xd7, 0x6b, 0xc5, 0xa4, 0xdd, 0xde, 0x88, 0x94, 0x1a, 0x01, 0x54,
+	0x54, 0x4a, 0xb3, 0xe3, 0xdd, 0x82, 0x85, 0x63, 0x42, 0x47, 0xc7, 0xba, 0xee, 0x79, 0xc7, 0xac,
+	0xa6, 0xa2, 0xe5, 0x66, 0x8f, 0x76, 0x02, 0x8b, 0x66, 0xf2, 0xa1, 0x0d, 0x28, 0x85, 0x84, 0x9f,
+	0x04, 0xc4, 0xe5, 0x8c, 0xe9, 0x60, 0x65, 0x07, 0xf4, 0x96, 0xc3, 0x98, 0xf8, 0x9f, 0x47, 0x42,
+	0xc3, 0x83, 0xb2, 0x09, 0x36, 0xe0, 0x8c, 0x1d, 0xa1, 0x0f, 0x21, 0xef, 0xe1, 0x78, 0x16, 0x39,
+	0x4b, 0x1c, 0x5a, 0x93, 0x5f, 0x49, 0x8c, 0x1d, 0xd9, 0xb9, 0x7a, 0x7e, 0xb3, 0xec, 0xe8, 0x45,
+	0xe3, 0x1f, 0x16, 0x54, 0x4d, 0x14, 0xf9, 0x7a, 0xf7, 0x18, 0x47, 0xde, 0x1b, 0x2c, 0xb1, 0x39,
+	0x72, 0x7e, 0xc6, 0x23, 0xf7, 0xa0, 0x18, 0x9b, 0xba, 0xce, 0x34, 0x28, 0xce, 0xd1, 0x8d, 0x3f,
+	0x5a, 0x70, 0xe3, 0xbf, 0xbc, 0xd5, 0xa0, 0x21, 0xdc, 0x3e, 0x9f, 0xbb, 0x2e, 0x3e, 0x12, 0x84,
+	0xbb, 0xfa, 0x7d, 0x33, 0x24, 0x66, 0x76, 0x5c, 0xb1, 0x70, 0x76, 0x36, 0x87, 0xdb, 0x92, 0x65,
+	0x3f, 0x23, 0x41, 0x2d, 0x58, 0x8b, 0xc6, 0xa1, 0x4b, 0x62, 0xe6, 0x1d, 0x27, 0x6e, 0x8c, 0xa9,
+	0xef, 0xb2, 0x53, 0x93, 0xc4, 0x82, 0xb3, 0x1a, 0x8d, 0xc3, 0xae, 0x32, 0x0d, 0x30, 0xf5, 0xf7,
+	0x4e, 0x09, 0x6f, 0xfc, 0x3b, 0x0f, 0xcb, 0x2f, 0xbf, 0x6c, 0x4c, 0xc9, 0xd7, 0x9a, 0x7d, 0xee,
+	0x76, 0xd3, 0x81, 0xef, 0xcf, 0xd2, 0x04, 0x29, 0x16, 0x51, 0xa8, 0xa6, 0xaf, 0x4e, 0x99, 0xc2,
+	0xf3, 0xaf, 0x4b, 0xd4, 0x1d, 0x19, 0xea, 0x5f, 0xcf, 0x36, 0xde, 0x9a, 0xe0, 0x30, 0xf8, 0xa0,
+	0x71, 0x91, 0xa0, 0xa1, 0x5f, 0x6b, 0xcc, 0x76, 0x8a, 0x7a, 0x5d, 0x79, 0x0a, 0x6f, 0xa2, 0x3c,
+	0x2f, 0x3f, 0x7a, 0xe7, 0x67, 0xfb, 0xa2, 0xb8, 0x0f, 0x4b, 0x24, 0xf2, 0x35, 0xc5, 0xc2, 0x35,
+	0x28, 0x16, 0x49, 0xe4, 0xcb, 0xfd, 0x0f, 0x0a, 0x9f, 0x7d, 0xb1, 0x31, 0xf7, 0xee, 0xb7, 0x16,
+	0x14, 0xb3, 0x0f, 0x62, 0xb4, 0x09, 0xd5, 0xce, 0xcf, 0x9d, 0x47, 0x5d, 0xf7, 0xe0, 0x17, 0x83,
+	0xae, 0x3b, 0xd8, 0xfb, 0xb4, 0xeb, 0x54, 0xe7, 0x6a, 0xe8, 0xc9, 0xd3, 0xfa, 0x72, 0xe6, 0x34,
+	0x60, 0x8f, 0x09, 0x97, 0x5f, 0xf9, 0x53, 0x9e, 0xdd, 0xc3, 0xc1, 0xde, 0x6e, 0x77, 0xf7, 0xa0,
+	0xd7, 0xee, 0x57, 0xad, 0x9a, 0xfd, 0xe4, 0x69, 0x7d, 0x2d, 0xf3, 0xef, 0x9e, 0xc5, 0x2c, 0x92,
+	0x6d, 0x80, 0x03, 0xf4, 0x21, 0xdc, 0x9e, 0xe6, 0xef, 0x75, 0x3b, 0xdd, 0x4f, 0x7b, 0xfb, 0x5d,
+	0xb7, 0xdf, 0xdb, 0xed, 0xb6, 0x9d, 0x6a, 0xae, 0xf6, 0x7f, 0x4f, 0x9e, 0xd6, 0xed, 0xf3, 0x50,
+	0x94, 0x78, 0xe4, 0x31, 0x4d, 0x48, 0x9f, 0x46, 0x04, 0x73, 0xd4, 0x84, 0x1b, 0x53, 0xf0, 0xfe,
+	0xde, 0x83, 0xde, 0xfe, 0x41, 0xaf, 0x53, 0xcd, 0xd7, 0x6e, 0x3e, 0x79, 0x5a, 0x5f, 0xcd, 0x60,
+	0x7d, 0x36, 0xa2, 0x89, 0xa0, 0x5e, 0xad, 0xf0, 0xd9, 0x1f, 0xd6, 0xe7, 0xb6, 0x3f, 0xfe, 0xea,
+	0xf9, 0xba, 0xf5, 0xf5, 0xf3, 0x75, 0xeb, 0xdb, 0xe7, 0xeb, 0xd6, 0xe7, 0x2f, 0xd6, 0xe7, 0xbe,
+	0x7e, 0xb1, 0x3e, 0xf7, 0xe7, 0x17, 0xeb, 0x73, 0xbf, 0xbc, 0x3b, 0xa2, 0xe2, 0x78, 0x3c, 0x6c,
+	0x7a, 0x2c, 0x6c, 0xbd, 0xe2, 0x57, 0xa5, 0xd3, 0xf7, 0x5b, 0x67, 0xea, 0xa7, 0x25, 0xf9, 0xeb,
+	0x42, 0x32, 0x5c, 0x50, 0xb9, 0x7d, 0xff, 0x3f, 0x03, 0x00, 0x30, 0xe6, 0xbe, 0xd0, 0x59, 0x13,
+	0x00, 0x00,
+}
+
+func (m *Breakpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Breakpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Breakpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.Claimed.Size()
+		size := m.Price.Size()
 		i -= size
-		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintIro(dAtA, i, uint64(size))
@@ -769,9 +1103,9 @@ func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.Amount.Size()
+		size := m.Supply.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintIro(dAtA, i, uint64(size))
@@ -781,133 +1115,2410 @@ func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIro(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BondingCurve) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.M.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.N.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.C.Size()
-	n += 1 + l + sovIro(uint64(l))
-	if m.RollappDenomDecimals != 0 {
-		n += 1 + sovIro(uint64(m.RollappDenomDecimals))
-	}
-	if m.LiquidityDenomDecimals != 0 {
-		n += 1 + sovIro(uint64(m.LiquidityDenomDecimals))
-	}
-	return n
+
+func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Plan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovIro(uint64(m.Id))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	if len(m.Breakpoints) > 0 {
+		for iNdEx := len(m.Breakpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIro(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	l = len(m.ModuleAccAddress)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	{
+		size := m.X0.Size()
+		i -= size
+		if _, err := m.X0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	l = m.TotalAllocation.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.BondingCurve.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = len(m.SettledDenom)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.K.Size()
+		i -= size
+		if _, err := m.K.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = m.SoldAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.ClaimedAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.IncentivePlanParams.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.MaxAmountToSell.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.LiquidityPart.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.VestingPlan.Size()
-	n += 1 + l + sovIro(uint64(l))
-	if m.TradingEnabled {
-		n += 2
+	i--
+	dAtA[i] = 0x3a
+	if m.CurveType != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.CurveType))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration)
-	n += 2 + l + sovIro(uint64(l))
-	l = len(m.LiquidityDenom)
-	if l > 0 {
-		n += 2 + l + sovIro(uint64(l))
+	if m.LiquidityDenomDecimals != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.LiquidityDenomDecimals))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *IncentivePlanParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.RollappDenomDecimals != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.RollappDenomDecimals))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.N.Size()
+		i -= size
+		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.M.Size()
+		i -= size
+		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Plan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BuyerVesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size, err := m.TradingLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size, err := m.Presale.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.Failed {
+		i--
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.LiquidityDenom) > 0 {
+		i -= len(m.LiquidityDenom)
+		copy(dAtA[i:], m.LiquidityDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.LiquidityDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintIro(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.TradingEnabled {
+		i--
+		if m.TradingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.LiquidityPart.Size()
+		i -= size
+		if _, err := m.LiquidityPart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxAmountToSell.Size()
+		i -= size
+		if _, err := m.MaxAmountToSell.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ClaimedAmt.Size()
+		i -= size
+		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SoldAmt.Size()
+		i -= size
+		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintIro(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x42
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintIro(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.SettledDenom) > 0 {
+		i -= len(m.SettledDenom)
+		copy(dAtA[i:], m.SettledDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ModuleAccAddress) > 0 {
+		i -= len(m.ModuleAccAddress)
+		copy(dAtA[i:], m.ModuleAccAddress)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyerVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyerVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyerVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintIro(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintIro(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Cliff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintIro(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyerVestingClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyerVestingClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyerVestingClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Released.Size()
+		i -= size
+		if _, err := m.Released.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RampDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RampDuration):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintIro(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RampInitialMaxBuy.Size()
+		i -= size
+		if _, err := m.RampInitialMaxBuy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxPerBlock.Size()
+		i -= size
+		if _, err := m.MaxPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxPerAccount.Size()
+		i -= size
+		if _, err := m.MaxPerAccount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountPurchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountPurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockPurchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockPurchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockPurchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Presale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Presale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Presale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintIro(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PresaleProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PresaleProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PresaleProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintIro(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PresaleAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PresaleAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PresaleAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Purchased.Size()
+		i -= size
+		if _, err := m.Purchased.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochsPaidOver != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x10
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintIro(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IROVestingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IROVestingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintIro(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x32
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintIro(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x2a
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintIro(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x22
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintIro(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIro(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Breakpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *BondingCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.M.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.N.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.RollappDenomDecimals != 0 {
+		n += 1 + sovIro(uint64(m.RollappDenomDecimals))
+	}
+	if m.LiquidityDenomDecimals != 0 {
+		n += 1 + sovIro(uint64(m.LiquidityDenomDecimals))
+	}
+	if m.CurveType != 0 {
+		n += 1 + sovIro(uint64(m.CurveType))
+	}
+	l = m.K.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.X0.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if len(m.Breakpoints) > 0 {
+		for _, e := range m.Breakpoints {
+			l = e.Size()
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Plan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIro(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.ModuleAccAddress)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.TotalAllocation.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.BondingCurve.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = len(m.SettledDenom)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.SoldAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.ClaimedAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.IncentivePlanParams.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxAmountToSell.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.LiquidityPart.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.VestingPlan.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.TradingEnabled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration)
+	n += 2 + l + sovIro(uint64(l))
+	l = len(m.LiquidityDenom)
+	if l > 0 {
+		n += 2 + l + sovIro(uint64(l))
+	}
+	if m.Failed {
+		n += 3
+	}
+	l = m.Presale.Size()
+	n += 2 + l + sovIro(uint64(l))
+	l = m.TradingLimits.Size()
+	n += 2 + l + sovIro(uint64(l))
+	l = m.BuyerVesting.Size()
+	n += 2 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *BuyerVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *BuyerVestingClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Released.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *TradingLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPerAccount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxPerBlock.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.RampInitialMaxBuy.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RampDuration)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *AccountPurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *BlockPurchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovIro(uint64(m.Height))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Presale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *PresaleProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cap.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PresaleAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Purchased.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *IncentivePlanParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
 		n += 1 + sovIro(uint64(m.NumEpochsPaidOver))
 	}
-	return n
-}
+	return n
+}
+
+func (m *IROVestingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func sovIro(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIro(x uint64) (n int) {
+	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Breakpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Breakpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Breakpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappDenomDecimals", wireType)
+			}
+			m.RollappDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollappDenomDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenomDecimals", wireType)
+			}
+			m.LiquidityDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityDenomDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurveType", wireType)
+			}
+			m.CurveType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurveType |= CurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.K.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.X0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Breakpoints = append(m.Breakpoints, Breakpoint{})
+			if err := m.Breakpoints[len(m.Breakpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountToSell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountToSell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityPart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TradingEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IroPlanDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IroPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Presale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradingLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyerVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyerVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyerVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *IROVestingPlan) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.Claimed.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovIro(uint64(l))
-	return n
-}
-
-func sovIro(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIro(x uint64) (n int) {
-	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+func (m *BuyerVestingClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,15 +3541,15 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuyerVestingClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuyerVestingClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,13 +3577,11 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1000,13 +3609,11 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1034,15 +3641,15 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappDenomDecimals", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
-			m.RollappDenomDecimals = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1052,30 +3659,26 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RollappDenomDecimals |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenomDecimals", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
 			}
-			m.LiquidityDenomDecimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LiquidityDenomDecimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.Released.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIro(dAtA[iNdEx:])
@@ -1097,7 +3700,7 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plan) Unmarshal(dAtA []byte) error {
+func (m *TradingLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,17 +3723,17 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerAccount", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1140,14 +3743,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,11 +3793,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RampInitialMaxBuy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1207,11 +3827,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RampInitialMaxBuy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RampDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1238,15 +3860,65 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RampDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountPurchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountPurchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountPurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1256,28 +3928,27 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,13 +3976,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledDenom = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1321,30 +3992,81 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockPurchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockPurchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockPurchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1354,28 +4076,14 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1403,15 +4111,65 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Presale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Presale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Presale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1421,29 +4179,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1470,13 +4228,63 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PresaleProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PresaleProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PresaleProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountToSell", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1504,15 +4312,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxAmountToSell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1522,31 +4330,79 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityPart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 14:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PresaleAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PresaleAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PresaleAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1556,30 +4412,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingEnabled", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1589,17 +4444,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TradingEnabled = bool(v != 0)
-		case 16:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IroPlanDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1609,28 +4476,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IroPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchased", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1658,7 +4526,9 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Purchased.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28,6 +28,21 @@ var (
 
 	// ParamsKey is the key to retrieve the module parameters
 	ParamsKey = []byte{0x4} // params
+
+	// PresaleAllowanceKeyPrefix is the prefix to retrieve the presale allowances by plan ID and buyer
+	PresaleAllowanceKeyPrefix = []byte{0x5} // prefix/planId/buyer
+
+	// AccountPurchaseKeyPrefix is the prefix to retrieve the amounts bought by plan ID and buyer
+	AccountPurchaseKeyPrefix = []byte{0x6} // prefix/planId/buyer
+
+	// BlockPurchaseKeyPrefix is the prefix to retrieve the amount bought in the last block by plan ID
+	BlockPurchaseKeyPrefix = []byte{0x7} // prefix/planId
+
+	// BuyerVestingClaimKeyPrefix is the prefix to retrieve the vesting claims by plan ID and claimer
+	BuyerVestingClaimKeyPrefix = []byte{0x8} // prefix/planId/claimer
+
+	// PlansByFailureDeadlineKeyPrefix is the prefix to retrieve the plans which can still fail, by their failure deadline
+	PlansByFailureDeadlineKeyPrefix = []byte{0x9} // prefix/preLaunchTime/planId
 )
 
 /* --------------------- specific plan ID keys -------------------- */
@@ -40,3 +55,19 @@ func PlansByRollappKey(rollappId string) []byte {
 	rollappIdBytes := []byte(rollappId)
 	return []byte(fmt.Sprintf("%s%s%s", PlansByRollappKeyPrefix, KeySeparator, rollappIdBytes))
 }
+
+func PresaleAllowanceKey(planId string, buyer string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", PresaleAllowanceKeyPrefix, KeySeparator, planId, KeySeparator, buyer))
+}
+
+func AccountPurchaseKey(planId string, buyer string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", AccountPurchaseKeyPrefix, KeySeparator, planId, KeySeparator, buyer))
+}
+
+func BlockPurchaseKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", BlockPurchaseKeyPrefix, KeySeparator, planId))
+}
+
+func BuyerVestingClaimKey(planId string, claimer string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", BuyerVestingClaimKeyPrefix, KeySeparator, planId, KeySeparator, claimer))
+}
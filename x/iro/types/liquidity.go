@@ -51,7 +51,13 @@ func CalcLiquidityPoolTokens(unsoldRATokens, raisedLiquidity math.Int, settledTo
 //		SpotPool(x)=(r*cx)/(totalAllocation-x)
 //		Solve SpotIRO=SpotPool [cancel c terms and rearrange linear eq]
 //	 => x=totalAllocation/(r+1) [same as above calculation but n=0]
+//
+// The other curve families have no closed form, the equation is solved by bisection.
 func FindEquilibrium(curve BondingCurve, totalAllocation math.Int, r math.LegacyDec) math.Int {
+	if curve.CurveType != CurveTypePower {
+		return findEquilibriumBisection(curve, totalAllocation, r)
+	}
+
 	n := curve.N
 
 	if curve.M.IsZero() { // c is allowed to be non-zero
@@ -64,3 +70,27 @@ func FindEquilibrium(curve BondingCurve, totalAllocation math.Int, r math.Legacy
 
 	return eq
 }
+
+// findEquilibriumBisection solves SpotIRO(x)*(totalAllocation-x) = r*RaisedLiquidity(x) on [0, totalAllocation].
+// The left side is positive at 0 and zero at totalAllocation, while the right side grows with x.
+func findEquilibriumBisection(curve BondingCurve, totalAllocation math.Int, r math.LegacyDec) math.Int {
+	t := ScaleFromBase(totalAllocation, curve.SupplyDecimals())
+	g := func(x math.LegacyDec) math.LegacyDec {
+		return curve.spotPriceInternal(x).Mul(t.Sub(x)).Sub(r.Mul(curve.integral(x)))
+	}
+
+	lo, hi := math.LegacyZeroDec(), t
+	for i := 0; i < maxIterations; i++ {
+		mid := lo.Add(hi).QuoInt64(2)
+		if mid.Equal(lo) || mid.Equal(hi) {
+			break
+		}
+		if g(mid).IsPositive() {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return ScaleToBase(lo, curve.SupplyDecimals())
+}
@@ -18,6 +18,8 @@ var (
 	_ sdk.Msg = &MsgClaimVested{}
 	_ sdk.Msg = &MsgEnableTrading{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgFailPlan{}
+	_ sdk.Msg = &MsgRefund{}
 )
 
 // ValidateBasic performs basic validation checks on the MsgCreatePlan message.
@@ -64,6 +66,18 @@ func (m *MsgCreatePlan) ValidateBasic() error {
 		return fmt.Errorf("vesting start time after settlement must be non-negative: %v", m.VestingStartTimeAfterSettlement)
 	}
 
+	if err := m.Presale.ValidateBasic(); err != nil {
+		return fmt.Errorf("presale: %w", err)
+	}
+
+	if err := m.TradingLimits.ValidateBasic(); err != nil {
+		return fmt.Errorf("trading limits: %w", err)
+	}
+
+	if err := m.BuyerVesting.ValidateBasic(); err != nil {
+		return fmt.Errorf("buyer vesting: %w", err)
+	}
+
 	if sdk.ValidateDenom(m.LiquidityDenom) != nil {
 		return fmt.Errorf("invalid liquidity denom: %s", m.LiquidityDenom)
 	}
@@ -86,6 +100,12 @@ func (m *MsgBuy) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MaxCostAmount)
 	}
 
+	if m.PresaleProof != nil {
+		if err := m.PresaleProof.ValidateBasic(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("presale proof: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -163,6 +183,12 @@ func (m *MsgBuyExactSpend) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MinOutTokensAmount)
 	}
 
+	if m.PresaleProof != nil {
+		if err := m.PresaleProof.ValidateBasic(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("presale proof: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -175,3 +201,25 @@ func (m *MsgEnableTrading) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgFailPlan) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"authority '%s' must be a valid bech32 address: %s",
+			m.Authority, err.Error(),
+		)
+	}
+
+	return nil
+}
+
+func (m *MsgRefund) ValidateBasic() error {
+	// claimer bech32
+	_, err := sdk.AccAddressFromBech32(m.Claimer)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid claimer address: %s", err)
+	}
+
+	return nil
+}
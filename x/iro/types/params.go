@@ -18,10 +18,11 @@ var (
 	DefaultMinLiquidityPart                             = "0.4"                       // default: at least 40% goes to the liquidity pool
 	DefaultMinVestingDuration                           = 7 * 24 * time.Hour          // default: min 7 days
 	DefaultMinVestingStartTimeAfterSettlement           = 0 * time.Minute             // default: no enforced minimum by default
+	DefaultPlanFailureDeadline                          = 90 * 24 * time.Hour         // default: fail 90 days after the pre-launch time
 )
 
 // NewParams creates a new Params object
-func NewParams(takerFee, liquidityPart math.LegacyDec, creationFee math.Int, minPlanDuration time.Duration, minIncentivePlanParams IncentivePlanParams, minVestingDuration, minVestingStartTimeAfterSettlement time.Duration) Params {
+func NewParams(takerFee, liquidityPart math.LegacyDec, creationFee math.Int, minPlanDuration time.Duration, minIncentivePlanParams IncentivePlanParams, minVestingDuration, minVestingStartTimeAfterSettlement, planFailureDeadline time.Duration) Params {
 	return Params{
 		TakerFee:                              takerFee,
 		CreationFee:                           creationFee,
@@ -31,6 +32,7 @@ func NewParams(takerFee, liquidityPart math.LegacyDec, creationFee math.Int, min
 		MinLiquidityPart:                      liquidityPart,
 		MinVestingDuration:                    minVestingDuration,
 		MinVestingStartTimeAfterSettlement:    minVestingStartTimeAfterSettlement,
+		PlanFailureDeadline:                   planFailureDeadline,
 	}
 }
 
@@ -45,6 +47,7 @@ func DefaultParams() Params {
 		MinLiquidityPart:                      math.LegacyMustNewDecFromStr(DefaultMinLiquidityPart),
 		MinVestingDuration:                    DefaultMinVestingDuration,
 		MinVestingStartTimeAfterSettlement:    DefaultMinVestingStartTimeAfterSettlement,
+		PlanFailureDeadline:                   DefaultPlanFailureDeadline,
 	}
 }
 
@@ -78,6 +81,10 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("minimum vesting duration must be non-negative: %v", p.MinVestingDuration)
 	}
 
+	if p.PlanFailureDeadline < 0 {
+		return fmt.Errorf("plan failure deadline must be non-negative: %v", p.PlanFailureDeadline)
+	}
+
 	return nil
 }
 
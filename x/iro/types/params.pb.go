@@ -53,6 +53,9 @@ type Params struct {
 	MinVestingDuration time.Duration               `protobuf:"bytes,7,opt,name=min_vesting_duration,json=minVestingDuration,proto3,stdduration" json:"min_vesting_duration"`
 	// Minimum start time after settlement to start vesting
 	MinVestingStartTimeAfterSettlement time.Duration `protobuf:"bytes,8,opt,name=min_vesting_start_time_after_settlement,json=minVestingStartTimeAfterSettlement,proto3,stdduration" json:"min_vesting_start_time_after_settlement"`
+	// The time after the pre-launch time after which a plan which is not
+	// settled fails. Zero disables the deadline.
+	PlanFailureDeadline time.Duration `protobuf:"bytes,9,opt,name=plan_failure_deadline,json=planFailureDeadline,proto3,stdduration" json:"plan_failure_deadline"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -123,6 +126,13 @@ func (m *Params) GetMinVestingStartTimeAfterSettlement() time.Duration {
 	return 0
 }
 
+func (m *Params) GetPlanFailureDeadline() time.Duration {
+	if m != nil {
+		return m.PlanFailureDeadline
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.iro.Params")
 }
@@ -132,41 +142,43 @@ func init() {
 }
 
 var fileDescriptor_321dd4e17bb4cbec = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc6, 0xe3, 0xff, 0xbf, 0x2d, 0xad, 0x8b, 0x04, 0x58, 0x45, 0x4a, 0x83, 0xe4, 0x44, 0x45,
-	0xa8, 0x11, 0x08, 0x2f, 0xa1, 0x4f, 0x40, 0x14, 0x90, 0x0a, 0x25, 0x8d, 0x52, 0xe0, 0xc0, 0x65,
-	0xb5, 0xb1, 0x27, 0xce, 0xaa, 0xd9, 0x5d, 0xb3, 0x3b, 0xb6, 0x12, 0x0e, 0x3c, 0x03, 0x47, 0x1e,
-	0x84, 0x87, 0xe8, 0xb1, 0xe2, 0x84, 0x38, 0x14, 0x94, 0xbc, 0x04, 0x47, 0x64, 0x3b, 0x4e, 0x42,
-	0x51, 0x51, 0xc4, 0x6d, 0x47, 0xf3, 0xcd, 0xef, 0x1b, 0x7d, 0xa3, 0xb5, 0xf7, 0x83, 0xb1, 0x00,
-	0x69, 0xb8, 0x92, 0xa3, 0xf1, 0x7b, 0x32, 0x2f, 0x08, 0xd7, 0x8a, 0x44, 0x4c, 0x33, 0x61, 0xbc,
-	0x48, 0x2b, 0x54, 0x4e, 0x65, 0x59, 0xe8, 0xcd, 0x0b, 0x8f, 0x6b, 0x55, 0xd9, 0x09, 0x55, 0xa8,
-	0x32, 0x19, 0x49, 0x5f, 0xf9, 0x44, 0xa5, 0x1a, 0x2a, 0x15, 0x0e, 0x81, 0x64, 0x55, 0x2f, 0xee,
-	0x13, 0xe4, 0x02, 0x0c, 0x32, 0x11, 0xcd, 0x04, 0xee, 0x65, 0x41, 0x10, 0x6b, 0x86, 0x29, 0x74,
-	0xd6, 0xf7, 0x95, 0x11, 0xca, 0x90, 0x1e, 0x33, 0x40, 0x92, 0x46, 0x0f, 0x90, 0x35, 0x88, 0xaf,
-	0x78, 0xd1, 0xdf, 0xcd, 0xfb, 0x34, 0x77, 0xce, 0x8b, 0xbc, 0xb5, 0xf7, 0x73, 0xdd, 0xde, 0xe8,
-	0x64, 0xeb, 0x3b, 0x6d, 0x7b, 0x0b, 0xd9, 0x29, 0x68, 0xda, 0x07, 0x28, 0x5b, 0x35, 0xab, 0xbe,
-	0xd5, 0x6c, 0x9c, 0x5d, 0x54, 0x4b, 0xdf, 0x2e, 0xaa, 0x77, 0xf2, 0x19, 0x13, 0x9c, 0x7a, 0x5c,
-	0x11, 0xc1, 0x70, 0xe0, 0x1d, 0x41, 0xc8, 0xfc, 0x71, 0x0b, 0xfc, 0x2f, 0x9f, 0x1f, 0xda, 0x33,
-	0x64, 0x0b, 0xfc, 0xee, 0x66, 0xc6, 0x78, 0x06, 0xe0, 0xb4, 0xed, 0xeb, 0xbe, 0x86, 0x6c, 0xcf,
-	0x0c, 0xf9, 0x5f, 0x86, 0x7c, 0x30, 0x43, 0xde, 0xfe, 0x13, 0x79, 0x28, 0x71, 0x09, 0x76, 0x28,
-	0xb1, 0xbb, 0x5d, 0x00, 0x52, 0xde, 0xb1, 0x7d, 0x4b, 0x70, 0x49, 0xa3, 0x21, 0x93, 0xb4, 0x08,
-	0xa0, 0xfc, 0x7f, 0xcd, 0xaa, 0x6f, 0x3f, 0xde, 0xf5, 0xf2, 0x84, 0xbc, 0x22, 0x21, 0xaf, 0x35,
-	0x13, 0x34, 0x37, 0x53, 0xbf, 0x4f, 0xdf, 0xab, 0x56, 0xf7, 0x86, 0xe0, 0xb2, 0x33, 0x64, 0xb2,
-	0x68, 0x39, 0x1f, 0xec, 0xfb, 0x5c, 0xfa, 0x20, 0x91, 0x27, 0x60, 0x68, 0xca, 0x36, 0xc8, 0x34,
-	0xd2, 0x34, 0x7e, 0xca, 0xfa, 0x08, 0x9a, 0x1a, 0x40, 0x1c, 0x82, 0x00, 0x89, 0xe5, 0xb5, 0xd5,
-	0x9d, 0xee, 0x2d, 0xb0, 0x2f, 0xb9, 0x3c, 0x49, 0xa1, 0xaf, 0xb8, 0x80, 0x27, 0x29, 0xf2, 0x64,
-	0x4e, 0x74, 0x5e, 0xd8, 0x77, 0x2f, 0xf9, 0xcb, 0x58, 0x50, 0x88, 0x94, 0x3f, 0x30, 0x34, 0x62,
-	0x3c, 0xa0, 0x2a, 0x01, 0x5d, 0x5e, 0xaf, 0x59, 0xf5, 0xb5, 0xae, 0xfb, 0x1b, 0xb3, 0x1d, 0x8b,
-	0xa7, 0x99, 0xae, 0xc3, 0x78, 0x70, 0x9c, 0x80, 0x76, 0xa8, 0xed, 0xa4, 0x84, 0x21, 0x7f, 0x17,
-	0xf3, 0x80, 0xe3, 0x98, 0x46, 0x4c, 0x63, 0x79, 0xe3, 0x5f, 0xcf, 0x78, 0x53, 0x70, 0x79, 0x54,
-	0xb0, 0x3a, 0x4c, 0xa3, 0xf3, 0xda, 0xde, 0x49, 0x0d, 0x12, 0x30, 0xc8, 0x65, 0xb8, 0xb8, 0xc0,
-	0xb5, 0xd5, 0x73, 0x49, 0x37, 0x7c, 0x93, 0xcf, 0xcf, 0x8f, 0x30, 0xb2, 0xf7, 0x97, 0xb1, 0x7f,
-	0xbb, 0xc0, 0xe6, 0xea, 0x4e, 0x7b, 0x0b, 0xa7, 0xab, 0xe2, 0x6f, 0x3e, 0x3f, 0x9b, 0xb8, 0xd6,
-	0xf9, 0xc4, 0xb5, 0x7e, 0x4c, 0x5c, 0xeb, 0xe3, 0xd4, 0x2d, 0x9d, 0x4f, 0xdd, 0xd2, 0xd7, 0xa9,
-	0x5b, 0x7a, 0xfb, 0x28, 0xe4, 0x38, 0x88, 0x7b, 0x9e, 0xaf, 0x04, 0xb9, 0xe2, 0xdb, 0x27, 0x07,
-	0x64, 0x94, 0xfd, 0x7d, 0x1c, 0x47, 0x60, 0x7a, 0x1b, 0xd9, 0x32, 0x07, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x8d, 0x37, 0x2d, 0x84, 0x26, 0x04, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdf, 0x4e, 0xd4, 0x40,
+	0x14, 0xc6, 0xb7, 0x8a, 0x2b, 0x0c, 0x26, 0x6a, 0x85, 0xa4, 0x60, 0xd2, 0x25, 0x18, 0x03, 0xd1,
+	0xd8, 0x11, 0x79, 0x02, 0xc9, 0x4a, 0x82, 0x22, 0x6c, 0x16, 0xff, 0x24, 0xde, 0x4c, 0x66, 0xdb,
+	0xb3, 0x65, 0x42, 0x67, 0xa6, 0xce, 0x4c, 0x1b, 0xd6, 0x0b, 0x9f, 0xc1, 0x4b, 0x1f, 0xc4, 0x87,
+	0xe0, 0x12, 0xbd, 0x32, 0x5e, 0xa0, 0x61, 0x5f, 0xc4, 0x4c, 0xff, 0xec, 0xae, 0x18, 0xcc, 0xc6,
+	0xbb, 0x9e, 0x9c, 0xef, 0xfc, 0xbe, 0x93, 0xef, 0x34, 0x83, 0xd6, 0xa2, 0x01, 0x07, 0xa1, 0x99,
+	0x14, 0xc7, 0x83, 0x0f, 0x78, 0x54, 0x60, 0xa6, 0x24, 0x4e, 0xa9, 0xa2, 0x5c, 0x07, 0xa9, 0x92,
+	0x46, 0xba, 0xcb, 0x93, 0xc2, 0x60, 0x54, 0x04, 0x4c, 0xc9, 0xe5, 0x85, 0x58, 0xc6, 0xb2, 0x90,
+	0x61, 0xfb, 0x55, 0x4e, 0x2c, 0xb7, 0x62, 0x29, 0xe3, 0x04, 0x70, 0x51, 0xf5, 0xb2, 0x3e, 0x36,
+	0x8c, 0x83, 0x36, 0x94, 0xa7, 0x95, 0xc0, 0xbf, 0x28, 0x88, 0x32, 0x45, 0x8d, 0x85, 0x56, 0xfd,
+	0x50, 0x6a, 0x2e, 0x35, 0xee, 0x51, 0x0d, 0x38, 0xdf, 0xe8, 0x81, 0xa1, 0x1b, 0x38, 0x94, 0xac,
+	0xee, 0x2f, 0x95, 0x7d, 0x52, 0x3a, 0x97, 0x45, 0xd9, 0x5a, 0xfd, 0xda, 0x44, 0xcd, 0x4e, 0xb1,
+	0xbe, 0xbb, 0x87, 0xe6, 0x0c, 0x3d, 0x02, 0x45, 0xfa, 0x00, 0x9e, 0xb3, 0xe2, 0xac, 0xcf, 0x6d,
+	0x6d, 0x9c, 0x9c, 0xb5, 0x1a, 0x3f, 0xce, 0x5a, 0x77, 0xcb, 0x19, 0x1d, 0x1d, 0x05, 0x4c, 0x62,
+	0x4e, 0xcd, 0x61, 0xb0, 0x0b, 0x31, 0x0d, 0x07, 0x6d, 0x08, 0xbf, 0x7d, 0x79, 0x84, 0x2a, 0x64,
+	0x1b, 0xc2, 0xee, 0x6c, 0xc1, 0xd8, 0x06, 0x70, 0xf7, 0xd0, 0x8d, 0x50, 0x41, 0xb1, 0x67, 0x81,
+	0xbc, 0x52, 0x20, 0x1f, 0x56, 0xc8, 0xc5, 0xbf, 0x91, 0x3b, 0xc2, 0x4c, 0xc0, 0x76, 0x84, 0xe9,
+	0xce, 0xd7, 0x00, 0xcb, 0xdb, 0x47, 0xb7, 0x39, 0x13, 0x24, 0x4d, 0xa8, 0x20, 0x75, 0x00, 0xde,
+	0xd5, 0x15, 0x67, 0x7d, 0xfe, 0xc9, 0x52, 0x50, 0x26, 0x14, 0xd4, 0x09, 0x05, 0xed, 0x4a, 0xb0,
+	0x35, 0x6b, 0xfd, 0x3e, 0xff, 0x6c, 0x39, 0xdd, 0x9b, 0x9c, 0x89, 0x4e, 0x42, 0x45, 0xdd, 0x72,
+	0x3f, 0xa2, 0x07, 0x4c, 0x84, 0x20, 0x0c, 0xcb, 0x41, 0x13, 0xcb, 0xd6, 0x86, 0x2a, 0x43, 0x6c,
+	0xfc, 0x84, 0xf6, 0x0d, 0x28, 0xa2, 0xc1, 0x98, 0x04, 0x38, 0x08, 0xe3, 0xcd, 0x4c, 0xef, 0x74,
+	0x7f, 0x8c, 0x7d, 0xc9, 0xc4, 0x81, 0x85, 0xbe, 0x62, 0x1c, 0x9e, 0x5a, 0xe4, 0xc1, 0x88, 0xe8,
+	0xbe, 0x40, 0xf7, 0x2e, 0xf8, 0x8b, 0x8c, 0x13, 0x48, 0x65, 0x78, 0xa8, 0x49, 0x4a, 0x59, 0x44,
+	0x64, 0x0e, 0xca, 0xbb, 0xb6, 0xe2, 0xac, 0xcf, 0x74, 0xfd, 0x3f, 0x98, 0x7b, 0x19, 0x7f, 0x56,
+	0xe8, 0x3a, 0x94, 0x45, 0xfb, 0x39, 0x28, 0x97, 0x20, 0xd7, 0x12, 0x12, 0xf6, 0x3e, 0x63, 0x11,
+	0x33, 0x03, 0x92, 0x52, 0x65, 0xbc, 0xe6, 0xff, 0x9e, 0xf1, 0x16, 0x67, 0x62, 0xb7, 0x66, 0x75,
+	0xa8, 0x32, 0xee, 0x6b, 0xb4, 0x60, 0x0d, 0x72, 0xd0, 0x86, 0x89, 0x78, 0x7c, 0x81, 0xeb, 0xd3,
+	0xe7, 0x62, 0x37, 0x7c, 0x53, 0xce, 0x8f, 0x8e, 0x70, 0x8c, 0xd6, 0x26, 0xb1, 0xff, 0xba, 0xc0,
+	0xec, 0xf4, 0x4e, 0xab, 0x63, 0xa7, 0x4b, 0xe3, 0x7f, 0x8b, 0x16, 0x8b, 0x7f, 0xa9, 0x4f, 0x59,
+	0x92, 0x29, 0x20, 0x11, 0xd0, 0x28, 0x61, 0x02, 0xbc, 0xb9, 0xe9, 0x7d, 0xee, 0x58, 0xc2, 0x76,
+	0x09, 0x68, 0x57, 0xf3, 0x5b, 0xcf, 0x4f, 0xce, 0x7d, 0xe7, 0xf4, 0xdc, 0x77, 0x7e, 0x9d, 0xfb,
+	0xce, 0xa7, 0xa1, 0xdf, 0x38, 0x1d, 0xfa, 0x8d, 0xef, 0x43, 0xbf, 0xf1, 0xee, 0x71, 0xcc, 0xcc,
+	0x61, 0xd6, 0x0b, 0x42, 0xc9, 0xf1, 0x25, 0xef, 0x49, 0xbe, 0x89, 0x8f, 0x8b, 0x47, 0xc5, 0x0c,
+	0x52, 0xd0, 0xbd, 0x66, 0xe1, 0xbe, 0xf9, 0x7b, 0x00, 0xde, 0x58, 0x4b, 0xcf, 0x7f, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -189,21 +201,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PlanFailureDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PlanFailureDeadline):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x42
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingDuration):])
+	dAtA[i] = 0x4a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x3a
 	{
 		size := m.MinLiquidityPart.Size()
@@ -220,21 +240,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintParams(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size := m.CreationFee.Size()
@@ -293,6 +313,8 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement)
 	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PlanFailureDeadline)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -584,6 +606,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanFailureDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PlanFailureDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -45,11 +45,36 @@ func NewPlan(id uint64, rollappId string, liquidityDenom string, allocation sdk.
 			VestingDuration:          vestingDuration,
 			StartTimeAfterSettlement: vestingStartTimeAfterSettlement,
 		},
+		TradingLimits: NoTradingLimits(),
 	}
 	plan.ModuleAccAddress = authtypes.NewModuleAddress(plan.ModuleAccName()).String()
 	return plan
 }
 
+// PlanOption sets an optional feature of a new plan
+type PlanOption func(*Plan)
+
+// WithPresale sets the allowlisted presale phase of the plan
+func WithPresale(presale Presale) PlanOption {
+	return func(p *Plan) {
+		p.Presale = presale
+	}
+}
+
+// WithTradingLimits sets the anti-sniping trading limits of the plan
+func WithTradingLimits(limits TradingLimits) PlanOption {
+	return func(p *Plan) {
+		p.TradingLimits = limits
+	}
+}
+
+// WithBuyerVesting sets the vesting schedule of the buyers' claims
+func WithBuyerVesting(vesting BuyerVesting) PlanOption {
+	return func(p *Plan) {
+		p.BuyerVesting = vesting
+	}
+}
+
 // ValidateBasic checks if the plan is valid
 func (p Plan) ValidateBasic() error {
 	if err := p.BondingCurve.ValidateBasic(); err != nil {
@@ -95,6 +120,25 @@ func (p Plan) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid liquidity denom")
 	}
 
+	if p.Failed && p.IsSettled() {
+		return errors.New("plan cannot be both failed and settled")
+	}
+
+	if err := p.Presale.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "presale")
+	}
+	if p.Presale.IsEnabled() && p.Presale.Duration >= p.IroPlanDuration {
+		return fmt.Errorf("presale must end before the plan: presale duration %v, plan duration %v", p.Presale.Duration, p.IroPlanDuration)
+	}
+
+	if err := p.TradingLimits.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "trading limits")
+	}
+
+	if err := p.BuyerVesting.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "buyer vesting")
+	}
+
 	return nil
 }
 
@@ -107,6 +151,18 @@ func (p Plan) IsSettled() bool {
 	return p.SettledDenom != ""
 }
 
+// IsFailed is true if the plan was failed, or it was not settled before the failure deadline.
+// A zero deadline disables the failure by deadline. The plans past the deadline are marked as failed in EndBlock.
+func (p Plan) IsFailed(now time.Time, failureDeadline time.Duration) bool {
+	if p.Failed {
+		return true
+	}
+	if p.IsSettled() || !p.TradingEnabled || failureDeadline == 0 {
+		return false
+	}
+	return !now.Before(p.PreLaunchTime.Add(failureDeadline))
+}
+
 func (p Plan) ModuleAccName() string {
 	return ModuleName + "-" + p.RollappId
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -39,3 +40,26 @@ func FuzzRollappIDFromIRODenom(f *testing.F) {
 		}
 	})
 }
+
+func TestPlanIsFailed(t *testing.T) {
+	preLaunch := time.Unix(1_700_000_000, 0).UTC()
+	deadline := 24 * time.Hour
+	plan := Plan{TradingEnabled: true, PreLaunchTime: preLaunch}
+
+	require.False(t, plan.IsFailed(preLaunch.Add(deadline-time.Second), deadline))
+	require.True(t, plan.IsFailed(preLaunch.Add(deadline), deadline))
+	// the deadline is disabled
+	require.False(t, plan.IsFailed(preLaunch.Add(deadline), 0))
+
+	settled := plan
+	settled.SettledDenom = "ibc/ABC"
+	require.False(t, settled.IsFailed(preLaunch.Add(deadline), deadline))
+
+	disabled := plan
+	disabled.TradingEnabled = false
+	require.False(t, disabled.IsFailed(preLaunch.Add(deadline), deadline))
+
+	failed := plan
+	failed.Failed = true
+	require.True(t, failed.IsFailed(preLaunch, deadline))
+}
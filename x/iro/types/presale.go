@@ -0,0 +1,163 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// Domain separation of the merkle tree hashes, so a leaf can't be presented as an inner node
+var (
+	presaleLeafPrefix = []byte{0x0}
+	presaleNodePrefix = []byte{0x1}
+)
+
+// IsEnabled is true if the plan has a presale
+func (p Presale) IsEnabled() bool {
+	return len(p.MerkleRoot) > 0
+}
+
+func (p Presale) ValidateBasic() error {
+	if !p.IsEnabled() {
+		if p.Duration != 0 {
+			return errors.New("presale duration set without a merkle root")
+		}
+		return nil
+	}
+	if len(p.MerkleRoot) != sha256.Size {
+		return fmt.Errorf("presale merkle root must be %d bytes: got %d", sha256.Size, len(p.MerkleRoot))
+	}
+	if p.Duration <= 0 {
+		return fmt.Errorf("presale duration must be positive: %v", p.Duration)
+	}
+	return nil
+}
+
+func (p PresaleProof) ValidateBasic() error {
+	if p.Cap.IsNil() || !p.Cap.IsPositive() {
+		return fmt.Errorf("presale cap must be positive: %v", p.Cap)
+	}
+	for i, sibling := range p.Proof {
+		if len(sibling) != sha256.Size {
+			return fmt.Errorf("presale proof %d must be %d bytes: got %d", i, sha256.Size, len(sibling))
+		}
+	}
+	return nil
+}
+
+func NewPresaleAllowance(planId string, buyer sdk.AccAddress, cap math.Int) PresaleAllowance {
+	return PresaleAllowance{
+		PlanId:    planId,
+		Buyer:     buyer.String(),
+		Cap:       cap,
+		Purchased: math.ZeroInt(),
+	}
+}
+
+func (a PresaleAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if a.Cap.IsNil() || !a.Cap.IsPositive() {
+		return fmt.Errorf("presale cap must be positive: %v", a.Cap)
+	}
+	if a.Purchased.IsNil() || a.Purchased.IsNegative() {
+		return fmt.Errorf("presale purchased amount must be non-negative: %v", a.Purchased)
+	}
+	if a.Purchased.GT(a.Cap) {
+		return fmt.Errorf("presale purchased amount exceeds the cap: %s > %s", a.Purchased, a.Cap)
+	}
+	return nil
+}
+
+// PresaleLeaf returns the allowlist leaf of the buyer with its cap:
+//
+//	sha256(0x0 | len(address) | address | cap)
+func PresaleLeaf(buyer sdk.AccAddress, cap math.Int) []byte {
+	h := sha256.New()
+	h.Write(presaleLeafPrefix)
+	h.Write(address.MustLengthPrefix(buyer))
+	h.Write([]byte(cap.String()))
+	return h.Sum(nil)
+}
+
+// presaleNode hashes the sorted pair of the nodes, so the proofs don't need the positions:
+//
+//	sha256(0x1 | min(a, b) | max(a, b))
+func presaleNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.New()
+	h.Write(presaleNodePrefix)
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// VerifyPresaleProof checks the leaf is in the merkle tree of the root
+func VerifyPresaleProof(root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = presaleNode(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+// presaleTree returns the levels of the merkle tree of the leaves, from the leaves to the root.
+// The last node of a level with an odd number of nodes is promoted to the next level.
+func presaleTree(leaves [][]byte) [][][]byte {
+	levels := [][][]byte{leaves}
+	for level := leaves; len(level) > 1; {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+			} else {
+				next = append(next, presaleNode(level[i], level[i+1]))
+			}
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels
+}
+
+// PresaleMerkleRoot returns the merkle root of the allowlist leaves
+func PresaleMerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	levels := presaleTree(leaves)
+	return levels[len(levels)-1][0]
+}
+
+// PresaleMerkleProof returns the proof of the leaf at the index in the allowlist leaves
+func PresaleMerkleProof(leaves [][]byte, index int) [][]byte {
+	var proof [][]byte
+	levels := presaleTree(leaves)
+	for _, level := range levels[:len(levels)-1] {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		index /= 2
+	}
+	return proof
+}
+
+// IsInPresale is true if the plan has a presale and it is not over
+func (p Plan) IsInPresale(now time.Time) bool {
+	return p.Presale.IsEnabled() && p.TradingEnabled && now.Before(p.PresaleEndTime())
+}
+
+// PresaleEndTime returns the time the public trading starts
+func (p Plan) PresaleEndTime() time.Time {
+	return p.StartTime.Add(p.Presale.Duration)
+}
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	fmt "fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestPresaleMerkleProof(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		t.Run(fmt.Sprintf("leaves=%d", n), func(t *testing.T) {
+			buyers := make([]string, n)
+			leaves := make([][]byte, n)
+			for i := range leaves {
+				buyer := sample.Acc()
+				buyers[i] = buyer.String()
+				leaves[i] = types.PresaleLeaf(buyer, math.NewInt(int64(i+1)).MulRaw(1e18))
+			}
+			root := types.PresaleMerkleRoot(leaves)
+			require.Len(t, root, sha256.Size)
+
+			for i, leaf := range leaves {
+				proof := types.PresaleMerkleProof(leaves, i)
+				require.True(t, types.VerifyPresaleProof(root, leaf, proof))
+
+				// the proof doesn't hold for another leaf
+				other := types.PresaleLeaf(sample.Acc(), math.NewInt(int64(i+1)).MulRaw(1e18))
+				require.False(t, types.VerifyPresaleProof(root, other, proof))
+			}
+		})
+	}
+}
+
+func TestPresaleLeaf(t *testing.T) {
+	buyer := sample.Acc()
+	cap := math.NewInt(1_000).MulRaw(1e18)
+
+	require.Equal(t, types.PresaleLeaf(buyer, cap), types.PresaleLeaf(buyer, cap))
+	require.NotEqual(t, types.PresaleLeaf(buyer, cap), types.PresaleLeaf(buyer, cap.AddRaw(1)))
+	require.NotEqual(t, types.PresaleLeaf(buyer, cap), types.PresaleLeaf(sample.Acc(), cap))
+}
+
+func TestPresale_ValidateBasic(t *testing.T) {
+	root := types.PresaleMerkleRoot([][]byte{types.PresaleLeaf(sample.Acc(), math.NewInt(1))})
+
+	tests := []struct {
+		name      string
+		presale   types.Presale
+		expectErr bool
+	}{
+		{"No presale", types.Presale{}, false},
+		{"Valid presale", types.Presale{MerkleRoot: root, Duration: time.Hour}, false},
+		{"Duration without root", types.Presale{Duration: time.Hour}, true},
+		{"Root without duration", types.Presale{MerkleRoot: root}, true},
+		{"Invalid root length", types.Presale{MerkleRoot: root[:16], Duration: time.Hour}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.presale.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -33,6 +33,106 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryClaimableRequest is the request type for the
+// Query/QueryClaimable RPC method.
+type QueryClaimableRequest struct {
+	// plan_id is the ID of the plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// claimer is the address of the claimer.
+	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+}
+
+func (m *QueryClaimableRequest) Reset()         { *m = QueryClaimableRequest{} }
+func (m *QueryClaimableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRequest) ProtoMessage()    {}
+func (*QueryClaimableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{0}
+}
+func (m *QueryClaimableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRequest.Merge(m, src)
+}
+func (m *QueryClaimableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *QueryClaimableRequest) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+// QueryClaimableResponse is the response type for the
+// Query/QueryClaimable RPC method.
+type QueryClaimableResponse struct {
+	// claimable_amount is the amount of tokens the claimer can claim now,
+	// including its IRO tokens which are not claimed yet.
+	ClaimableAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=claimable_amount,json=claimableAmount,proto3,customtype=cosmossdk.io/math.Int" json:"claimable_amount"`
+	// locked_amount is the amount of tokens of the claimer still locked by the
+	// buyer vesting.
+	LockedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=locked_amount,json=lockedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"locked_amount"`
+}
+
+func (m *QueryClaimableResponse) Reset()         { *m = QueryClaimableResponse{} }
+func (m *QueryClaimableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableResponse) ProtoMessage()    {}
+func (*QueryClaimableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{1}
+}
+func (m *QueryClaimableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableResponse.Merge(m, src)
+}
+func (m *QueryClaimableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableResponse proto.InternalMessageInfo
+
 // QueryVestingRequest is the request type for the
 // Query/QueryVesting RPC method.
 type QueryVestingRequest struct {
@@ -44,7 +144,7 @@ func (m *QueryVestingRequest) Reset()         { *m = QueryVestingRequest{} }
 func (m *QueryVestingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVestingRequest) ProtoMessage()    {}
 func (*QueryVestingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{0}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{2}
 }
 func (m *QueryVestingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -95,7 +195,7 @@ func (m *QueryVestingResponse) Reset()         { *m = QueryVestingResponse{} }
 func (m *QueryVestingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVestingResponse) ProtoMessage()    {}
 func (*QueryVestingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{1}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{3}
 }
 func (m *QueryVestingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,7 +239,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{2}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{4}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,7 +278,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{3}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{5}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,7 +326,7 @@ func (m *QueryPlansRequest) Reset()         { *m = QueryPlansRequest{} }
 func (m *QueryPlansRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPlansRequest) ProtoMessage()    {}
 func (*QueryPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{4}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{6}
 }
 func (m *QueryPlansRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +379,7 @@ func (m *QueryPlansResponse) Reset()         { *m = QueryPlansResponse{} }
 func (m *QueryPlansResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPlansResponse) ProtoMessage()    {}
 func (*QueryPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{5}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{7}
 }
 func (m *QueryPlansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +431,7 @@ func (m *QueryPlanRequest) Reset()         { *m = QueryPlanRequest{} }
 func (m *QueryPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanRequest) ProtoMessage()    {}
 func (*QueryPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{6}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{8}
 }
 func (m *QueryPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,13 +470,16 @@ func (m *QueryPlanRequest) GetPlanId() string {
 // QueryPlanResponse is the response type for the Query/QueryPlan RPC method.
 type QueryPlanResponse struct {
 	Plan *Plan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	// The maximum amount of tokens in a single buy at the current time, by the
+	// ramp of the trading limits. Zero if not limited.
+	CurrentMaxBuy cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=current_max_buy,json=currentMaxBuy,proto3,customtype=cosmossdk.io/math.Int" json:"current_max_buy"`
 }
 
 func (m *QueryPlanResponse) Reset()         { *m = QueryPlanResponse{} }
 func (m *QueryPlanResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanResponse) ProtoMessage()    {}
 func (*QueryPlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{7}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{9}
 }
 func (m *QueryPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,7 +525,7 @@ func (m *QueryPlanByRollappRequest) Reset()         { *m = QueryPlanByRollappReq
 func (m *QueryPlanByRollappRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanByRollappRequest) ProtoMessage()    {}
 func (*QueryPlanByRollappRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{8}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{10}
 }
 func (m *QueryPlanByRollappRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +571,7 @@ func (m *QueryPlanByRollappResponse) Reset()         { *m = QueryPlanByRollappRe
 func (m *QueryPlanByRollappResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPlanByRollappResponse) ProtoMessage()    {}
 func (*QueryPlanByRollappResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{9}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{11}
 }
 func (m *QueryPlanByRollappResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +617,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{10}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{12}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -560,7 +663,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{11}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{13}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +703,7 @@ func (m *QueryCostRequest) Reset()         { *m = QueryCostRequest{} }
 func (m *QueryCostRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCostRequest) ProtoMessage()    {}
 func (*QueryCostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{12}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{14}
 }
 func (m *QueryCostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,7 +755,7 @@ func (m *QueryCostResponse) Reset()         { *m = QueryCostResponse{} }
 func (m *QueryCostResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCostResponse) ProtoMessage()    {}
 func (*QueryCostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{13}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{15}
 }
 func (m *QueryCostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -699,7 +802,7 @@ func (m *QueryTokensForExactInAmountRequest) Reset()         { *m = QueryTokensF
 func (m *QueryTokensForExactInAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTokensForExactInAmountRequest) ProtoMessage()    {}
 func (*QueryTokensForExactInAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{14}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{16}
 }
 func (m *QueryTokensForExactInAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +848,7 @@ func (m *QueryTokensForExactInAmountResponse) Reset()         { *m = QueryTokens
 func (m *QueryTokensForExactInAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokensForExactInAmountResponse) ProtoMessage()    {}
 func (*QueryTokensForExactInAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{15}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{17}
 }
 func (m *QueryTokensForExactInAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -791,7 +894,7 @@ func (m *QueryClaimedRequest) Reset()         { *m = QueryClaimedRequest{} }
 func (m *QueryClaimedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClaimedRequest) ProtoMessage()    {}
 func (*QueryClaimedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{16}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{18}
 }
 func (m *QueryClaimedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +940,7 @@ func (m *QueryClaimedResponse) Reset()         { *m = QueryClaimedResponse{} }
 func (m *QueryClaimedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClaimedResponse) ProtoMessage()    {}
 func (*QueryClaimedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae2c72bd0c23c1c0, []int{17}
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{19}
 }
 func (m *QueryClaimedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,6 +970,8 @@ func (m *QueryClaimedResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryClaimedResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*QueryClaimableRequest)(nil), "dymensionxyz.dymension.iro.QueryClaimableRequest")
+	proto.RegisterType((*QueryClaimableResponse)(nil), "dymensionxyz.dymension.iro.QueryClaimableResponse")
 	proto.RegisterType((*QueryVestingRequest)(nil), "dymensionxyz.dymension.iro.QueryVestingRequest")
 	proto.RegisterType((*QueryVestingResponse)(nil), "dymensionxyz.dymension.iro.QueryVestingResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.iro.QueryParamsRequest")
@@ -892,76 +997,82 @@ func init() {
 }
 
 var fileDescriptor_ae2c72bd0c23c1c0 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
+	// 1191 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x9b, 0xec, 0xd2, 0xbc, 0x84, 0x12, 0x86, 0x14, 0x12, 0x17, 0xb6, 0xc5, 0xad, 0x9a,
-	0x90, 0x74, 0xed, 0xec, 0x86, 0x22, 0xf1, 0xbb, 0xd9, 0x94, 0xb6, 0x8b, 0x90, 0x08, 0x2e, 0x2a,
-	0x88, 0x8b, 0x99, 0xf5, 0x0e, 0x5b, 0xab, 0xde, 0x19, 0xd7, 0x9e, 0x84, 0x2c, 0xa5, 0x1c, 0x90,
-	0xb8, 0x23, 0x21, 0x90, 0x10, 0x70, 0xe7, 0xc0, 0x81, 0x03, 0x7f, 0x03, 0xea, 0xb1, 0x82, 0x0b,
-	0xe2, 0x50, 0xa1, 0x84, 0x03, 0x7f, 0x06, 0xf2, 0xcc, 0xd8, 0xeb, 0x4d, 0x53, 0xdb, 0x5b, 0xc4,
-	0x6d, 0x67, 0xe6, 0x7d, 0xef, 0x7d, 0xdf, 0x9b, 0xe7, 0xcf, 0x6b, 0x38, 0xdb, 0x1d, 0xf4, 0x09,
-	0x8d, 0x3c, 0x46, 0x77, 0x07, 0x9f, 0x58, 0xe9, 0xc2, 0xf2, 0x42, 0x66, 0xdd, 0xdc, 0x26, 0xe1,
-	0xc0, 0x0c, 0x42, 0xc6, 0x19, 0xd2, 0xb3, 0x71, 0x66, 0xba, 0x30, 0xbd, 0x90, 0xe9, 0xf3, 0x3d,
-	0xd6, 0x63, 0x22, 0xcc, 0x8a, 0x7f, 0x49, 0x84, 0xbe, 0xe8, 0xb2, 0xa8, 0xcf, 0x22, 0x47, 0x1e,
-	0xc8, 0x85, 0x3a, 0x7a, 0xba, 0xc7, 0x58, 0xcf, 0x27, 0x16, 0x0e, 0x3c, 0x0b, 0x53, 0xca, 0x38,
-	0xe6, 0x1e, 0xa3, 0xc9, 0xe9, 0x99, 0x1c, 0x4a, 0x5e, 0x98, 0xa4, 0xaf, 0xc9, 0x8c, 0x56, 0x07,
-	0x47, 0xc4, 0xda, 0x69, 0x74, 0x08, 0xc7, 0x0d, 0xcb, 0x65, 0x1e, 0x55, 0xe7, 0x4b, 0x39, 0x59,
-	0x02, 0x1c, 0xe2, 0x7e, 0x52, 0x6e, 0x25, 0x9b, 0x48, 0x48, 0x4e, 0xd3, 0x05, 0xb8, 0xe7, 0x51,
-	0xc1, 0x4d, 0xc6, 0x1a, 0x26, 0x3c, 0xf1, 0x4e, 0x1c, 0x71, 0x8d, 0x44, 0xdc, 0xa3, 0x3d, 0x9b,
-	0xdc, 0xdc, 0x26, 0x11, 0x47, 0x4f, 0xc1, 0x23, 0x81, 0x8f, 0xa9, 0xe3, 0x75, 0x17, 0xb4, 0x53,
-	0xda, 0xf2, 0xb4, 0x5d, 0x8d, 0x97, 0xed, 0xae, 0xf1, 0xed, 0x11, 0x98, 0x1f, 0x05, 0x44, 0x01,
-	0xa3, 0x11, 0x41, 0xf3, 0x50, 0x61, 0x1f, 0x53, 0x12, 0xaa, 0x78, 0xb9, 0x40, 0x1b, 0x50, 0xe1,
-	0x8c, 0x63, 0x7f, 0xe1, 0x48, 0xbc, 0xdb, 0x5a, 0xbd, 0x73, 0xef, 0xe4, 0xc4, 0x9f, 0xf7, 0x4e,
-	0x1e, 0x97, 0x0c, 0xa3, 0xee, 0x0d, 0xd3, 0x63, 0x56, 0x1f, 0xf3, 0xeb, 0x66, 0x9b, 0xf2, 0xdf,
-	0x7e, 0xa9, 0x83, 0xea, 0x6a, 0x9b, 0x72, 0x5b, 0x22, 0xd1, 0x16, 0x3c, 0xba, 0x43, 0x22, 0x4e,
-	0xba, 0x0e, 0xee, 0xb3, 0x6d, 0xca, 0x17, 0x26, 0xc7, 0x4f, 0x35, 0x2b, 0x33, 0x6c, 0x88, 0x04,
-	0xe8, 0x1a, 0xcc, 0xb9, 0x3e, 0xf6, 0xfa, 0xb8, 0xe3, 0x93, 0x24, 0xe9, 0xd4, 0xf8, 0x49, 0x1f,
-	0x4b, 0x93, 0xc8, 0xbc, 0xc6, 0x3c, 0x20, 0xd1, 0x9a, 0x2d, 0x71, 0x19, 0xaa, 0x95, 0xc6, 0x7b,
-	0xaa, 0xc3, 0xc9, 0xae, 0xea, 0xd7, 0x05, 0xa8, 0xca, 0x4b, 0x13, 0x0d, 0x9b, 0x69, 0x1a, 0xe6,
-	0x83, 0xe7, 0xd1, 0x94, 0xd8, 0xd6, 0x54, 0x4c, 0xcf, 0x56, 0x38, 0xe3, 0x0b, 0x0d, 0x1e, 0x97,
-	0x99, 0x7d, 0x4c, 0x93, 0x72, 0x68, 0x19, 0xe6, 0x28, 0xa3, 0x4e, 0x44, 0x38, 0xf7, 0x49, 0xd7,
-	0x61, 0xd4, 0x1f, 0x88, 0x0a, 0x47, 0xed, 0x63, 0x94, 0xd1, 0xab, 0x72, 0xfb, 0x6d, 0xea, 0x0f,
-	0xd0, 0x25, 0x80, 0xe1, 0x38, 0x88, 0x0b, 0x9a, 0x69, 0x9e, 0x35, 0x95, 0xc0, 0x78, 0x76, 0x4c,
-	0xf9, 0xb8, 0xa8, 0xd9, 0x31, 0xb7, 0x70, 0x8f, 0xa8, 0x2a, 0x76, 0x06, 0x69, 0x7c, 0xa7, 0x25,
-	0xba, 0x25, 0x0f, 0x25, 0xf0, 0x15, 0xa8, 0xc4, 0x33, 0x13, 0xeb, 0x9b, 0x5c, 0x9e, 0x69, 0x9e,
-	0xca, 0xd5, 0xe7, 0x63, 0xaa, 0xd4, 0x49, 0x10, 0xba, 0x7c, 0x08, 0xb9, 0xa5, 0x42, 0x72, 0xb2,
-	0xf4, 0x08, 0xbb, 0x55, 0x98, 0x4b, 0xc9, 0x15, 0x4e, 0x77, 0x3b, 0xd3, 0xd1, 0x54, 0xc8, 0xf3,
-	0x30, 0x15, 0x1f, 0xab, 0x7b, 0x2a, 0xd4, 0x61, 0x8b, 0x68, 0xe3, 0x25, 0x58, 0x4c, 0x53, 0xb5,
-	0x06, 0x36, 0xf3, 0x7d, 0x1c, 0x04, 0x09, 0x81, 0x67, 0x00, 0x42, 0xb9, 0x33, 0xe4, 0x30, 0xad,
-	0x76, 0xda, 0x5d, 0xc3, 0x06, 0xfd, 0x30, 0xec, 0x7f, 0xe2, 0xb3, 0x06, 0xc7, 0x45, 0xce, 0xab,
-	0x01, 0xe3, 0x5b, 0xa1, 0xe7, 0x92, 0xc2, 0x66, 0x60, 0x78, 0xf2, 0x20, 0x42, 0x31, 0xb8, 0x0c,
-	0x95, 0x20, 0xde, 0x90, 0x80, 0x56, 0x43, 0x3d, 0x35, 0x27, 0xee, 0x7f, 0x6a, 0xde, 0x22, 0x3d,
-	0xec, 0x0e, 0x2e, 0x12, 0x37, 0xf3, 0xec, 0x5c, 0x24, 0xae, 0x2d, 0xf1, 0xc6, 0x67, 0xea, 0x72,
-	0x36, 0x59, 0xc4, 0x8b, 0xf8, 0xa0, 0x57, 0x61, 0x12, 0xf7, 0xf9, 0xc3, 0x38, 0x49, 0x8c, 0x43,
-	0x08, 0xa6, 0x22, 0xe2, 0xfb, 0xc2, 0x3e, 0x8e, 0xda, 0xe2, 0xb7, 0xd1, 0x52, 0xf7, 0x2d, 0xeb,
-	0x2b, 0x75, 0x75, 0x98, 0x72, 0x59, 0xc4, 0x55, 0x7f, 0x17, 0x47, 0x86, 0x2e, 0x19, 0xb7, 0x4d,
-	0xe6, 0x51, 0x5b, 0x84, 0x19, 0x9f, 0x82, 0x21, 0x72, 0xbc, 0xcb, 0x6e, 0x10, 0x1a, 0x5d, 0x62,
-	0xe1, 0x1b, 0xbb, 0xd8, 0xe5, 0x6d, 0x2a, 0x4d, 0xe1, 0x7f, 0x56, 0x65, 0xbc, 0x0f, 0xa7, 0x73,
-	0xab, 0x2b, 0x4d, 0x0d, 0xa8, 0x72, 0x11, 0x51, 0xac, 0x4a, 0x05, 0xa6, 0x6f, 0x86, 0xcd, 0xd8,
-	0xe5, 0x48, 0xb7, 0x70, 0x5c, 0x3e, 0x54, 0x2f, 0x86, 0x34, 0x5e, 0x95, 0xbe, 0x02, 0x33, 0xae,
-	0xdc, 0x72, 0x62, 0xa1, 0x72, 0x64, 0x96, 0xca, 0x8a, 0x04, 0x85, 0xdd, 0xe8, 0xf3, 0xe6, 0xcf,
-	0xb3, 0x50, 0x11, 0x25, 0xd0, 0xd7, 0x1a, 0x54, 0xa5, 0x27, 0x22, 0x33, 0x6f, 0xfe, 0xef, 0xb7,
-	0x63, 0xdd, 0x2a, 0x1d, 0x2f, 0xf9, 0x1b, 0x2b, 0x9f, 0xff, 0xfe, 0xf7, 0x57, 0x47, 0xce, 0x20,
-	0xc3, 0x2a, 0x7c, 0xff, 0xa2, 0x6f, 0x34, 0x80, 0xa1, 0x15, 0xa2, 0x7a, 0x71, 0xad, 0x8c, 0x75,
-	0xeb, 0x66, 0xd9, 0x70, 0xc5, 0xec, 0x39, 0xc1, 0xec, 0x34, 0x7a, 0x36, 0x97, 0x99, 0x60, 0xf2,
-	0x83, 0x06, 0xd3, 0x69, 0x06, 0x74, 0xae, 0x54, 0xa1, 0x84, 0x56, 0xbd, 0x64, 0xb4, 0x62, 0xb5,
-	0x2e, 0x58, 0xd5, 0xd1, 0x6a, 0x21, 0x2b, 0xeb, 0x96, 0x9a, 0xa4, 0xdb, 0xe8, 0xd7, 0xec, 0x3b,
-	0x24, 0xb5, 0x3c, 0x74, 0xbe, 0x54, 0xe9, 0x83, 0xf6, 0xaa, 0xbf, 0x30, 0x2e, 0x4c, 0x51, 0xdf,
-	0x10, 0xd4, 0x5f, 0x46, 0x2f, 0x16, 0x52, 0x77, 0x3a, 0x03, 0x47, 0xf9, 0xb5, 0x75, 0x6b, 0x68,
-	0xe5, 0xb7, 0xd1, 0x4f, 0x1a, 0x1c, 0x1b, 0x75, 0x4d, 0xd4, 0x28, 0x64, 0x73, 0xd0, 0x93, 0xf5,
-	0xe6, 0x38, 0x90, 0xb1, 0xfa, 0x1e, 0x43, 0x32, 0x7d, 0xff, 0x3e, 0x99, 0x8b, 0xd8, 0x01, 0x4b,
-	0xcc, 0x45, 0xc6, 0xa8, 0x4b, 0xcc, 0x45, 0xd6, 0x56, 0x8d, 0xa6, 0xe0, 0x77, 0x0e, 0xad, 0xe4,
-	0xf1, 0x8b, 0x1d, 0x35, 0x43, 0xef, 0x1f, 0x0d, 0x4e, 0xe4, 0xd8, 0x1b, 0x7a, 0xad, 0x90, 0x42,
-	0xae, 0x2b, 0xeb, 0xaf, 0x3f, 0x34, 0x5e, 0x89, 0xba, 0x22, 0x44, 0xb5, 0xd0, 0x85, 0x3c, 0x51,
-	0xd2, 0x50, 0x9d, 0x8f, 0x58, 0xe8, 0x90, 0x38, 0x8b, 0xe3, 0x51, 0xf5, 0xb7, 0x33, 0x23, 0xf5,
-	0x47, 0x0d, 0x66, 0xb3, 0xfe, 0x89, 0x8a, 0x8d, 0x6a, 0xd4, 0x99, 0xf5, 0xb5, 0xf2, 0x00, 0xc5,
-	0xfe, 0xbc, 0x60, 0x6f, 0xa1, 0x7a, 0xee, 0x95, 0x48, 0xd0, 0x61, 0x54, 0xd5, 0x37, 0x40, 0x09,
-	0xaa, 0xa3, 0x9f, 0x17, 0x25, 0xa8, 0x1e, 0xf8, 0xbc, 0x28, 0x47, 0x75, 0x47, 0x82, 0x86, 0x54,
-	0x5b, 0x6f, 0xde, 0xd9, 0xab, 0x69, 0x77, 0xf7, 0x6a, 0xda, 0x5f, 0x7b, 0x35, 0xed, 0xcb, 0xfd,
-	0xda, 0xc4, 0xdd, 0xfd, 0xda, 0xc4, 0x1f, 0xfb, 0xb5, 0x89, 0x0f, 0xd6, 0x7a, 0x1e, 0xbf, 0xbe,
-	0xdd, 0x31, 0x5d, 0xd6, 0x7f, 0x50, 0xca, 0x9d, 0x75, 0x6b, 0x57, 0x5e, 0xe0, 0x20, 0x20, 0x51,
-	0xa7, 0x2a, 0xbe, 0x98, 0xd6, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb3, 0x21, 0xf8, 0x61,
-	0x0e, 0x00, 0x00,
+	0x14, 0x8e, 0xd3, 0x24, 0x6d, 0x5f, 0x9a, 0x36, 0x9d, 0xa6, 0x25, 0x71, 0x61, 0x5b, 0xdc, 0xaa,
+	0x09, 0x49, 0xd7, 0x4e, 0x36, 0x14, 0x89, 0xdf, 0xcd, 0xa6, 0xb4, 0x4d, 0x05, 0x22, 0x38, 0xa8,
+	0x20, 0x2e, 0x66, 0xd6, 0x3b, 0x6c, 0xad, 0x78, 0x67, 0xb6, 0xf6, 0x6c, 0xc8, 0x12, 0xc2, 0x01,
+	0x89, 0x3b, 0x12, 0x02, 0x09, 0xf1, 0xe3, 0xcc, 0x81, 0x23, 0x27, 0x8e, 0x1c, 0x50, 0x8f, 0x15,
+	0x5c, 0x10, 0x87, 0x0a, 0x25, 0x1c, 0xb8, 0xf0, 0x3f, 0x20, 0xcf, 0x8c, 0xbd, 0xde, 0x34, 0xb5,
+	0xbd, 0xa9, 0xb8, 0xad, 0x67, 0xde, 0xf7, 0xe6, 0xfb, 0xde, 0xbc, 0xf9, 0x66, 0x07, 0x2e, 0xd5,
+	0x3b, 0x4d, 0x42, 0x43, 0x8f, 0xd1, 0xcd, 0xce, 0x47, 0x56, 0xf2, 0x61, 0x79, 0x01, 0xb3, 0xee,
+	0xb6, 0x49, 0xd0, 0x31, 0x5b, 0x01, 0xe3, 0x0c, 0xe9, 0xe9, 0x38, 0x33, 0xf9, 0x30, 0xbd, 0x80,
+	0xe9, 0x13, 0x0d, 0xd6, 0x60, 0x22, 0xcc, 0x8a, 0x7e, 0x49, 0x84, 0x3e, 0xe5, 0xb2, 0xb0, 0xc9,
+	0x42, 0x47, 0x4e, 0xc8, 0x0f, 0x35, 0xf5, 0x64, 0x83, 0xb1, 0x86, 0x4f, 0x2c, 0xdc, 0xf2, 0x2c,
+	0x4c, 0x29, 0xe3, 0x98, 0x7b, 0x8c, 0xc6, 0xb3, 0x17, 0x33, 0x28, 0x79, 0x41, 0x9c, 0xbe, 0x24,
+	0x33, 0x5a, 0x35, 0x1c, 0x12, 0x6b, 0x63, 0xa1, 0x46, 0x38, 0x5e, 0xb0, 0x5c, 0xe6, 0x51, 0x35,
+	0x3f, 0x9d, 0x91, 0xa5, 0x85, 0x03, 0xdc, 0x8c, 0x97, 0x9b, 0x4d, 0x27, 0x12, 0x92, 0x93, 0x74,
+	0x2d, 0xdc, 0xf0, 0xa8, 0xe0, 0x26, 0x63, 0x8d, 0x5b, 0x70, 0xfa, 0xad, 0x28, 0x62, 0xd9, 0xc7,
+	0x5e, 0x13, 0xd7, 0x7c, 0x62, 0x93, 0xbb, 0x6d, 0x12, 0x72, 0xf4, 0x04, 0x1c, 0x6e, 0xf9, 0x98,
+	0x3a, 0x5e, 0x7d, 0x52, 0x3b, 0xaf, 0xcd, 0x1c, 0xb5, 0x47, 0xa2, 0xcf, 0x95, 0x3a, 0x9a, 0x84,
+	0xc3, 0x6e, 0x14, 0x4c, 0x82, 0xc9, 0x41, 0x31, 0x11, 0x7f, 0x1a, 0xbf, 0x68, 0x70, 0x66, 0x6f,
+	0xb2, 0xb0, 0xc5, 0x68, 0x48, 0xd0, 0x6d, 0x18, 0x77, 0xe3, 0x41, 0x07, 0x37, 0x59, 0x9b, 0x72,
+	0x99, 0xb6, 0x3a, 0x77, 0xef, 0xc1, 0xb9, 0x81, 0x3f, 0x1f, 0x9c, 0x3b, 0x2d, 0x49, 0x87, 0xf5,
+	0x75, 0xd3, 0x63, 0x56, 0x13, 0xf3, 0x3b, 0xe6, 0x0a, 0xe5, 0xbf, 0xfd, 0x54, 0x06, 0x55, 0xe8,
+	0x15, 0xca, 0xed, 0x13, 0x49, 0x92, 0x25, 0x91, 0x03, 0xad, 0xc2, 0x98, 0xcf, 0xdc, 0x75, 0x52,
+	0x8f, 0x93, 0x0e, 0xf6, 0x9f, 0xf4, 0x98, 0xcc, 0x20, 0x33, 0x1a, 0x26, 0x9c, 0x12, 0x1a, 0x6e,
+	0x93, 0x90, 0x7b, 0xb4, 0x91, 0x57, 0x0e, 0xe3, 0xeb, 0x41, 0x98, 0xe8, 0x05, 0x28, 0xc9, 0x13,
+	0x30, 0xcc, 0x3e, 0xa4, 0x24, 0x50, 0xf1, 0xf2, 0x03, 0x2d, 0xc1, 0x30, 0x67, 0x1c, 0xfb, 0x07,
+	0x21, 0x2a, 0x91, 0x91, 0xe6, 0x0d, 0x12, 0xf2, 0xae, 0xe6, 0x43, 0x07, 0xd0, 0x2c, 0x33, 0xa8,
+	0x2a, 0xee, 0xb7, 0x3b, 0x43, 0x8f, 0xbf, 0x3b, 0xc6, 0x04, 0x20, 0x51, 0x9a, 0x55, 0xd1, 0x9d,
+	0xaa, 0x94, 0xc6, 0x3b, 0x70, 0xaa, 0x67, 0x54, 0xd5, 0xeb, 0x2a, 0x8c, 0xc8, 0x2e, 0x16, 0x05,
+	0x1b, 0xad, 0x18, 0xe6, 0xa3, 0x0f, 0xa8, 0x29, 0xb1, 0xd5, 0xa1, 0x88, 0x9e, 0xad, 0x70, 0xc6,
+	0x67, 0x1a, 0x9c, 0x94, 0x99, 0x7d, 0x4c, 0xe3, 0xe5, 0xd0, 0x0c, 0x8c, 0x53, 0x46, 0x9d, 0x90,
+	0x70, 0xee, 0x93, 0xba, 0xc3, 0xa8, 0xdf, 0x11, 0x2b, 0x1c, 0xb1, 0x8f, 0x53, 0x46, 0xd7, 0xe4,
+	0xf0, 0x9b, 0xd4, 0xef, 0xa0, 0xeb, 0x00, 0xdd, 0xf3, 0x21, 0x36, 0x68, 0xb4, 0x72, 0xc9, 0x54,
+	0x02, 0xa3, 0xc3, 0x64, 0x4a, 0xff, 0x50, 0x87, 0xc9, 0x5c, 0xc5, 0x8d, 0xf8, 0xb8, 0xd8, 0x29,
+	0xa4, 0xf1, 0x8d, 0x06, 0x28, 0xcd, 0x43, 0x09, 0x7c, 0x09, 0x86, 0xa3, 0x9e, 0x89, 0xf4, 0x1d,
+	0x9a, 0x19, 0xad, 0x9c, 0xcf, 0xd4, 0xe7, 0x63, 0xaa, 0xd4, 0x49, 0x10, 0xba, 0xb1, 0x0f, 0xb9,
+	0xe9, 0x5c, 0x72, 0x72, 0xe9, 0x1e, 0x76, 0x73, 0x30, 0x9e, 0x90, 0xcb, 0xed, 0xee, 0xef, 0xd3,
+	0x25, 0x4d, 0x94, 0x3c, 0x0b, 0x43, 0xd1, 0xbc, 0xda, 0xa8, 0x5c, 0x21, 0xb6, 0x88, 0x46, 0x6b,
+	0x70, 0xc2, 0x6d, 0x07, 0x01, 0xa1, 0xdc, 0x69, 0xe2, 0x4d, 0xa7, 0xd6, 0xee, 0x1c, 0xe4, 0x10,
+	0x8c, 0xa9, 0x1c, 0x6f, 0xe0, 0xcd, 0x6a, 0xbb, 0x63, 0xbc, 0x00, 0x53, 0x09, 0xbf, 0x6a, 0xc7,
+	0x66, 0xbe, 0x8f, 0x5b, 0xad, 0x58, 0xd6, 0x53, 0x00, 0x81, 0x1c, 0xe9, 0x2a, 0x3b, 0xaa, 0x46,
+	0x56, 0xea, 0x86, 0x0d, 0xfa, 0x7e, 0xd8, 0xc7, 0x11, 0x69, 0xcc, 0x2b, 0x3f, 0x5d, 0x6b, 0x31,
+	0xbe, 0x1a, 0x78, 0x6e, 0xae, 0x9f, 0x1a, 0x18, 0xce, 0xec, 0x45, 0x28, 0x06, 0x37, 0x60, 0xb8,
+	0x15, 0x0d, 0x28, 0xa7, 0x5c, 0x50, 0x65, 0x3a, 0xfb, 0x70, 0x99, 0x5e, 0x27, 0x0d, 0xec, 0x76,
+	0xae, 0x11, 0x37, 0x55, 0xac, 0x6b, 0xc4, 0xb5, 0x25, 0xde, 0xf8, 0x44, 0x6d, 0xf9, 0x32, 0x0b,
+	0x79, 0xae, 0xbf, 0xbf, 0x0c, 0x87, 0x70, 0xf3, 0x40, 0x46, 0x1a, 0xe1, 0x10, 0x82, 0xa1, 0x90,
+	0xf8, 0xbe, 0x30, 0xa5, 0x23, 0xb6, 0xf8, 0x6d, 0x54, 0xe1, 0x64, 0x6a, 0x7d, 0xa5, 0xae, 0x0c,
+	0x43, 0x2e, 0x0b, 0xb9, 0xaa, 0xef, 0x54, 0x4f, 0x2b, 0xc7, 0x4d, 0xbc, 0xcc, 0x3c, 0x6a, 0x8b,
+	0x30, 0xe3, 0x63, 0x30, 0x44, 0x8e, 0xb7, 0xd9, 0x3a, 0xa1, 0xe1, 0x75, 0x16, 0xbc, 0xb6, 0x89,
+	0x5d, 0xbe, 0x42, 0xa5, 0xd5, 0xfc, 0xcf, 0xaa, 0x8c, 0x77, 0xe1, 0x42, 0xe6, 0xea, 0x4a, 0xd3,
+	0x02, 0x8c, 0x70, 0x11, 0x91, 0xaf, 0x4a, 0x05, 0x26, 0xf7, 0x8d, 0xb8, 0x33, 0x49, 0x3d, 0xb7,
+	0x5d, 0xde, 0x87, 0x89, 0xde, 0x78, 0xb5, 0xf4, 0x4d, 0x18, 0x95, 0xf7, 0x70, 0x74, 0x2d, 0xc4,
+	0x97, 0xeb, 0x74, 0x51, 0x91, 0xa0, 0xb0, 0x4b, 0x4d, 0x5e, 0xf9, 0x77, 0x0c, 0x86, 0xc5, 0x12,
+	0xe8, 0x4b, 0x0d, 0x46, 0xa4, 0xd3, 0x22, 0x33, 0xab, 0xff, 0x1f, 0x36, 0x79, 0xdd, 0x2a, 0x1c,
+	0x2f, 0xf9, 0x1b, 0xb3, 0x9f, 0xfe, 0xfe, 0xf7, 0x17, 0x83, 0x17, 0x91, 0x61, 0xe5, 0xfe, 0xcd,
+	0x41, 0x5f, 0x69, 0x00, 0x5d, 0x83, 0x45, 0xe5, 0xfc, 0xb5, 0x52, 0x17, 0x82, 0x6e, 0x16, 0x0d,
+	0x57, 0xcc, 0x9e, 0x11, 0xcc, 0x2e, 0xa0, 0xa7, 0x33, 0x99, 0x09, 0x26, 0xdf, 0x69, 0x70, 0x34,
+	0xc9, 0x80, 0x2e, 0x17, 0x5a, 0x28, 0xa6, 0x55, 0x2e, 0x18, 0xad, 0x58, 0x2d, 0x0a, 0x56, 0x65,
+	0x34, 0x97, 0xcb, 0xca, 0xda, 0x52, 0x9d, 0xb4, 0x8d, 0x7e, 0x4d, 0xdf, 0x4c, 0x89, 0xe5, 0xa1,
+	0x2b, 0x85, 0x96, 0xde, 0x6b, 0xaf, 0xfa, 0x73, 0xfd, 0xc2, 0x14, 0xf5, 0x25, 0x41, 0xfd, 0x45,
+	0xf4, 0x7c, 0x2e, 0x75, 0xa7, 0xd6, 0x71, 0x94, 0x5f, 0x5b, 0x5b, 0x5d, 0x2b, 0xdf, 0x46, 0x3f,
+	0x6a, 0x70, 0xbc, 0xd7, 0x35, 0xd1, 0x42, 0x2e, 0x9b, 0xbd, 0x9e, 0xac, 0x57, 0xfa, 0x81, 0xf4,
+	0x55, 0xf7, 0x08, 0x92, 0xaa, 0xfb, 0xb7, 0x71, 0x5f, 0x44, 0x0e, 0x58, 0xa0, 0x2f, 0x52, 0x46,
+	0xad, 0x97, 0x0b, 0x46, 0x2b, 0x7e, 0x15, 0xc1, 0xef, 0x32, 0x9a, 0xcd, 0xe2, 0x17, 0x39, 0x6a,
+	0x8a, 0xde, 0x3f, 0x1a, 0x9c, 0xcd, 0xb0, 0x37, 0xf4, 0x4a, 0x2e, 0x85, 0x4c, 0x57, 0xd6, 0x5f,
+	0x3d, 0x30, 0x5e, 0x89, 0xba, 0x29, 0x44, 0x55, 0xd1, 0xd5, 0x2c, 0x51, 0xd2, 0x50, 0x9d, 0x0f,
+	0x58, 0xe0, 0x90, 0x28, 0x8b, 0xe3, 0x51, 0xf5, 0x67, 0x36, 0x25, 0xf5, 0x07, 0x0d, 0x8e, 0xa5,
+	0xfd, 0x13, 0xe5, 0x1b, 0x55, 0xaf, 0x33, 0xeb, 0xf3, 0xc5, 0x01, 0x8a, 0xfd, 0x15, 0xc1, 0xde,
+	0x42, 0xe5, 0xcc, 0x2d, 0x91, 0xa0, 0xfd, 0xa8, 0xaa, 0x97, 0x45, 0x01, 0xaa, 0xbd, 0x8f, 0x16,
+	0x7d, 0xbe, 0x38, 0xa0, 0x1f, 0xaa, 0x1b, 0x12, 0x94, 0xa2, 0xfa, 0x73, 0x7c, 0x1c, 0x93, 0x97,
+	0x5f, 0x81, 0xe3, 0xb8, 0xf7, 0xc9, 0xa9, 0x57, 0xfa, 0x81, 0xf4, 0xe3, 0x25, 0xc9, 0xbb, 0xa4,
+	0x4b, 0xd9, 0xda, 0x52, 0xaf, 0xd6, 0xed, 0xea, 0xad, 0x7b, 0x3b, 0x25, 0xed, 0xfe, 0x4e, 0x49,
+	0xfb, 0x6b, 0xa7, 0xa4, 0x7d, 0xbe, 0x5b, 0x1a, 0xb8, 0xbf, 0x5b, 0x1a, 0xf8, 0x63, 0xb7, 0x34,
+	0xf0, 0xde, 0x7c, 0xc3, 0xe3, 0x77, 0xda, 0x35, 0xd3, 0x65, 0xcd, 0x47, 0xa5, 0xdf, 0x58, 0xb4,
+	0x36, 0x65, 0xf7, 0x75, 0x5a, 0x24, 0xac, 0x8d, 0x88, 0x57, 0xf5, 0xe2, 0x7f, 0x03, 0x00, 0xaf,
+	0xba, 0x8f, 0xbb, 0x85, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -997,6 +1108,9 @@ type QueryClient interface {
 	// QueryVesting queries the claimable and vested amount for
 	// the specified plan ID.
 	QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error)
+	// QueryClaimable queries the claimable and locked amounts of a claimer for
+	// the specified plan ID.
+	QueryClaimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error)
 }
 
 type queryClient struct {
@@ -1088,6 +1202,15 @@ func (c *queryClient) QueryVesting(ctx context.Context, in *QueryVestingRequest,
 	return out, nil
 }
 
+func (c *queryClient) QueryClaimable(ctx context.Context, in *QueryClaimableRequest, opts ...grpc.CallOption) (*QueryClaimableResponse, error) {
+	out := new(QueryClaimableResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the IRO module.
@@ -1111,6 +1234,9 @@ type QueryServer interface {
 	// QueryVesting queries the claimable and vested amount for
 	// the specified plan ID.
 	QueryVesting(context.Context, *QueryVestingRequest) (*QueryVestingResponse, error)
+	// QueryClaimable queries the claimable and locked amounts of a claimer for
+	// the specified plan ID.
+	QueryClaimable(context.Context, *QueryClaimableRequest) (*QueryClaimableResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1144,6 +1270,9 @@ func (*UnimplementedQueryServer) QueryClaimed(ctx context.Context, req *QueryCla
 func (*UnimplementedQueryServer) QueryVesting(ctx context.Context, req *QueryVestingRequest) (*QueryVestingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryVesting not implemented")
 }
+func (*UnimplementedQueryServer) QueryClaimable(ctx context.Context, req *QueryClaimableRequest) (*QueryClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryClaimable not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1311,6 +1440,24 @@ func _Query_QueryVesting_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryClaimable(ctx, req.(*QueryClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.iro.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1351,11 +1498,95 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryVesting",
 			Handler:    _Query_QueryVesting_Handler,
 		},
+		{
+			MethodName: "QueryClaimable",
+			Handler:    _Query_QueryClaimable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/iro/query.proto",
 }
 
+func (m *QueryClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LockedAmount.Size()
+		i -= size
+		if _, err := m.LockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ClaimableAmount.Size()
+		i -= size
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1646,6 +1877,16 @@ func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.CurrentMaxBuy.Size()
+		i -= size
+		if _, err := m.CurrentMaxBuy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.Plan != nil {
 		{
 			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
@@ -2025,6 +2266,36 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LockedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryVestingRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2135,6 +2406,8 @@ func (m *QueryPlanResponse) Size() (n int) {
 		l = m.Plan.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = m.CurrentMaxBuy.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -2279,6 +2552,238 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *QueryClaimableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3051,6 +3556,40 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMaxBuy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentMaxBuy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
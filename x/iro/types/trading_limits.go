@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NoTradingLimits returns the trading limits with all the limits disabled
+func NoTradingLimits() TradingLimits {
+	return TradingLimits{
+		MaxPerAccount:     math.ZeroInt(),
+		MaxPerBlock:       math.ZeroInt(),
+		RampInitialMaxBuy: math.ZeroInt(),
+	}
+}
+
+// isLimitSet is true if the limit is enabled. The limits are unset on the plans created before the limits.
+func isLimitSet(limit math.Int) bool {
+	return !limit.IsNil() && limit.IsPositive()
+}
+
+func (l TradingLimits) HasMaxPerAccount() bool {
+	return isLimitSet(l.MaxPerAccount)
+}
+
+func (l TradingLimits) HasMaxPerBlock() bool {
+	return isLimitSet(l.MaxPerBlock)
+}
+
+func (l TradingLimits) HasRamp() bool {
+	return isLimitSet(l.RampInitialMaxBuy)
+}
+
+func (l TradingLimits) ValidateBasic() error {
+	if !l.MaxPerAccount.IsNil() && l.MaxPerAccount.IsNegative() {
+		return fmt.Errorf("max per account must be non-negative: %s", l.MaxPerAccount)
+	}
+	if !l.MaxPerBlock.IsNil() && l.MaxPerBlock.IsNegative() {
+		return fmt.Errorf("max per block must be non-negative: %s", l.MaxPerBlock)
+	}
+	if !l.RampInitialMaxBuy.IsNil() && l.RampInitialMaxBuy.IsNegative() {
+		return fmt.Errorf("ramp initial max buy must be non-negative: %s", l.RampInitialMaxBuy)
+	}
+	if l.RampDuration < 0 {
+		return fmt.Errorf("ramp duration must be non-negative: %v", l.RampDuration)
+	}
+	if l.HasRamp() != (l.RampDuration > 0) {
+		return fmt.Errorf("ramp initial max buy and ramp duration must be set together: %s, %v", l.RampInitialMaxBuy, l.RampDuration)
+	}
+	return nil
+}
+
+// MaxBuy returns the max amount of tokens in a single buy at the time, by the ramp of the trading limits.
+// The max buy relaxes linearly from the initial max buy at the start time to the max amount to sell at
+// the end of the ramp. Returns false if not limited.
+func (p Plan) MaxBuy(now time.Time) (math.Int, bool) {
+	limits := p.TradingLimits
+	if !limits.HasRamp() || !p.TradingEnabled {
+		return math.Int{}, false
+	}
+
+	elapsed := max(now.Sub(p.StartTime), 0)
+	if elapsed >= limits.RampDuration {
+		return math.Int{}, false
+	}
+	if p.MaxAmountToSell.LTE(limits.RampInitialMaxBuy) {
+		return limits.RampInitialMaxBuy, true
+	}
+
+	// initial + (max amount to sell - initial) * elapsed / duration
+	progress := math.LegacyNewDec(int64(elapsed)).QuoInt64(int64(limits.RampDuration))
+	relaxed := math.LegacyNewDecFromInt(p.MaxAmountToSell.Sub(limits.RampInitialMaxBuy)).Mul(progress).TruncateInt()
+	return limits.RampInitialMaxBuy.Add(relaxed), true
+}
+
+func NewAccountPurchase(planId string, buyer sdk.AccAddress) AccountPurchase {
+	return AccountPurchase{
+		PlanId: planId,
+		Buyer:  buyer.String(),
+		Amount: math.ZeroInt(),
+	}
+}
+
+func (a AccountPurchase) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if a.Amount.IsNil() || a.Amount.IsNegative() {
+		return fmt.Errorf("purchased amount must be non-negative: %v", a.Amount)
+	}
+	return nil
+}
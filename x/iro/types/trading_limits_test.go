@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestTradingLimits_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		limits    types.TradingLimits
+		expectErr bool
+	}{
+		{"No limits", types.TradingLimits{}, false},
+		{"Zero limits", types.TradingLimits{MaxPerAccount: math.ZeroInt(), MaxPerBlock: math.ZeroInt(), RampInitialMaxBuy: math.ZeroInt()}, false},
+		{"Valid limits", types.TradingLimits{MaxPerAccount: math.NewInt(100), MaxPerBlock: math.NewInt(1000), RampInitialMaxBuy: math.NewInt(10), RampDuration: time.Hour}, false},
+		{"Negative max per account", types.TradingLimits{MaxPerAccount: math.NewInt(-1)}, true},
+		{"Negative max per block", types.TradingLimits{MaxPerBlock: math.NewInt(-1)}, true},
+		{"Negative ramp initial max buy", types.TradingLimits{RampInitialMaxBuy: math.NewInt(-1), RampDuration: time.Hour}, true},
+		{"Ramp without duration", types.TradingLimits{RampInitialMaxBuy: math.NewInt(10)}, true},
+		{"Ramp duration without max buy", types.TradingLimits{RampDuration: time.Hour}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limits.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPlanMaxBuy(t *testing.T) {
+	start := time.Now()
+	plan := types.Plan{
+		TradingEnabled:  true,
+		StartTime:       start,
+		MaxAmountToSell: math.NewInt(10_000),
+		TradingLimits: types.TradingLimits{
+			RampInitialMaxBuy: math.NewInt(1_000),
+			RampDuration:      10 * time.Hour,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected math.Int
+		limited  bool
+	}{
+		{"Before the start", start.Add(-time.Hour), math.NewInt(1_000), true},
+		{"At the start", start, math.NewInt(1_000), true},
+		{"Middle of the ramp", start.Add(5 * time.Hour), math.NewInt(5_500), true},
+		{"Near the end of the ramp", start.Add(9 * time.Hour), math.NewInt(9_100), true},
+		{"End of the ramp", start.Add(10 * time.Hour), math.Int{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxBuy, limited := plan.MaxBuy(tt.now)
+			require.Equal(t, tt.limited, limited)
+			if tt.limited {
+				require.Equal(t, tt.expected, maxBuy)
+			}
+		})
+	}
+
+	// no ramp for the plans without trading limits
+	plan.TradingLimits = types.TradingLimits{}
+	_, limited := plan.MaxBuy(start)
+	require.False(t, limited)
+}
@@ -155,6 +155,14 @@ type MsgCreatePlan struct {
 	LiquidityDenom                  string                      `protobuf:"bytes,10,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
 	VestingDuration                 time.Duration               `protobuf:"bytes,11,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
 	VestingStartTimeAfterSettlement time.Duration               `protobuf:"bytes,12,opt,name=vesting_start_time_after_settlement,json=vestingStartTimeAfterSettlement,proto3,stdduration" json:"vesting_start_time_after_settlement" yaml:"vesting_start_time_after_settlement"`
+	// The optional allowlisted presale at the start of the plan.
+	Presale Presale `protobuf:"bytes,13,opt,name=presale,proto3" json:"presale"`
+	// The optional limits on the buys, against sniping at the start of the
+	// trading.
+	TradingLimits TradingLimits `protobuf:"bytes,14,opt,name=trading_limits,json=tradingLimits,proto3" json:"trading_limits"`
+	// The optional vesting of the tokens claimed by the buyers. The start time
+	// is set on the settlement.
+	BuyerVesting BuyerVesting `protobuf:"bytes,15,opt,name=buyer_vesting,json=buyerVesting,proto3" json:"buyer_vesting"`
 }
 
 func (m *MsgCreatePlan) Reset()         { *m = MsgCreatePlan{} }
@@ -260,6 +268,27 @@ func (m *MsgCreatePlan) GetVestingStartTimeAfterSettlement() time.Duration {
 	return 0
 }
 
+func (m *MsgCreatePlan) GetPresale() Presale {
+	if m != nil {
+		return m.Presale
+	}
+	return Presale{}
+}
+
+func (m *MsgCreatePlan) GetTradingLimits() TradingLimits {
+	if m != nil {
+		return m.TradingLimits
+	}
+	return TradingLimits{}
+}
+
+func (m *MsgCreatePlan) GetBuyerVesting() BuyerVesting {
+	if m != nil {
+		return m.BuyerVesting
+	}
+	return BuyerVesting{}
+}
+
 type MsgCreatePlanResponse struct {
 	// The ID of the plan.
 	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -404,6 +433,9 @@ type MsgBuy struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	// The maximum cost this buy action can incur.
 	MaxCostAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_cost_amount,json=maxCostAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_cost_amount"`
+	// The proof of the buyer in the presale allowlist. Required for the first
+	// buy of the buyer during the presale.
+	PresaleProof *PresaleProof `protobuf:"bytes,5,opt,name=presale_proof,json=presaleProof,proto3" json:"presale_proof,omitempty"`
 }
 
 func (m *MsgBuy) Reset()         { *m = MsgBuy{} }
@@ -453,6 +485,13 @@ func (m *MsgBuy) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuy) GetPresaleProof() *PresaleProof {
+	if m != nil {
+		return m.PresaleProof
+	}
+	return nil
+}
+
 type MsgBuyExactSpend struct {
 	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
 	// The ID of the plan.
@@ -461,6 +500,9 @@ type MsgBuyExactSpend struct {
 	Spend cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spend,proto3,customtype=cosmossdk.io/math.Int" json:"spend"`
 	// The minimum tokens this buy action can provide.
 	MinOutTokensAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_out_tokens_amount,json=minOutTokensAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_out_tokens_amount"`
+	// The proof of the buyer in the presale allowlist. Required for the first
+	// buy of the buyer during the presale.
+	PresaleProof *PresaleProof `protobuf:"bytes,5,opt,name=presale_proof,json=presaleProof,proto3" json:"presale_proof,omitempty"`
 }
 
 func (m *MsgBuyExactSpend) Reset()         { *m = MsgBuyExactSpend{} }
@@ -510,6 +552,13 @@ func (m *MsgBuyExactSpend) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuyExactSpend) GetPresaleProof() *PresaleProof {
+	if m != nil {
+		return m.PresaleProof
+	}
+	return nil
+}
+
 type MsgBuyResponse struct {
 }
 
@@ -820,6 +869,188 @@ func (m *MsgClaimVestedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimVestedResponse proto.InternalMessageInfo
 
+// MsgFailPlan defines a message to fail a plan which is not settled.
+type MsgFailPlan struct {
+	// Authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgFailPlan) Reset()         { *m = MsgFailPlan{} }
+func (m *MsgFailPlan) String() string { return proto.CompactTextString(m) }
+func (*MsgFailPlan) ProtoMessage()    {}
+func (*MsgFailPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{15}
+}
+func (m *MsgFailPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFailPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFailPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFailPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFailPlan.Merge(m, src)
+}
+func (m *MsgFailPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFailPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFailPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFailPlan proto.InternalMessageInfo
+
+func (m *MsgFailPlan) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgFailPlan) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+type MsgFailPlanResponse struct {
+}
+
+func (m *MsgFailPlanResponse) Reset()         { *m = MsgFailPlanResponse{} }
+func (m *MsgFailPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFailPlanResponse) ProtoMessage()    {}
+func (*MsgFailPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{16}
+}
+func (m *MsgFailPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFailPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFailPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFailPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFailPlanResponse.Merge(m, src)
+}
+func (m *MsgFailPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFailPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFailPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFailPlanResponse proto.InternalMessageInfo
+
+// MsgRefund defines a message to redeem the IRO tokens for the raised
+// liquidity after the plan failed.
+type MsgRefund struct {
+	Claimer string `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgRefund) Reset()         { *m = MsgRefund{} }
+func (m *MsgRefund) String() string { return proto.CompactTextString(m) }
+func (*MsgRefund) ProtoMessage()    {}
+func (*MsgRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{17}
+}
+func (m *MsgRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefund.Merge(m, src)
+}
+func (m *MsgRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefund proto.InternalMessageInfo
+
+func (m *MsgRefund) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
+}
+
+func (m *MsgRefund) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+type MsgRefundResponse struct {
+}
+
+func (m *MsgRefundResponse) Reset()         { *m = MsgRefundResponse{} }
+func (m *MsgRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundResponse) ProtoMessage()    {}
+func (*MsgRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{18}
+}
+func (m *MsgRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundResponse.Merge(m, src)
+}
+func (m *MsgRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.iro.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.iro.MsgUpdateParamsResponse")
@@ -836,6 +1067,10 @@ func init() {
 	proto.RegisterType((*MsgClaimResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimResponse")
 	proto.RegisterType((*MsgClaimVested)(nil), "dymensionxyz.dymension.iro.MsgClaimVested")
 	proto.RegisterType((*MsgClaimVestedResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimVestedResponse")
+	proto.RegisterType((*MsgFailPlan)(nil), "dymensionxyz.dymension.iro.MsgFailPlan")
+	proto.RegisterType((*MsgFailPlanResponse)(nil), "dymensionxyz.dymension.iro.MsgFailPlanResponse")
+	proto.RegisterType((*MsgRefund)(nil), "dymensionxyz.dymension.iro.MsgRefund")
+	proto.RegisterType((*MsgRefundResponse)(nil), "dymensionxyz.dymension.iro.MsgRefundResponse")
 }
 
 func init() {
@@ -843,82 +1078,93 @@ func init() {
 }
 
 var fileDescriptor_41b9ae3e091bbd60 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0x42, 0xe2, 0xc4, 0x6f, 0xfe, 0xb2, 0x3f, 0xf2, 0xcb, 0xb2, 0x55, 0x1d, 0xb4, 0x41,
-	0x22, 0x0d, 0xb0, 0x4b, 0x42, 0xc5, 0x81, 0x5b, 0x9c, 0xa0, 0x2a, 0x55, 0x23, 0x90, 0x0d, 0x14,
-	0xb5, 0x52, 0x57, 0xe3, 0xdd, 0x61, 0x99, 0xb2, 0x3b, 0xe3, 0xee, 0xcc, 0x06, 0xbb, 0xa7, 0xaa,
-	0xc7, 0x9e, 0x38, 0xf6, 0x56, 0xa9, 0x9f, 0x80, 0x03, 0xdf, 0xa1, 0x1c, 0x29, 0xa7, 0xaa, 0x07,
-	0x5a, 0xc1, 0x81, 0x7b, 0x2f, 0x3d, 0xb6, 0x9a, 0x99, 0xdd, 0xb5, 0x1d, 0x1a, 0xdb, 0xa1, 0xe5,
-	0x14, 0xcf, 0xcc, 0x33, 0xcf, 0xf3, 0xfa, 0x79, 0xff, 0x64, 0x0c, 0x6b, 0x61, 0x37, 0xc1, 0x94,
-	0x13, 0x46, 0x3b, 0xdd, 0xaf, 0xbd, 0x72, 0xe1, 0x91, 0x94, 0x79, 0xa2, 0xe3, 0xb6, 0x53, 0x26,
-	0x98, 0x69, 0xf7, 0x83, 0xdc, 0x72, 0xe1, 0x92, 0x94, 0xd9, 0xa7, 0x23, 0x16, 0x31, 0x05, 0xf3,
-	0xe4, 0x27, 0x7d, 0xc3, 0x3e, 0x13, 0x30, 0x9e, 0x30, 0xee, 0xeb, 0x03, 0xbd, 0xc8, 0x8f, 0x56,
-	0xf4, 0xca, 0x4b, 0x78, 0xe4, 0x1d, 0x6c, 0xca, 0x3f, 0xf9, 0xc1, 0xb9, 0x21, 0xa1, 0x90, 0xb4,
-	0x60, 0xae, 0x45, 0x8c, 0x45, 0x31, 0xf6, 0xd4, 0xaa, 0x95, 0xdd, 0xf3, 0xc2, 0x2c, 0x45, 0x42,
-	0x46, 0xa3, 0xcf, 0x57, 0x0f, 0x9f, 0x0b, 0x92, 0x60, 0x2e, 0x50, 0xd2, 0x2e, 0x08, 0x72, 0xfd,
-	0x16, 0xe2, 0xd8, 0x3b, 0xd8, 0x6c, 0x61, 0x81, 0x36, 0xbd, 0x80, 0x91, 0x82, 0xe0, 0xfc, 0x90,
-	0x30, 0xda, 0x28, 0x45, 0x49, 0xfe, 0x45, 0x9c, 0x1f, 0x0d, 0x58, 0xdc, 0xe7, 0xd1, 0xed, 0x76,
-	0x88, 0x04, 0xbe, 0xa9, 0x4e, 0xcc, 0xab, 0x50, 0x45, 0x99, 0xb8, 0xcf, 0x52, 0x22, 0xba, 0x96,
-	0x71, 0xd6, 0x58, 0xaf, 0xd6, 0xad, 0xe7, 0x4f, 0x2e, 0x9d, 0xce, 0x1d, 0xd8, 0x0e, 0xc3, 0x14,
-	0x73, 0xde, 0x14, 0x29, 0xa1, 0x51, 0xa3, 0x07, 0x35, 0x3f, 0x02, 0xa0, 0xf8, 0xa1, 0xaf, 0xf9,
-	0xad, 0x13, 0x67, 0x8d, 0xf5, 0xd9, 0x2d, 0xc7, 0x3d, 0xda, 0x76, 0x57, 0xeb, 0xd5, 0x27, 0x9f,
-	0xbe, 0x58, 0x9d, 0x68, 0x54, 0x29, 0x7e, 0xa8, 0x37, 0xae, 0x2d, 0x7c, 0xfb, 0xfa, 0xf1, 0x46,
-	0x8f, 0xd8, 0x39, 0x03, 0x2b, 0x87, 0x62, 0x6c, 0x60, 0xde, 0x66, 0x94, 0x63, 0xe7, 0xe7, 0x69,
-	0x98, 0xdf, 0xe7, 0xd1, 0x4e, 0x8a, 0xe5, 0x59, 0x8c, 0xa8, 0xe9, 0xc2, 0x14, 0x7b, 0x48, 0x71,
-	0x3a, 0x32, 0x72, 0x0d, 0x33, 0xdf, 0x07, 0x48, 0x59, 0x1c, 0xa3, 0x76, 0xdb, 0x27, 0xa1, 0x8a,
-	0xba, 0xda, 0xa8, 0xe6, 0x3b, 0x7b, 0xa1, 0x79, 0x07, 0x96, 0x50, 0x1c, 0xb3, 0x00, 0x09, 0x1c,
-	0xfa, 0x28, 0x61, 0x19, 0x15, 0xd6, 0x49, 0xc5, 0x7c, 0x41, 0x86, 0xfd, 0xeb, 0x8b, 0xd5, 0x65,
-	0xcd, 0xce, 0xc3, 0x07, 0x2e, 0x61, 0x5e, 0x82, 0xc4, 0x7d, 0x77, 0x8f, 0x8a, 0xe7, 0x4f, 0x2e,
-	0x41, 0x2e, 0xbb, 0x47, 0x45, 0x63, 0xb1, 0x24, 0xd9, 0x56, 0x1c, 0x66, 0x13, 0xe6, 0x5b, 0x8c,
-	0x86, 0x84, 0x46, 0x7e, 0x90, 0xa5, 0x07, 0xd8, 0x9a, 0x54, 0x7e, 0xad, 0x0f, 0xf3, 0xab, 0xae,
-	0x2f, 0xec, 0x48, 0x7c, 0xee, 0xda, 0x5c, 0xab, 0x6f, 0xcf, 0x3c, 0x0f, 0x8b, 0x22, 0x45, 0x8a,
-	0x14, 0x53, 0xd4, 0x8a, 0x71, 0x68, 0x4d, 0x9d, 0x35, 0xd6, 0x67, 0x1a, 0x0b, 0xf9, 0xf6, 0x75,
-	0xbd, 0x6b, 0xee, 0x00, 0x70, 0x81, 0x52, 0xe1, 0xcb, 0xc2, 0xb2, 0x2a, 0x4a, 0xda, 0x76, 0x75,
-	0xd5, 0xb9, 0x45, 0xd5, 0xb9, 0xb7, 0x8a, 0xaa, 0xab, 0xcf, 0x48, 0xb1, 0x47, 0xbf, 0xad, 0x1a,
-	0x8d, 0xaa, 0xba, 0x27, 0x4f, 0xcc, 0x1b, 0x70, 0x8a, 0xa4, 0xcc, 0x6f, 0xc7, 0x88, 0xfa, 0x45,
-	0x01, 0x5b, 0xd3, 0x8a, 0xeb, 0xcc, 0x1b, 0x5c, 0xbb, 0x39, 0x40, 0x53, 0x7d, 0x2f, 0xa9, 0x16,
-	0x49, 0xca, 0x64, 0xca, 0x8a, 0x23, 0x93, 0xc0, 0x32, 0xa1, 0x01, 0xa6, 0x82, 0x1c, 0x60, 0x4d,
-	0x9b, 0xd7, 0xd2, 0x8c, 0x22, 0xf5, 0x86, 0x79, 0xb3, 0x57, 0x5c, 0x94, 0x8c, 0x03, 0x85, 0xf5,
-	0x3f, 0xf2, 0xe6, 0x91, 0x79, 0x17, 0x16, 0x62, 0xf2, 0x55, 0x46, 0x42, 0x22, 0xba, 0x52, 0x45,
-	0x58, 0x55, 0x95, 0xd4, 0xcd, 0x3c, 0xa9, 0xef, 0xbd, 0x99, 0xd4, 0x4f, 0x70, 0x84, 0x82, 0xee,
-	0x2e, 0x0e, 0xfa, 0x52, 0xbb, 0x8b, 0x83, 0xc6, 0x7c, 0x49, 0x74, 0x13, 0xa5, 0x42, 0xe6, 0xa0,
-	0xc7, 0x1c, 0x62, 0xca, 0x12, 0x0b, 0x54, 0x51, 0xf5, 0x04, 0x77, 0xe5, 0xae, 0x49, 0x60, 0xe9,
-	0x00, 0x73, 0x21, 0x93, 0x55, 0xba, 0x37, 0x3b, 0xca, 0xbd, 0x35, 0x19, 0xdf, 0x1f, 0x2f, 0x56,
-	0x57, 0xba, 0x28, 0x89, 0xaf, 0x39, 0x87, 0x09, 0x1c, 0x6d, 0x6c, 0xbe, 0x5d, 0x1a, 0xfb, 0x83,
-	0x01, 0x6b, 0x05, 0xb4, 0x97, 0x77, 0x1f, 0xdd, 0x13, 0x38, 0xf5, 0x39, 0x16, 0x22, 0xc6, 0x09,
-	0xa6, 0xc2, 0x9a, 0x1b, 0x25, 0x7f, 0x35, 0x97, 0xdf, 0x18, 0x94, 0x1f, 0xc2, 0xa9, 0x23, 0x5a,
-	0xcd, 0x91, 0xcd, 0xa2, 0x78, 0xb6, 0x25, 0xac, 0x59, 0xa2, 0xae, 0x81, 0x6c, 0x79, 0xdd, 0x91,
-	0xce, 0x65, 0x58, 0x1e, 0x68, 0xe9, 0xa2, 0xd9, 0xcd, 0x15, 0x98, 0x56, 0x55, 0x41, 0x42, 0xdd,
-	0xdc, 0x8d, 0x8a, 0x5c, 0xee, 0x85, 0x4e, 0x04, 0x4b, 0xfb, 0x3c, 0x2f, 0xee, 0x5b, 0xba, 0xd2,
-	0x8f, 0x3d, 0x07, 0xfa, 0xc8, 0x4f, 0xf4, 0x93, 0x0f, 0x84, 0x66, 0x83, 0x75, 0x58, 0xa8, 0x1c,
-	0x45, 0x7f, 0x1a, 0x50, 0xd9, 0xe7, 0x51, 0x3d, 0xeb, 0x4a, 0xed, 0x56, 0xd6, 0x1d, 0x47, 0x5b,
-	0xc1, 0x8e, 0xd4, 0x36, 0x77, 0xa0, 0xf2, 0xf6, 0x33, 0x27, 0xbf, 0x6a, 0x36, 0x61, 0x31, 0x41,
-	0x1d, 0x3f, 0x60, 0x5c, 0x14, 0x13, 0x6c, 0xf2, 0xf8, 0x6c, 0xf3, 0x09, 0xea, 0xec, 0x30, 0x2e,
-	0xf4, 0xfc, 0xca, 0x5d, 0x51, 0xe1, 0x3b, 0xdf, 0x9d, 0x50, 0xfe, 0xd7, 0xb3, 0xee, 0xf5, 0x0e,
-	0x0a, 0x44, 0xb3, 0x8d, 0x69, 0xf8, 0xdf, 0x79, 0xb0, 0x0d, 0x53, 0x5c, 0x32, 0xbe, 0x8d, 0x05,
-	0xfa, 0xa6, 0xf9, 0x05, 0x2c, 0x27, 0x84, 0xfa, 0x2c, 0x13, 0xbe, 0x60, 0x0f, 0x30, 0xe5, 0xff,
-	0xc2, 0x07, 0x33, 0x21, 0xf4, 0x46, 0x26, 0x6e, 0x29, 0x9e, 0x7f, 0x30, 0x63, 0x09, 0x16, 0xb4,
-	0x17, 0x65, 0x61, 0xfc, 0x65, 0xc0, 0xf4, 0x3e, 0x8f, 0x9a, 0x38, 0x8e, 0xcd, 0xcb, 0x50, 0xe1,
-	0x38, 0x8e, 0xc7, 0xb0, 0x25, 0xc7, 0xbd, 0xe3, 0xda, 0xf8, 0x14, 0x4e, 0x49, 0x67, 0x08, 0x0d,
-	0x98, 0x6c, 0xde, 0xb7, 0x76, 0x65, 0x31, 0x21, 0x74, 0x4f, 0x91, 0xe4, 0x96, 0xcc, 0x4a, 0x4b,
-	0xf2, 0xef, 0xe0, 0x9c, 0x52, 0x8f, 0x0c, 0x69, 0x40, 0x69, 0x0a, 0x86, 0x19, 0xd9, 0xe4, 0x31,
-	0x22, 0x89, 0xb9, 0x05, 0xd3, 0x81, 0xfc, 0x30, 0x86, 0x2b, 0x05, 0xf0, 0xe8, 0x76, 0x9d, 0x93,
-	0xc2, 0x05, 0xcc, 0x31, 0x55, 0x65, 0x2a, 0x99, 0x52, 0xfa, 0x81, 0xca, 0x90, 0xda, 0xbb, 0x83,
-	0xb9, 0xc0, 0xe1, 0xbb, 0x0c, 0xc0, 0x82, 0xff, 0x0f, 0x8a, 0x15, 0x61, 0x6c, 0xfd, 0x54, 0x81,
-	0x93, 0xfb, 0x3c, 0x32, 0xdb, 0x30, 0x37, 0xf0, 0xfc, 0xba, 0x30, 0xec, 0xdf, 0xdc, 0xa1, 0x77,
-	0x90, 0x7d, 0xe5, 0x18, 0xe0, 0x72, 0x8e, 0x7e, 0x09, 0xd0, 0xf7, 0x60, 0xfa, 0x60, 0x04, 0x45,
-	0x0f, 0x6a, 0x6f, 0x8e, 0x0d, 0x2d, 0xb5, 0x38, 0xcc, 0x0f, 0xce, 0xe5, 0x8b, 0x23, 0x38, 0x06,
-	0xd0, 0xf6, 0x87, 0xc7, 0x41, 0x97, 0xa2, 0xb7, 0xe1, 0xa4, 0x1c, 0xc3, 0xce, 0x88, 0xcb, 0xf5,
-	0xac, 0x6b, 0x6f, 0x8c, 0xc6, 0x94, 0xb4, 0x04, 0xe6, 0x07, 0x67, 0xdc, 0xc5, 0xd1, 0x97, 0x7b,
-	0xe8, 0x63, 0x49, 0xdd, 0x85, 0x49, 0x35, 0x2f, 0xd6, 0x46, 0xdc, 0x91, 0x20, 0xfb, 0xc2, 0x18,
-	0xa0, 0x92, 0xf9, 0x73, 0x98, 0xd2, 0x5d, 0x77, 0x6e, 0x54, 0x32, 0x25, 0xca, 0xbe, 0x38, 0x0e,
-	0xaa, 0x24, 0x4f, 0x60, 0xb6, 0xbf, 0xaf, 0x36, 0xc6, 0xb9, 0xac, 0xb1, 0xf6, 0xd6, 0xf8, 0xd8,
-	0x42, 0xce, 0x9e, 0xfa, 0xe6, 0xf5, 0xe3, 0x0d, 0xa3, 0xfe, 0xf1, 0xd3, 0x97, 0x35, 0xe3, 0xd9,
-	0xcb, 0x9a, 0xf1, 0xfb, 0xcb, 0x9a, 0xf1, 0xe8, 0x55, 0x6d, 0xe2, 0xd9, 0xab, 0xda, 0xc4, 0x2f,
-	0xaf, 0x6a, 0x13, 0x9f, 0x5d, 0x8e, 0x88, 0xb8, 0x9f, 0xb5, 0xdc, 0x80, 0x25, 0xde, 0x11, 0x3f,
-	0x89, 0x0e, 0xae, 0x78, 0x1d, 0xfd, 0x4b, 0xb1, 0xdb, 0xc6, 0xbc, 0x55, 0x51, 0x8f, 0x9e, 0x2b,
-	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x59, 0xcf, 0x69, 0x2f, 0x54, 0x0e, 0x00, 0x00,
+	// 1363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0x13, 0x47,
+	0x1b, 0x8e, 0x43, 0xe2, 0xc4, 0x6f, 0xe2, 0x38, 0x59, 0xc8, 0x97, 0x65, 0x3f, 0x7d, 0x0e, 0x72,
+	0xf8, 0x44, 0x08, 0xe0, 0x25, 0xa1, 0xe2, 0x90, 0x5b, 0x9c, 0xd0, 0x2a, 0x15, 0x16, 0xc8, 0x06,
+	0x8a, 0x5a, 0x89, 0xd5, 0xd8, 0x3b, 0x59, 0xa6, 0xec, 0xee, 0xb8, 0x3b, 0xb3, 0x21, 0xee, 0xa9,
+	0xea, 0xbd, 0x12, 0xc7, 0xde, 0x2a, 0xf5, 0x2f, 0xe0, 0xc0, 0x7f, 0x50, 0xa9, 0xe2, 0x88, 0x38,
+	0xb5, 0x3d, 0xd0, 0x0a, 0x0e, 0xdc, 0xfb, 0x0f, 0xb4, 0x9a, 0x1f, 0xbb, 0xb6, 0x83, 0xe2, 0x75,
+	0xd2, 0xe6, 0x64, 0xcf, 0xcc, 0xf3, 0x3e, 0xcf, 0xbb, 0xcf, 0xbc, 0xf3, 0xee, 0xd8, 0xb0, 0xe2,
+	0x76, 0x03, 0x1c, 0x32, 0x42, 0xc3, 0x83, 0xee, 0xd7, 0x76, 0x3a, 0xb0, 0x49, 0x44, 0x6d, 0x7e,
+	0x50, 0xed, 0x44, 0x94, 0x53, 0xc3, 0xea, 0x07, 0x55, 0xd3, 0x41, 0x95, 0x44, 0xd4, 0x3a, 0xe7,
+	0x51, 0x8f, 0x4a, 0x98, 0x2d, 0xbe, 0xa9, 0x08, 0xeb, 0x7c, 0x9b, 0xb2, 0x80, 0x32, 0x47, 0x2d,
+	0xa8, 0x81, 0x5e, 0x5a, 0x52, 0x23, 0x3b, 0x60, 0x9e, 0xbd, 0xbf, 0x2e, 0x3e, 0xf4, 0xc2, 0xc5,
+	0x21, 0xa9, 0x90, 0x28, 0x61, 0x2e, 0x7b, 0x94, 0x7a, 0x3e, 0xb6, 0xe5, 0xa8, 0x15, 0xef, 0xd9,
+	0x6e, 0x1c, 0x21, 0x2e, 0xb2, 0x51, 0xeb, 0xcb, 0x87, 0xd7, 0x39, 0x09, 0x30, 0xe3, 0x28, 0xe8,
+	0x24, 0x04, 0x5a, 0xbf, 0x85, 0x18, 0xb6, 0xf7, 0xd7, 0x5b, 0x98, 0xa3, 0x75, 0xbb, 0x4d, 0x49,
+	0x42, 0x70, 0x69, 0x48, 0x1a, 0x1d, 0x14, 0xa1, 0x40, 0x3f, 0x48, 0xe5, 0xc7, 0x1c, 0x94, 0xea,
+	0xcc, 0xbb, 0xdf, 0x71, 0x11, 0xc7, 0x77, 0xe5, 0x8a, 0x71, 0x13, 0x0a, 0x28, 0xe6, 0x8f, 0x69,
+	0x44, 0x78, 0xd7, 0xcc, 0x5d, 0xc8, 0xad, 0x16, 0x6a, 0xe6, 0xeb, 0x17, 0xd7, 0xce, 0x69, 0x07,
+	0xb6, 0x5c, 0x37, 0xc2, 0x8c, 0x35, 0x79, 0x44, 0x42, 0xaf, 0xd1, 0x83, 0x1a, 0x9f, 0x00, 0x84,
+	0xf8, 0xa9, 0xa3, 0xf8, 0xcd, 0xf1, 0x0b, 0xb9, 0xd5, 0x99, 0x8d, 0x4a, 0xf5, 0x68, 0xdb, 0xab,
+	0x4a, 0xaf, 0x36, 0xf1, 0xf2, 0xcd, 0xf2, 0x58, 0xa3, 0x10, 0xe2, 0xa7, 0x6a, 0x62, 0x73, 0xee,
+	0xdb, 0xf7, 0xcf, 0xd7, 0x7a, 0xc4, 0x95, 0xf3, 0xb0, 0x74, 0x28, 0xc7, 0x06, 0x66, 0x1d, 0x1a,
+	0x32, 0x5c, 0xf9, 0xa9, 0x00, 0xc5, 0x3a, 0xf3, 0xb6, 0x23, 0x2c, 0xd6, 0x7c, 0x14, 0x1a, 0x55,
+	0x98, 0xa4, 0x4f, 0x43, 0x1c, 0x65, 0x66, 0xae, 0x60, 0xc6, 0xff, 0x00, 0x22, 0xea, 0xfb, 0xa8,
+	0xd3, 0x71, 0x88, 0x2b, 0xb3, 0x2e, 0x34, 0x0a, 0x7a, 0x66, 0xd7, 0x35, 0x1e, 0xc0, 0x3c, 0xf2,
+	0x7d, 0xda, 0x46, 0x1c, 0xbb, 0x0e, 0x0a, 0x68, 0x1c, 0x72, 0xf3, 0x8c, 0x64, 0xbe, 0x22, 0xd2,
+	0xfe, 0xed, 0xcd, 0xf2, 0xa2, 0x62, 0x67, 0xee, 0x93, 0x2a, 0xa1, 0x76, 0x80, 0xf8, 0xe3, 0xea,
+	0x6e, 0xc8, 0x5f, 0xbf, 0xb8, 0x06, 0x5a, 0x76, 0x37, 0xe4, 0x8d, 0x52, 0x4a, 0xb2, 0x25, 0x39,
+	0x8c, 0x26, 0x14, 0x5b, 0x34, 0x74, 0x49, 0xe8, 0x39, 0xed, 0x38, 0xda, 0xc7, 0xe6, 0x84, 0xf4,
+	0x6b, 0x75, 0x98, 0x5f, 0x35, 0x15, 0xb0, 0x2d, 0xf0, 0xda, 0xb5, 0xd9, 0x56, 0xdf, 0x9c, 0x71,
+	0x09, 0x4a, 0x3c, 0x42, 0x92, 0x14, 0x87, 0xa8, 0xe5, 0x63, 0xd7, 0x9c, 0xbc, 0x90, 0x5b, 0x9d,
+	0x6e, 0xcc, 0xe9, 0xe9, 0x5b, 0x6a, 0xd6, 0xd8, 0x06, 0x60, 0x1c, 0x45, 0xdc, 0x11, 0x85, 0x65,
+	0xe6, 0xa5, 0xb4, 0x55, 0x55, 0x55, 0x57, 0x4d, 0xaa, 0xae, 0x7a, 0x2f, 0xa9, 0xba, 0xda, 0xb4,
+	0x10, 0x7b, 0xf6, 0xfb, 0x72, 0xae, 0x51, 0x90, 0x71, 0x62, 0xc5, 0xb8, 0x03, 0x0b, 0x24, 0xa2,
+	0x4e, 0xc7, 0x47, 0xa1, 0x93, 0x14, 0xb0, 0x39, 0x25, 0xb9, 0xce, 0x7f, 0xc0, 0xb5, 0xa3, 0x01,
+	0x8a, 0xea, 0x7b, 0x41, 0x55, 0x22, 0x11, 0x15, 0x5b, 0x96, 0x2c, 0x19, 0x04, 0x16, 0x49, 0xd8,
+	0xc6, 0x21, 0x27, 0xfb, 0x58, 0xd1, 0xea, 0x5a, 0x9a, 0x96, 0xa4, 0xf6, 0x30, 0x6f, 0x76, 0x93,
+	0x40, 0xc1, 0x38, 0x50, 0x58, 0x67, 0xc9, 0x87, 0x4b, 0xc6, 0x43, 0x98, 0xf3, 0xc9, 0x57, 0x31,
+	0x71, 0x09, 0xef, 0x0a, 0x15, 0x6e, 0x16, 0xe4, 0xa6, 0xae, 0xeb, 0x4d, 0xfd, 0xef, 0x87, 0x9b,
+	0x7a, 0x1b, 0x7b, 0xa8, 0xdd, 0xdd, 0xc1, 0xed, 0xbe, 0xad, 0xdd, 0xc1, 0xed, 0x46, 0x31, 0x25,
+	0xba, 0x8b, 0x22, 0x2e, 0xf6, 0xa0, 0xc7, 0xec, 0xe2, 0x90, 0x06, 0x26, 0xc8, 0xa2, 0xea, 0x09,
+	0xee, 0x88, 0x59, 0x83, 0xc0, 0xfc, 0x3e, 0x66, 0x5c, 0x6c, 0x56, 0xea, 0xde, 0x4c, 0x96, 0x7b,
+	0x2b, 0x22, 0xbf, 0x3f, 0xdf, 0x2c, 0x2f, 0x75, 0x51, 0xe0, 0x6f, 0x56, 0x0e, 0x13, 0x54, 0x94,
+	0xb1, 0x7a, 0x3a, 0x35, 0xf6, 0x87, 0x1c, 0xac, 0x24, 0xd0, 0xde, 0xbe, 0x3b, 0x68, 0x8f, 0xe3,
+	0xc8, 0x61, 0x98, 0x73, 0x1f, 0x07, 0x38, 0xe4, 0xe6, 0x6c, 0x96, 0xfc, 0x4d, 0x2d, 0xbf, 0x36,
+	0x28, 0x3f, 0x84, 0x53, 0x65, 0xb4, 0xac, 0x91, 0xcd, 0xa4, 0x78, 0xb6, 0x04, 0xac, 0x99, 0xa2,
+	0x8c, 0x6d, 0x98, 0xea, 0x44, 0x98, 0x21, 0x1f, 0x9b, 0x45, 0x99, 0xc4, 0xca, 0xd0, 0xc6, 0xa1,
+	0xa0, 0x7a, 0x83, 0x93, 0x48, 0xe3, 0x01, 0x24, 0x75, 0xee, 0xf8, 0x24, 0x20, 0x9c, 0x99, 0x73,
+	0x92, 0xeb, 0xf2, 0x30, 0xae, 0x7b, 0x2a, 0xe2, 0xb6, 0x0c, 0xd0, 0x8c, 0x45, 0xde, 0x3f, 0x29,
+	0xcf, 0x6a, 0xdc, 0xc5, 0x91, 0xa3, 0x9f, 0xc2, 0x2c, 0x8d, 0x70, 0x56, 0x45, 0xc0, 0x03, 0x85,
+	0x4f, 0xcf, 0x6a, 0xdf, 0xdc, 0x26, 0x88, 0x26, 0xa7, 0x7a, 0x50, 0xe5, 0x3a, 0x2c, 0x0e, 0x34,
+	0xb1, 0xa4, 0xbd, 0x19, 0x4b, 0x30, 0x25, 0xcf, 0x01, 0x71, 0x55, 0x3b, 0x6b, 0xe4, 0xc5, 0x70,
+	0xd7, 0xad, 0x78, 0x30, 0x5f, 0x67, 0xfa, 0x38, 0xeb, 0x27, 0x38, 0x76, 0xe7, 0xeb, 0x23, 0x1f,
+	0xef, 0x27, 0x1f, 0x48, 0xcd, 0x02, 0xf3, 0xb0, 0x50, 0xda, 0x7c, 0x7f, 0x1e, 0x87, 0x7c, 0x9d,
+	0x79, 0xb5, 0xb8, 0x2b, 0xb4, 0xe5, 0xd3, 0x65, 0x6b, 0x4b, 0xd8, 0x91, 0xda, 0xc6, 0x36, 0xe4,
+	0x4f, 0xde, 0x65, 0x75, 0xa8, 0xd1, 0x84, 0x52, 0x80, 0x0e, 0x9c, 0x36, 0x65, 0x3c, 0xe9, 0xd9,
+	0x13, 0xc7, 0x67, 0x2b, 0x06, 0xe8, 0x60, 0x9b, 0x32, 0xae, 0x3b, 0x76, 0x1d, 0x8a, 0xba, 0xd0,
+	0xc4, 0x8d, 0x80, 0xee, 0x99, 0x93, 0xd9, 0x55, 0xa0, 0x0b, 0xf5, 0xae, 0xc0, 0x37, 0x66, 0x3b,
+	0x7d, 0x23, 0x6d, 0xb2, 0x74, 0xa3, 0xf2, 0xeb, 0xb8, 0xdc, 0xce, 0x5a, 0xdc, 0xbd, 0x75, 0x80,
+	0xda, 0xbc, 0xd9, 0xc1, 0xa1, 0xfb, 0xef, 0x59, 0xba, 0x05, 0x93, 0x4c, 0x30, 0x9e, 0xc4, 0x51,
+	0x15, 0x69, 0x3c, 0x82, 0xc5, 0x80, 0x84, 0x0e, 0x8d, 0xb9, 0xc3, 0xe9, 0x13, 0x1c, 0xb2, 0x7f,
+	0x60, 0xab, 0x11, 0x90, 0xf0, 0x4e, 0xcc, 0xef, 0x49, 0x9e, 0xd3, 0xf7, 0x76, 0x1e, 0xe6, 0x94,
+	0xb5, 0x69, 0xd9, 0xfe, 0x95, 0x83, 0xa9, 0x3a, 0xf3, 0x9a, 0xd8, 0xf7, 0x8d, 0xeb, 0x90, 0x67,
+	0xd8, 0xf7, 0x47, 0x70, 0x59, 0xe3, 0x4e, 0xb9, 0x72, 0x3f, 0x83, 0x05, 0x61, 0x34, 0x09, 0xdb,
+	0x54, 0x34, 0xd3, 0x13, 0x9b, 0x5c, 0x0a, 0x48, 0xb8, 0x2b, 0x49, 0x94, 0xc3, 0x9b, 0x33, 0xc2,
+	0x12, 0xfd, 0x0c, 0x95, 0x05, 0x28, 0x69, 0x03, 0x52, 0x53, 0x30, 0x4c, 0x8b, 0x16, 0xe4, 0x23,
+	0x12, 0x18, 0x1b, 0x30, 0xd5, 0x16, 0x5f, 0x46, 0x70, 0x25, 0x01, 0x1e, 0xdd, 0x4c, 0x66, 0x85,
+	0x70, 0x02, 0xab, 0x18, 0x30, 0x9f, 0xc8, 0xa4, 0xd2, 0x4f, 0x60, 0x2e, 0x99, 0x13, 0xcd, 0x11,
+	0xbb, 0xa7, 0x99, 0x80, 0x09, 0xff, 0x19, 0x14, 0x4b, 0xd3, 0x08, 0x61, 0xa6, 0xce, 0xbc, 0x8f,
+	0x11, 0xf1, 0xe5, 0x3d, 0xf2, 0xa4, 0xb7, 0xe0, 0x23, 0xf3, 0x38, 0x7c, 0xab, 0x5d, 0x84, 0xb3,
+	0x7d, 0x7a, 0x69, 0x1a, 0x7b, 0x50, 0xa8, 0x33, 0xaf, 0x81, 0xf7, 0xe2, 0xf0, 0x54, 0x8d, 0x38,
+	0x0b, 0x0b, 0xa9, 0x4e, 0x22, 0xbe, 0xf1, 0xdd, 0x34, 0x9c, 0xa9, 0x33, 0xcf, 0xe8, 0xc0, 0xec,
+	0xc0, 0x4f, 0x82, 0x2b, 0xc3, 0x0e, 0xe2, 0xa1, 0xbb, 0xb9, 0x75, 0xe3, 0x18, 0xe0, 0xf4, 0x4d,
+	0xf7, 0x25, 0x40, 0xdf, 0x25, 0xfe, 0x72, 0x06, 0x45, 0x0f, 0x6a, 0xad, 0x8f, 0x0c, 0x4d, 0xb5,
+	0x18, 0x14, 0x07, 0xdf, 0x9c, 0x57, 0x33, 0x38, 0x06, 0xd0, 0xd6, 0x47, 0xc7, 0x41, 0xa7, 0xa2,
+	0xf7, 0xe1, 0x8c, 0x78, 0x51, 0x56, 0x32, 0x82, 0x6b, 0x71, 0xd7, 0x5a, 0xcb, 0xc6, 0xa4, 0xb4,
+	0x04, 0x8a, 0x83, 0xaf, 0x8d, 0xab, 0xd9, 0xc1, 0x3d, 0xf4, 0xb1, 0xa4, 0x1e, 0xc2, 0x84, 0xec,
+	0x99, 0x2b, 0x19, 0x31, 0x02, 0x64, 0x5d, 0x19, 0x01, 0x94, 0x32, 0x7f, 0x01, 0x93, 0xaa, 0xf3,
+	0x5c, 0xcc, 0xda, 0x4c, 0x81, 0xb2, 0xae, 0x8e, 0x82, 0x4a, 0xc9, 0x03, 0x98, 0xe9, 0xef, 0x2d,
+	0x6b, 0xa3, 0x04, 0x2b, 0xac, 0xb5, 0x31, 0x3a, 0x36, 0x95, 0x73, 0x61, 0x3a, 0xed, 0x21, 0x97,
+	0x32, 0xe2, 0x13, 0xa0, 0x65, 0x8f, 0x08, 0x4c, 0x55, 0x1e, 0x41, 0x5e, 0xb7, 0x88, 0xff, 0x67,
+	0x84, 0x2a, 0x98, 0x75, 0x6d, 0x24, 0x58, 0xc2, 0x6f, 0x4d, 0x7e, 0xf3, 0xfe, 0xf9, 0x5a, 0xae,
+	0xf6, 0xe9, 0xcb, 0xb7, 0xe5, 0xdc, 0xab, 0xb7, 0xe5, 0xdc, 0x1f, 0x6f, 0xcb, 0xb9, 0x67, 0xef,
+	0xca, 0x63, 0xaf, 0xde, 0x95, 0xc7, 0x7e, 0x79, 0x57, 0x1e, 0xfb, 0xfc, 0xba, 0x47, 0xf8, 0xe3,
+	0xb8, 0x55, 0x6d, 0xd3, 0xc0, 0x3e, 0xe2, 0xcf, 0x86, 0xfd, 0x1b, 0xf6, 0x81, 0xfa, 0x0f, 0xa6,
+	0xdb, 0xc1, 0xac, 0x95, 0x97, 0x3f, 0x27, 0x6e, 0xfc, 0x3d, 0x00, 0x62, 0xc2, 0x00, 0x73, 0xae,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -946,6 +1192,11 @@ type MsgClient interface {
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
 	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// FailPlan is used by the governance to fail a plan which is not settled.
+	FailPlan(ctx context.Context, in *MsgFailPlan, opts ...grpc.CallOption) (*MsgFailPlanResponse, error)
+	// Refund is used to redeem the IRO tokens for the raised liquidity after
+	// the plan failed.
+	Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error)
 }
 
 type msgClient struct {
@@ -1028,6 +1279,24 @@ func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ..
 	return out, nil
 }
 
+func (c *msgClient) FailPlan(ctx context.Context, in *MsgFailPlan, opts ...grpc.CallOption) (*MsgFailPlanResponse, error) {
+	out := new(MsgFailPlanResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/FailPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error) {
+	out := new(MsgRefundResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -1043,6 +1312,11 @@ type MsgServer interface {
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
 	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// FailPlan is used by the governance to fail a plan which is not settled.
+	FailPlan(context.Context, *MsgFailPlan) (*MsgFailPlanResponse, error)
+	// Refund is used to redeem the IRO tokens for the raised liquidity after
+	// the plan failed.
+	Refund(context.Context, *MsgRefund) (*MsgRefundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1073,6 +1347,12 @@ func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgCl
 func (*UnimplementedMsgServer) ClaimVested(ctx context.Context, req *MsgClaimVested) (*MsgClaimVestedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
 }
+func (*UnimplementedMsgServer) FailPlan(ctx context.Context, req *MsgFailPlan) (*MsgFailPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailPlan not implemented")
+}
+func (*UnimplementedMsgServer) Refund(ctx context.Context, req *MsgRefund) (*MsgRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1222,6 +1502,42 @@ func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FailPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFailPlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FailPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/FailPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FailPlan(ctx, req.(*MsgFailPlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Refund(ctx, req.(*MsgRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.iro.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1258,6 +1574,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimVested",
 			Handler:    _Msg_ClaimVested_Handler,
 		},
+		{
+			MethodName: "FailPlan",
+			Handler:    _Msg_FailPlan_Handler,
+		},
+		{
+			MethodName: "Refund",
+			Handler:    _Msg_Refund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/iro/tx.proto",
@@ -1346,20 +1670,50 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement):])
-	if err2 != nil {
-		return 0, err2
+	{
+		size, err := m.BuyerVesting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.TradingLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.Presale.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x62
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
-	if err3 != nil {
-		return 0, err3
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x5a
 	if len(m.LiquidityDenom) > 0 {
@@ -1389,20 +1743,20 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x3a
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x32
 	if m.TradingEnabled {
@@ -1562,6 +1916,18 @@ func (m *MsgBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PresaleProof != nil {
+		{
+			size, err := m.PresaleProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.MaxCostAmount.Size()
 		i -= size
@@ -1619,6 +1985,18 @@ func (m *MsgBuyExactSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PresaleProof != nil {
+		{
+			size, err := m.PresaleProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.MinOutTokensAmount.Size()
 		i -= size
@@ -1879,25 +2257,145 @@ func (m *MsgClaimVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFailPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
+
+func (m *MsgFailPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFailPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFailPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFailPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFailPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.NewParams.Size()
@@ -1951,6 +2449,12 @@ func (m *MsgCreatePlan) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement)
 	n += 1 + l + sovTx(uint64(l))
+	l = m.Presale.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TradingLimits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BuyerVesting.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -2011,6 +2515,10 @@ func (m *MsgBuy) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MaxCostAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.PresaleProof != nil {
+		l = m.PresaleProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2032,6 +2540,10 @@ func (m *MsgBuyExactSpend) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinOutTokensAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.PresaleProof != nil {
+		l = m.PresaleProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2126,6 +2638,58 @@ func (m *MsgClaimVestedResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFailPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFailPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2708,61 +3272,44 @@ func (m *MsgCreatePlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Presale", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Presale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePlanResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2772,26 +3319,142 @@ func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			if err := m.TradingLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -2891,18 +3554,504 @@ func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableTradingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCostAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresaleProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PresaleProof == nil {
+				m.PresaleProof = &PresaleProof{}
+			}
+			if err := m.PresaleProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyExactSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyExactSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinOutTokensAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinOutTokensAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PresaleProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			if m.PresaleProof == nil {
+				m.PresaleProof = &PresaleProof{}
+			}
+			if err := m.PresaleProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2925,7 +4074,7 @@ func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2948,10 +4097,10 @@ func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableTradingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2975,7 +4124,7 @@ func (m *MsgEnableTradingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuy) Unmarshal(dAtA []byte) error {
+func (m *MsgSell) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2998,15 +4147,15 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSell: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSell: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3034,7 +4183,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3104,7 +4253,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCostAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncomeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3132,7 +4281,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxCostAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinIncomeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3157,7 +4306,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
+func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3180,79 +4329,65 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyExactSpend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyExactSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3280,13 +4415,11 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinOutTokensAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3314,9 +4447,7 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinOutTokensAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3339,7 +4470,7 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3362,10 +4493,10 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3389,7 +4520,7 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSell) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3412,15 +4543,15 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSell: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSell: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3448,7 +4579,7 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3482,74 +4613,6 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinIncomeAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinIncomeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3571,7 +4634,7 @@ func (m *MsgSell) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3594,10 +4657,10 @@ func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3621,7 +4684,7 @@ func (m *MsgSellResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgFailPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3644,15 +4707,15 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFailPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFailPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3680,7 +4743,7 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3735,7 +4798,7 @@ func (m *MsgClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFailPlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3758,10 +4821,10 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFailPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFailPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3785,7 +4848,7 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
+func (m *MsgRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3808,10 +4871,10 @@ func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimVested: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimVested: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3899,7 +4962,7 @@ func (m *MsgClaimVested) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3922,10 +4985,10 @@ func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimVestedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -126,7 +126,9 @@ func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientSt
 	}
 	baseHeight := k.GetFirstConsensusStateHeight(ctx, clientID)
 	atLeastOneMatch := false
-	for i := sinfo.Index; i > 0; i-- {
+	// consensus states for heights of pruned state infos cannot be validated, they are of finalized states
+	first := k.rollappKeeper.FirstRetainedStateIndex(ctx, rollappId)
+	for i := sinfo.Index; i >= first && i > 0; i-- {
 		sInfo, ok := k.rollappKeeper.GetStateInfo(ctx, rollappId, i)
 		if !ok {
 			return errorsmod.Wrap(gerrc.ErrInternal, "get state info")
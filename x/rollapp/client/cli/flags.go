@@ -5,14 +5,18 @@ import (
 )
 
 const (
-	FlagInitSequencer    = "init-sequencer"
-	FlagMinSequencerBond = "min-sequencer-bond"
-	FlagGenesisChecksum  = "genesis-checksum"
-	FlagNativeDenom      = "native-denom"
-	FlagInitialSupply    = "initial-supply"
-	FlagMetadata         = "metadata"
-	FlagBech32Prefix     = "bech32-prefix"
-	FlagGenesisAccounts  = "genesis-accounts"
+	FlagInitSequencer     = "init-sequencer"
+	FlagMinSequencerBond  = "min-sequencer-bond"
+	FlagGenesisChecksum   = "genesis-checksum"
+	FlagNativeDenom       = "native-denom"
+	FlagInitialSupply     = "initial-supply"
+	FlagMetadata          = "metadata"
+	FlagBech32Prefix      = "bech32-prefix"
+	FlagGenesisAccounts   = "genesis-accounts"
+	FlagDisputePeriod     = "dispute-period"
+	FlagDAScheme          = "da-scheme"
+	FlagProposerSelection = "proposer-selection"
+	FlagWarningsPageKey   = "warnings-page-key"
 )
 
 // FlagSetUpdateRollapp returns flags for updating rollapps.
@@ -27,6 +31,8 @@ func FlagSetUpdateRollapp() *flag.FlagSet {
 	fs.String(FlagMetadata, "", "The metadata of the rollapp")
 	fs.String(FlagBech32Prefix, "", "Bech32 prefix of the rollapp")
 	fs.String(FlagGenesisAccounts, "", "<address>:<amount>,<address>:<amount>")
+	fs.Uint64(FlagDisputePeriod, 0, "Dispute period of the rollapp in hub blocks, within the bounds of the module params")
+	fs.String(FlagProposerSelection, "", "Proposer selection strategy: PROPOSER_SELECTION_MAX_BOND, PROPOSER_SELECTION_ROUND_ROBIN, PROPOSER_SELECTION_STAKE_WEIGHTED_RANDOM or PROPOSER_SELECTION_LOWEST_DISHONOR")
 
 	return fs
 }
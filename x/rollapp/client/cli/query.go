@@ -27,6 +27,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowLatestHeight())
 	cmd.AddCommand(CmdShowLatestStateIndex())
 	cmd.AddCommand(CmdQueryRegisteredDenoms())
+	cmd.AddCommand(CmdQueryUpcomingLivenessEvents())
+	cmd.AddCommand(CmdQueryFraudChallenge())
+	cmd.AddCommand(CmdQueryPendingOwnershipTransfer())
+	cmd.AddCommand(CmdQueryDRSVersions())
+	cmd.AddCommand(CmdQueryDRSVersion())
+	cmd.AddCommand(CmdQueryStateRootAtHeight())
 
 	return cmd
 }
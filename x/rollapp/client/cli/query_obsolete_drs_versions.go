@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -33,3 +35,59 @@ func CmdQueryObsoleteDRSVersions() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryDRSVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drs-versions",
+		Short: "shows the registered DRS versions, with their status and upgrade deadline",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DRSVersions(cmd.Context(), &types.QueryDRSVersionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDRSVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drs-version [version]",
+		Short: "shows a registered DRS version, with its status and upgrade deadline",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			version, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DRSVersion(cmd.Context(), &types.QueryDRSVersionRequest{Version: uint32(version)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
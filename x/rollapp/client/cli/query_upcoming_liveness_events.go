@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdQueryUpcomingLivenessEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-liveness-events [rollapp-id]",
+		Short: "shows the upcoming liveness slashes and warnings, of all rollapps or of a single rollapp",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// the warnings are paginated with the same flags, except for their own page key
+			warningsPageReq := *pageReq
+			warningsPageKey, err := cmd.Flags().GetString(FlagWarningsPageKey)
+			if err != nil {
+				return err
+			}
+			warningsPageReq.Key = []byte(warningsPageKey)
+
+			req := &types.QueryUpcomingLivenessEventsRequest{Pagination: pageReq, WarningsPagination: &warningsPageReq}
+			if len(args) == 1 {
+				req.RollappId = args[0]
+			}
+
+			res, err := queryClient.UpcomingLivenessEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	cmd.Flags().String(FlagWarningsPageKey, "", "pagination page-key of the warnings")
+
+	return cmd
+}
@@ -22,9 +22,14 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateRollapp())
 	cmd.AddCommand(CmdUpdateRollapp())
 	cmd.AddCommand(CmdTransferOwnership())
+	cmd.AddCommand(CmdAcceptOwnership())
+	cmd.AddCommand(CmdCancelOwnershipTransfer())
 	cmd.AddCommand(CmdAddApp())
 	cmd.AddCommand(CmdUpdateApp())
 	cmd.AddCommand(CmdRemoveApp())
+	cmd.AddCommand(CmdSubmitFraudChallenge())
+	cmd.AddCommand(CmdBisectFraudChallenge())
+	cmd.AddCommand(CmdProveFraudChallenge())
 
 	return cmd
 }
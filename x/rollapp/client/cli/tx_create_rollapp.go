@@ -21,7 +21,7 @@ func CmdCreateRollapp() *cobra.Command {
 		Use:   "create-rollapp [rollapp-id] [alias] [vm-type]",
 		Short: "Create a new rollapp",
 		Example: `
-		dymd tx rollapp create-rollapp myrollapp_12345-1 RollappAlias EVM 
+		dymd tx rollapp create-rollapp myrollapp_12345-1 RollappAlias EVM --da-scheme celestia
 		// optional flags:
 		--init-sequencer '<seq_address1>,<seq_address2>'
         --min-sequencer-bond 100
@@ -68,6 +68,11 @@ func CmdCreateRollapp() *cobra.Command {
 				return err
 			}
 
+			daScheme, err := cmd.Flags().GetString(FlagDAScheme)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -83,12 +88,14 @@ func CmdCreateRollapp() *cobra.Command {
 				metadata,
 				genesisInfo,
 			)
+			msg.DaScheme = daScheme
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().AddFlagSet(FlagSetUpdateRollapp())
+	cmd.Flags().String(FlagDAScheme, "", "The DA layer the rollapp posts its batches to, e.g. celestia (required)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdSubmitFraudChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-fraud-challenge [rollapp-id] [state-index]",
+		Short:   "Dispute a pending state update, locking the fraud challenge bond",
+		Example: "dymd tx rollapp submit-fraud-challenge ROLLAPP_CHAIN_ID 42",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stateIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitFraudChallenge{
+				Challenger: clientCtx.GetFromAddress().String(),
+				RollappId:  args[0],
+				StateIndex: stateIndex,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBisectFraudChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bisect-fraud-challenge [rollapp-id] [height] [state-root-hex]",
+		Short:   "Give the state root claimed at the middle of the disputed range of a fraud challenge",
+		Example: "dymd tx rollapp bisect-fraud-challenge ROLLAPP_CHAIN_ID 1050 4f2a...",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			stateRoot, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBisectFraudChallenge{
+				Challenger: clientCtx.GetFromAddress().String(),
+				RollappId:  args[0],
+				Height:     height,
+				StateRoot:  stateRoot,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdProveFraudChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "prove-fraud-challenge [rollapp-id] [proof-hex] [state-root-hex]",
+		Short:   "Prove the disputed block of a fraud challenge, with the state root of the sequencer or another one to prove the fraud",
+		Example: "dymd tx rollapp prove-fraud-challenge ROLLAPP_CHAIN_ID 0a1b... 4f2a...",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proof, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			var stateRoot []byte
+			if len(args) == 3 {
+				stateRoot, err = hex.DecodeString(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProveFraudChallenge{
+				Prover:    clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Proof:     proof,
+				StateRoot: stateRoot,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -12,7 +12,7 @@ import (
 func CmdTransferOwnership() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "transfer-ownership [rollapp-id] [new-owner]",
-		Short:   "Transfer ownership of a rollapp to a new owner",
+		Short:   "Propose to transfer ownership of a rollapp to a new owner, who must accept it",
 		Example: "dymd tx rollapp transfer-ownership ROLLAPP_CHAIN_ID <new_owner_address>",
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -38,3 +38,55 @@ func CmdTransferOwnership() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAcceptOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-ownership [rollapp-id]",
+		Short:   "Accept the pending transfer of ownership of a rollapp",
+		Example: "dymd tx rollapp accept-ownership ROLLAPP_CHAIN_ID",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptOwnership(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-ownership-transfer [rollapp-id]",
+		Short:   "Cancel the pending transfer of ownership of a rollapp",
+		Example: "dymd tx rollapp cancel-ownership-transfer ROLLAPP_CHAIN_ID",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOwnershipTransfer(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -28,7 +28,9 @@ func CmdUpdateRollapp() *cobra.Command {
 		--initial-supply 1000000
 		--native-denom native_denom.json
 		--genesis-accounts '<acc1>:1000000,<acc2>:1000000'
-		--metadata metadata.json`,
+		--metadata metadata.json
+		--dispute-period 120960
+		--proposer-selection PROPOSER_SELECTION_ROUND_ROBIN`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argRollappId := args[0]
@@ -62,6 +64,24 @@ func CmdUpdateRollapp() *cobra.Command {
 				return
 			}
 
+			disputePeriod, err := cmd.Flags().GetUint64(FlagDisputePeriod)
+			if err != nil {
+				return
+			}
+
+			proposerSelectionS, err := cmd.Flags().GetString(FlagProposerSelection)
+			if err != nil {
+				return
+			}
+			var proposerSelection types.ProposerSelection
+			if proposerSelectionS != "" {
+				v, ok := types.ProposerSelection_value[proposerSelectionS]
+				if !ok {
+					return fmt.Errorf("invalid proposer selection: %s", proposerSelectionS)
+				}
+				proposerSelection = types.ProposerSelection(v)
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return
@@ -75,6 +95,8 @@ func CmdUpdateRollapp() *cobra.Command {
 				metadata,
 				genesisInfo,
 			)
+			msg.DisputePeriodInBlocks = disputePeriod
+			msg.ProposerSelection = proposerSelection
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
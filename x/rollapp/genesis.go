@@ -16,6 +16,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the stateInfo
 	for _, elem := range genState.StateInfoList {
 		k.SetStateInfo(ctx, elem)
+		if err := k.IndexStateInfoHeights(ctx, elem); err != nil {
+			panic(err)
+		}
 	}
 	// Set all the latestStateInfoIndex
 	for _, elem := range genState.LatestStateInfoIndexList {
@@ -32,6 +35,31 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.LivenessEvents {
 		k.PutLivenessEvent(ctx, elem)
 	}
+	for _, elem := range genState.LivenessWarnings {
+		if err := k.PutLivenessWarning(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.FraudChallenges {
+		if err := k.SetFraudChallenge(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.PrunedStateIndexes {
+		if err := k.SetPrunedStateIndex(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.PendingOwnershipTransfers {
+		if err := k.SetPendingOwnershipTransfer(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.DrsVersions {
+		if err := k.SetDRSVersion(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
 	// Set all the app
 	for _, elem := range genState.AppList {
 		k.SetApp(ctx, elem)
@@ -77,6 +105,26 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.BlockHeightToFinalizationQueueList = finalizationQueue
 	genesis.LivenessEvents = k.GetLivenessEvents(ctx, nil)
+	genesis.LivenessWarnings, err = k.GetLivenessWarnings(ctx, "")
+	if err != nil {
+		panic(err)
+	}
+	genesis.FraudChallenges, err = k.GetAllFraudChallenges(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PrunedStateIndexes, err = k.GetAllPrunedStateIndexes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PendingOwnershipTransfers, err = k.GetAllPendingOwnershipTransfers(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.DrsVersions, err = k.GetAllDRSVersions(ctx)
+	if err != nil {
+		panic(err)
+	}
 	apps := k.GetRollappApps(ctx, "")
 	var appList []types.App
 	for _, app := range apps {
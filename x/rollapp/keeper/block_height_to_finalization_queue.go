@@ -58,24 +58,38 @@ func (k Keeper) AllSequencerHeightPairs(ctx sdk.Context) ([]types.SequencerHeigh
 }
 
 // FinalizeRollappStates is called every block to finalize states when their dispute period over.
+// The dispute period can differ per rollapp, so the queues are looked up by the finalization height they got
+// when they were created. Rollapps with an active fraud challenge are not finalized until it is resolved.
 func (k Keeper) FinalizeRollappStates(ctx sdk.Context) {
 	h := uint64(ctx.BlockHeight()) //nolint:gosec
-	if h < k.DisputePeriodInBlocks(ctx) {
-		// hub just started
-		return
-	}
 	// check to see if there are pending  states to be finalized
-	finalizationHeight := h - k.DisputePeriodInBlocks(ctx)
-	queue, err := k.GetFinalizationQueueUntilHeightInclusive(ctx, finalizationHeight)
+	queue, err := k.GetFinalizationQueueDueInclusive(ctx, h)
 	if err != nil {
 		// The error is returned only if there is an internal issue with the store iterator or encoding.
 		// This should never happen in practice.
-		k.Logger(ctx).With("error", err, "height", finalizationHeight).
-			Error("failed to get finalization queue until height")
+		k.Logger(ctx).With("error", err, "height", h).
+			Error("failed to get finalization queue due until height")
 		return
 	}
 
-	k.FinalizeAllPending(ctx, queue)
+	challenged := make(map[string]bool)
+	due := queue[:0]
+	for _, q := range queue {
+		c, ok := challenged[q.RollappId]
+		if !ok {
+			c, err = k.HasFraudChallenge(ctx, q.RollappId)
+			if err != nil {
+				k.Logger(ctx).Error("Has fraud challenge.", "rollapp", q.RollappId, "err", err)
+				c = true
+			}
+			challenged[q.RollappId] = c
+		}
+		if !c {
+			due = append(due, q)
+		}
+	}
+
+	k.FinalizeAllPending(ctx, due)
 }
 
 // FinalizeAllPending is called every block to finalize all pending states in the queue.
@@ -178,8 +192,49 @@ func (k *Keeper) finalizePendingState(ctx sdk.Context, stateInfoIndex types.Stat
 }
 
 // SetFinalizationQueue set types.BlockHeightToFinalizationQueue for a specific height and rollappID.
+// The finalization height of the queue is set when the queue is created.
 func (k Keeper) SetFinalizationQueue(ctx sdk.Context, queue types.BlockHeightToFinalizationQueue) error {
-	return k.finalizationQueue.Set(ctx, collections.Join(queue.CreationHeight, queue.RollappId), queue)
+	key := collections.Join(queue.CreationHeight, queue.RollappId)
+	ok, err := k.finalizationHeights.Has(ctx, key)
+	if err != nil {
+		return errorsmod.Wrap(err, "has finalization height")
+	}
+	if !ok {
+		h, err := k.nextFinalizationHeight(ctx, queue.CreationHeight, queue.RollappId)
+		if err != nil {
+			return errorsmod.Wrap(err, "next finalization height")
+		}
+		if err := k.finalizationHeights.Set(ctx, key, h); err != nil {
+			return errorsmod.Wrap(err, "set finalization height")
+		}
+	}
+	return k.finalizationQueue.Set(ctx, key, queue)
+}
+
+// nextFinalizationHeight returns the finalization height of a new queue of the rollapp: the creation height plus the
+// dispute period of the rollapp, but not before the latest queue of the rollapp, so the states stay finalized in order
+// when the dispute period is shortened.
+func (k Keeper) nextFinalizationHeight(ctx sdk.Context, creationHeight uint64, rollappID string) (uint64, error) {
+	h := creationHeight + k.RollappDisputePeriodInBlocks(ctx, rollappID)
+
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID).Descending()
+	iter, err := k.finalizationQueue.Indexes.RollappIDReverseLookup.Iterate(ctx, rng)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close() // nolint: errcheck
+	if !iter.Valid() {
+		return h, nil
+	}
+	key, err := iter.PrimaryKey()
+	if err != nil {
+		return 0, err
+	}
+	latest, err := k.finalizationHeights.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	return max(h, latest), nil
 }
 
 // MustSetFinalizationQueue is a wrapper for SetFinalizationQueue that panics on error.
@@ -204,7 +259,17 @@ func (k Keeper) GetFinalizationQueue(ctx sdk.Context, height uint64, rollappID s
 
 // RemoveFinalizationQueue removes types.BlockHeightToFinalizationQueue.
 func (k Keeper) RemoveFinalizationQueue(ctx sdk.Context, height uint64, rollappID string) error {
-	return k.finalizationQueue.Remove(ctx, collections.Join(height, rollappID))
+	key := collections.Join(height, rollappID)
+	ok, err := k.finalizationHeights.Has(ctx, key)
+	if err != nil {
+		return errorsmod.Wrap(err, "has finalization height")
+	}
+	if ok {
+		if err := k.finalizationHeights.Remove(ctx, key); err != nil {
+			return errorsmod.Wrap(err, "remove finalization height")
+		}
+	}
+	return k.finalizationQueue.Remove(ctx, key)
 }
 
 // MustRemoveFinalizationQueue is a wrapper for RemoveFinalizationQueue that panics on error.
@@ -227,6 +292,45 @@ func (k Keeper) GetFinalizationQueueUntilHeightInclusive(ctx sdk.Context, height
 	return iter.Values()
 }
 
+// GetFinalizationQueueDueInclusive returns all types.BlockHeightToFinalizationQueue with finalization height equal or
+// less to the input height, ordered by finalization height, then creation height and rollappID.
+func (k Keeper) GetFinalizationQueueDueInclusive(ctx sdk.Context, height uint64) ([]types.BlockHeightToFinalizationQueue, error) {
+	rng := collections.NewPrefixUntilPairRange[uint64, collections.Pair[uint64, string]](height)
+	iter, err := k.finalizationHeights.Indexes.ByHeight.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	var res []types.BlockHeightToFinalizationQueue
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, err
+		}
+		queue, err := k.finalizationQueue.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, queue)
+	}
+	return res, nil
+}
+
+// MigrateFinalizationHeights sets the finalization height of all the queues from the current dispute periods
+func (k Keeper) MigrateFinalizationHeights(ctx sdk.Context) error {
+	queues, err := k.GetEntireFinalizationQueue(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "get entire finalization queue")
+	}
+	for _, q := range queues {
+		h := q.CreationHeight + k.RollappDisputePeriodInBlocks(ctx, q.RollappId)
+		if err := k.finalizationHeights.Set(ctx, collections.Join(q.CreationHeight, q.RollappId), h); err != nil {
+			return errorsmod.Wrap(err, "set finalization height")
+		}
+	}
+	return nil
+}
+
 // GetFinalizationQueueByRollapp returns all states from different heights associated with a given rollapp
 func (k Keeper) GetFinalizationQueueByRollapp(ctx sdk.Context, rollapp string) ([]types.BlockHeightToFinalizationQueue, error) {
 	iter, err := k.finalizationQueue.Indexes.RollappIDReverseLookup.MatchExact(ctx, rollapp)
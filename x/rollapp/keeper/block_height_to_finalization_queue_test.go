@@ -467,6 +467,110 @@ func (s *RollappTestSuite) TestFinalize() {
 	s.True(findEvent(response, types.EventTypeStatusChange))
 }
 
+func (s *RollappTestSuite) TestFinalizeRollappDisputePeriod() {
+	s.SetupTest()
+
+	k := s.k()
+	k.SetParams(s.Ctx, k.GetParams(s.Ctx).WithDisputePeriodInBlocks(3).WithDisputePeriodBounds(2, 10))
+
+	short, shortProposer := s.CreateDefaultRollappAndProposer()
+	long, longProposer := s.CreateDefaultRollappAndProposer()
+
+	// out of bounds
+	_, err := s.msgServer.UpdateRollappInformation(s.Ctx, &types.MsgUpdateRollappInformation{
+		Owner:                 alice,
+		RollappId:             long,
+		DisputePeriodInBlocks: 11,
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrInvalidDisputePeriod))
+
+	_, err = s.msgServer.UpdateRollappInformation(s.Ctx, &types.MsgUpdateRollappInformation{
+		Owner:                 alice,
+		RollappId:             long,
+		DisputePeriodInBlocks: 6,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), k.RollappDisputePeriodInBlocks(s.Ctx, short))
+	s.Require().Equal(uint64(6), k.RollappDisputePeriodInBlocks(s.Ctx, long))
+
+	initialHeight := int64(10)
+	s.Ctx = s.Ctx.WithBlockHeight(initialHeight)
+	_, err = s.PostStateUpdate(s.Ctx, short, shortProposer, 1, 10)
+	s.Require().NoError(err)
+	_, err = s.PostStateUpdate(s.Ctx, long, longProposer, 1, 10)
+	s.Require().NoError(err)
+
+	// only the rollapp with the default period is finalized
+	s.Ctx = s.Ctx.WithBlockHeight(initialHeight + 3)
+	_, err = s.App.EndBlocker(s.Ctx)
+	s.Require().NoError(err)
+	queue, err := k.GetEntireFinalizationQueue(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(queue, 1)
+	s.Require().Equal(long, queue[0].RollappId)
+
+	s.Ctx = s.Ctx.WithBlockHeight(initialHeight + 5)
+	_, err = s.App.EndBlocker(s.Ctx)
+	s.Require().NoError(err)
+	queue, err = k.GetEntireFinalizationQueue(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(queue, 1)
+
+	s.Ctx = s.Ctx.WithBlockHeight(initialHeight + 6)
+	_, err = s.App.EndBlocker(s.Ctx)
+	s.Require().NoError(err)
+	queue, err = k.GetEntireFinalizationQueue(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(queue, 0)
+}
+
+func (s *RollappTestSuite) TestFinalizationHeightIndex() {
+	s.SetupTest()
+
+	k := s.k()
+	k.SetParams(s.Ctx, k.GetParams(s.Ctx).WithDisputePeriodInBlocks(3).WithDisputePeriodBounds(2, 10))
+
+	rollapp, proposer := s.CreateDefaultRollappAndProposer()
+	_, err := s.msgServer.UpdateRollappInformation(s.Ctx, &types.MsgUpdateRollappInformation{
+		Owner:                 alice,
+		RollappId:             rollapp,
+		DisputePeriodInBlocks: 8,
+	})
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	_, err = s.PostStateUpdate(s.Ctx, rollapp, proposer, 1, 10)
+	s.Require().NoError(err)
+
+	// the period is shortened: the new queue is not due before the earlier one
+	_, err = s.msgServer.UpdateRollappInformation(s.Ctx, &types.MsgUpdateRollappInformation{
+		Owner:                 alice,
+		RollappId:             rollapp,
+		DisputePeriodInBlocks: 2,
+	})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	_, err = s.PostStateUpdate(s.Ctx, rollapp, proposer, 11, 10)
+	s.Require().NoError(err)
+
+	due, err := k.GetFinalizationQueueDueInclusive(s.Ctx, 17)
+	s.Require().NoError(err)
+	s.Require().Empty(due)
+	due, err = k.GetFinalizationQueueDueInclusive(s.Ctx, 18)
+	s.Require().NoError(err)
+	s.Require().Len(due, 2)
+	s.Require().Equal(uint64(10), due[0].CreationHeight)
+	s.Require().Equal(uint64(11), due[1].CreationHeight)
+
+	// the finalized queues are removed from the index
+	s.Ctx = s.Ctx.WithBlockHeight(18)
+	_, err = s.App.EndBlocker(s.Ctx)
+	s.Require().NoError(err)
+	due, err = k.GetFinalizationQueueDueInclusive(s.Ctx, 100)
+	s.Require().NoError(err)
+	s.Require().Empty(due)
+}
+
 /* ---------------------------------- utils --------------------------------- */
 func createNBlockHeightToFinalizationQueue(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.BlockHeightToFinalizationQueue {
 	items := make([]types.BlockHeightToFinalizationQueue, n)
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// SetDAPathParser registers the parser of the DA paths with the given scheme, replacing any existing one
+func (k Keeper) SetDAPathParser(scheme string, parser types.DAPathParser) {
+	k.daPathParsers[scheme] = parser
+}
+
+func (k Keeper) DASchemeRegistered(scheme string) bool {
+	_, ok := k.daPathParsers[scheme]
+	return ok
+}
+
+// ParseDAPath parses the path with the registered parser of its scheme
+func (k Keeper) ParseDAPath(path string) (types.ParsedDAPath, error) {
+	return types.ParseDAPath(k.daPathParsers, path)
+}
+
+// ValidateDAPath checks the path of a state update is valid for the DA scheme declared by the rollapp.
+func (k Keeper) ValidateDAPath(rollapp types.Rollapp, path string) error {
+	if rollapp.DaScheme == "" {
+		return errorsmod.Wrap(types.ErrInvalidDAPath, "rollapp has no da scheme")
+	}
+	parsed, err := k.ParseDAPath(path)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != rollapp.DaScheme {
+		return errorsmod.Wrapf(types.ErrInvalidDAPath, "expected scheme: %s: got: %s", rollapp.DaScheme, parsed.Scheme)
+	}
+	return nil
+}
+
+// MigrateDASchemes sets the DA scheme of the rollapps created before it was required. The scheme
+// is taken from the DA path of the latest state update, or is the fallback if it is not registered.
+func (k Keeper) MigrateDASchemes(ctx sdk.Context, fallback string) {
+	for _, ra := range k.GetAllRollapps(ctx) {
+		if ra.DaScheme != "" {
+			continue
+		}
+		ra.DaScheme = fallback
+		if info, ok := k.GetLatestStateInfo(ctx, ra.RollappId); ok {
+			if scheme, _, err := types.SplitDAPath(info.DAPath); err == nil && k.DASchemeRegistered(scheme) {
+				ra.DaScheme = scheme
+			}
+		}
+		k.SetRollapp(ctx, ra)
+	}
+}
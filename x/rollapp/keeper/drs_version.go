@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+/*
+DRS version lifecycle.
+
+The governance registers the DRS versions as active, and deprecates them with an upgrade deadline at least the
+minimum notice of the params away. Rollapps which still update the state with a deprecated version get a warning
+event on each update. Once the deadline passes, the version becomes obsolete in the end block, the same as
+MarkObsoleteRollapps: state updates with it are rejected and the rollapps which used it last are hard forked.
+Versions which are not registered are considered active.
+*/
+
+func (k Keeper) GetDRSVersion(ctx sdk.Context, version uint32) (types.DRSVersion, error) {
+	v, err := k.drsVersions.Get(ctx, version)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v, errorsmod.Wrapf(types.ErrDRSVersionNotFound, "version: %d", version)
+	}
+	return v, err
+}
+
+func (k Keeper) SetDRSVersion(ctx sdk.Context, v types.DRSVersion) error {
+	return k.drsVersions.Set(ctx, v.Version, v)
+}
+
+func (k Keeper) GetAllDRSVersions(ctx sdk.Context) ([]types.DRSVersion, error) {
+	iter, err := k.drsVersions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// UpdateDRSVersion changes the status of the version, it must not be obsolete
+func (k Keeper) UpdateDRSVersion(ctx sdk.Context, v types.DRSVersion) error {
+	if k.IsDRSVersionObsolete(ctx, v.Version) {
+		return errorsmod.Wrapf(types.ErrDRSVersionObsolete, "version: %d", v.Version)
+	}
+
+	switch v.Status {
+	case types.DRSVersionObsolete:
+		return k.markObsolete(ctx, []uint32{v.Version})
+	case types.DRSVersionActive:
+		v = types.DRSVersion{Version: v.Version, Status: types.DRSVersionActive}
+	case types.DRSVersionDeprecated:
+		if err := k.validateDeprecation(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetDRSVersion(ctx, v); err != nil {
+		return errorsmod.Wrap(err, "set DRS version")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDRSVersionUpdated{DrsVersion: v})
+}
+
+// validateDeprecation checks the rollapps get the minimum notice to upgrade. A deadline can always be postponed.
+func (k Keeper) validateDeprecation(ctx sdk.Context, v types.DRSVersion) error {
+	if v.UpgradeHeight != 0 && v.UpgradeHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrDRSDeprecationNotice, "upgrade height: %d: current: %d", v.UpgradeHeight, ctx.BlockHeight())
+	}
+	curr, err := k.GetDRSVersion(ctx, v.Version)
+	if err == nil && curr.Status == types.DRSVersionDeprecated &&
+		!v.UpgradeTime.Before(curr.UpgradeTime) && curr.UpgradeHeight <= v.UpgradeHeight {
+		return nil
+	}
+	if err != nil && !errorsmod.IsOf(err, types.ErrDRSVersionNotFound) {
+		return err
+	}
+	minTime := ctx.BlockTime().Add(k.GetParams(ctx).MinDrsDeprecationNotice)
+	if v.UpgradeTime.Before(minTime) {
+		return errorsmod.Wrapf(types.ErrDRSDeprecationNotice, "upgrade time: %s: min: %s", v.UpgradeTime, minTime)
+	}
+	return nil
+}
+
+// setDRSVersionObsolete keeps the registry in sync with the obsolete versions
+func (k Keeper) setDRSVersionObsolete(ctx sdk.Context, version uint32) error {
+	v, err := k.GetDRSVersion(ctx, version)
+	if err != nil && !errorsmod.IsOf(err, types.ErrDRSVersionNotFound) {
+		return err
+	}
+	v.Version = version
+	v.Status = types.DRSVersionObsolete
+	if err := k.SetDRSVersion(ctx, v); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDRSVersionUpdated{DrsVersion: v})
+}
+
+// ObsoleteExpiredDRSVersions marks obsolete the deprecated versions past their deadline, and the rollapps using them
+func (k Keeper) ObsoleteExpiredDRSVersions(ctx sdk.Context) error {
+	var expired []uint32
+	err := k.drsVersions.Walk(ctx, nil, func(version uint32, v types.DRSVersion) (bool, error) {
+		if v.DeadlinePassed(ctx.BlockTime(), ctx.BlockHeight()) {
+			expired = append(expired, version)
+		}
+		return false, nil
+	})
+	if err != nil || len(expired) == 0 {
+		return err
+	}
+
+	return k.markObsolete(ctx, expired)
+}
+
+func (k Keeper) markObsolete(ctx sdk.Context, versions []uint32) error {
+	obsoleteNum, err := k.MarkObsoleteRollapps(ctx, versions)
+	if err != nil {
+		return errorsmod.Wrap(err, "mark obsolete rollapps")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventMarkObsoleteRollapps{
+		ObsoleteRollappNum: uint64(obsoleteNum), //nolint:gosec
+		DrsVersions:        versions,
+	})
+}
+
+// warnDeprecatedDRSVersion emits a warning if the rollapp updates the state with a deprecated version
+func (k Keeper) warnDeprecatedDRSVersion(ctx sdk.Context, rollappID string, version uint32) error {
+	v, err := k.GetDRSVersion(ctx, version)
+	if errorsmod.IsOf(err, types.ErrDRSVersionNotFound) || v.Status != types.DRSVersionDeprecated {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDeprecatedDRSVersion{
+		RollappId:  rollappID,
+		DrsVersion: v,
+	})
+}
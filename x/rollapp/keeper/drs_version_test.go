@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestDRSVersionLifecycle() {
+	s.k().SetHooks(nil) // disable hooks
+	govModule := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	now := time.Unix(1_700_000_000, 0).UTC()
+	s.Ctx = s.Ctx.WithBlockTime(now)
+	notice := s.k().GetParams(s.Ctx).MinDrsDeprecationNotice
+
+	const (
+		rollappID  = "rollappa_1-1"
+		drsVersion = 3
+	)
+	s.CreateRollappByName(rollappID)
+	ra := s.k().MustGetRollapp(s.Ctx, rollappID)
+	ra.GenesisState.TransferProofHeight = 1
+	s.k().SetRollapp(s.Ctx, ra)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollappID)
+
+	setVersion := func(v types.DRSVersion) error {
+		_, err := s.msgServer.SetDRSVersion(s.Ctx, &types.MsgSetDRSVersion{Authority: govModule, DrsVersion: v})
+		return err
+	}
+	deprecatedEvent := proto.MessageName(new(types.EventDeprecatedDRSVersion))
+
+	// an unregistered version is active
+	lastHeight, err := s.PostStateUpdateWithDRSVersion(s.Ctx, rollappID, proposer, 1, 3, drsVersion)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, deprecatedEvent, 0)
+
+	// only the gov module can set the versions
+	_, err = s.msgServer.SetDRSVersion(s.Ctx, &types.MsgSetDRSVersion{
+		Authority:  proposer,
+		DrsVersion: types.DRSVersion{Version: drsVersion, Status: types.DRSVersionActive},
+	})
+	s.Require().Error(err)
+
+	// the deadline must give the minimum notice
+	err = setVersion(types.DRSVersion{Version: drsVersion, Status: types.DRSVersionDeprecated, UpgradeTime: now.Add(notice - time.Second)})
+	s.Require().ErrorIs(err, types.ErrDRSDeprecationNotice)
+
+	deadline := now.Add(notice)
+	err = setVersion(types.DRSVersion{Version: drsVersion, Status: types.DRSVersionDeprecated, UpgradeTime: deadline})
+	s.Require().NoError(err)
+
+	// the deadline can be postponed
+	deadline = deadline.Add(time.Hour)
+	err = setVersion(types.DRSVersion{Version: drsVersion, Status: types.DRSVersionDeprecated, UpgradeTime: deadline})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.DRSVersion(s.Ctx, &types.QueryDRSVersionRequest{Version: drsVersion})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DRSVersionDeprecated, res.DrsVersion.Status)
+	s.Require().Equal(deadline, res.DrsVersion.UpgradeTime)
+
+	// the rollapp is warned on update
+	_, err = s.PostStateUpdateWithDRSVersion(s.Ctx, rollappID, proposer, lastHeight, 3, drsVersion)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, deprecatedEvent, 1)
+
+	// nothing happens before the deadline
+	s.Ctx = s.Ctx.WithBlockTime(deadline.Add(-time.Second))
+	s.Require().NoError(s.k().ObsoleteExpiredDRSVersions(s.Ctx))
+	s.Require().False(s.k().IsDRSVersionObsolete(s.Ctx, drsVersion))
+	s.assertNotForked(rollappID)
+
+	// the version becomes obsolete after the deadline and the rollapp is forked
+	s.Ctx = s.Ctx.WithBlockTime(deadline)
+	s.Require().NoError(s.k().ObsoleteExpiredDRSVersions(s.Ctx))
+	s.Require().True(s.k().IsDRSVersionObsolete(s.Ctx, drsVersion))
+	s.Require().True(FilterForked(s.k().MustGetRollapp(s.Ctx, rollappID)))
+
+	v, err := s.k().GetDRSVersion(s.Ctx, drsVersion)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DRSVersionObsolete, v.Status)
+
+	// an obsolete version is final
+	err = setVersion(types.DRSVersion{Version: drsVersion, Status: types.DRSVersionActive})
+	s.Require().ErrorIs(err, types.ErrDRSVersionObsolete)
+}
+
+func (s *RollappTestSuite) TestMarkObsoleteRollappsUpdatesDRSVersions() {
+	s.k().SetHooks(nil) // disable hooks
+	govModule := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	_, err := s.msgServer.MarkObsoleteRollapps(s.Ctx, &types.MsgMarkObsoleteRollapps{
+		Authority:   govModule,
+		DrsVersions: []uint32{1},
+	})
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.DRSVersions(s.Ctx, &types.QueryDRSVersionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.DrsVersions, 1)
+	s.Require().Equal(types.DRSVersionObsolete, res.DrsVersions[0].Status)
+}
@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+/*
+Permissionless fraud challenges.
+
+A challenger locks a bond and disputes a pending state info. The sequencer committed to a state root for each
+block of the state info, so the challenger bisects the range of the state info against them: at each step the
+challenger gives its own state root for the middle height, and the range shrinks to the half where the sides
+disagree. Once a single block is left, the sequencer must respond by proving it with the fraud proof verifier,
+starting from the agreed state root. If it does not respond in time, the challenger must prove the fraud, i.e.
+that the block results in another state root. The sequencer is punished and the rollapp is forked at the disputed
+block only if the fraud is proven, so a challenger cannot get an honest sequencer punished. Otherwise, if the
+block is proven, or if the challenger does not move in time, the challenger loses the bond.
+Challenges are disabled while no fraud proof verifier is set.
+Finalization of the rollapp is paused while the challenge is active.
+*/
+
+func (k Keeper) GetFraudChallenge(ctx sdk.Context, rollappID string) (types.FraudChallenge, error) {
+	c, err := k.fraudChallenges.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return c, errorsmod.Wrapf(types.ErrFraudChallengeNotFound, "rollapp: %s", rollappID)
+	}
+	return c, err
+}
+
+func (k Keeper) HasFraudChallenge(ctx sdk.Context, rollappID string) (bool, error) {
+	return k.fraudChallenges.Has(ctx, rollappID)
+}
+
+func (k Keeper) SetFraudChallenge(ctx sdk.Context, c types.FraudChallenge) error {
+	return k.fraudChallenges.Set(ctx, c.RollappId, c)
+}
+
+func (k Keeper) GetAllFraudChallenges(ctx sdk.Context) ([]types.FraudChallenge, error) {
+	iter, err := k.fraudChallenges.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SubmitFraudChallenge locks the bond of the challenger and opens a challenge of the state info
+func (k Keeper) SubmitFraudChallenge(ctx sdk.Context, challenger sdk.AccAddress, rollappID string, stateIndex uint64) (types.FraudChallenge, error) {
+	if k.fraudVerifier == nil {
+		return types.FraudChallenge{}, types.ErrNoFraudProofVerifier
+	}
+	exists, err := k.HasFraudChallenge(ctx, rollappID)
+	if err != nil {
+		return types.FraudChallenge{}, err
+	}
+	if exists {
+		return types.FraudChallenge{}, errorsmod.Wrapf(types.ErrFraudChallengeExists, "rollapp: %s", rollappID)
+	}
+
+	state, ok := k.GetStateInfo(ctx, rollappID, stateIndex)
+	if !ok {
+		return types.FraudChallenge{}, errorsmod.Wrapf(gerrc.ErrNotFound, "state info: rollapp: %s: index: %d", rollappID, stateIndex)
+	}
+	if state.Status != common.Status_PENDING {
+		return types.FraudChallenge{}, errorsmod.Wrapf(types.ErrDisputeAlreadyFinalized, "state info: index: %d", stateIndex)
+	}
+	// the state root before the state info is agreed, if there is none then it's the genesis
+	var preStateRoot []byte
+	if prev, ok := k.GetStateInfo(ctx, rollappID, stateIndex-1); ok {
+		preStateRoot = prev.GetLatestBlockDescriptor().StateRoot
+	}
+
+	params := k.GetParams(ctx)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, challenger, types.ModuleName, sdk.NewCoins(params.FraudChallengeBond)); err != nil {
+		return types.FraudChallenge{}, errorsmod.Wrap(err, "lock bond")
+	}
+
+	c := types.FraudChallenge{
+		RollappId:    rollappID,
+		StateIndex:   stateIndex,
+		Sequencer:    state.Sequencer,
+		Challenger:   challenger.String(),
+		Bond:         params.FraudChallengeBond,
+		Low:          state.StartHeight - 1,
+		LowStateRoot: preStateRoot,
+		High:         state.GetLatestHeight(),
+		Deadline:     ctx.BlockHeight() + int64(params.FraudChallengeStepBlocks), //nolint:gosec
+	}
+	if err := k.SetFraudChallenge(ctx, c); err != nil {
+		return types.FraudChallenge{}, errorsmod.Wrap(err, "set challenge")
+	}
+
+	return c, uevent.EmitTypedEvent(ctx, &types.EventFraudChallengeSubmitted{
+		RollappId:  c.RollappId,
+		StateIndex: c.StateIndex,
+		Sequencer:  c.Sequencer,
+		Challenger: c.Challenger,
+		Low:        c.Low,
+		High:       c.High,
+		Deadline:   c.Deadline,
+	})
+}
+
+// BisectFraudChallenge compares the state root claimed by the challenger at the middle of the disputed range
+// with the one committed by the sequencer, and keeps the half where they disagree.
+func (k Keeper) BisectFraudChallenge(ctx sdk.Context, challenger, rollappID string, height uint64, stateRoot []byte) error {
+	c, err := k.GetFraudChallenge(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	if c.Challenger != challenger {
+		return errorsmod.Wrap(types.ErrUnauthorizedSigner, "not the challenger")
+	}
+	if !c.Bisecting() {
+		return errorsmod.Wrap(types.ErrWrongFraudChallengeStep, "disputed block must be proven")
+	}
+	if height != c.Mid() {
+		return errorsmod.Wrapf(types.ErrWrongFraudChallengeStep, "height: expected: %d: got: %d", c.Mid(), height)
+	}
+
+	bd, err := k.challengedBlockDescriptor(ctx, c, height)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(bd.StateRoot, stateRoot) {
+		c.Low = height
+		c.LowStateRoot = stateRoot
+	} else {
+		c.High = height
+	}
+	c.Deadline = ctx.BlockHeight() + int64(k.GetParams(ctx).FraudChallengeStepBlocks) //nolint:gosec
+	if err := k.SetFraudChallenge(ctx, c); err != nil {
+		return errorsmod.Wrap(err, "set challenge")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventFraudChallengeBisected{
+		RollappId: c.RollappId,
+		Low:       c.Low,
+		High:      c.High,
+		Deadline:  c.Deadline,
+	})
+}
+
+// ProveFraudChallenge verifies the disputed block. If the state root is empty or the one of the sequencer, the proof
+// is the response of the sequencer and the challenger loses. Otherwise, the proof is a fraud proof and the sequencer
+// is at fault.
+func (k Keeper) ProveFraudChallenge(ctx sdk.Context, rollappID string, stateRoot, proof []byte) error {
+	if k.fraudVerifier == nil {
+		return types.ErrNoFraudProofVerifier
+	}
+	c, err := k.GetFraudChallenge(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	if c.Bisecting() {
+		return errorsmod.Wrap(types.ErrWrongFraudChallengeStep, "disputed range must be bisected")
+	}
+
+	bd, err := k.challengedBlockDescriptor(ctx, c, c.High)
+	if err != nil {
+		return err
+	}
+	if len(stateRoot) == 0 || bytes.Equal(stateRoot, bd.StateRoot) {
+		if err := k.fraudVerifier.VerifyBlock(ctx, rollappID, c.LowStateRoot, bd, proof); err != nil {
+			return errorsmod.Wrap(err, "verify block")
+		}
+		return k.resolveFraudChallenge(ctx, c, false, "block proven")
+	}
+
+	bd.StateRoot = stateRoot
+	if err := k.fraudVerifier.VerifyBlock(ctx, rollappID, c.LowStateRoot, bd, proof); err != nil {
+		return errorsmod.Wrap(err, "verify fraud")
+	}
+	return k.resolveFraudChallenge(ctx, c, true, "fraud proven")
+}
+
+// ExpireFraudChallenges handles the challenges whose deadline passed. If the sequencer did not respond, the
+// challenger must prove the fraud. Otherwise, the challenger did not move and loses. The sequencer is never punished
+// on timeout. If no fraud proof verifier is set, the challenges are cancelled. Run in end block.
+func (k Keeper) ExpireFraudChallenges(ctx sdk.Context) {
+	challenges, err := k.GetAllFraudChallenges(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Get fraud challenges.", "err", err)
+		return
+	}
+	for _, c := range challenges {
+		if k.fraudVerifier == nil {
+			if err := k.cancelFraudChallenge(ctx, c, "no fraud proof verifier"); err != nil {
+				k.Logger(ctx).Error("Cancel fraud challenge.", "rollapp", c.RollappId, "err", err)
+			}
+			continue
+		}
+		if ctx.BlockHeight() < c.Deadline {
+			continue
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			if !c.Bisecting() && !c.ResponseTimedOut {
+				return k.timeoutFraudChallengeResponse(ctx, c)
+			}
+			return k.resolveFraudChallenge(ctx, c, false, "timeout")
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Expire fraud challenge.", "rollapp", c.RollappId, "err", err)
+			// don't keep the rollapp paused
+			if err := k.cancelFraudChallenge(ctx, c, "expiry failed"); err != nil {
+				k.Logger(ctx).Error("Cancel fraud challenge.", "rollapp", c.RollappId, "err", err)
+			}
+		}
+	}
+}
+
+// timeoutFraudChallengeResponse gives the challenger a step to prove the fraud, the sequencer did not respond
+func (k Keeper) timeoutFraudChallengeResponse(ctx sdk.Context, c types.FraudChallenge) error {
+	c.ResponseTimedOut = true
+	c.Deadline = ctx.BlockHeight() + int64(k.GetParams(ctx).FraudChallengeStepBlocks) //nolint:gosec
+	if err := k.SetFraudChallenge(ctx, c); err != nil {
+		return errorsmod.Wrap(err, "set challenge")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventFraudChallengeResponseTimedOut{
+		RollappId: c.RollappId,
+		High:      c.High,
+		Deadline:  c.Deadline,
+	})
+}
+
+// resolveFraudChallenge removes the challenge. If the sequencer is at fault, it is punished, the rollapp is
+// forked at the disputed block and the bond is returned to the challenger. Otherwise, the bond is burned.
+func (k Keeper) resolveFraudChallenge(ctx sdk.Context, c types.FraudChallenge, sequencerAtFault bool, reason string) error {
+	if err := k.fraudChallenges.Remove(ctx, c.RollappId); err != nil {
+		return errorsmod.Wrap(err, "remove challenge")
+	}
+
+	challenger := sdk.MustAccAddressFromBech32(c.Challenger)
+	if sequencerAtFault {
+		if err := k.SequencerK.PunishSequencer(ctx, c.Sequencer, &challenger); err != nil {
+			return errorsmod.Wrap(err, "punish sequencer")
+		}
+		if err := k.HardFork(ctx, c.RollappId, c.High-1); err != nil {
+			return errorsmod.Wrap(err, "hard fork")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger, sdk.NewCoins(c.Bond)); err != nil {
+			return errorsmod.Wrap(err, "return bond")
+		}
+	} else {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(c.Bond)); err != nil {
+			return errorsmod.Wrap(err, "burn bond")
+		}
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventFraudChallengeResolved{
+		RollappId:        c.RollappId,
+		StateIndex:       c.StateIndex,
+		Sequencer:        c.Sequencer,
+		Challenger:       c.Challenger,
+		SequencerAtFault: sequencerAtFault,
+		Height:           c.High,
+		Reason:           reason,
+	})
+}
+
+// voidFraudChallengeAbove cancels the challenge of the rollapp, if the challenged state info has blocks above the
+// height. It's called before the states above the height are reverted.
+func (k Keeper) voidFraudChallengeAbove(ctx sdk.Context, rollappID string, height uint64) error {
+	c, err := k.GetFraudChallenge(ctx, rollappID)
+	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	state, ok := k.GetStateInfo(ctx, rollappID, c.StateIndex)
+	if ok && state.GetLatestHeight() <= height {
+		return nil
+	}
+	return k.cancelFraudChallenge(ctx, c, "state reverted")
+}
+
+// cancelFraudChallenge removes the challenge and returns the bond to the challenger
+func (k Keeper) cancelFraudChallenge(ctx sdk.Context, c types.FraudChallenge, reason string) error {
+	rollappID := c.RollappId
+	if err := k.fraudChallenges.Remove(ctx, rollappID); err != nil {
+		return errorsmod.Wrap(err, "remove challenge")
+	}
+	challenger := sdk.MustAccAddressFromBech32(c.Challenger)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger, sdk.NewCoins(c.Bond)); err != nil {
+		return errorsmod.Wrap(err, "return bond")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventFraudChallengeResolved{
+		RollappId:  c.RollappId,
+		StateIndex: c.StateIndex,
+		Sequencer:  c.Sequencer,
+		Challenger: c.Challenger,
+		Height:     c.High,
+		Reason:     reason,
+	})
+}
+
+func (k Keeper) challengedBlockDescriptor(ctx sdk.Context, c types.FraudChallenge, height uint64) (types.BlockDescriptor, error) {
+	state, ok := k.GetStateInfo(ctx, c.RollappId, c.StateIndex)
+	if !ok {
+		return types.BlockDescriptor{}, errorsmod.Wrapf(gerrc.ErrInternal, "challenged state info not found: index: %d", c.StateIndex)
+	}
+	bd, ok := state.GetBlockDescriptor(height)
+	if !ok {
+		return types.BlockDescriptor{}, errorsmod.Wrapf(gerrc.ErrInternal, "challenged block descriptor not found: height: %d", height)
+	}
+	return bd, nil
+}
@@ -0,0 +1,307 @@
+package keeper_test
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// honestStateRoots returns the state roots accepted by the mock verifier, from genesis until the height
+func honestStateRoots(height uint64) [][]byte {
+	roots := make([][]byte, height+1)
+	for h := uint64(1); h <= height; h++ {
+		roots[h] = keepertest.MockStateRoot(roots[h-1], h)
+	}
+	return roots
+}
+
+func (s *RollappTestSuite) postStateUpdateWithRoots(rollappID, proposer string, startHeight uint64, roots [][]byte) {
+	var bds types.BlockDescriptors
+	for i, root := range roots {
+		bds.BD = append(bds.BD, types.BlockDescriptor{
+			Height:     startHeight + uint64(i),
+			StateRoot:  root,
+			Timestamp:  time.Now().UTC(),
+			DrsVersion: 1,
+		})
+	}
+	_, err := s.msgServer.UpdateState(s.Ctx, &types.MsgUpdateState{
+		Creator:     proposer,
+		RollappId:   rollappID,
+		StartHeight: startHeight,
+		NumBlocks:   uint64(len(roots)),
+		DAPath:      "mock|1",
+		BDs:         bds,
+	})
+	s.Require().NoError(err)
+}
+
+// setupFraudChallenge posts two state updates, of heights 1-4 and 5-12. From the fraud height onwards the
+// sequencer posts wrong state roots. Returns the rollapp, the sequencer, the challenger and the honest roots.
+func (s *RollappTestSuite) setupFraudChallenge(fraudHeight uint64) (string, string, sdk.AccAddress, [][]byte) {
+	s.k().SetFraudProofVerifier(keepertest.MockFraudProofVerifier{})
+	s.k().SetParams(s.Ctx, s.k().GetParams(s.Ctx).WithDisputePeriodInBlocks(5))
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	honest := honestStateRoots(12)
+	posted := make([][]byte, len(honest))
+	copy(posted, honest)
+	for h := fraudHeight; h <= 12; h++ {
+		posted[h] = []byte("wrong")
+	}
+	s.postStateUpdateWithRoots(rollappID, proposer, 1, posted[1:5])
+	s.postStateUpdateWithRoots(rollappID, proposer, 5, posted[5:13])
+
+	challenger := apptesting.CreateRandomAccounts(1)[0]
+	s.FundAcc(challenger, sdk.NewCoins(s.k().GetParams(s.Ctx).FraudChallengeBond))
+	return rollappID, proposer, challenger, honest
+}
+
+func (s *RollappTestSuite) bisect(rollappID string, challenger sdk.AccAddress, roots [][]byte, expectLow, expectHigh uint64) {
+	c, err := s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	_, err = s.msgServer.BisectFraudChallenge(s.Ctx, &types.MsgBisectFraudChallenge{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		Height:     c.Mid(),
+		StateRoot:  roots[c.Mid()],
+	})
+	s.Require().NoError(err)
+	c, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Require().Equal(expectLow, c.Low)
+	s.Require().Equal(expectHigh, c.High)
+}
+
+func (s *RollappTestSuite) TestFraudChallengeNoVerifier() {
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	_, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 4)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SubmitFraudChallenge(s.Ctx, &types.MsgSubmitFraudChallenge{
+		Challenger: alice,
+		RollappId:  rollappID,
+		StateIndex: 1,
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrNoFraudProofVerifier))
+}
+
+// The sequencer posted a wrong state root, the challenger narrows down to it, the block cannot be proven and the
+// challenger proves the fraud.
+func (s *RollappTestSuite) TestFraudChallengeSequencerAtFault() {
+	rollappID, proposer, challenger, honest := s.setupFraudChallenge(9)
+	bond := s.k().GetParams(s.Ctx).FraudChallengeBond
+
+	_, err := s.msgServer.SubmitFraudChallenge(s.Ctx, &types.MsgSubmitFraudChallenge{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		StateIndex: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, challenger, bond.Denom).IsZero())
+
+	// only one challenge at a time
+	_, err = s.msgServer.SubmitFraudChallenge(s.Ctx, &types.MsgSubmitFraudChallenge{
+		Challenger: alice,
+		RollappId:  rollappID,
+		StateIndex: 2,
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrFraudChallengeExists))
+
+	c, err := s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(4), c.Low)
+	s.Require().Equal(honest[4], c.LowStateRoot)
+	s.Require().Equal(uint64(12), c.High)
+
+	// only the challenger can bisect, at the middle
+	_, err = s.msgServer.BisectFraudChallenge(s.Ctx, &types.MsgBisectFraudChallenge{
+		Challenger: alice,
+		RollappId:  rollappID,
+		Height:     8,
+		StateRoot:  honest[8],
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrUnauthorizedSigner))
+	_, err = s.msgServer.BisectFraudChallenge(s.Ctx, &types.MsgBisectFraudChallenge{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		Height:     7,
+		StateRoot:  honest[7],
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrWrongFraudChallengeStep))
+
+	s.bisect(rollappID, challenger, honest, 8, 12)
+	s.bisect(rollappID, challenger, honest, 8, 10)
+	s.bisect(rollappID, challenger, honest, 8, 9)
+
+	// the wrong block cannot be proven
+	_, err = s.msgServer.ProveFraudChallenge(s.Ctx, &types.MsgProveFraudChallenge{
+		Prover:    proposer,
+		RollappId: rollappID,
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrInvalidFraudProof))
+
+	// states are not finalized while the challenge is active
+	c, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockHeight(c.Deadline - 1)
+	_, err = s.App.EndBlocker(s.Ctx)
+	s.Require().NoError(err)
+	_, ok := s.k().GetLatestFinalizedStateIndex(s.Ctx, rollappID)
+	s.Require().False(ok)
+
+	// the sequencer did not respond, it is not punished on timeout
+	s.Ctx = s.Ctx.WithBlockHeight(c.Deadline).WithEventManager(sdk.NewEventManager())
+	s.k().ExpireFraudChallenges(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, proto.MessageName(&types.EventFraudChallengeResponseTimedOut{}), 1)
+	c, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Require().True(c.ResponseTimedOut)
+
+	// a wrong fraud proof is rejected
+	_, err = s.msgServer.ProveFraudChallenge(s.Ctx, &types.MsgProveFraudChallenge{
+		Prover:    challenger.String(),
+		RollappId: rollappID,
+		StateRoot: []byte("lie"),
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrInvalidFraudProof))
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.ProveFraudChallenge(s.Ctx, &types.MsgProveFraudChallenge{
+		Prover:    challenger.String(),
+		RollappId: rollappID,
+		StateRoot: honest[9],
+	})
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, proto.MessageName(&types.EventFraudChallengeResolved{}), 1)
+
+	_, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().True(errorsmod.IsOf(err, types.ErrFraudChallengeNotFound))
+	// the bond is returned, on top of the reward from the sequencer punishment
+	s.Require().True(bond.Amount.LT(s.App.BankKeeper.GetBalance(s.Ctx, challenger, bond.Denom).Amount))
+
+	// forked at the wrong block
+	ra := s.k().MustGetRollapp(s.Ctx, rollappID)
+	s.Require().Equal(uint64(1), ra.LatestRevision().Number)
+	s.Require().Equal(uint64(9), ra.LatestRevision().StartHeight)
+	height, ok := s.k().GetLatestHeight(s.Ctx, rollappID)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(8), height)
+}
+
+// The sequencer is honest, the challenger lies about a state root and ends up disputing a valid block.
+func (s *RollappTestSuite) TestFraudChallengeChallengerLoses() {
+	rollappID, _, challenger, honest := s.setupFraudChallenge(13)
+	bond := s.k().GetParams(s.Ctx).FraudChallengeBond
+	supply := s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom)
+
+	_, err := s.msgServer.SubmitFraudChallenge(s.Ctx, &types.MsgSubmitFraudChallenge{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		StateIndex: 2,
+	})
+	s.Require().NoError(err)
+
+	lies := make([][]byte, len(honest))
+	copy(lies, honest)
+	lies[8] = []byte("lie")
+	s.bisect(rollappID, challenger, lies, 4, 8)
+	s.bisect(rollappID, challenger, lies, 6, 8)
+	s.bisect(rollappID, challenger, lies, 7, 8)
+
+	// bisection is over
+	_, err = s.msgServer.BisectFraudChallenge(s.Ctx, &types.MsgBisectFraudChallenge{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		Height:     7,
+		StateRoot:  honest[7],
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrWrongFraudChallengeStep))
+
+	_, err = s.msgServer.ProveFraudChallenge(s.Ctx, &types.MsgProveFraudChallenge{
+		Prover:    alice,
+		RollappId: rollappID,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().True(errorsmod.IsOf(err, types.ErrFraudChallengeNotFound))
+	// the bond is burned
+	s.Require().Equal(supply.Sub(bond), s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom))
+	s.assertNotForked(rollappID)
+}
+
+// The challenger must keep bisecting, or lose.
+func (s *RollappTestSuite) TestFraudChallengeChallengerTimeout() {
+	rollappID, _, challenger, _ := s.setupFraudChallenge(9)
+
+	_, err := s.msgServer.SubmitFraudChallenge(s.Ctx, &types.MsgSubmitFraudChallenge{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		StateIndex: 2,
+	})
+	s.Require().NoError(err)
+	c, err := s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(c.Deadline)
+	_, err = s.App.EndBlocker(s.Ctx)
+	s.Require().NoError(err)
+
+	_, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().True(errorsmod.IsOf(err, types.ErrFraudChallengeNotFound))
+	s.assertNotForked(rollappID)
+	// finalization resumed
+	_, ok := s.k().GetLatestFinalizedStateIndex(s.Ctx, rollappID)
+	s.Require().True(ok)
+}
+
+// The sequencer is honest but does not respond, the challenger cannot prove a fraud and loses.
+func (s *RollappTestSuite) TestFraudChallengeNoResponse() {
+	rollappID, _, challenger, honest := s.setupFraudChallenge(13)
+	bond := s.k().GetParams(s.Ctx).FraudChallengeBond
+	supply := s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom)
+
+	_, err := s.msgServer.SubmitFraudChallenge(s.Ctx, &types.MsgSubmitFraudChallenge{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		StateIndex: 2,
+	})
+	s.Require().NoError(err)
+
+	lies := make([][]byte, len(honest))
+	copy(lies, honest)
+	lies[8] = []byte("lie")
+	s.bisect(rollappID, challenger, lies, 4, 8)
+	s.bisect(rollappID, challenger, lies, 6, 8)
+	s.bisect(rollappID, challenger, lies, 7, 8)
+
+	c, err := s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockHeight(c.Deadline)
+	s.k().ExpireFraudChallenges(s.Ctx)
+
+	_, err = s.msgServer.ProveFraudChallenge(s.Ctx, &types.MsgProveFraudChallenge{
+		Prover:    challenger.String(),
+		RollappId: rollappID,
+		StateRoot: lies[8],
+	})
+	s.Require().True(errorsmod.IsOf(err, types.ErrInvalidFraudProof))
+
+	c, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockHeight(c.Deadline)
+	s.k().ExpireFraudChallenges(s.Ctx)
+
+	_, err = s.k().GetFraudChallenge(s.Ctx, rollappID)
+	s.Require().True(errorsmod.IsOf(err, types.ErrFraudChallengeNotFound))
+	s.Require().Equal(supply.Sub(bond), s.App.BankKeeper.GetSupply(s.Ctx, bond.Denom))
+	s.assertNotForked(rollappID)
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -23,3 +25,34 @@ func (k Keeper) ObsoleteDRSVersions(goCtx context.Context, req *types.QueryObsol
 
 	return &types.QueryObsoleteDRSVersionsResponse{DrsVersions: versions}, nil
 }
+
+func (k Keeper) DRSVersions(goCtx context.Context, req *types.QueryDRSVersionsRequest) (*types.QueryDRSVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	versions, err := k.GetAllDRSVersions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDRSVersionsResponse{DrsVersions: versions}, nil
+}
+
+func (k Keeper) DRSVersion(goCtx context.Context, req *types.QueryDRSVersionRequest) (*types.QueryDRSVersionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	v, err := k.GetDRSVersion(ctx, req.Version)
+	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDRSVersionResponse{DrsVersion: v}, nil
+}
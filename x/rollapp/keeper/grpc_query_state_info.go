@@ -54,7 +54,11 @@ func (k Keeper) StateInfo(c context.Context, req *types.QueryGetStateInfoRequest
 		stateInfo = *val
 	}
 
-	return &types.QueryGetStateInfoResponse{StateInfo: stateInfo}, nil
+	res := &types.QueryGetStateInfoResponse{StateInfo: stateInfo}
+	if parsed, err := k.ParseDAPath(stateInfo.DAPath); err == nil {
+		res.ParsedDAPath = &parsed
+	}
+	return res, nil
 }
 
 func (k Keeper) FindStateInfoByHeight(ctx sdk.Context, rollappId string, height uint64) (*types.StateInfo, error) {
@@ -77,7 +81,10 @@ func (k Keeper) FindStateInfoByHeight(ctx sdk.Context, rollappId string, height
 	}
 
 	// initial interval to search in
-	startInfoIndex := uint64(1) // TODO: handle pruned states (https://github.com/dymensionxyz/dymension/issues/1307)
+	startInfoIndex := k.FirstRetainedStateIndex(ctx, rollappId)
+	if first, ok := k.GetStateInfo(ctx, rollappId, startInfoIndex); ok && height < first.GetStartHeight() {
+		return nil, errorsmod.Wrapf(types.ErrStateInfoPruned, "rollappId=%s, height=%d", rollappId, height)
+	}
 	endInfoIndex := ss.StateInfoIndex.Index
 	for startInfoIndex <= endInfoIndex {
 		midIndex := startInfoIndex + (endInfoIndex-startInfoIndex)/2
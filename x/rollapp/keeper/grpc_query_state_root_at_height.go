@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) StateRootAtHeight(goCtx context.Context, req *types.QueryStateRootAtHeightRequest) (*types.QueryStateRootAtHeightResponse, error) {
+	if req == nil || req.RollappId == "" || req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ra, ok := k.GetRollapp(ctx, req.RollappId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, types.ErrRollappNotFound.Error())
+	}
+
+	s, err := k.GetStateInfoByHeight(ctx, req.RollappId, req.Height)
+	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	bd, _ := s.GetBlockDescriptor(req.Height)
+
+	return &types.QueryStateRootAtHeightResponse{
+		BlockDescriptor: bd,
+		Status:          s.Status,
+		Revision:        ra.GetRevisionForHeight(req.Height).Number,
+		StateInfoIndex:  s.StateInfoIndex,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestStateRootAtHeight() {
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+
+	_, err := s.PostStateUpdate(s.Ctx, rollappId, proposer, 1, 5)
+	s.Require().NoError(err)
+	_, err = s.PostStateUpdate(s.Ctx, rollappId, proposer, 6, 5)
+	s.Require().NoError(err)
+
+	query := func(height uint64) (*types.QueryStateRootAtHeightResponse, error) {
+		return s.k().StateRootAtHeight(s.Ctx, &types.QueryStateRootAtHeightRequest{RollappId: rollappId, Height: height})
+	}
+
+	for _, h := range []uint64{1, 3, 5, 6, 8, 10} {
+		res, err := query(h)
+		s.Require().NoError(err)
+		s.Require().Equal(h, res.BlockDescriptor.Height)
+		s.Require().Equal(common.Status_PENDING, res.Status)
+		s.Require().Equal(uint64(0), res.Revision)
+
+		stateInfo, err := s.k().FindStateInfoByHeight(s.Ctx, rollappId, h)
+		s.Require().NoError(err)
+		s.Require().Equal(stateInfo.StateInfoIndex, res.StateInfoIndex)
+		bd, _ := stateInfo.GetBlockDescriptor(h)
+		s.Require().Equal(bd, res.BlockDescriptor)
+	}
+
+	_, err = query(11)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	// the reverted heights are not found
+	err = s.k().HardFork(s.Ctx, rollappId, 6)
+	s.Require().NoError(err)
+	_, err = query(6)
+	s.Require().NoError(err)
+	_, err = query(7)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) UpcomingLivenessEvents(goCtx context.Context, req *types.QueryUpcomingLivenessEventsRequest) (*types.QueryUpcomingLivenessEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.RollappId == "" {
+		slashes, pageRes, err := k.GetLivenessEventsPaginated(ctx, req.Pagination)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		warnings, warningsPageRes, err := k.GetLivenessWarningsPaginated(ctx, req.WarningsPagination)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryUpcomingLivenessEventsResponse{
+			Slashes:            slashes,
+			Warnings:           warnings,
+			Pagination:         pageRes,
+			WarningsPagination: warningsPageRes,
+		}, nil
+	}
+
+	ra, ok := k.GetRollapp(ctx, req.RollappId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "rollapp not found")
+	}
+	var slashes []types.LivenessEvent
+	if ra.LivenessEventHeight != 0 {
+		slashes = append(slashes, types.LivenessEvent{RollappId: ra.RollappId, HubHeight: ra.LivenessEventHeight})
+	}
+
+	warnings, err := k.GetLivenessWarnings(ctx, req.RollappId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUpcomingLivenessEventsResponse{
+		Slashes:  slashes,
+		Warnings: warnings,
+	}, nil
+}
@@ -24,6 +24,11 @@ func (k Keeper) HardFork(ctx sdk.Context, rollappID string, lastValidHeight uint
 		return gerrc.ErrFailedPrecondition.Wrap("fork not allowed")
 	}
 
+	// a challenge of a state which is reverted is void
+	if err := k.voidFraudChallengeAbove(ctx, rollappID, lastValidHeight); err != nil {
+		return errorsmod.Wrap(err, "void fraud challenge")
+	}
+
 	_, ok := k.GetLatestStateInfo(ctx, rollappID)
 	if ok {
 		lastValidHeight, err := k.RevertPendingStates(ctx, rollappID, lastValidHeight+1)
@@ -106,6 +111,12 @@ func (k Keeper) RevertPendingStates(ctx sdk.Context, rollappID string, newRevisi
 		Index:     lastStateIdxToKeep,
 	})
 
+	lastStateInfo := k.MustGetStateInfo(ctx, rollappID, lastStateIdxToKeep)
+	err = k.unindexStateInfoHeightsFrom(ctx, rollappID, lastStateInfo.GetLatestHeight()+1)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "unindex state info heights")
+	}
+
 	// remove all the pending states from the finalization queue
 	err = k.pruneFinalizationsAbove(ctx, rollappID, lastStateIdxToKeep)
 	if err != nil {
@@ -113,7 +124,6 @@ func (k Keeper) RevertPendingStates(ctx sdk.Context, rollappID string, newRevisi
 	}
 
 	// remove the sequencers heights
-	lastStateInfo := k.MustGetStateInfo(ctx, rollappID, lastStateIdxToKeep)
 	err = k.PruneSequencerHeights(ctx, mapKeysToSlice(uniqueProposers), lastStateInfo.GetLatestHeight())
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "prune sequencer heights")
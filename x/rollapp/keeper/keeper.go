@@ -26,6 +26,28 @@ func (b finalizationQueueIndex) IndexesList() []collections.Index[collections.Pa
 	return []collections.Index[collections.Pair[uint64, string], types.BlockHeightToFinalizationQueue]{b.RollappIDReverseLookup}
 }
 
+// finalizationHeightIndex is a set of indexes for the finalization heights of the finalization queue.
+type finalizationHeightIndex struct {
+	// ByHeight is an index of the finalization queue by finalization height.
+	// It helps to find the queues to finalize at a height without scanning the others.
+	ByHeight *indexes.Multi[uint64, collections.Pair[uint64, string], uint64]
+}
+
+func (b finalizationHeightIndex) IndexesList() []collections.Index[collections.Pair[uint64, string], uint64] {
+	return []collections.Index[collections.Pair[uint64, string], uint64]{b.ByHeight}
+}
+
+// livenessWarningIndex is a set of indexes for the liveness warning queue.
+type livenessWarningIndex struct {
+	// RollappIDReverseLookup is a reverse lookup index for the liveness warning queue.
+	// It helps to find all upcoming warnings by rollapp.
+	RollappIDReverseLookup *indexes.ReversePair[int64, string, types.LivenessWarning]
+}
+
+func (b livenessWarningIndex) IndexesList() []collections.Index[collections.Pair[int64, string], types.LivenessWarning] {
+	return []collections.Index[collections.Pair[int64, string], types.LivenessWarning]{b.RollappIDReverseLookup}
+}
+
 type Keeper struct {
 	cdc       codec.BinaryCodec
 	storeKey  storetypes.StoreKey
@@ -45,6 +67,35 @@ type Keeper struct {
 	// Contains a special index that helps reverse lookup: finalization queue (all available heights) by rollapp.
 	// Index key: (rollappID, creation height), Value: state indexes to finalize.
 	finalizationQueue *collections.IndexedMap[collections.Pair[uint64, string], types.BlockHeightToFinalizationQueue, finalizationQueueIndex]
+	// finalizationHeights is a map from creation height and rollapp to the height the finalization queue is finalized at.
+	// Key: (creation height, rollappID), Value: finalization height.
+	// Contains an index by finalization height. Index key: (finalization height, (creation height, rollappID)).
+	finalizationHeights *collections.IndexedMap[collections.Pair[uint64, string], uint64, finalizationHeightIndex]
+
+	// livenessWarnings is a map from hub height and rollapp to the liveness warning to emit at that height.
+	// Key: (hub height, rollappID), Value: liveness warning.
+	// Contains a special index that helps reverse lookup: liveness warnings by rollapp.
+	livenessWarnings *collections.IndexedMap[collections.Pair[int64, string], types.LivenessWarning, livenessWarningIndex]
+
+	// fraudChallenges is a map from rollappID to the active fraud challenge of the rollapp.
+	fraudChallenges collections.Map[string, types.FraudChallenge]
+	// fraudVerifier settles fraud challenges, they are disabled if it is not set
+	fraudVerifier types.FraudProofVerifier
+	// daPathParsers validate the DA paths of state updates, by scheme
+	daPathParsers map[string]types.DAPathParser
+
+	// stateInfoIndexByHeight is a map from rollappID and rollapp height to the index of the state info with the height.
+	stateInfoIndexByHeight collections.Map[collections.Pair[string, uint64], uint64]
+	// prunedStateIndex is a map from rollappID to the index of the last pruned state info of the rollapp.
+	prunedStateIndex collections.Map[string, uint64]
+	// statePrunerCursor is the rollapp the pruner continues from in the next block.
+	statePrunerCursor collections.Item[string]
+
+	// pendingOwnershipTransfers is a map from rollappID to the ownership transfer waiting to be accepted.
+	pendingOwnershipTransfers collections.Map[string, types.PendingOwnershipTransfer]
+
+	// drsVersions is a map from DRS version to its lifecycle. The obsolete versions are also in obsoleteDRSVersions.
+	drsVersions collections.Map[uint32, types.DRSVersion]
 
 	finalizePending        func(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) error
 	seqToUnfinalizedHeight collections.KeySet[collections.Pair[string, uint64]]
@@ -103,6 +154,82 @@ func NewKeeper(
 				),
 			},
 		),
+		finalizationHeights: collections.NewIndexedMap(
+			sb,
+			types.FinalizationHeightKeyPrefix,
+			"finalization_heights",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			collections.Uint64Value,
+			finalizationHeightIndex{
+				ByHeight: indexes.NewMulti(
+					sb,
+					types.FinalizationHeightIndexKeyPrefix,
+					"finalization_heights_by_height",
+					collections.Uint64Key,
+					collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+					func(_ collections.Pair[uint64, string], h uint64) (uint64, error) {
+						return h, nil
+					},
+				),
+			},
+		),
+		livenessWarnings: collections.NewIndexedMap(
+			sb,
+			types.LivenessWarningQueueKeyPrefix,
+			"liveness_warnings",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+			collcompat.ProtoValue[types.LivenessWarning](cdc),
+			livenessWarningIndex{
+				RollappIDReverseLookup: indexes.NewReversePair[types.LivenessWarning](
+					sb,
+					types.LivenessWarningByRollappKeyPrefix,
+					"liveness_warnings_rollapp_id_reverse_lookup",
+					collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+				),
+			},
+		),
+		fraudChallenges: collections.NewMap(
+			sb,
+			types.FraudChallengeKeyPrefix,
+			"fraud_challenges",
+			collections.StringKey,
+			collcompat.ProtoValue[types.FraudChallenge](cdc),
+		),
+		stateInfoIndexByHeight: collections.NewMap(
+			sb,
+			types.StateInfoIndexByHeightKeyPrefix,
+			"state_info_index_by_height",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+		),
+		prunedStateIndex: collections.NewMap(
+			sb,
+			types.PrunedStateIndexKeyPrefix,
+			"pruned_state_index",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		statePrunerCursor: collections.NewItem(
+			sb,
+			types.StatePrunerCursorKeyPrefix,
+			"state_pruner_cursor",
+			collections.StringValue,
+		),
+		pendingOwnershipTransfers: collections.NewMap(
+			sb,
+			types.PendingOwnershipTransferKeyPrefix,
+			"pending_ownership_transfers",
+			collections.StringKey,
+			collcompat.ProtoValue[types.PendingOwnershipTransfer](cdc),
+		),
+		drsVersions: collections.NewMap(
+			sb,
+			types.DRSVersionKeyPrefix,
+			"drs_versions",
+			collections.Uint32Key,
+			collcompat.ProtoValue[types.DRSVersion](cdc),
+		),
+		daPathParsers:         types.DefaultDAPathParsers(),
 		finalizePending:       nil,
 		canonicalClientKeeper: canonicalClientKeeper,
 		seqToUnfinalizedHeight: collections.NewKeySet(
@@ -132,6 +259,11 @@ func (k *Keeper) SetCanonicalClientKeeper(kk CanonicalLightClientKeeper) {
 	k.canonicalClientKeeper = kk
 }
 
+// SetFraudProofVerifier sets the verifier of the blocks disputed by fraud challenges
+func (k *Keeper) SetFraudProofVerifier(v types.FraudProofVerifier) {
+	k.fraudVerifier = v
+}
+
 func (k *Keeper) SetTransferKeeper(transferKeeper TransferKeeper) {
 	k.transferKeeper = transferKeeper
 }
@@ -1,11 +1,18 @@
 package keeper
 
 import (
+	"slices"
+
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
@@ -39,9 +46,12 @@ func NextSlashHeight(
 }
 
 // CheckLiveness will slash or jail any sequencers for whom their rollapp has been down
-// and a slash or jail event is due. Run in end block.
+// and a slash or jail event is due. It also warns the sequencers which are about to be. Run in end block.
 func (k Keeper) CheckLiveness(ctx sdk.Context) {
 	h := ctx.BlockHeight()
+	if err := k.emitLivenessWarnings(ctx, h); err != nil {
+		k.Logger(ctx).Error("Emit liveness warnings.", "err", err)
+	}
 	events := k.GetLivenessEvents(ctx, &h)
 	for _, e := range events {
 		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
@@ -81,6 +91,9 @@ func (k Keeper) IndicateLiveness(ctx sdk.Context, ra *types.Rollapp) {
 // Modifies the passed-in rollapp object.
 func (k Keeper) ResetLivenessClock(ctx sdk.Context, ra *types.Rollapp) {
 	k.DelLivenessEvents(ctx, ra.LivenessEventHeight, ra.RollappId)
+	if err := k.DelLivenessWarnings(ctx, ra.RollappId); err != nil {
+		k.Logger(ctx).Error("Delete liveness warnings.", "rollapp", ra.RollappId, "err", err)
+	}
 	ra.LivenessEventHeight = 0
 	ra.LivenessCountdownStartHeight = ctx.BlockHeight()
 }
@@ -99,6 +112,65 @@ func (k Keeper) ScheduleLivenessEvent(ctx sdk.Context, ra *types.Rollapp) {
 		RollappId: ra.RollappId,
 		HubHeight: nextH,
 	})
+	if err := k.scheduleLivenessWarnings(ctx, ra.RollappId, nextH); err != nil {
+		k.Logger(ctx).Error("Schedule liveness warnings.", "rollapp", ra.RollappId, "err", err)
+	}
+}
+
+// scheduleLivenessWarnings schedules a warning at each threshold of the time between now and the slash height.
+// Thresholds which fall on the current or the slash height are skipped.
+func (k Keeper) scheduleLivenessWarnings(ctx sdk.Context, rollappID string, slashHeight int64) error {
+	h := ctx.BlockHeight()
+	for _, t := range k.GetParams(ctx).LivenessWarningThresholds {
+		warnH := h + t.MulInt64(slashHeight-h).TruncateInt64()
+		if warnH <= h || slashHeight <= warnH {
+			continue
+		}
+		err := k.PutLivenessWarning(ctx, types.LivenessWarning{
+			RollappId:   rollappID,
+			HubHeight:   warnH,
+			SlashHeight: slashHeight,
+			Threshold:   t,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emitLivenessWarnings emits the warnings due at the height, and removes them from the queue. Warnings
+// for a slash which is not scheduled anymore are dropped.
+func (k Keeper) emitLivenessWarnings(ctx sdk.Context, height int64) error {
+	rng := collections.NewPrefixedPairRange[int64, string](height)
+	iter, err := k.livenessWarnings.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	warnings, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range warnings {
+		if err := k.livenessWarnings.Remove(ctx, collections.Join(w.HubHeight, w.RollappId)); err != nil {
+			return errorsmod.Wrap(err, "remove liveness warning")
+		}
+		ra, ok := k.GetRollapp(ctx, w.RollappId)
+		if !ok || ra.LivenessEventHeight != w.SlashHeight {
+			continue
+		}
+		err = uevent.EmitTypedEvent(ctx, &types.EventLivenessWarning{
+			RollappId:   w.RollappId,
+			Sequencer:   k.SequencerK.GetProposer(ctx, w.RollappId).Address,
+			SlashHeight: w.SlashHeight,
+			Threshold:   w.Threshold.String(),
+		})
+		if err != nil {
+			return errorsmod.Wrap(err, "emit event")
+		}
+	}
+	return nil
 }
 
 // GetLivenessEvents returns events. If a height is specified, only for that height.
@@ -123,6 +195,20 @@ func (k Keeper) GetLivenessEvents(ctx sdk.Context, height *int64) []types.Livene
 	return ret
 }
 
+// GetLivenessEventsPaginated returns a page of the events, ordered by height
+func (k Keeper) GetLivenessEventsPaginated(ctx sdk.Context, pageReq *query.PageRequest) ([]types.LivenessEvent, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LivenessEventQueueKeyPrefix)
+	ret := []types.LivenessEvent{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		ret = append(ret, types.LivenessEventQueueKeyToEvent(slices.Concat(types.LivenessEventQueueKeyPrefix, key)))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return ret, pageRes, nil
+}
+
 // PutLivenessEvent puts a new event in the queue
 func (k Keeper) PutLivenessEvent(ctx sdk.Context, e types.LivenessEvent) {
 	store := ctx.KVStore(k.storeKey)
@@ -139,3 +225,64 @@ func (k Keeper) DelLivenessEvents(ctx sdk.Context, height int64, rollappID strin
 	})
 	store.Delete(key)
 }
+
+// PutLivenessWarning puts a new warning in the queue
+func (k Keeper) PutLivenessWarning(ctx sdk.Context, w types.LivenessWarning) error {
+	return k.livenessWarnings.Set(ctx, collections.Join(w.HubHeight, w.RollappId), w)
+}
+
+// GetLivenessWarnings returns the upcoming warnings, ordered by height. If a rollapp is specified, only for that rollapp.
+func (k Keeper) GetLivenessWarnings(ctx sdk.Context, rollappID string) ([]types.LivenessWarning, error) {
+	if rollappID == "" {
+		iter, err := k.livenessWarnings.Iterate(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return iter.Values()
+	}
+
+	keys, err := k.livenessWarningKeysByRollapp(ctx, rollappID)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]types.LivenessWarning, 0, len(keys))
+	for _, key := range keys {
+		w, err := k.livenessWarnings.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, w)
+	}
+	return ret, nil
+}
+
+// DelLivenessWarnings deletes all liveness warnings for the rollapp from the queue
+func (k Keeper) DelLivenessWarnings(ctx sdk.Context, rollappID string) error {
+	keys, err := k.livenessWarningKeysByRollapp(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.livenessWarnings.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLivenessWarningsPaginated returns a page of the upcoming warnings, ordered by height
+func (k Keeper) GetLivenessWarningsPaginated(ctx sdk.Context, pageReq *query.PageRequest) ([]types.LivenessWarning, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.livenessWarnings, pageReq,
+		func(_ collections.Pair[int64, string], w types.LivenessWarning) (types.LivenessWarning, error) {
+			return w, nil
+		},
+	)
+}
+
+func (k Keeper) livenessWarningKeysByRollapp(ctx sdk.Context, rollappID string) ([]collections.Pair[int64, string], error) {
+	iter, err := k.livenessWarnings.Indexes.RollappIDReverseLookup.MatchExact(ctx, rollappID)
+	if err != nil {
+		return nil, err
+	}
+	return iter.PrimaryKeys()
+}
@@ -7,9 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/dymensionxyz/sdk-utils/utils/urand"
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
@@ -167,6 +170,84 @@ func (s *RollappTestSuite) TestLivenessEndBlock() {
 	s.checkLiveness(rollapp, false, true)
 }
 
+func (s *RollappTestSuite) TestLivenessWarnings() {
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+	k := s.k()
+	k.SetParams(s.Ctx, k.GetParams(s.Ctx).
+		WithLivenessSlashBlocks(10).
+		WithLivenessWarningThresholds(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(9, 1)))
+	eventName := proto.MessageName(&types.EventLivenessWarning{})
+
+	rollapp, proposer := s.CreateDefaultRollappAndProposer()
+	_, err := s.PostStateUpdate(s.Ctx, rollapp, proposer, 1, 10)
+	s.Require().NoError(err)
+
+	res, err := k.UpcomingLivenessEvents(s.Ctx, &types.QueryUpcomingLivenessEventsRequest{RollappId: rollapp})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.LivenessEvent{{RollappId: rollapp, HubHeight: 11}}, res.Slashes)
+	s.Require().Len(res.Warnings, 2)
+	s.Require().Equal(int64(6), res.Warnings[0].HubHeight)
+	s.Require().Equal(int64(10), res.Warnings[1].HubHeight)
+	s.Require().Equal(int64(11), res.Warnings[1].SlashHeight)
+
+	// not due yet
+	s.Ctx = s.Ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	k.CheckLiveness(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, eventName, 0)
+
+	s.Ctx = s.Ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+	k.CheckLiveness(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, eventName, 1)
+	res, err = k.UpcomingLivenessEvents(s.Ctx, &types.QueryUpcomingLivenessEventsRequest{RollappId: rollapp})
+	s.Require().NoError(err)
+	s.Require().Len(res.Warnings, 1)
+
+	// an update resets the clock, and the pending warning is replaced
+	s.Ctx = s.Ctx.WithBlockHeight(7)
+	_, err = s.PostStateUpdate(s.Ctx, rollapp, proposer, 11, 10)
+	s.Require().NoError(err)
+	res, err = k.UpcomingLivenessEvents(s.Ctx, &types.QueryUpcomingLivenessEventsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.LivenessEvent{{RollappId: rollapp, HubHeight: 17}}, res.Slashes)
+	s.Require().Len(res.Warnings, 2)
+	s.Require().Equal(int64(12), res.Warnings[0].HubHeight)
+	s.Require().Equal(int64(16), res.Warnings[1].HubHeight)
+
+	s.Ctx = s.Ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	k.CheckLiveness(s.Ctx)
+	s.AssertEventEmitted(s.Ctx, eventName, 0)
+}
+
+func (s *RollappTestSuite) TestUpcomingLivenessEventsPagination() {
+	k := s.k()
+	for i := range 3 {
+		ra := fmt.Sprintf("rollapp_%d-1", i+1)
+		k.PutLivenessEvent(s.Ctx, types.LivenessEvent{RollappId: ra, HubHeight: int64(10 + i)})
+		s.Require().NoError(k.PutLivenessWarning(s.Ctx, types.LivenessWarning{RollappId: ra, HubHeight: int64(5 + i), SlashHeight: int64(10 + i)}))
+	}
+
+	res, err := k.UpcomingLivenessEvents(s.Ctx, &types.QueryUpcomingLivenessEventsRequest{
+		Pagination:         &query.PageRequest{Limit: 2},
+		WarningsPagination: &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Slashes, 2)
+	s.Require().Equal(int64(10), res.Slashes[0].HubHeight)
+	s.Require().Len(res.Warnings, 1)
+	s.Require().Equal(int64(5), res.Warnings[0].HubHeight)
+
+	res, err = k.UpcomingLivenessEvents(s.Ctx, &types.QueryUpcomingLivenessEventsRequest{
+		Pagination:         &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		WarningsPagination: &query.PageRequest{Key: res.WarningsPagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.LivenessEvent{{RollappId: "rollapp_3-1", HubHeight: 12}}, res.Slashes)
+	s.Require().Nil(res.Pagination.NextKey)
+	s.Require().Len(res.Warnings, 2)
+	s.Require().Equal(int64(6), res.Warnings[0].HubHeight)
+	s.Require().Nil(res.WarningsPagination.NextKey)
+}
+
 func (s *RollappTestSuite) checkLiveness(rollappId string, expectClockReset, expectEvent bool) {
 	msg, broken := keeper.LivenessEventInvariant(*s.k())(s.Ctx)
 	s.Require().False(broken, msg)
@@ -625,6 +625,7 @@ func (s *RollappTestSuite) createRollappWithIDAndCreator(rollappId string, creat
 		RollappId:        rollappId,
 		InitialSequencer: sample.AccAddress(),
 		VmType:           types.Rollapp_EVM,
+		DaScheme:         types.DASchemeMock,
 		Metadata:         &mockRollappMetadata,
 		GenesisInfo:      mockGenesisInfo,
 	}
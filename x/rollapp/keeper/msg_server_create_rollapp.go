@@ -30,6 +30,9 @@ func (k msgServer) CreateRollapp(goCtx context.Context, msg *types.MsgCreateRoll
 	if err := k.validMinBond(ctx, msg.MinSequencerBond); err != nil {
 		return nil, err
 	}
+	if !k.DASchemeRegistered(msg.DaScheme) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDAPath, "unknown scheme: %s", msg.DaScheme)
+	}
 
 	k.SetRollapp(ctx, msg.GetRollapp())
 
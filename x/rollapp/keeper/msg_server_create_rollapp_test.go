@@ -36,6 +36,7 @@ func (s *RollappTestSuite) TestCreateRollappWithBechGenesisSum() {
 
 		Alias:       "rollapp",
 		VmType:      types.Rollapp_EVM,
+		DaScheme:    types.DASchemeMock,
 		GenesisInfo: mockGenesisInfo,
 	}
 	_, err := s.msgServer.CreateRollapp(goCtx, &rollapp)
@@ -52,8 +53,9 @@ func (s *RollappTestSuite) TestCreateRollappAlreadyExists() {
 		InitialSequencer: sample.AccAddress(),
 		MinSequencerBond: types.DefaultMinSequencerBondGlobalCoin,
 
-		Alias:  "rollapp",
-		VmType: types.Rollapp_EVM,
+		Alias:    "rollapp",
+		VmType:   types.Rollapp_EVM,
+		DaScheme: types.DASchemeMock,
 	}
 
 	_, err := s.msgServer.CreateRollapp(goCtx, &rollapp)
@@ -84,6 +86,7 @@ func (s *RollappTestSuite) TestCreateRollappAlreadyExists() {
 				Creator:     alice,
 				RollappId:   test.rollappId,
 				VmType:      types.Rollapp_EVM,
+				DaScheme:    types.DASchemeMock,
 				Alias:       strings.ToLower(rand.Str(7)),
 				GenesisInfo: mockGenesisInfo,
 			}
@@ -125,6 +128,7 @@ func (s *RollappTestSuite) TestOverwriteEIP155Key() {
 
 				Alias:       "alias",
 				VmType:      types.Rollapp_EVM,
+				DaScheme:    types.DASchemeMock,
 				GenesisInfo: mockGenesisInfo,
 			}
 			s.FundForAliasRegistration(rollapp)
@@ -147,6 +151,7 @@ func (s *RollappTestSuite) TestOverwriteEIP155Key() {
 				InitialSequencer: sample.AccAddress(),
 				Alias:            "alias",
 				VmType:           types.Rollapp_EVM,
+				DaScheme:         types.DASchemeMock,
 				GenesisInfo:      mockGenesisInfo,
 			}
 			s.FundForAliasRegistration(rollapp)
@@ -196,6 +201,7 @@ func (s *RollappTestSuite) createRollappWithCreatorAndVerify(
 		MinSequencerBond: types.DefaultMinSequencerBondGlobalCoin,
 		Alias:            strings.ToLower(rand.Str(7)),
 		VmType:           types.Rollapp_EVM,
+		DaScheme:         types.DASchemeMock,
 		Metadata:         &mockRollappMetadata,
 		GenesisInfo:      mockGenesisInfo,
 	}
@@ -209,6 +215,7 @@ func (s *RollappTestSuite) createRollappWithCreatorAndVerify(
 		InitialSequencer: rollapp.GetInitialSequencer(),
 		MinSequencerBond: sdk.NewCoins(types.DefaultMinSequencerBondGlobalCoin),
 		VmType:           types.Rollapp_EVM,
+		DaScheme:         types.DASchemeMock,
 		Metadata:         rollapp.GetMetadata(),
 		GenesisInfo:      *rollapp.GetGenesisInfo(),
 		Revisions: []types.Revision{{
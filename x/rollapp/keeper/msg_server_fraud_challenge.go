@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) SubmitFraudChallenge(goCtx context.Context, msg *types.MsgSubmitFraudChallenge) (*types.MsgSubmitFraudChallengeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := k.Keeper.SubmitFraudChallenge(ctx, sdk.MustAccAddressFromBech32(msg.Challenger), msg.RollappId, msg.StateIndex)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitFraudChallengeResponse{}, nil
+}
+
+func (k msgServer) BisectFraudChallenge(goCtx context.Context, msg *types.MsgBisectFraudChallenge) (*types.MsgBisectFraudChallengeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.BisectFraudChallenge(ctx, msg.Challenger, msg.RollappId, msg.Height, msg.StateRoot); err != nil {
+		return nil, err
+	}
+	return &types.MsgBisectFraudChallengeResponse{}, nil
+}
+
+func (k msgServer) ProveFraudChallenge(goCtx context.Context, msg *types.MsgProveFraudChallenge) (*types.MsgProveFraudChallengeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.ProveFraudChallenge(ctx, msg.RollappId, msg.StateRoot, msg.Proof); err != nil {
+		return nil, err
+	}
+	return &types.MsgProveFraudChallengeResponse{}, nil
+}
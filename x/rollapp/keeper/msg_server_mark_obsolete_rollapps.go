@@ -50,6 +50,9 @@ func (k Keeper) MarkObsoleteRollapps(ctx sdk.Context, drsVersions []uint32) (int
 		if err != nil {
 			return 0, fmt.Errorf("set obsolete DRS version: %w", err)
 		}
+		if err := k.setDRSVersionObsolete(ctx, v); err != nil {
+			return 0, fmt.Errorf("set DRS version status: %w", err)
+		}
 	}
 
 	var (
@@ -83,3 +86,22 @@ func (k Keeper) MarkObsoleteRollapps(ctx sdk.Context, drsVersions []uint32) (int
 
 	return obsoleteNum, nil
 }
+
+func (k msgServer) SetDRSVersion(goCtx context.Context, msg *types.MsgSetDRSVersion) (*types.MsgSetDRSVersionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "only the gov module can set DRS versions")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.UpdateDRSVersion(ctx, msg.DrsVersion); err != nil {
+		return nil, fmt.Errorf("update DRS version: %w", err)
+	}
+
+	return &types.MsgSetDRSVersionResponse{}, nil
+}
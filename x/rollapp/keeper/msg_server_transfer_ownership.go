@@ -2,11 +2,9 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -18,25 +16,37 @@ func (k msgServer) TransferOwnership(goCtx context.Context, msg *types.MsgTransf
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
-	if !ok {
-		return nil, types.ErrUnknownRollappID
+	if _, err := k.ProposeOwnershipTransfer(ctx, msg.CurrentOwner, msg.NewOwner, msg.RollappId); err != nil {
+		return nil, err
 	}
 
-	if rollapp.Owner != msg.CurrentOwner {
-		return nil, types.ErrUnauthorizedSigner
+	return &types.MsgTransferOwnershipResponse{}, nil
+}
+
+func (k msgServer) AcceptOwnership(goCtx context.Context, msg *types.MsgAcceptOwnership) (*types.MsgAcceptOwnershipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
 	}
 
-	if rollapp.Owner == msg.NewOwner {
-		return nil, types.ErrSameOwner
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.AcceptOwnership(ctx, msg.NewOwner, msg.RollappId); err != nil {
+		return nil, err
 	}
 
-	rollapp.Owner = msg.NewOwner
-	k.SetRollapp(ctx, rollapp)
+	return &types.MsgAcceptOwnershipResponse{}, nil
+}
 
-	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
-		return nil, fmt.Errorf("emit event: %w", err)
+func (k msgServer) CancelOwnershipTransfer(goCtx context.Context, msg *types.MsgCancelOwnershipTransfer) (*types.MsgCancelOwnershipTransferResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
 	}
 
-	return &types.MsgTransferOwnershipResponse{}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.CancelOwnershipTransfer(ctx, msg.CurrentOwner, msg.RollappId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOwnershipTransferResponse{}, nil
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -77,6 +79,8 @@ func (s *RollappTestSuite) TestTransferOwnership() {
 			goCtx := sdk.WrapSDKContext(s.Ctx)
 			_, err := s.msgServer.TransferOwnership(goCtx, tc.request)
 			if tc.expError == nil {
+				s.Require().NoError(err)
+				_, err = s.msgServer.AcceptOwnership(goCtx, types.NewMsgAcceptOwnership(tc.request.NewOwner, tc.request.RollappId))
 				s.Require().NoError(err)
 				resp, err := s.queryClient.Rollapp(goCtx, &types.QueryGetRollappRequest{RollappId: tc.request.RollappId})
 				s.Require().NoError(err)
@@ -87,3 +91,68 @@ func (s *RollappTestSuite) TestTransferOwnership() {
 		})
 	}
 }
+
+func (s *RollappTestSuite) TestTwoStepOwnershipTransfer() {
+	const rollappId = "rollapp_1234-1"
+	timelock := time.Hour
+	params := s.k().GetParams(s.Ctx)
+	params.OwnershipTransferTimelock = timelock
+	s.k().SetParams(s.Ctx, params)
+	s.k().SetRollapp(s.Ctx, types.Rollapp{
+		RollappId:   rollappId,
+		Owner:       alice,
+		GenesisInfo: *mockGenesisInfo,
+	})
+
+	owner := func() string {
+		rollapp, _ := s.k().GetRollapp(s.Ctx, rollappId)
+		return rollapp.Owner
+	}
+
+	_, err := s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, rollappId))
+	s.Require().NoError(err)
+	s.Require().Equal(alice, owner())
+
+	res, err := s.queryClient.PendingOwnershipTransfer(s.Ctx, &types.QueryPendingOwnershipTransferRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().Equal(bob, res.Transfer.NewOwner)
+	s.Require().Equal(s.Ctx.BlockTime().Add(timelock), res.Transfer.UnlockTime)
+
+	s.Run("only the new owner can accept", func() {
+		_, err := s.msgServer.AcceptOwnership(s.Ctx, types.NewMsgAcceptOwnership(alice, rollappId))
+		s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	})
+
+	s.Run("timelocked", func() {
+		_, err := s.msgServer.AcceptOwnership(s.Ctx, types.NewMsgAcceptOwnership(bob, rollappId))
+		s.Require().ErrorIs(err, types.ErrOwnershipTransferLocked)
+	})
+
+	s.Run("only the owner can cancel", func() {
+		_, err := s.msgServer.CancelOwnershipTransfer(s.Ctx, types.NewMsgCancelOwnershipTransfer(bob, rollappId))
+		s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	})
+
+	s.Run("cancel", func() {
+		_, err := s.msgServer.CancelOwnershipTransfer(s.Ctx, types.NewMsgCancelOwnershipTransfer(alice, rollappId))
+		s.Require().NoError(err)
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(timelock))
+		_, err = s.msgServer.AcceptOwnership(s.Ctx, types.NewMsgAcceptOwnership(bob, rollappId))
+		s.Require().ErrorIs(err, types.ErrOwnershipTransferNotFound)
+		s.Require().Equal(alice, owner())
+	})
+
+	s.Run("accept after the timelock", func() {
+		_, err := s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, rollappId))
+		s.Require().NoError(err)
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(timelock))
+		_, err = s.msgServer.AcceptOwnership(s.Ctx, types.NewMsgAcceptOwnership(bob, rollappId))
+		s.Require().NoError(err)
+		s.Require().Equal(bob, owner())
+
+		_, err = s.k().GetPendingOwnershipTransfer(s.Ctx, rollappId)
+		s.Require().ErrorIs(err, types.ErrOwnershipTransferNotFound)
+		_, err = s.msgServer.TransferOwnership(s.Ctx, types.NewMsgTransferOwnership(alice, bob, rollappId))
+		s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	})
+}
@@ -18,6 +18,8 @@ import (
 // - the rollapp metadata
 // - the genesis info (in case the genesis info is not sealed)
 // - the initial sequencer (in case the rollapp is not launched)
+// - the dispute period (within the bounds of the params)
+// - the proposer selection strategy
 func (k msgServer) UpdateRollappInformation(goCtx context.Context, msg *types.MsgUpdateRollappInformation) (*types.MsgUpdateRollappInformationResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
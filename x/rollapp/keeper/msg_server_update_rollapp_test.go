@@ -788,6 +788,7 @@ func (s *RollappTestSuite) TestCreateAndUpdateRollapp() {
 		MinSequencerBond: types.DefaultMinSequencerBondGlobalCoin,
 		Alias:            "default",
 		VmType:           types.Rollapp_EVM,
+		DaScheme:         types.DASchemeMock,
 		GenesisInfo:      &gInfo,
 	}
 	s.FundForAliasRegistration(msg)
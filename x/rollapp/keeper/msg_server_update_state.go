@@ -33,6 +33,10 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 			rollapp.LatestRevision().Number, msg.RollappRevision)
 	}
 
+	if err := k.ValidateDAPath(rollapp, msg.DAPath); err != nil {
+		return nil, errorsmod.Wrap(err, "da path")
+	}
+
 	// retrieve last updating index
 	var newIndex, lastIndex uint64
 	latestStateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, msg.RollappId)
@@ -102,6 +106,9 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "MsgUpdateState with an obsolete DRS version. rollapp_id: %s, drs_version: %d",
 			msg.RollappId, stateInfo.GetLatestBlockDescriptor().DrsVersion)
 	}
+	if err := k.warnDeprecatedDRSVersion(ctx, msg.RollappId, stateInfo.GetLatestBlockDescriptor().DrsVersion); err != nil {
+		return nil, errorsmod.Wrap(err, "warn deprecated DRS version")
+	}
 
 	// Write new index information to the store
 	k.SetLatestStateInfoIndex(ctx, types.StateInfoIndex{
@@ -110,6 +117,9 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 	})
 	// Write new state information to the store indexed by <RollappId,LatestStateInfoIndex>
 	k.SetStateInfo(ctx, *stateInfo)
+	if err := k.IndexStateInfoHeights(ctx, *stateInfo); err != nil {
+		return nil, errorsmod.Wrap(err, "index state info heights")
+	}
 
 	// call the after-update-state hook
 	// currently used by `x/lightclient` to validate the state update against consensus states
@@ -151,8 +161,7 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		}
 	}
 
-	// TODO: enforce `final_state_update_timeout` if sequencer rotation is in progress
-	// https://github.com/dymensionxyz/dymension/issues/1085
+	// NOTE: the `final_state_update_timeout` of a rotation is enforced by x/sequencer in begin block
 	rollapp = k.MustGetRollapp(ctx, msg.RollappId)
 	k.IndicateLiveness(ctx, &rollapp)
 	k.SetRollapp(ctx, rollapp)
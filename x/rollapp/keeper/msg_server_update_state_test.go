@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -182,7 +183,7 @@ func (s *RollappTestSuite) TestUpdateStateErrLogicUnpermissioned() {
 		RollappId:   rollappID,
 		StartHeight: 1,
 		NumBlocks:   3,
-		DAPath:      "",
+		DAPath:      "mock|1",
 		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 1}, {Height: 2}, {Height: 3}}},
 	}
 
@@ -225,6 +226,7 @@ func (s *RollappTestSuite) TestUpdateStateErrWrongBlockHeight() {
 		RollappId:   rollappId,
 		StartHeight: 2,
 		NumBlocks:   3,
+		DAPath:      "mock|1",
 		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 2}, {Height: 3}, {Height: 4}}},
 	}
 
@@ -248,6 +250,7 @@ func (s *RollappTestSuite) TestUpdateStateErrLogicMissingStateInfo() {
 		RollappId:   rollappId,
 		StartHeight: 1,
 		NumBlocks:   3,
+		DAPath:      "mock|1",
 		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 1}, {Height: 2}, {Height: 3}}},
 	}
 
@@ -272,7 +275,7 @@ func (s *RollappTestSuite) TestUpdateStateDowngradeTimestamp() {
 		Sequencer:      proposer,
 		StartHeight:    1,
 		NumBlocks:      1,
-		DAPath:         "",
+		DAPath:         "mock|1",
 		BDs:            types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 1}}},
 	}
 	s.k().SetLatestStateInfoIndex(s.Ctx, stateInfo.StateInfoIndex)
@@ -284,7 +287,7 @@ func (s *RollappTestSuite) TestUpdateStateDowngradeTimestamp() {
 		RollappId:   rollappId,
 		StartHeight: 2,
 		NumBlocks:   1,
-		DAPath:      "",
+		DAPath:      "mock|1",
 		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 2, Timestamp: time.Now().UTC()}}},
 	}
 	_, err := s.msgServer.UpdateState(s.Ctx, &updateState)
@@ -296,7 +299,7 @@ func (s *RollappTestSuite) TestUpdateStateDowngradeTimestamp() {
 		RollappId:   rollappId,
 		StartHeight: 3,
 		NumBlocks:   1,
-		DAPath:      "",
+		DAPath:      "mock|1",
 		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 3}}},
 	}
 	_, err = s.msgServer.UpdateState(s.Ctx, &updateState)
@@ -356,3 +359,43 @@ func getAll(suite *RollappTestSuite) (map[string]*types.RollappSummary, int) {
 
 	return rollappsRes, totalRes
 }
+
+func (s *RollappTestSuite) TestUpdateStateDAPath() {
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+	s.Require().Equal(types.DASchemeMock, s.k().MustGetRollapp(s.Ctx, rollappId).DaScheme)
+
+	update := func(daPath string) error {
+		_, err := s.msgServer.UpdateState(s.Ctx, &types.MsgUpdateState{
+			Creator:     proposer,
+			RollappId:   rollappId,
+			StartHeight: 1,
+			NumBlocks:   1,
+			DAPath:      daPath,
+			BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 1, Timestamp: time.Now().UTC()}}},
+		})
+		return err
+	}
+
+	// malformed
+	s.Require().ErrorIs(update(""), types.ErrInvalidDAPath)
+	s.Require().ErrorIs(update("mock|x"), types.ErrInvalidDAPath)
+	// another scheme
+	s.Require().ErrorIs(update("avail|7|"+strings.Repeat("ab", 32)+"|1"), types.ErrInvalidDAPath)
+
+	// the rollapp has no scheme
+	rollapp := s.k().MustGetRollapp(s.Ctx, rollappId)
+	rollapp.DaScheme = ""
+	s.k().SetRollapp(s.Ctx, rollapp)
+	s.Require().ErrorIs(update("mock|12"), types.ErrInvalidDAPath)
+	rollapp.DaScheme = types.DASchemeMock
+	s.k().SetRollapp(s.Ctx, rollapp)
+
+	s.Require().NoError(update("mock|12"))
+
+	// the parsed path is exposed in the query
+	res, err := s.k().StateInfo(s.Ctx, &types.QueryGetStateInfoRequest{RollappId: rollappId, Index: 1})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.ParsedDAPath)
+	s.Require().Equal(types.DASchemeMock, res.ParsedDAPath.Scheme)
+	s.Require().Equal([]types.DAPathField{{Key: "height", Value: "12"}}, res.ParsedDAPath.Fields)
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+/*
+Two-step ownership transfers.
+
+The owner proposes a new owner, who must accept, so that the rollapp is not lost to a wrong address. The
+transfer can be accepted once the timelock in the params has passed, and the owner can cancel it until then.
+The ownership of the IRO plan and of the DymNS aliases of the rollapp is the one of the rollapp, so it moves with it.
+*/
+
+func (k Keeper) GetPendingOwnershipTransfer(ctx sdk.Context, rollappID string) (types.PendingOwnershipTransfer, error) {
+	t, err := k.pendingOwnershipTransfers.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return t, errorsmod.Wrapf(types.ErrOwnershipTransferNotFound, "rollapp: %s", rollappID)
+	}
+	return t, err
+}
+
+func (k Keeper) SetPendingOwnershipTransfer(ctx sdk.Context, t types.PendingOwnershipTransfer) error {
+	return k.pendingOwnershipTransfers.Set(ctx, t.RollappId, t)
+}
+
+func (k Keeper) GetAllPendingOwnershipTransfers(ctx sdk.Context) ([]types.PendingOwnershipTransfer, error) {
+	iter, err := k.pendingOwnershipTransfers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// ProposeOwnershipTransfer replaces the pending transfer of the rollapp, if any
+func (k Keeper) ProposeOwnershipTransfer(ctx sdk.Context, owner, newOwner, rollappID string) (types.PendingOwnershipTransfer, error) {
+	rollapp, ok := k.GetRollapp(ctx, rollappID)
+	if !ok {
+		return types.PendingOwnershipTransfer{}, types.ErrUnknownRollappID
+	}
+	if rollapp.Owner != owner {
+		return types.PendingOwnershipTransfer{}, types.ErrUnauthorizedSigner
+	}
+	if rollapp.Owner == newOwner {
+		return types.PendingOwnershipTransfer{}, types.ErrSameOwner
+	}
+
+	t := types.PendingOwnershipTransfer{
+		RollappId:    rollappID,
+		CurrentOwner: owner,
+		NewOwner:     newOwner,
+		UnlockTime:   ctx.BlockTime().Add(k.GetParams(ctx).OwnershipTransferTimelock),
+	}
+	if err := k.SetPendingOwnershipTransfer(ctx, t); err != nil {
+		return t, errorsmod.Wrap(err, "set pending ownership transfer")
+	}
+
+	return t, uevent.EmitTypedEvent(ctx, &types.EventOwnershipTransferProposed{
+		RollappId:    t.RollappId,
+		CurrentOwner: t.CurrentOwner,
+		NewOwner:     t.NewOwner,
+		UnlockTime:   t.UnlockTime,
+	})
+}
+
+// AcceptOwnership completes the pending transfer of the rollapp
+func (k Keeper) AcceptOwnership(ctx sdk.Context, newOwner, rollappID string) error {
+	t, err := k.GetPendingOwnershipTransfer(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	if t.NewOwner != newOwner {
+		return types.ErrUnauthorizedSigner
+	}
+	if ctx.BlockTime().Before(t.UnlockTime) {
+		return errorsmod.Wrapf(types.ErrOwnershipTransferLocked, "unlock time: %s", t.UnlockTime)
+	}
+
+	rollapp, ok := k.GetRollapp(ctx, rollappID)
+	if !ok {
+		return types.ErrUnknownRollappID
+	}
+	if rollapp.Owner != t.CurrentOwner {
+		// not expected, the transfer is removed whenever the owner changes
+		return errorsmod.Wrap(types.ErrLogic, "pending ownership transfer proposed by a previous owner")
+	}
+
+	if err := k.hooks.BeforeRollappOwnerChanged(ctx, rollappID, sdk.MustAccAddressFromBech32(newOwner)); err != nil {
+		return errorsmod.Wrap(err, "before rollapp owner changed")
+	}
+
+	rollapp.Owner = newOwner
+	k.SetRollapp(ctx, rollapp)
+	if err := k.pendingOwnershipTransfers.Remove(ctx, rollappID); err != nil {
+		return errorsmod.Wrap(err, "remove pending ownership transfer")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventOwnershipTransferred{
+		RollappId:     rollappID,
+		PreviousOwner: t.CurrentOwner,
+		NewOwner:      newOwner,
+	})
+}
+
+// CancelOwnershipTransfer removes the pending transfer of the rollapp
+func (k Keeper) CancelOwnershipTransfer(ctx sdk.Context, owner, rollappID string) error {
+	t, err := k.GetPendingOwnershipTransfer(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	if t.CurrentOwner != owner {
+		return types.ErrUnauthorizedSigner
+	}
+
+	if err := k.pendingOwnershipTransfers.Remove(ctx, rollappID); err != nil {
+		return errorsmod.Wrap(err, "remove pending ownership transfer")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventOwnershipTransferCanceled{
+		RollappId:    rollappID,
+		CurrentOwner: t.CurrentOwner,
+		NewOwner:     t.NewOwner,
+	})
+}
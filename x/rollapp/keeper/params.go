@@ -27,6 +27,17 @@ func (k Keeper) DisputePeriodInBlocks(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).DisputePeriodInBlocks
 }
 
+// RollappDisputePeriodInBlocks returns the dispute period of the rollapp. It is the rollapp override, if set,
+// kept within the current bounds of the params, or the DisputePeriodInBlocks param.
+func (k Keeper) RollappDisputePeriodInBlocks(ctx sdk.Context, rollappID string) uint64 {
+	params := k.GetParams(ctx)
+	ra, ok := k.GetRollapp(ctx, rollappID)
+	if !ok || ra.DisputePeriodInBlocks == 0 {
+		return params.DisputePeriodInBlocks
+	}
+	return params.ClampDisputePeriod(ra.DisputePeriodInBlocks)
+}
+
 func (k Keeper) LivenessSlashBlocks(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).LivenessSlashBlocks
 }
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// FirstRetainedStateIndex returns the index of the oldest state info of the rollapp which was not pruned
+func (k Keeper) FirstRetainedStateIndex(ctx sdk.Context, rollappID string) uint64 {
+	pruned, err := k.prunedStateIndex.Get(ctx, rollappID)
+	if err != nil {
+		// not found, nothing was pruned
+		return 1
+	}
+	return pruned + 1
+}
+
+func (k Keeper) SetPrunedStateIndex(ctx sdk.Context, ix types.StateInfoIndex) error {
+	return k.prunedStateIndex.Set(ctx, ix.RollappId, ix.Index)
+}
+
+func (k Keeper) GetAllPrunedStateIndexes(ctx sdk.Context) ([]types.StateInfoIndex, error) {
+	var ret []types.StateInfoIndex
+	err := k.prunedStateIndex.Walk(ctx, nil, func(rollappID string, index uint64) (bool, error) {
+		ret = append(ret, types.StateInfoIndex{RollappId: rollappID, Index: index})
+		return false, nil
+	})
+	return ret, err
+}
+
+// PruneStateInfos is called in EndBlock. It removes the finalized state infos which are beyond either the retention
+// count or the retention period, oldest first, resuming with the rollapp where the previous block stopped.
+// Visiting a rollapp and pruning a state info each consume one unit of the budget.
+// The latest finalized state info, and all the pending ones, are never pruned.
+func (k Keeper) PruneStateInfos(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	budget := params.StatePruningBudget
+	if budget == 0 {
+		return nil
+	}
+
+	cursor, err := k.statePrunerCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(err, "get cursor")
+	}
+
+	// one more than can be visited, to know where to continue next time
+	candidates := k.prunerCandidates(ctx, cursor, budget+1)
+
+	next := ""
+	for _, latestFinalized := range candidates {
+		if budget == 0 {
+			next = latestFinalized.RollappId
+			break
+		}
+		budget--
+		pruned, done, err := k.pruneRollappStateInfos(ctx, latestFinalized, params, budget)
+		if err != nil {
+			return errorsmod.Wrapf(err, "prune: rollapp: %s", latestFinalized.RollappId)
+		}
+		budget -= pruned
+		if !done {
+			next = latestFinalized.RollappId
+			break
+		}
+	}
+
+	if next != cursor {
+		if err := k.statePrunerCursor.Set(ctx, next); err != nil {
+			return errorsmod.Wrap(err, "set cursor")
+		}
+	}
+	return nil
+}
+
+// prunerCandidates returns the latest finalized state index of up to max rollapps, starting with the cursor.
+// The scan wraps around, so that each rollapp is eventually visited.
+func (k Keeper) prunerCandidates(ctx sdk.Context, cursor string, max uint64) []types.StateInfoIndex {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LatestFinalizedStateIndexKeyPrefix))
+	var mid []byte
+	if cursor != "" {
+		mid = types.LatestFinalizedStateIndexKey(cursor)
+	}
+
+	var ret []types.StateInfoIndex
+	scan := func(start, end []byte) {
+		it := store.Iterator(start, end)
+		defer it.Close() // nolint: errcheck
+		for ; it.Valid() && uint64(len(ret)) < max; it.Next() {
+			var ix types.StateInfoIndex
+			k.cdc.MustUnmarshal(it.Value(), &ix)
+			ret = append(ret, ix)
+		}
+	}
+	scan(mid, nil)
+	if mid != nil {
+		// wrap around, everything from the cursor was scanned
+		scan(nil, mid)
+	}
+	return ret
+}
+
+// pruneRollappStateInfos prunes up to budget state infos of the rollapp. It returns the number pruned, and whether
+// there is nothing more to prune for now.
+func (k Keeper) pruneRollappStateInfos(
+	ctx sdk.Context,
+	latestFinalized types.StateInfoIndex,
+	params types.Params,
+	budget uint64,
+) (uint64, bool, error) {
+	rollappID := latestFinalized.RollappId
+	first := k.FirstRetainedStateIndex(ctx, rollappID)
+	var pruned uint64
+	for ; pruned < budget; pruned++ {
+		i := first + pruned
+		if latestFinalized.Index <= i {
+			// the latest finalized is always kept
+			return pruned, true, nil
+		}
+		s, ok := k.GetStateInfo(ctx, rollappID, i)
+		if !ok {
+			return pruned, false, errorsmod.Wrapf(gerrc.ErrInternal, "state info not found: index: %d", i)
+		}
+		beyondCount := i+params.StateRetentionCount <= latestFinalized.Index
+		beyondPeriod := 0 < params.StateRetentionPeriod && !ctx.BlockTime().Before(s.CreatedAt.Add(params.StateRetentionPeriod))
+		if !beyondCount && !beyondPeriod {
+			// the next ones are younger and within the count
+			return pruned, true, nil
+		}
+		if err := k.pruneStateInfo(ctx, s); err != nil {
+			return pruned, false, errorsmod.Wrapf(err, "index: %d", i)
+		}
+	}
+	return pruned, false, nil
+}
+
+// pruneStateInfo removes the state info and its height index, and records it as the last pruned of the rollapp.
+// The state info is emitted in full, so that it can be archived.
+func (k Keeper) pruneStateInfo(ctx sdk.Context, s types.StateInfo) error {
+	rollappID := s.StateInfoIndex.RollappId
+	k.RemoveStateInfo(ctx, rollappID, s.StateInfoIndex.Index)
+
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID).
+		StartInclusive(s.StartHeight).
+		EndInclusive(s.GetLatestHeight())
+	if err := k.stateInfoIndexByHeight.Clear(ctx, rng); err != nil {
+		return errorsmod.Wrap(err, "unindex heights")
+	}
+
+	if err := k.SetPrunedStateIndex(ctx, s.StateInfoIndex); err != nil {
+		return errorsmod.Wrap(err, "set pruned state index")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventStateInfoPruned{StateInfo: &s})
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestPruneStateInfos() {
+	rollappId, proposer := s.CreateDefaultRollappAndProposer()
+
+	initialHeight := int64(10)
+	s.Ctx = s.Ctx.WithBlockHeight(initialHeight)
+	created := s.Ctx.BlockTime()
+	for i := uint64(0); i < 5; i++ {
+		_, err := s.PostStateUpdate(s.Ctx, rollappId, proposer, 1+i*5, 5)
+		s.Require().NoError(err)
+	}
+	s.Ctx = s.Ctx.WithBlockHeight(initialHeight + int64(types.DefaultDisputePeriodInBlocks))
+	s.k().FinalizeRollappStates(s.Ctx)
+	latestFinalized, ok := s.k().GetLatestFinalizedStateIndex(s.Ctx, rollappId)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(5), latestFinalized.Index)
+
+	// keep 3 states or an hour, prune one state per block since visiting the rollapp uses one unit
+	s.k().SetParams(s.Ctx, s.k().GetParams(s.Ctx).WithStateRetention(3, time.Hour, 2))
+	eventName := proto.MessageName(&types.EventStateInfoPruned{})
+
+	// recent, but beyond the count
+	s.Ctx = s.Ctx.WithBlockTime(created.Add(30 * time.Minute))
+	for i := uint64(1); i <= 3; i++ {
+		s.Require().NoError(s.k().PruneStateInfos(s.Ctx))
+		// the last three finalized states are retained
+		s.Require().Equal(min(i, 2)+1, s.k().FirstRetainedStateIndex(s.Ctx, rollappId))
+	}
+	s.AssertEventEmitted(s.Ctx, eventName, 2)
+
+	// beyond the period, only the latest finalized state is retained
+	s.Ctx = s.Ctx.WithBlockTime(created.Add(2 * time.Hour))
+	for i := uint64(1); i <= 3; i++ {
+		s.Require().NoError(s.k().PruneStateInfos(s.Ctx))
+		s.Require().Equal(min(i, 2)+3, s.k().FirstRetainedStateIndex(s.Ctx, rollappId))
+	}
+	s.AssertEventEmitted(s.Ctx, eventName, 4)
+
+	for i := uint64(1); i <= 4; i++ {
+		_, ok := s.k().GetStateInfo(s.Ctx, rollappId, i)
+		s.Require().False(ok)
+	}
+	_, ok = s.k().GetLatestFinalizedStateInfo(s.Ctx, rollappId)
+	s.Require().True(ok)
+
+	_, err := s.k().FindStateInfoByHeight(s.Ctx, rollappId, 15)
+	s.Require().True(errorsmod.IsOf(err, types.ErrStateInfoPruned))
+	_, err = s.k().GetStateInfoByHeight(s.Ctx, rollappId, 1)
+	s.Require().True(errorsmod.IsOf(err, types.ErrStateInfoPruned))
+	stateInfo, err := s.k().GetStateInfoByHeight(s.Ctx, rollappId, 21)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), stateInfo.StateInfoIndex.Index)
+
+	_, broken := keeper.RollappFinalizedStateInvariant(*s.k())(s.Ctx)
+	s.Require().False(broken)
+}
@@ -61,6 +61,19 @@ func (k Keeper) CheckAndUpdateRollappFields(ctx sdk.Context, update *types.MsgUp
 		current.Metadata = update.Metadata
 	}
 
+	if update.DisputePeriodInBlocks != 0 {
+		params := k.GetParams(ctx)
+		if params.ClampDisputePeriod(update.DisputePeriodInBlocks) != update.DisputePeriodInBlocks {
+			return current, errorsmod.Wrapf(types.ErrInvalidDisputePeriod, "min: %d: max: %d: got: %d",
+				params.MinDisputePeriodInBlocks, params.MaxDisputePeriodInBlocks, update.DisputePeriodInBlocks)
+		}
+		current.DisputePeriodInBlocks = update.DisputePeriodInBlocks
+	}
+
+	if update.ProposerSelection != types.ProposerSelectionUnspecified {
+		current.ProposerSelection = update.ProposerSelection
+	}
+
 	if err := current.ValidateBasic(); err != nil {
 		return current, fmt.Errorf("validate rollapp: %w", err)
 	}
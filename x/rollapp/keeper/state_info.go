@@ -1,12 +1,16 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -97,3 +101,41 @@ func (k Keeper) GetAllStateInfo(ctx sdk.Context) (list []types.StateInfo) {
 
 	return
 }
+
+// IndexStateInfoHeights indexes each height of the state info, for lookups by height
+func (k Keeper) IndexStateInfoHeights(ctx sdk.Context, stateInfo types.StateInfo) error {
+	for _, bd := range stateInfo.BDs.BD {
+		key := collections.Join(stateInfo.StateInfoIndex.RollappId, bd.Height)
+		if err := k.stateInfoIndexByHeight.Set(ctx, key, stateInfo.StateInfoIndex.Index); err != nil {
+			return errorsmod.Wrapf(err, "set: height: %d", bd.Height)
+		}
+	}
+	return nil
+}
+
+// unindexStateInfoHeightsFrom removes the indexed heights of the rollapp, from the given height and up
+func (k Keeper) unindexStateInfoHeightsFrom(ctx sdk.Context, rollappID string, height uint64) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID).StartInclusive(height)
+	return k.stateInfoIndexByHeight.Clear(ctx, rng)
+}
+
+// GetStateInfoByHeight returns the state info with the height, using the height index.
+// Heights which were not indexed, i.e. committed before the index existed, are searched for.
+func (k Keeper) GetStateInfoByHeight(ctx sdk.Context, rollappID string, height uint64) (types.StateInfo, error) {
+	index, err := k.stateInfoIndexByHeight.Get(ctx, collections.Join(rollappID, height))
+	if errors.Is(err, collections.ErrNotFound) {
+		s, err := k.FindStateInfoByHeight(ctx, rollappID, height)
+		if err != nil {
+			return types.StateInfo{}, err
+		}
+		return *s, nil
+	}
+	if err != nil {
+		return types.StateInfo{}, errorsmod.Wrap(err, "get index")
+	}
+	s, ok := k.GetStateInfo(ctx, rollappID, index)
+	if !ok || !s.ContainsHeight(height) {
+		return types.StateInfo{}, errorsmod.Wrapf(gerrc.ErrInternal, "indexed state info does not contain height: rollapp: %s: height: %d", rollappID, height)
+	}
+	return s, nil
+}
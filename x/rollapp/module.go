@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 	"github.com/spf13/cobra"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/client/cli"
@@ -162,11 +163,19 @@ func (am AppModule) GetHooks() []types.RollappHooks {
 	return am.keeper.GetHooks()
 }
 
-// EndBlock finalizes states from rollapps (after dispute period) and corresponding packets. It slashes and jails
-// sequencers of inactive rollapps.
+// EndBlock resolves expired fraud challenges, obsoletes the DRS versions past their upgrade deadline, finalizes states from rollapps (after dispute period) and corresponding
+// packets. It slashes and jails sequencers of inactive rollapps, and prunes old finalized states.
 func (am AppModule) EndBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	am.keeper.ExpireFraudChallenges(ctx)
+	if err := osmoutils.ApplyFuncIfNoError(ctx, am.keeper.ObsoleteExpiredDRSVersions); err != nil {
+		am.keeper.Logger(ctx).Error("Obsolete expired DRS versions.", "err", err)
+	}
 	am.keeper.FinalizeRollappStates(ctx)
 	am.keeper.CheckLiveness(ctx)
+	// the pruning is atomic, so that a failure does not leave a partially pruned state info behind
+	if err := osmoutils.ApplyFuncIfNoError(ctx, am.keeper.PruneStateInfos); err != nil {
+		am.keeper.Logger(ctx).Error("Prune state infos.", "err", err)
+	}
 	return nil
 }
@@ -11,12 +11,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateRollapp{}, "rollapp/CreateRollapp", nil)
 	cdc.RegisterConcrete(&MsgUpdateRollappInformation{}, "rollapp/UpdateRollappInformation", nil)
 	cdc.RegisterConcrete(&MsgTransferOwnership{}, "rollapp/TransferDymNameOwnership", nil)
+	cdc.RegisterConcrete(&MsgAcceptOwnership{}, "rollapp/AcceptOwnership", nil)
+	cdc.RegisterConcrete(&MsgCancelOwnershipTransfer{}, "rollapp/CancelOwnershipTransfer", nil)
 	cdc.RegisterConcrete(&MsgUpdateState{}, "rollapp/UpdateState", nil)
 	cdc.RegisterConcrete(&MsgAddApp{}, "rollapp/AddApp", nil)
 	cdc.RegisterConcrete(&MsgUpdateApp{}, "rollapp/UpdateApp", nil)
 	cdc.RegisterConcrete(&MsgRemoveApp{}, "rollapp/RemoveApp", nil)
 	cdc.RegisterConcrete(&MsgRollappFraudProposal{}, "rollapp/RollappFraudProposal", nil)
 	cdc.RegisterConcrete(&MsgMarkObsoleteRollapps{}, "rollapp/MarkObsoleteRollapps", nil)
+	cdc.RegisterConcrete(&MsgSetDRSVersion{}, "rollapp/SetDRSVersion", nil)
+	cdc.RegisterConcrete(&MsgSubmitFraudChallenge{}, "rollapp/SubmitFraudChallenge", nil)
+	cdc.RegisterConcrete(&MsgBisectFraudChallenge{}, "rollapp/BisectFraudChallenge", nil)
+	cdc.RegisterConcrete(&MsgProveFraudChallenge{}, "rollapp/ProveFraudChallenge", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "rollapp/UpdateParams", nil)
 	cdc.RegisterConcrete(Params{}, "rollapp/Params", nil)
 	cdc.RegisterConcrete(&MsgForceGenesisInfoChange{}, "rollapp/ForceGenesisInfoChange", nil)
@@ -28,12 +34,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateRollapp{},
 		&MsgUpdateRollappInformation{},
 		&MsgTransferOwnership{},
+		&MsgAcceptOwnership{},
+		&MsgCancelOwnershipTransfer{},
 		&MsgUpdateState{},
 		&MsgAddApp{},
 		&MsgUpdateApp{},
 		&MsgRemoveApp{},
 		&MsgRollappFraudProposal{},
 		&MsgMarkObsoleteRollapps{},
+		&MsgSetDRSVersion{},
+		&MsgSubmitFraudChallenge{},
+		&MsgBisectFraudChallenge{},
+		&MsgProveFraudChallenge{},
 		&MsgForceGenesisInfoChange{},
 		&MsgUpdateParams{},
 	)
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DAPathSeparator separates the scheme and the fields of a DA path, as in dymint
+	DAPathSeparator = "|"
+
+	maxDAPathLength = 1024
+
+	DASchemeCelestia = "celestia"
+	DASchemeAvail    = "avail"
+	DASchemeMock     = "mock"
+)
+
+var daSchemeRegex = regexp.MustCompile(`^[a-z][a-z0-9]{0,31}$`)
+
+// DAPathParser validates the structure and the commitment format of the fields of a DA path,
+// which follow the scheme prefix
+type DAPathParser interface {
+	Parse(fields []string) ([]DAPathField, error)
+}
+
+// DefaultDAPathParsers returns the parsers of the DA layers supported by dymint
+func DefaultDAPathParsers() map[string]DAPathParser {
+	return map[string]DAPathParser{
+		DASchemeCelestia: CelestiaDAPathParser{},
+		DASchemeAvail:    AvailDAPathParser{},
+		DASchemeMock:     MockDAPathParser{},
+	}
+}
+
+func ValidateDAScheme(scheme string) error {
+	if !daSchemeRegex.MatchString(scheme) {
+		return errorsmod.Wrapf(ErrInvalidDAPath, "scheme: %s", scheme)
+	}
+	return nil
+}
+
+// SplitDAPath splits the path into its scheme and its fields
+func SplitDAPath(path string) (string, []string, error) {
+	if maxDAPathLength < len(path) {
+		return "", nil, errorsmod.Wrapf(ErrInvalidDAPath, "too long: %d", len(path))
+	}
+	parts := strings.Split(path, DAPathSeparator)
+	if err := ValidateDAScheme(parts[0]); err != nil {
+		return "", nil, err
+	}
+	return parts[0], parts[1:], nil
+}
+
+// ValidateBasicDAPath checks the path is bounded, without knowing the DA scheme of the rollapp.
+// Rollapps which did not declare their DA scheme may post any path, so the structure is checked statefully.
+func ValidateBasicDAPath(path string) error {
+	if maxDAPathLength < len(path) {
+		return errorsmod.Wrapf(ErrInvalidDAPath, "too long: %d", len(path))
+	}
+	return nil
+}
+
+// ParseDAPath parses the path with the parser of its scheme
+func ParseDAPath(parsers map[string]DAPathParser, path string) (ParsedDAPath, error) {
+	scheme, fields, err := SplitDAPath(path)
+	if err != nil {
+		return ParsedDAPath{}, err
+	}
+	parser, ok := parsers[scheme]
+	if !ok {
+		return ParsedDAPath{}, errorsmod.Wrapf(ErrInvalidDAPath, "unknown scheme: %s", scheme)
+	}
+	parsed, err := parser.Parse(fields)
+	if err != nil {
+		return ParsedDAPath{}, errorsmod.Wrapf(ErrInvalidDAPath, "scheme: %s: %s", scheme, err)
+	}
+	return ParsedDAPath{Scheme: scheme, Fields: parsed}, nil
+}
+
+// CelestiaDAPathParser parses height|index|length|commitment|namespace|root
+type CelestiaDAPathParser struct{}
+
+func (CelestiaDAPathParser) Parse(fields []string) ([]DAPathField, error) {
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("expected 6 fields, got %d", len(fields))
+	}
+	if err := parsePositive("height", fields[0]); err != nil {
+		return nil, err
+	}
+	if _, err := strconv.ParseUint(fields[1], 10, 32); err != nil {
+		return nil, fmt.Errorf("index: %w", err)
+	}
+	if err := parsePositive("length", fields[2]); err != nil {
+		return nil, err
+	}
+	if err := parseHex("commitment", fields[3], 32); err != nil {
+		return nil, err
+	}
+	if err := parseHex("namespace", fields[4], 29); err != nil {
+		return nil, err
+	}
+	if err := parseHex("root", fields[5], 32); err != nil {
+		return nil, err
+	}
+	return namedFields([]string{"height", "index", "length", "commitment", "namespace", "root"}, fields), nil
+}
+
+// AvailDAPathParser parses height|block_hash|index
+type AvailDAPathParser struct{}
+
+func (AvailDAPathParser) Parse(fields []string) ([]DAPathField, error) {
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("expected 3 fields, got %d", len(fields))
+	}
+	if err := parsePositive("height", fields[0]); err != nil {
+		return nil, err
+	}
+	if err := parseHex("block hash", fields[1], 32); err != nil {
+		return nil, err
+	}
+	if _, err := strconv.ParseUint(fields[2], 10, 32); err != nil {
+		return nil, fmt.Errorf("index: %w", err)
+	}
+	return namedFields([]string{"height", "block_hash", "index"}, fields), nil
+}
+
+// MockDAPathParser parses the path of the local DA used for testing: height
+type MockDAPathParser struct{}
+
+func (MockDAPathParser) Parse(fields []string) ([]DAPathField, error) {
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("expected 1 field, got %d", len(fields))
+	}
+	if _, err := strconv.ParseUint(fields[0], 10, 64); err != nil {
+		return nil, fmt.Errorf("height: %w", err)
+	}
+	return namedFields([]string{"height"}, fields), nil
+}
+
+func parsePositive(name, s string) error {
+	x, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if x == 0 {
+		return fmt.Errorf("%s: zero", name)
+	}
+	return nil
+}
+
+func parseHex(name, s string, size int) error {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	if len(bz) != size {
+		return fmt.Errorf("%s: expected %d bytes, got %d", name, size, len(bz))
+	}
+	return nil
+}
+
+func namedFields(keys, values []string) []DAPathField {
+	ret := make([]DAPathField, len(keys))
+	for i := range keys {
+		ret[i] = DAPathField{Key: keys[i], Value: values[i]}
+	}
+	return ret
+}
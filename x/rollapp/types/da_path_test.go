@@ -0,0 +1,86 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDAPath(t *testing.T) {
+	hex32 := strings.Repeat("ab", 32)
+	hex29 := strings.Repeat("cd", 29)
+
+	tests := []struct {
+		name   string
+		path   string
+		scheme string
+		fields int
+		err    error
+	}{
+		{
+			name:   "celestia",
+			path:   "celestia|100|2|5|" + hex32 + "|" + hex29 + "|" + hex32,
+			scheme: DASchemeCelestia,
+			fields: 6,
+		}, {
+			name: "celestia: missing root",
+			path: "celestia|100|2|5|" + hex32 + "|" + hex29,
+			err:  ErrInvalidDAPath,
+		}, {
+			name: "celestia: zero height",
+			path: "celestia|0|2|5|" + hex32 + "|" + hex29 + "|" + hex32,
+			err:  ErrInvalidDAPath,
+		}, {
+			name: "celestia: short commitment",
+			path: "celestia|100|2|5|abcd|" + hex29 + "|" + hex32,
+			err:  ErrInvalidDAPath,
+		}, {
+			name: "celestia: namespace not hex",
+			path: "celestia|100|2|5|" + hex32 + "|" + strings.Repeat("zz", 29) + "|" + hex32,
+			err:  ErrInvalidDAPath,
+		}, {
+			name:   "avail",
+			path:   "avail|7|" + hex32 + "|1",
+			scheme: DASchemeAvail,
+			fields: 3,
+		}, {
+			name: "avail: bad index",
+			path: "avail|7|" + hex32 + "|x",
+			err:  ErrInvalidDAPath,
+		}, {
+			name:   "mock",
+			path:   "mock|12",
+			scheme: DASchemeMock,
+			fields: 1,
+		}, {
+			name: "unknown scheme",
+			path: "foo|12",
+			err:  ErrInvalidDAPath,
+		}, {
+			name: "bad scheme",
+			path: "Mock|12",
+			err:  ErrInvalidDAPath,
+		}, {
+			name: "empty",
+			path: "",
+			err:  ErrInvalidDAPath,
+		}, {
+			name: "too long",
+			path: "mock|" + strings.Repeat("1", maxDAPathLength),
+			err:  ErrInvalidDAPath,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := ParseDAPath(DefaultDAPathParsers(), tt.path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.scheme, parsed.Scheme)
+			require.Len(t, parsed.Fields, tt.fields)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (v DRSVersion) ValidateBasic() error {
+	switch v.Status {
+	case DRSVersionActive, DRSVersionObsolete:
+	case DRSVersionDeprecated:
+		if v.UpgradeTime.IsZero() {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "deprecated version without upgrade time")
+		}
+		if v.UpgradeHeight < 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "negative upgrade height")
+		}
+	default:
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "status: %s", v.Status)
+	}
+	return nil
+}
+
+// DeadlinePassed is true if the version is deprecated, and both the upgrade time and height are reached
+func (v DRSVersion) DeadlinePassed(now time.Time, height int64) bool {
+	return v.Status == DRSVersionDeprecated && !now.Before(v.UpgradeTime) && v.UpgradeHeight <= height
+}
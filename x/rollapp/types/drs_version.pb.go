@@ -0,0 +1,488 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/rollapp/drs_version.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DRSVersionStatus int32
+
+const (
+	// DRS_VERSION_STATUS_UNSPECIFIED is not a valid status
+	DRSVersionStatusUnspecified DRSVersionStatus = 0
+	// DRS_VERSION_STATUS_ACTIVE is a supported version
+	DRSVersionActive DRSVersionStatus = 1
+	// DRS_VERSION_STATUS_DEPRECATED is a version the rollapps must upgrade from
+	// before the deadline, when it becomes obsolete
+	DRSVersionDeprecated DRSVersionStatus = 2
+	// DRS_VERSION_STATUS_OBSOLETE is a version the rollapps cannot update the
+	// state with. The rollapps which used it are hard forked.
+	DRSVersionObsolete DRSVersionStatus = 3
+)
+
+var DRSVersionStatus_name = map[int32]string{
+	0: "DRS_VERSION_STATUS_UNSPECIFIED",
+	1: "DRS_VERSION_STATUS_ACTIVE",
+	2: "DRS_VERSION_STATUS_DEPRECATED",
+	3: "DRS_VERSION_STATUS_OBSOLETE",
+}
+
+var DRSVersionStatus_value = map[string]int32{
+	"DRS_VERSION_STATUS_UNSPECIFIED": 0,
+	"DRS_VERSION_STATUS_ACTIVE":      1,
+	"DRS_VERSION_STATUS_DEPRECATED":  2,
+	"DRS_VERSION_STATUS_OBSOLETE":    3,
+}
+
+func (x DRSVersionStatus) String() string {
+	return proto.EnumName(DRSVersionStatus_name, int32(x))
+}
+
+func (DRSVersionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e9b35311a5317566, []int{0}
+}
+
+// DRSVersion is the lifecycle of a DRS version, managed by the governance
+type DRSVersion struct {
+	Version uint32           `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Status  DRSVersionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=dymensionxyz.dymension.rollapp.DRSVersionStatus" json:"status,omitempty"`
+	// upgrade_time is the deadline of a deprecated version, it becomes obsolete
+	// once it passes
+	UpgradeTime time.Time `protobuf:"bytes,3,opt,name=upgrade_time,json=upgradeTime,proto3,stdtime" json:"upgrade_time"`
+	// upgrade_height is the hub height the deprecated version must also reach
+	// before it becomes obsolete, zero for none
+	UpgradeHeight int64 `protobuf:"varint,4,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+}
+
+func (m *DRSVersion) Reset()         { *m = DRSVersion{} }
+func (m *DRSVersion) String() string { return proto.CompactTextString(m) }
+func (*DRSVersion) ProtoMessage()    {}
+func (*DRSVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9b35311a5317566, []int{0}
+}
+func (m *DRSVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DRSVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DRSVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DRSVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DRSVersion.Merge(m, src)
+}
+func (m *DRSVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DRSVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DRSVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DRSVersion proto.InternalMessageInfo
+
+func (m *DRSVersion) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DRSVersion) GetStatus() DRSVersionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DRSVersionStatusUnspecified
+}
+
+func (m *DRSVersion) GetUpgradeTime() time.Time {
+	if m != nil {
+		return m.UpgradeTime
+	}
+	return time.Time{}
+}
+
+func (m *DRSVersion) GetUpgradeHeight() int64 {
+	if m != nil {
+		return m.UpgradeHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.rollapp.DRSVersionStatus", DRSVersionStatus_name, DRSVersionStatus_value)
+	proto.RegisterType((*DRSVersion)(nil), "dymensionxyz.dymension.rollapp.DRSVersion")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/drs_version.proto", fileDescriptor_e9b35311a5317566)
+}
+
+var fileDescriptor_e9b35311a5317566 = []byte{
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xda, 0x40,
+	0x14, 0xc7, 0x33, 0xba, 0x6c, 0xcb, 0x6c, 0x77, 0x09, 0x83, 0x94, 0x34, 0x4b, 0xc7, 0x50, 0x28,
+	0x48, 0x0f, 0x93, 0x65, 0x2d, 0xf4, 0xd0, 0x93, 0x9a, 0x69, 0x57, 0x28, 0xba, 0x24, 0xd1, 0x43,
+	0x2f, 0x21, 0x9a, 0xd9, 0x18, 0x50, 0x27, 0x64, 0x46, 0x59, 0xfb, 0x09, 0x16, 0x4f, 0xfb, 0x05,
+	0x3c, 0xf5, 0xcb, 0xec, 0x71, 0x8f, 0x3d, 0xb5, 0x8b, 0x7e, 0x91, 0x62, 0x4c, 0x74, 0x11, 0xdb,
+	0x5b, 0xfe, 0x2f, 0xef, 0xf7, 0xde, 0x0f, 0xe6, 0xc1, 0x8b, 0x60, 0x36, 0x62, 0x63, 0x11, 0xf1,
+	0xf1, 0xed, 0xec, 0x87, 0xb9, 0x0d, 0x66, 0xc2, 0x87, 0x43, 0x3f, 0x8e, 0xcd, 0x20, 0x11, 0xde,
+	0x94, 0x25, 0xeb, 0x1a, 0x89, 0x13, 0x2e, 0x39, 0xc2, 0xcf, 0x09, 0xb2, 0x0d, 0x24, 0x23, 0xf4,
+	0x52, 0xc8, 0x43, 0x9e, 0xb6, 0x9a, 0xeb, 0xaf, 0x0d, 0xa5, 0x97, 0x43, 0xce, 0xc3, 0x21, 0x33,
+	0xd3, 0xd4, 0x9b, 0xdc, 0x98, 0x32, 0x1a, 0x31, 0x21, 0xfd, 0x51, 0xbc, 0x69, 0x78, 0xf7, 0x04,
+	0x20, 0xb4, 0x6c, 0xa7, 0xbb, 0xd9, 0x85, 0x34, 0xf8, 0x22, 0x5b, 0xab, 0x01, 0x03, 0x54, 0x4e,
+	0xed, 0x3c, 0xa2, 0x2b, 0x78, 0x2c, 0xa4, 0x2f, 0x27, 0x42, 0x2b, 0x18, 0xa0, 0x72, 0x76, 0x79,
+	0x41, 0xfe, 0x2f, 0x44, 0x76, 0x53, 0x9d, 0x94, 0xb3, 0x33, 0x1e, 0x7d, 0x85, 0xaf, 0x26, 0x71,
+	0x98, 0xf8, 0x01, 0xf3, 0xd6, 0x36, 0x5a, 0xd1, 0x00, 0x95, 0x93, 0x4b, 0x9d, 0x6c, 0x54, 0x49,
+	0xae, 0x4a, 0xdc, 0x5c, 0xb5, 0xfe, 0xf2, 0xe1, 0x77, 0x59, 0xb9, 0xff, 0x53, 0x06, 0xf6, 0x49,
+	0x46, 0xae, 0xff, 0xa1, 0xf7, 0xf0, 0x2c, 0x1f, 0x34, 0x60, 0x51, 0x38, 0x90, 0xda, 0x91, 0x01,
+	0x2a, 0x45, 0xfb, 0x34, 0xab, 0x5e, 0xa5, 0xc5, 0x0f, 0x77, 0x05, 0xa8, 0xee, 0xcb, 0xa0, 0x06,
+	0xc4, 0x96, 0xed, 0x78, 0x5d, 0x6a, 0x3b, 0xcd, 0x76, 0xcb, 0x73, 0xdc, 0x9a, 0xdb, 0x71, 0xbc,
+	0x4e, 0xcb, 0xb9, 0xa6, 0x8d, 0xe6, 0x97, 0x26, 0xb5, 0x54, 0x45, 0x2f, 0xcf, 0x17, 0xc6, 0xf9,
+	0x3e, 0xd9, 0x19, 0x8b, 0x98, 0xf5, 0xa3, 0x9b, 0x88, 0x05, 0xa8, 0x0a, 0xdf, 0x1c, 0x18, 0x52,
+	0x6b, 0xb8, 0xcd, 0x2e, 0x55, 0x81, 0x5e, 0x9a, 0x2f, 0x8c, 0x67, 0x9b, 0x6b, 0x7d, 0x19, 0x4d,
+	0x19, 0xfa, 0x0c, 0xdf, 0x1e, 0x80, 0x2c, 0x7a, 0x6d, 0xd3, 0x46, 0xcd, 0xa5, 0x96, 0x5a, 0xd0,
+	0xb5, 0xf9, 0xc2, 0x28, 0xed, 0x40, 0x8b, 0xc5, 0x09, 0xeb, 0xfb, 0x92, 0x05, 0xe8, 0x13, 0x3c,
+	0x3f, 0x00, 0xb7, 0xeb, 0x4e, 0xfb, 0x1b, 0x75, 0xa9, 0x5a, 0xd4, 0x5f, 0xcf, 0x17, 0x06, 0xda,
+	0xa1, 0xed, 0x9e, 0xe0, 0x43, 0x26, 0x99, 0x7e, 0x74, 0xf7, 0x13, 0x2b, 0xf5, 0xd6, 0xc3, 0x12,
+	0x83, 0xc7, 0x25, 0x06, 0x4f, 0x4b, 0x0c, 0xee, 0x57, 0x58, 0x79, 0x5c, 0x61, 0xe5, 0xd7, 0x0a,
+	0x2b, 0xdf, 0x3f, 0x86, 0x91, 0x1c, 0x4c, 0x7a, 0xa4, 0xcf, 0x47, 0xe6, 0x3f, 0x6e, 0x73, 0x5a,
+	0x35, 0x6f, 0xb7, 0x07, 0x2a, 0x67, 0x31, 0x13, 0xbd, 0xe3, 0xf4, 0xb1, 0xaa, 0x7f, 0x07, 0x00,
+	0x08, 0x88, 0xff, 0x0f, 0xcf, 0x02, 0x00, 0x00,
+}
+
+func (m *DRSVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DRSVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DRSVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeHeight != 0 {
+		i = encodeVarintDrsVersion(dAtA, i, uint64(m.UpgradeHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpgradeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpgradeTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDrsVersion(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Status != 0 {
+		i = encodeVarintDrsVersion(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintDrsVersion(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDrsVersion(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDrsVersion(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DRSVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovDrsVersion(uint64(m.Version))
+	}
+	if m.Status != 0 {
+		n += 1 + sovDrsVersion(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpgradeTime)
+	n += 1 + l + sovDrsVersion(uint64(l))
+	if m.UpgradeHeight != 0 {
+		n += 1 + sovDrsVersion(uint64(m.UpgradeHeight))
+	}
+	return n
+}
+
+func sovDrsVersion(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDrsVersion(x uint64) (n int) {
+	return sovDrsVersion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DRSVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDrsVersion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DRSVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DRSVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrsVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrsVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DRSVersionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrsVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDrsVersion
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDrsVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpgradeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeight", wireType)
+			}
+			m.UpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDrsVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDrsVersion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDrsVersion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDrsVersion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDrsVersion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDrsVersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDrsVersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDrsVersion
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDrsVersion
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDrsVersion
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDrsVersion        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDrsVersion          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDrsVersion = fmt.Errorf("proto: unexpected end of group")
+)
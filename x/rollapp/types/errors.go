@@ -55,6 +55,12 @@ var (
 	ErrTooManyGenesisAccounts            = errorsmod.Wrap(gerrc.ErrInvalidArgument, "too many genesis accounts")
 	ErrInvalidBech32Prefix               = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid bech32 prefix")
 	ErrRollappNotFound                   = errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
+	ErrInvalidDisputePeriod              = errorsmod.Wrap(gerrc.ErrInvalidArgument, "dispute period out of bounds")
+	ErrInvalidDAPath                     = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid DA path")
+	ErrStateInfoPruned                   = errorsmod.Wrap(gerrc.ErrOutOfRange, "state info pruned")
+	ErrInvalidProposerSelection          = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid proposer selection")
+	ErrOwnershipTransferNotFound         = errorsmod.Wrap(gerrc.ErrNotFound, "pending ownership transfer")
+	ErrOwnershipTransferLocked           = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "ownership transfer is timelocked")
 
 	/* ------------------------------ fraud related ----------------------------- */
 	ErrDisputeAlreadyFinalized = errorsmod.Register(ModuleName, 2000, "disputed height already finalized")
@@ -62,4 +68,12 @@ var (
 	ErrWrongProposerAddr       = errorsmod.Register(ModuleName, 2003, "wrong proposer address")
 	ErrInvalidDRSVersion       = errorsmod.Register(ModuleName, 2004, "wrong DRS version")
 	ErrWrongRollappRevision    = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "wrong rollapp revision")
+	ErrDRSVersionNotFound      = errorsmod.Wrap(gerrc.ErrNotFound, "DRS version")
+	ErrDRSVersionObsolete      = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "DRS version is obsolete")
+	ErrDRSDeprecationNotice    = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "DRS deprecation notice too short")
+	ErrFraudChallengeExists    = errorsmod.Wrap(gerrc.ErrAlreadyExists, "fraud challenge")
+	ErrFraudChallengeNotFound  = errorsmod.Wrap(gerrc.ErrNotFound, "fraud challenge")
+	ErrNoFraudProofVerifier    = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "no fraud proof verifier")
+	ErrInvalidFraudProof       = errorsmod.Wrap(gerrc.ErrInvalidArgument, "fraud proof")
+	ErrWrongFraudChallengeStep = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "wrong fraud challenge step")
 )
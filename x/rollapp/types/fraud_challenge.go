@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FraudProofVerifier checks the execution of a single rollapp block, to settle a fraud challenge.
+type FraudProofVerifier interface {
+	// VerifyBlock returns nil if the proof shows that executing the block on top of the pre state root
+	// results in the state root of the block descriptor.
+	VerifyBlock(ctx sdk.Context, rollappID string, preStateRoot []byte, bd BlockDescriptor, proof []byte) error
+}
+
+// Bisecting is true while the disputed range is wider than one block
+func (c FraudChallenge) Bisecting() bool {
+	return c.Low+1 < c.High
+}
+
+// Mid is the height the challenger must give the state root for, when bisecting
+func (c FraudChallenge) Mid() uint64 {
+	return c.Low + (c.High-c.Low)/2
+}
@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/rollapp/fraud_challenge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FraudChallenge is a permissionless dispute of a state update. The challenger
+// narrows the disagreement with the sequencer down to a single block by
+// bisection, against the state roots the sequencer committed in the block
+// descriptors. The block must then be proven, or the sequencer is at fault.
+type FraudChallenge struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// state_index is the index of the disputed state info
+	StateIndex uint64 `protobuf:"varint,2,opt,name=state_index,json=stateIndex,proto3" json:"state_index,omitempty"`
+	// sequencer is the bech32-encoded address of the sequencer which posted the
+	// disputed state info
+	Sequencer string `protobuf:"bytes,3,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// challenger is the bech32-encoded address of the challenger
+	Challenger string `protobuf:"bytes,4,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// bond is locked by the challenger, and lost if the sequencer is not at fault
+	Bond types.Coin `protobuf:"bytes,5,opt,name=bond,proto3" json:"bond"`
+	// low is the highest rollapp height both sides agree on
+	Low uint64 `protobuf:"varint,6,opt,name=low,proto3" json:"low,omitempty"`
+	// low_state_root is the state root at low
+	LowStateRoot []byte `protobuf:"bytes,7,opt,name=low_state_root,json=lowStateRoot,proto3" json:"low_state_root,omitempty"`
+	// high is the lowest rollapp height the sides disagree on
+	High uint64 `protobuf:"varint,8,opt,name=high,proto3" json:"high,omitempty"`
+	// deadline is the hub height until which the next move can be made. While
+	// the range is wider than one block, the challenger must bisect. Then the
+	// sequencer must respond by proving the block at high, and if it does not,
+	// the challenger must prove the fraud.
+	Deadline int64 `protobuf:"varint,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// response_timed_out is true if the sequencer did not respond to the
+	// bisection in time, the challenger must then prove the fraud
+	ResponseTimedOut bool `protobuf:"varint,10,opt,name=response_timed_out,json=responseTimedOut,proto3" json:"response_timed_out,omitempty"`
+}
+
+func (m *FraudChallenge) Reset()         { *m = FraudChallenge{} }
+func (m *FraudChallenge) String() string { return proto.CompactTextString(m) }
+func (*FraudChallenge) ProtoMessage()    {}
+func (*FraudChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_543c4d3ae77a6a83, []int{0}
+}
+func (m *FraudChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FraudChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FraudChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FraudChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FraudChallenge.Merge(m, src)
+}
+func (m *FraudChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *FraudChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_FraudChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FraudChallenge proto.InternalMessageInfo
+
+func (m *FraudChallenge) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *FraudChallenge) GetStateIndex() uint64 {
+	if m != nil {
+		return m.StateIndex
+	}
+	return 0
+}
+
+func (m *FraudChallenge) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *FraudChallenge) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *FraudChallenge) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *FraudChallenge) GetLow() uint64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *FraudChallenge) GetLowStateRoot() []byte {
+	if m != nil {
+		return m.LowStateRoot
+	}
+	return nil
+}
+
+func (m *FraudChallenge) GetHigh() uint64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *FraudChallenge) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *FraudChallenge) GetResponseTimedOut() bool {
+	if m != nil {
+		return m.ResponseTimedOut
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*FraudChallenge)(nil), "dymensionxyz.dymension.rollapp.FraudChallenge")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/fraud_challenge.proto", fileDescriptor_543c4d3ae77a6a83)
+}
+
+var fileDescriptor_543c4d3ae77a6a83 = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x8d, 0x29, 0xc9, 0xb6, 0xaa, 0xaa, 0x15, 0x87, 0x25, 0x82, 0xad, 0x85, 0x38,
+	0xf8, 0x80, 0xbc, 0x2a, 0xe9, 0x13, 0xb4, 0x12, 0x52, 0x2f, 0x20, 0x19, 0x4e, 0x5c, 0x2c, 0xdb,
+	0x3b, 0x38, 0x2b, 0x39, 0x3b, 0xc6, 0xbb, 0x6e, 0x12, 0x9e, 0x82, 0xc7, 0xea, 0xb1, 0x47, 0xb8,
+	0x20, 0x94, 0xbc, 0x08, 0xf2, 0xda, 0xb5, 0x7a, 0xe1, 0x36, 0xf3, 0xfd, 0xf3, 0x7b, 0x7e, 0x6b,
+	0x87, 0x5e, 0xa9, 0xdd, 0x1a, 0x8c, 0xd5, 0x68, 0xb6, 0xbb, 0x1f, 0x72, 0x6c, 0x64, 0x83, 0x55,
+	0x95, 0xd5, 0xb5, 0xfc, 0xd6, 0x64, 0xad, 0x4a, 0x8b, 0x55, 0x56, 0x55, 0x60, 0x4a, 0x88, 0xeb,
+	0x06, 0x1d, 0x32, 0xf1, 0xd4, 0x15, 0x8f, 0x4d, 0x3c, 0xb8, 0x16, 0x2f, 0x4a, 0x2c, 0xd1, 0x8f,
+	0xca, 0xae, 0xea, 0x5d, 0x0b, 0x51, 0xa0, 0x5d, 0xa3, 0x95, 0x79, 0x66, 0x41, 0xde, 0x5d, 0xe6,
+	0xe0, 0xb2, 0x4b, 0x59, 0xa0, 0x36, 0xbd, 0xfe, 0xe6, 0xf7, 0x11, 0x3d, 0xfb, 0xd0, 0xed, 0xbb,
+	0x79, 0x5c, 0xc7, 0x5e, 0x53, 0x3a, 0x7c, 0x33, 0xd5, 0x8a, 0x93, 0x90, 0x44, 0xf3, 0x64, 0x3e,
+	0x90, 0x5b, 0xc5, 0x2e, 0xe8, 0x89, 0x75, 0x99, 0x83, 0x54, 0x1b, 0x05, 0x5b, 0x7e, 0x14, 0x92,
+	0x28, 0x48, 0xa8, 0x47, 0xb7, 0x1d, 0x61, 0xaf, 0xe8, 0xdc, 0xc2, 0xf7, 0x16, 0x4c, 0x01, 0x0d,
+	0x9f, 0xf6, 0xf6, 0x11, 0x30, 0x41, 0xe9, 0xf8, 0x67, 0x0d, 0x0f, 0xbc, 0xfc, 0x84, 0xb0, 0x25,
+	0x0d, 0x72, 0x34, 0x8a, 0x3f, 0x0b, 0x49, 0x74, 0xf2, 0xfe, 0x65, 0xdc, 0xe7, 0x8f, 0xbb, 0xfc,
+	0xf1, 0x90, 0x3f, 0xbe, 0x41, 0x6d, 0xae, 0x83, 0xfb, 0x3f, 0x17, 0x93, 0xc4, 0x0f, 0xb3, 0x73,
+	0x3a, 0xad, 0x70, 0xc3, 0x8f, 0x7d, 0x96, 0xae, 0x64, 0x6f, 0xe9, 0x59, 0x85, 0x9b, 0xb4, 0x4f,
+	0xda, 0x20, 0x3a, 0xfe, 0x3c, 0x24, 0xd1, 0x69, 0x72, 0x5a, 0xe1, 0xe6, 0x73, 0x07, 0x13, 0x44,
+	0xc7, 0x18, 0x0d, 0x56, 0xba, 0x5c, 0xf1, 0x99, 0x37, 0xfa, 0x9a, 0x2d, 0xe8, 0x4c, 0x41, 0xa6,
+	0x2a, 0x6d, 0x80, 0xcf, 0x43, 0x12, 0x4d, 0x93, 0xb1, 0x67, 0xef, 0x28, 0x6b, 0xc0, 0xd6, 0x68,
+	0x2c, 0xa4, 0x4e, 0xaf, 0x41, 0xa5, 0xd8, 0x3a, 0x4e, 0x43, 0x12, 0xcd, 0x92, 0xf3, 0x47, 0xe5,
+	0x4b, 0x27, 0x7c, 0x6a, 0xdd, 0xf5, 0xc7, 0xfb, 0xbd, 0x20, 0x0f, 0x7b, 0x41, 0xfe, 0xee, 0x05,
+	0xf9, 0x79, 0x10, 0x93, 0x87, 0x83, 0x98, 0xfc, 0x3a, 0x88, 0xc9, 0xd7, 0xab, 0x52, 0xbb, 0x55,
+	0x9b, 0xc7, 0x05, 0xae, 0xe5, 0x7f, 0x8e, 0xe1, 0x6e, 0x29, 0xb7, 0xe3, 0x45, 0xb8, 0x5d, 0x0d,
+	0x36, 0x3f, 0xf6, 0x4f, 0xb6, 0xfc, 0x37, 0x00, 0x9c, 0xa4, 0x9c, 0x4d, 0x40, 0x02, 0x00, 0x00,
+}
+
+func (m *FraudChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FraudChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FraudChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResponseTimedOut {
+		i--
+		if m.ResponseTimedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.High != 0 {
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(m.High))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.LowStateRoot) > 0 {
+		i -= len(m.LowStateRoot)
+		copy(dAtA[i:], m.LowStateRoot)
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(len(m.LowStateRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Low != 0 {
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(m.Low))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StateIndex != 0 {
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(m.StateIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintFraudChallenge(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFraudChallenge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFraudChallenge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FraudChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovFraudChallenge(uint64(l))
+	}
+	if m.StateIndex != 0 {
+		n += 1 + sovFraudChallenge(uint64(m.StateIndex))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovFraudChallenge(uint64(l))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovFraudChallenge(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovFraudChallenge(uint64(l))
+	if m.Low != 0 {
+		n += 1 + sovFraudChallenge(uint64(m.Low))
+	}
+	l = len(m.LowStateRoot)
+	if l > 0 {
+		n += 1 + l + sovFraudChallenge(uint64(l))
+	}
+	if m.High != 0 {
+		n += 1 + sovFraudChallenge(uint64(m.High))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovFraudChallenge(uint64(m.Deadline))
+	}
+	if m.ResponseTimedOut {
+		n += 2
+	}
+	return n
+}
+
+func sovFraudChallenge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFraudChallenge(x uint64) (n int) {
+	return sovFraudChallenge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FraudChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFraudChallenge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FraudChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FraudChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateIndex", wireType)
+			}
+			m.StateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			m.Low = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Low |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LowStateRoot = append(m.LowStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.LowStateRoot == nil {
+				m.LowStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			m.High = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.High |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTimedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResponseTimedOut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFraudChallenge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFraudChallenge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFraudChallenge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFraudChallenge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraudChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFraudChallenge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFraudChallenge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFraudChallenge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFraudChallenge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFraudChallenge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFraudChallenge = fmt.Errorf("proto: unexpected end of group")
+)
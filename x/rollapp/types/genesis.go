@@ -99,6 +99,46 @@ func (gs GenesisState) Validate() error {
 		livenessEventsIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated index in livenessWarnings
+	livenessWarningsIndexMap := make(map[string]struct{})
+	for _, elem := range gs.LivenessWarnings {
+		index := fmt.Sprintf("%d/%s", elem.HubHeight, elem.RollappId)
+		if _, ok := livenessWarningsIndexMap[index]; ok {
+			return errors.New("duplicated index for LivenessWarnings")
+		}
+		livenessWarningsIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in fraudChallenges
+	fraudChallengesIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FraudChallenges {
+		if _, ok := fraudChallengesIndexMap[elem.RollappId]; ok {
+			return errors.New("duplicated index for FraudChallenges")
+		}
+		fraudChallengesIndexMap[elem.RollappId] = struct{}{}
+	}
+
+	// Check for duplicated index in pendingOwnershipTransfers
+	pendingOwnershipTransfersIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingOwnershipTransfers {
+		if _, ok := pendingOwnershipTransfersIndexMap[elem.RollappId]; ok {
+			return errors.New("duplicated index for PendingOwnershipTransfers")
+		}
+		if err := elem.ValidateBasic(); err != nil {
+			return fmt.Errorf("pending ownership transfer: %w", err)
+		}
+		pendingOwnershipTransfersIndexMap[elem.RollappId] = struct{}{}
+	}
+
+	// Check for duplicated index in prunedStateIndexes
+	prunedStateIndexesIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PrunedStateIndexes {
+		if _, ok := prunedStateIndexesIndexMap[elem.RollappId]; ok {
+			return errors.New("duplicated index for PrunedStateIndexes")
+		}
+		prunedStateIndexesIndexMap[elem.RollappId] = struct{}{}
+	}
+
 	// Check for duplicated index in registerDenoms
 	registeredDenomsIndexMap := make(map[string]struct{})
 	for _, entry := range gs.RegisteredDenoms {
@@ -153,5 +193,16 @@ func (gs GenesisState) Validate() error {
 		obsoleteDRSVersionIndexMap[elem] = struct{}{}
 	}
 
+	drsVersionIndexMap := make(map[uint32]struct{})
+	for _, elem := range gs.DrsVersions {
+		if _, ok := drsVersionIndexMap[elem.Version]; ok {
+			return errors.New("duplicated index for DrsVersions")
+		}
+		if err := elem.ValidateBasic(); err != nil {
+			return fmt.Errorf("DRS version: %w", err)
+		}
+		drsVersionIndexMap[elem.Version] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -38,6 +38,15 @@ type GenesisState struct {
 	SequencerHeightPairs []SequencerHeightPair     `protobuf:"bytes,10,rep,name=sequencerHeightPairs,proto3" json:"sequencerHeightPairs"`
 	// ObsoleteDrsVersions is a list of DRS versions that are marked obsolete
 	ObsoleteDrsVersions []uint32 `protobuf:"varint,11,rep,packed,name=obsolete_drs_versions,json=obsoleteDrsVersions,proto3" json:"obsolete_drs_versions,omitempty"`
+	// LivenessWarnings are scheduled upcoming liveness warnings
+	LivenessWarnings []LivenessWarning `protobuf:"bytes,12,rep,name=liveness_warnings,json=livenessWarnings,proto3" json:"liveness_warnings"`
+	// FraudChallenges are the active fraud challenges
+	FraudChallenges []FraudChallenge `protobuf:"bytes,13,rep,name=fraud_challenges,json=fraudChallenges,proto3" json:"fraud_challenges"`
+	// PrunedStateIndexes are the indexes of the last pruned state info of each
+	// rollapp
+	PrunedStateIndexes        []StateInfoIndex           `protobuf:"bytes,14,rep,name=pruned_state_indexes,json=prunedStateIndexes,proto3" json:"pruned_state_indexes"`
+	PendingOwnershipTransfers []PendingOwnershipTransfer `protobuf:"bytes,15,rep,name=pending_ownership_transfers,json=pendingOwnershipTransfers,proto3" json:"pending_ownership_transfers"`
+	DrsVersions               []DRSVersion               `protobuf:"bytes,16,rep,name=drs_versions,json=drsVersions,proto3" json:"drs_versions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +159,41 @@ func (m *GenesisState) GetObsoleteDrsVersions() []uint32 {
 	return nil
 }
 
+func (m *GenesisState) GetLivenessWarnings() []LivenessWarning {
+	if m != nil {
+		return m.LivenessWarnings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFraudChallenges() []FraudChallenge {
+	if m != nil {
+		return m.FraudChallenges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrunedStateIndexes() []StateInfoIndex {
+	if m != nil {
+		return m.PrunedStateIndexes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOwnershipTransfers() []PendingOwnershipTransfer {
+	if m != nil {
+		return m.PendingOwnershipTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDrsVersions() []DRSVersion {
+	if m != nil {
+		return m.DrsVersions
+	}
+	return nil
+}
+
 type SequencerHeightPair struct {
 	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
 	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -265,44 +309,55 @@ func init() {
 }
 
 var fileDescriptor_b76890aebc09aa04 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xda, 0x4c, 0xbe, 0x22, 0x34, 0x2d, 0x60, 0x45, 0xd4, 0x44, 0x41,
-	0x82, 0x20, 0xa8, 0x2d, 0x25, 0x48, 0xec, 0x90, 0x08, 0xe1, 0x4f, 0x44, 0x05, 0xc1, 0x05, 0x16,
-	0xb0, 0x88, 0x9c, 0xf8, 0xd6, 0x19, 0xe1, 0x78, 0x8c, 0x67, 0x12, 0x25, 0x79, 0x0a, 0x16, 0xbc,
-	0x08, 0x6f, 0xd1, 0x65, 0x97, 0xac, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x3c, 0x36, 0xa1, 0x6d, 0x32,
-	0x91, 0x58, 0x39, 0xe3, 0x39, 0xf7, 0x77, 0x8e, 0x6f, 0xae, 0x2e, 0x7a, 0xe0, 0x4e, 0x06, 0x10,
-	0x30, 0x42, 0x83, 0xf1, 0x64, 0x6a, 0x65, 0x07, 0x2b, 0xa2, 0xbe, 0xef, 0x84, 0xa1, 0xe5, 0x41,
-	0x00, 0x8c, 0x30, 0x33, 0x8c, 0x28, 0xa7, 0xd8, 0x58, 0x54, 0x9b, 0xd9, 0xc1, 0x94, 0xea, 0xd2,
-	0xbe, 0x47, 0x3d, 0x2a, 0xa4, 0x56, 0xfc, 0x2b, 0xa9, 0x2a, 0xdd, 0x57, 0x78, 0x84, 0x4e, 0xe4,
-	0x0c, 0xa4, 0x45, 0x49, 0x15, 0x48, 0x3e, 0xa5, 0xda, 0x52, 0xa8, 0x19, 0x77, 0x38, 0x74, 0x48,
-	0x70, 0x92, 0x66, 0x39, 0x54, 0x14, 0xf8, 0x64, 0x14, 0x7f, 0x71, 0x9a, 0xa6, 0xaa, 0x90, 0x67,
-	0x49, 0x2a, 0xdf, 0x77, 0xd0, 0xff, 0x2f, 0x92, 0x66, 0x1d, 0xc7, 0xa6, 0xb8, 0x89, 0xf2, 0xc9,
-	0x87, 0xe9, 0x5a, 0x59, 0xab, 0x16, 0x6b, 0x77, 0xcc, 0xd5, 0xcd, 0x33, 0xdb, 0x42, 0xdd, 0xd8,
-	0x3a, 0xfd, 0x79, 0x2b, 0x67, 0xcb, 0x5a, 0xfc, 0x06, 0x15, 0xe5, 0xfd, 0x11, 0x61, 0x5c, 0xdf,
-	0x28, 0x6f, 0x56, 0x8b, 0xb5, 0xbb, 0x2a, 0x94, 0x9d, 0x3c, 0x25, 0x6b, 0x91, 0x80, 0xdf, 0xa3,
-	0x5d, 0xd1, 0x94, 0x56, 0x70, 0x42, 0x05, 0x72, 0x53, 0x20, 0xef, 0xa9, 0x90, 0xc7, 0x69, 0x91,
-	0x84, 0xfe, 0x4d, 0xc1, 0x21, 0xd2, 0x7d, 0x87, 0x03, 0xe3, 0x99, 0xae, 0x15, 0xb8, 0x30, 0x16,
-	0x0e, 0x5b, 0xc2, 0xc1, 0x5c, 0xdb, 0x41, 0x54, 0x4a, 0x9b, 0xa5, 0x54, 0x3c, 0x45, 0x07, 0xc9,
-	0xdd, 0x73, 0x12, 0x38, 0x3e, 0x99, 0x82, 0x2b, 0x45, 0xa9, 0xed, 0x7f, 0xff, 0x60, 0xbb, 0x1a,
-	0x8d, 0xbf, 0x69, 0xa8, 0xd2, 0xf5, 0x69, 0xef, 0xf3, 0x4b, 0x20, 0x5e, 0x9f, 0xbf, 0xa3, 0x52,
-	0xe8, 0x70, 0x42, 0x83, 0xb7, 0x43, 0x18, 0x82, 0x48, 0x90, 0x17, 0x09, 0x1e, 0xab, 0x12, 0x34,
-	0x56, 0x92, 0x64, 0xa2, 0x35, 0xfc, 0xf0, 0x27, 0x74, 0x25, 0x9d, 0xdf, 0x67, 0x23, 0x08, 0x38,
-	0xd3, 0xb7, 0x45, 0x82, 0x43, 0x55, 0x82, 0xa3, 0xc5, 0x2a, 0x69, 0x78, 0x0e, 0x85, 0x9f, 0xa2,
-	0xed, 0x74, 0x0a, 0x77, 0x04, 0xf5, 0xb6, 0x8a, 0xfa, 0x24, 0x9b, 0xc0, 0xb4, 0x12, 0x13, 0x74,
-	0x35, 0x02, 0x8f, 0x30, 0x0e, 0x11, 0xb8, 0x4d, 0x08, 0xe8, 0x80, 0xe9, 0x05, 0x41, 0x7b, 0xb4,
-	0xe6, 0x4c, 0xdb, 0xe7, 0xca, 0xa5, 0xc3, 0x05, 0x2c, 0x1e, 0xa0, 0x7d, 0x06, 0x5f, 0x86, 0x10,
-	0xf4, 0x20, 0x4a, 0xda, 0xd6, 0x76, 0x48, 0xc4, 0x74, 0x24, 0xec, 0xea, 0xca, 0xb1, 0xb8, 0x58,
-	0x2b, 0xad, 0x2e, 0xc5, 0xe2, 0x1a, 0xba, 0x46, 0xbb, 0x8c, 0xfa, 0xc0, 0xa1, 0xe3, 0x46, 0xac,
-	0x33, 0x82, 0x28, 0xe6, 0x31, 0xbd, 0x58, 0xde, 0xac, 0xee, 0xda, 0x7b, 0xe9, 0x65, 0x33, 0x62,
-	0x1f, 0xe4, 0x55, 0xe5, 0x15, 0xda, 0xbb, 0xc4, 0x06, 0xdf, 0x44, 0x85, 0xcc, 0x42, 0x2c, 0x8f,
-	0x82, 0xfd, 0xe7, 0x05, 0xbe, 0x8e, 0xf2, 0x7d, 0xa1, 0xd5, 0x37, 0xca, 0x5a, 0x75, 0xcb, 0x96,
-	0xa7, 0x4a, 0x1b, 0xdd, 0x58, 0xd2, 0x22, 0x7c, 0x80, 0x90, 0xfc, 0xac, 0x0e, 0x71, 0x53, 0xa2,
-	0x7c, 0xd3, 0x72, 0x63, 0xa2, 0x9b, 0xfc, 0x15, 0xf1, 0x7a, 0x29, 0xd8, 0xf2, 0xd4, 0x78, 0x7d,
-	0x3a, 0x33, 0xb4, 0xb3, 0x99, 0xa1, 0xfd, 0x9a, 0x19, 0xda, 0xd7, 0xb9, 0x91, 0x3b, 0x9b, 0x1b,
-	0xb9, 0x1f, 0x73, 0x23, 0xf7, 0xf1, 0xa1, 0x47, 0x78, 0x7f, 0xd8, 0x35, 0x7b, 0x74, 0xb0, 0x6c,
-	0x03, 0x8f, 0xea, 0xd6, 0x38, 0x5b, 0x93, 0x7c, 0x12, 0x02, 0xeb, 0xe6, 0xc5, 0xa6, 0xac, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x21, 0x55, 0x13, 0x74, 0x06, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x4e, 0x13, 0x41,
+	0x1c, 0x6f, 0x01, 0x8b, 0x9d, 0x96, 0x0f, 0x07, 0xd4, 0x15, 0xa5, 0x36, 0x35, 0xd1, 0xfa, 0x41,
+	0x6b, 0x80, 0x04, 0x4f, 0x26, 0x42, 0x45, 0x89, 0x44, 0x70, 0x8b, 0x9a, 0xe8, 0x61, 0xb3, 0xed,
+	0xfe, 0xbb, 0x9d, 0xb8, 0x9d, 0x5d, 0x67, 0xb6, 0xa5, 0x70, 0xf0, 0x19, 0x3c, 0xf8, 0x26, 0xbe,
+	0x04, 0x47, 0x8e, 0x9e, 0x8c, 0x81, 0x17, 0x31, 0x9d, 0x9d, 0xe9, 0x07, 0x50, 0xa6, 0x09, 0xa7,
+	0x65, 0x66, 0x7e, 0x5f, 0xcc, 0xff, 0x3f, 0x33, 0x45, 0xcf, 0x9c, 0x83, 0x06, 0x50, 0x4e, 0x7c,
+	0xda, 0x3e, 0x38, 0x2c, 0x76, 0x07, 0x45, 0xe6, 0x7b, 0x9e, 0x1d, 0x04, 0x45, 0x17, 0x28, 0x70,
+	0xc2, 0x0b, 0x01, 0xf3, 0x43, 0x1f, 0x67, 0xfa, 0xd1, 0x85, 0xee, 0xa0, 0x20, 0xd1, 0x0b, 0xf3,
+	0xae, 0xef, 0xfa, 0x02, 0x5a, 0xec, 0xfc, 0x15, 0xb1, 0x16, 0x9e, 0x6a, 0x3c, 0x02, 0x9b, 0xd9,
+	0x0d, 0x69, 0xb1, 0xa0, 0x0b, 0x24, 0xbf, 0x12, 0x5d, 0xd4, 0xa0, 0x79, 0x68, 0x87, 0x60, 0x11,
+	0x5a, 0x53, 0x59, 0x96, 0x34, 0x04, 0x8f, 0xb4, 0x3a, 0xff, 0xb1, 0x4a, 0x93, 0xd7, 0xc0, 0x7b,
+	0x49, 0x56, 0x35, 0xc8, 0x1a, 0xb3, 0x9b, 0x8e, 0x55, 0xad, 0xdb, 0x9e, 0x07, 0xd4, 0x05, 0xc9,
+	0x5a, 0xd3, 0xb0, 0xfc, 0x7d, 0x0a, 0x8c, 0xd7, 0x49, 0x60, 0x85, 0xcc, 0xa6, 0xbc, 0x06, 0x4c,
+	0x12, 0x9f, 0x6b, 0x88, 0x0e, 0xe3, 0x56, 0x0b, 0x98, 0xa8, 0x8e, 0x60, 0xe4, 0x7e, 0xa7, 0x51,
+	0xfa, 0x4d, 0x54, 0xcd, 0x72, 0x67, 0x57, 0x70, 0x09, 0x25, 0xa2, 0x9d, 0x37, 0xe2, 0xd9, 0x78,
+	0x3e, 0xb5, 0xfc, 0xb0, 0x70, 0x79, 0x75, 0x0b, 0xbb, 0x02, 0xbd, 0x3e, 0x71, 0xf4, 0xf7, 0x7e,
+	0xcc, 0x94, 0x5c, 0xbc, 0x83, 0x52, 0x72, 0x7d, 0x9b, 0xf0, 0xd0, 0x18, 0xcb, 0x8e, 0xe7, 0x53,
+	0xcb, 0x8f, 0x74, 0x52, 0x66, 0xf4, 0x95, 0x5a, 0xfd, 0x0a, 0xf8, 0x23, 0x9a, 0x12, 0x55, 0xdb,
+	0xa2, 0x35, 0x5f, 0x48, 0x8e, 0x0b, 0xc9, 0xc7, 0x3a, 0xc9, 0xb2, 0x22, 0x49, 0xd1, 0x41, 0x15,
+	0x1c, 0x20, 0xc3, 0xb3, 0x43, 0xe0, 0x61, 0x17, 0xb7, 0x45, 0x1d, 0x68, 0x0b, 0x87, 0x09, 0xe1,
+	0x50, 0x18, 0xd9, 0x41, 0x30, 0xa5, 0xcd, 0x50, 0x55, 0x7c, 0x88, 0x16, 0xa3, 0xb5, 0x4d, 0x42,
+	0x6d, 0x8f, 0x1c, 0x82, 0x23, 0x41, 0xca, 0xf6, 0xda, 0x15, 0x6c, 0x2f, 0x97, 0xc6, 0xbf, 0xe2,
+	0x28, 0x57, 0xf1, 0xfc, 0xea, 0xb7, 0xb7, 0x40, 0xdc, 0x7a, 0xb8, 0xe7, 0x4b, 0xa0, 0x1d, 0x12,
+	0x9f, 0x7e, 0x68, 0x42, 0x13, 0x44, 0x82, 0x84, 0x48, 0xf0, 0x52, 0x97, 0x60, 0xfd, 0x52, 0x25,
+	0x99, 0x68, 0x04, 0x3f, 0xfc, 0x15, 0x4d, 0xab, 0x03, 0xf6, 0xba, 0x05, 0x34, 0xe4, 0xc6, 0xa4,
+	0x48, 0xb0, 0xa4, 0x4b, 0xb0, 0xdd, 0xcf, 0x92, 0x86, 0x67, 0xa4, 0xf0, 0x06, 0x9a, 0x54, 0x5d,
+	0x78, 0x5d, 0xa8, 0x3e, 0xd0, 0xa9, 0xbe, 0xea, 0x76, 0xa0, 0x62, 0x62, 0x82, 0x66, 0x19, 0xb8,
+	0x84, 0x87, 0xc0, 0xc0, 0x29, 0x01, 0xf5, 0x1b, 0xdc, 0x48, 0x0a, 0xb5, 0xb5, 0x11, 0x7b, 0xda,
+	0x3c, 0x43, 0x97, 0x0e, 0xe7, 0x64, 0x71, 0x03, 0xcd, 0x73, 0xf8, 0xde, 0x04, 0x5a, 0x05, 0x16,
+	0x6d, 0xdb, 0xae, 0x4d, 0x18, 0x37, 0x90, 0xb0, 0x5b, 0xd1, 0xb6, 0xc5, 0x79, 0xae, 0xb4, 0xba,
+	0x50, 0x16, 0x2f, 0xa3, 0x9b, 0x7e, 0x85, 0xfb, 0x1e, 0x84, 0x60, 0xf5, 0xdd, 0x0e, 0xdc, 0x48,
+	0x65, 0xc7, 0xf3, 0x53, 0xe6, 0x9c, 0x5a, 0x2c, 0x31, 0xfe, 0x49, 0x2e, 0xe1, 0x0a, 0xba, 0xa1,
+	0x36, 0xd9, 0xda, 0xb7, 0x19, 0x25, 0xd4, 0xe5, 0x46, 0x5a, 0xe4, 0x2b, 0x8e, 0x5a, 0xb2, 0xcf,
+	0x11, 0x4f, 0x6d, 0x83, 0x37, 0x38, 0xcd, 0xb1, 0x85, 0x66, 0xcf, 0xdc, 0x8d, 0xdc, 0x98, 0x1a,
+	0xed, 0x64, 0x6c, 0x76, 0x78, 0x1b, 0x8a, 0x26, 0x1d, 0x66, 0x6a, 0x03, 0xb3, 0x1c, 0xd7, 0xd0,
+	0x7c, 0xc0, 0x9a, 0x14, 0x1c, 0x4b, 0xbd, 0x06, 0x0e, 0xb4, 0x81, 0x1b, 0xd3, 0x57, 0x38, 0x7e,
+	0x38, 0x52, 0xec, 0x9d, 0x3a, 0xe0, 0xf8, 0x07, 0xba, 0x1b, 0x00, 0x75, 0x08, 0x75, 0xad, 0xf3,
+	0xd7, 0x36, 0x37, 0x66, 0x84, 0xdd, 0x0b, 0xed, 0x25, 0x1b, 0x49, 0xec, 0x28, 0x85, 0x3d, 0x29,
+	0x20, 0x8d, 0xef, 0x04, 0x43, 0xd6, 0x39, 0x2e, 0xa3, 0xf4, 0x40, 0x5d, 0x67, 0x85, 0xe1, 0x13,
+	0x9d, 0x61, 0xc9, 0x2c, 0xcb, 0x7a, 0xab, 0xdb, 0xd8, 0xe9, 0x75, 0x40, 0xee, 0x1d, 0x9a, 0xbb,
+	0xa0, 0xd1, 0xf0, 0x3d, 0x94, 0xec, 0x36, 0x99, 0x78, 0x3e, 0x92, 0x66, 0x6f, 0x02, 0xdf, 0x42,
+	0x89, 0xba, 0xc0, 0x1a, 0x63, 0xd9, 0x78, 0x7e, 0xc2, 0x94, 0xa3, 0xdc, 0x2e, 0xba, 0x3d, 0xe4,
+	0x90, 0xe0, 0x45, 0x84, 0x64, 0x20, 0x8b, 0x38, 0x4a, 0x51, 0xce, 0x6c, 0x39, 0x1d, 0x45, 0x27,
+	0x3a, 0x8c, 0x9d, 0x07, 0x26, 0x69, 0xca, 0xd1, 0xfa, 0xfb, 0xa3, 0x93, 0x4c, 0xfc, 0xf8, 0x24,
+	0x13, 0xff, 0x77, 0x92, 0x89, 0xff, 0x3c, 0xcd, 0xc4, 0x8e, 0x4f, 0x33, 0xb1, 0x3f, 0xa7, 0x99,
+	0xd8, 0x97, 0x55, 0x97, 0x84, 0xf5, 0x66, 0xa5, 0x50, 0xf5, 0x1b, 0xc3, 0x7e, 0x24, 0xb4, 0x56,
+	0x8a, 0xed, 0xee, 0x83, 0x19, 0x1e, 0x04, 0xc0, 0x2b, 0x09, 0xf1, 0x56, 0xae, 0xfc, 0x1f, 0x00,
+	0x29, 0x45, 0x21, 0x28, 0x17, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -325,6 +380,78 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DrsVersions) > 0 {
+		for iNdEx := len(m.DrsVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DrsVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PendingOwnershipTransfers) > 0 {
+		for iNdEx := len(m.PendingOwnershipTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOwnershipTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PrunedStateIndexes) > 0 {
+		for iNdEx := len(m.PrunedStateIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedStateIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FraudChallenges) > 0 {
+		for iNdEx := len(m.FraudChallenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FraudChallenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.LivenessWarnings) > 0 {
+		for iNdEx := len(m.LivenessWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LivenessWarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ObsoleteDrsVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ObsoleteDrsVersions)*10)
 		var j1 int
@@ -636,6 +763,36 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.LivenessWarnings) > 0 {
+		for _, e := range m.LivenessWarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FraudChallenges) > 0 {
+		for _, e := range m.FraudChallenges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PrunedStateIndexes) > 0 {
+		for _, e := range m.PrunedStateIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOwnershipTransfers) > 0 {
+		for _, e := range m.PendingOwnershipTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DrsVersions) > 0 {
+		for _, e := range m.DrsVersions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1124,6 +1281,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteDrsVersions", wireType)
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessWarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LivenessWarnings = append(m.LivenessWarnings, LivenessWarning{})
+			if err := m.LivenessWarnings[len(m.LivenessWarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudChallenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FraudChallenges = append(m.FraudChallenges, FraudChallenge{})
+			if err := m.FraudChallenges[len(m.FraudChallenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedStateIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedStateIndexes = append(m.PrunedStateIndexes, StateInfoIndex{})
+			if err := m.PrunedStateIndexes[len(m.PrunedStateIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnershipTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwnershipTransfers = append(m.PendingOwnershipTransfers, PendingOwnershipTransfer{})
+			if err := m.PendingOwnershipTransfers[len(m.PendingOwnershipTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrsVersions = append(m.DrsVersions, DRSVersion{})
+			if err := m.DrsVersions[len(m.DrsVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
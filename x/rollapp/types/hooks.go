@@ -16,6 +16,7 @@ type RollappHooks interface {
 	AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *StateInfo) error                   // Must be called when a rollapp's state changes
 	RollappCreated(ctx sdk.Context, rollappID, alias string, creator sdk.AccAddress) error
 	AfterTransfersEnabled(ctx sdk.Context, rollappID, rollappIBCDenom string) error
+	BeforeRollappOwnerChanged(ctx sdk.Context, rollappID string, newOwner sdk.AccAddress) error // Can veto the change, the rollapp still has the previous owner
 
 	OnHardFork(ctx sdk.Context, rollappID string, height uint64) error
 }
@@ -91,6 +92,16 @@ func (h MultiRollappHooks) AfterTransfersEnabled(ctx sdk.Context, rollappID, rol
 	return nil
 }
 
+func (h MultiRollappHooks) BeforeRollappOwnerChanged(ctx sdk.Context, rollappID string, newOwner sdk.AccAddress) error {
+	for i := range h {
+		err := h[i].BeforeRollappOwnerChanged(ctx, rollappID, newOwner)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var _ RollappHooks = &StubRollappCreatedHooks{}
 
 type StubRollappCreatedHooks struct{}
@@ -109,3 +120,7 @@ func (StubRollappCreatedHooks) AfterStateFinalized(sdk.Context, string, *StateIn
 }
 
 func (StubRollappCreatedHooks) AfterTransfersEnabled(sdk.Context, string, string) error { return nil }
+
+func (StubRollappCreatedHooks) BeforeRollappOwnerChanged(sdk.Context, string, sdk.AccAddress) error {
+	return nil
+}
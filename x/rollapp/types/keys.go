@@ -33,3 +33,26 @@ const (
 )
 
 var SeqToUnfinalizedHeightKeyPrefix = collections.NewPrefix("seqToFinalizeHeight/")
+
+var FraudChallengeKeyPrefix = collections.NewPrefix("fraudChallenge/")
+
+var StateInfoIndexByHeightKeyPrefix = collections.NewPrefix("stateInfoIndexByHeight/")
+
+var PendingOwnershipTransferKeyPrefix = collections.NewPrefix("pendingOwnershipTransfer/")
+
+var DRSVersionKeyPrefix = collections.NewPrefix("drsVersion/")
+
+var (
+	PrunedStateIndexKeyPrefix  = collections.NewPrefix("prunedStateIndex/")
+	StatePrunerCursorKeyPrefix = collections.NewPrefix("statePrunerCursor/")
+)
+
+var (
+	FinalizationHeightKeyPrefix      = collections.NewPrefix("finalizationHeight/")
+	FinalizationHeightIndexKeyPrefix = collections.NewPrefix("finalizationHeightIndex/")
+)
+
+var (
+	LivenessWarningQueueKeyPrefix     = collections.NewPrefix("livenessWarningQueue/")
+	LivenessWarningByRollappKeyPrefix = collections.NewPrefix("livenessWarningByRollapp/")
+)
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -79,8 +80,76 @@ func (m *LivenessEvent) GetHubHeight() int64 {
 	return 0
 }
 
+// LivenessWarning is an upcoming warning that the sequencer of a rollapp is
+// about to be slashed for liveness
+type LivenessWarning struct {
+	// RollappId of relevant rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// HubHeight when the warning will be emitted
+	HubHeight int64 `protobuf:"varint,2,opt,name=hub_height,json=hubHeight,proto3" json:"hub_height,omitempty"`
+	// SlashHeight of the liveness event the warning is for
+	SlashHeight int64 `protobuf:"varint,3,opt,name=slash_height,json=slashHeight,proto3" json:"slash_height,omitempty"`
+	// Threshold is the fraction of the time until the slash which passed
+	Threshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=threshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"threshold"`
+}
+
+func (m *LivenessWarning) Reset()         { *m = LivenessWarning{} }
+func (m *LivenessWarning) String() string { return proto.CompactTextString(m) }
+func (*LivenessWarning) ProtoMessage()    {}
+func (*LivenessWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e2dfe628b004fdb, []int{1}
+}
+func (m *LivenessWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessWarning.Merge(m, src)
+}
+func (m *LivenessWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessWarning proto.InternalMessageInfo
+
+func (m *LivenessWarning) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *LivenessWarning) GetHubHeight() int64 {
+	if m != nil {
+		return m.HubHeight
+	}
+	return 0
+}
+
+func (m *LivenessWarning) GetSlashHeight() int64 {
+	if m != nil {
+		return m.SlashHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LivenessEvent)(nil), "dymensionxyz.dymension.rollapp.LivenessEvent")
+	proto.RegisterType((*LivenessWarning)(nil), "dymensionxyz.dymension.rollapp.LivenessWarning")
 }
 
 func init() {
@@ -88,23 +157,28 @@ func init() {
 }
 
 var fileDescriptor_0e2dfe628b004fdb = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x73, 0x32, 0xcb, 0x52, 0xf3, 0x52, 0x8b, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0xe4, 0x90, 0x95, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xe5, 0x52, 0x22, 0xe9, 0xf9,
-	0xe9, 0xf9, 0x60, 0xa5, 0xfa, 0x20, 0x16, 0x44, 0x97, 0x94, 0x3e, 0x01, 0x4b, 0x8a, 0x4b, 0x12,
-	0x4b, 0x52, 0xe3, 0x33, 0xf3, 0xd2, 0x60, 0x1a, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5,
-	0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33,
-	0xf3, 0x20, 0xf2, 0x4a, 0xc1, 0x5c, 0xbc, 0x3e, 0x50, 0x87, 0xb9, 0x96, 0xa5, 0xe6, 0x95, 0x08,
-	0xc9, 0x72, 0x71, 0x41, 0x0d, 0x8b, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2,
-	0x84, 0x8a, 0x78, 0xa6, 0x80, 0xa4, 0x33, 0x4a, 0x93, 0xe2, 0x33, 0x52, 0x33, 0xd3, 0x33, 0x4a,
-	0x24, 0x98, 0x14, 0x18, 0x35, 0x98, 0x83, 0x38, 0x33, 0x4a, 0x93, 0x3c, 0xc0, 0x02, 0x5e, 0x2c,
-	0x1c, 0xcc, 0x02, 0x2c, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x8b, 0xcb, 0x2b, 0x65, 0xc6,
-	0xfa, 0x15, 0x70, 0xff, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x6a, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x0d, 0x3f, 0x53, 0x4d, 0x63, 0x01, 0x00, 0x00,
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0xb3, 0xff, 0x96, 0x3f, 0x66, 0x55, 0x94, 0xe2, 0xa1, 0x54, 0xdc, 0xd6, 0x7a, 0xe9,
+	0xc5, 0x2c, 0xa5, 0xbe, 0x80, 0x45, 0x41, 0xa5, 0x78, 0xa8, 0x07, 0xc1, 0x4b, 0xd9, 0x24, 0x6b,
+	0x76, 0x31, 0xd9, 0x0d, 0x99, 0x6d, 0x68, 0x7c, 0x0a, 0x5f, 0xc6, 0x77, 0xe8, 0xb1, 0x47, 0xf1,
+	0x50, 0xa4, 0x7d, 0x11, 0x69, 0x9a, 0x46, 0x2f, 0xe2, 0xc1, 0xdb, 0xce, 0x37, 0xbf, 0xef, 0xdb,
+	0x61, 0x06, 0x9f, 0xfa, 0x59, 0xc4, 0x15, 0x48, 0xad, 0x26, 0xd9, 0x33, 0x2d, 0x0b, 0x9a, 0xe8,
+	0x30, 0x64, 0x71, 0x4c, 0x43, 0x99, 0x72, 0xc5, 0x01, 0x9c, 0x38, 0xd1, 0x46, 0xd7, 0xc8, 0x77,
+	0xdc, 0x29, 0x0b, 0xa7, 0xc0, 0x1b, 0x07, 0x81, 0x0e, 0x74, 0x8e, 0xd2, 0xd5, 0x6b, 0xed, 0x6a,
+	0xd0, 0x5f, 0x3e, 0x01, 0xc3, 0x0c, 0x1f, 0x49, 0xf5, 0xb8, 0x31, 0x10, 0x4f, 0x43, 0xa4, 0x81,
+	0xba, 0x0c, 0x38, 0x4d, 0xbb, 0x2e, 0x37, 0xac, 0x4b, 0x3d, 0x2d, 0xd5, 0xba, 0xdf, 0xbe, 0xc3,
+	0xbb, 0x83, 0x62, 0xb0, 0xcb, 0x94, 0x2b, 0x53, 0x3b, 0xc2, 0xb8, 0x08, 0x1b, 0x49, 0xbf, 0x8e,
+	0x5a, 0xa8, 0x63, 0x0f, 0xed, 0x42, 0xb9, 0xf6, 0x57, 0x6d, 0x31, 0x76, 0x47, 0x82, 0xcb, 0x40,
+	0x98, 0xfa, 0xbf, 0x16, 0xea, 0x54, 0x86, 0xb6, 0x18, 0xbb, 0x57, 0xb9, 0x70, 0x53, 0xdd, 0xaa,
+	0xec, 0x57, 0xdb, 0xaf, 0x08, 0xef, 0x6d, 0x52, 0xef, 0x59, 0xa2, 0xa4, 0x0a, 0xfe, 0x96, 0x5b,
+	0x3b, 0xc6, 0x3b, 0x10, 0x32, 0x10, 0x1b, 0xa0, 0x92, 0x03, 0xdb, 0xb9, 0x56, 0x20, 0xe7, 0xd8,
+	0x36, 0x22, 0xe1, 0x20, 0x74, 0xe8, 0xd7, 0xab, 0xab, 0xfc, 0xfe, 0xc9, 0x74, 0xde, 0xb4, 0xde,
+	0xe7, 0xcd, 0xc3, 0xf5, 0x12, 0xc0, 0x7f, 0x72, 0xa4, 0xa6, 0x11, 0x33, 0xc2, 0x19, 0xf0, 0x80,
+	0x79, 0xd9, 0x05, 0xf7, 0x86, 0x5f, 0xae, 0xfe, 0xed, 0x74, 0x41, 0xd0, 0x6c, 0x41, 0xd0, 0xc7,
+	0x82, 0xa0, 0x97, 0x25, 0xb1, 0x66, 0x4b, 0x62, 0xbd, 0x2d, 0x89, 0xf5, 0x70, 0x16, 0x48, 0x23,
+	0xc6, 0xae, 0xe3, 0xe9, 0xe8, 0xa7, 0x13, 0xa4, 0x3d, 0x3a, 0x29, 0xef, 0x60, 0xb2, 0x98, 0x83,
+	0xfb, 0x3f, 0xdf, 0x71, 0xef, 0x73, 0x00, 0xbb, 0xe2, 0x86, 0x2c, 0x1b, 0x02, 0x00, 0x00,
 }
 
 func (m *LivenessEvent) Marshal() (dAtA []byte, err error) {
@@ -142,6 +216,56 @@ func (m *LivenessEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LivenessWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiveness(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SlashHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.SlashHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HubHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.HubHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLiveness(v)
 	base := offset
@@ -169,6 +293,27 @@ func (m *LivenessEvent) Size() (n int) {
 	return n
 }
 
+func (m *LivenessWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.HubHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.HubHeight))
+	}
+	if m.SlashHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.SlashHeight))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovLiveness(uint64(l))
+	return n
+}
+
 func sovLiveness(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -276,6 +421,160 @@ func (m *LivenessEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LivenessWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubHeight", wireType)
+			}
+			m.HubHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashHeight", wireType)
+			}
+			m.SlashHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLiveness(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
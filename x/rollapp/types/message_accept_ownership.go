@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAcceptOwnership{}
+	_ sdk.Msg = &MsgCancelOwnershipTransfer{}
+)
+
+func NewMsgAcceptOwnership(newOwner, rollappId string) *MsgAcceptOwnership {
+	return &MsgAcceptOwnership{
+		NewOwner:  newOwner,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgAcceptOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgCancelOwnershipTransfer(currentOwner, rollappId string) *MsgCancelOwnershipTransfer {
+	return &MsgCancelOwnershipTransfer{
+		CurrentOwner: currentOwner,
+		RollappId:    rollappId,
+	}
+}
+
+func (msg *MsgCancelOwnershipTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.CurrentOwner); err != nil {
+		return err
+	}
+
+	return nil
+}
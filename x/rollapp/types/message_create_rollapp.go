@@ -1,6 +1,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,7 +38,7 @@ func (msg *MsgCreateRollapp) GetRollapp() Rollapp {
 			genInfo.NativeDenom = DenomMetadata{}
 		}
 	}
-	return NewRollapp(
+	ra := NewRollapp(
 		msg.Creator,
 		msg.RollappId,
 		msg.InitialSequencer,
@@ -46,6 +47,8 @@ func (msg *MsgCreateRollapp) GetRollapp() Rollapp {
 		msg.Metadata,
 		genInfo,
 	)
+	ra.DaScheme = msg.DaScheme
+	return ra
 }
 
 func (msg *MsgCreateRollapp) ValidateBasic() error {
@@ -53,6 +56,10 @@ func (msg *MsgCreateRollapp) ValidateBasic() error {
 		return ErrInvalidAlias
 	}
 
+	if msg.DaScheme == "" {
+		return errorsmod.Wrap(ErrInvalidDAPath, "da scheme is required")
+	}
+
 	rollapp := msg.GetRollapp()
 	if err := rollapp.ValidateBasic(); err != nil {
 		return err
@@ -29,6 +29,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				MinSequencerBond: DefaultMinSequencerBondGlobalCoin,
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -57,6 +58,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        " ",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -74,6 +76,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -92,6 +95,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -110,6 +114,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -127,6 +132,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -145,6 +151,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_WASM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -163,6 +170,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_WASM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -183,6 +191,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -201,6 +210,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "Rollapp",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    "DYM",
 					GenesisChecksum: "checksum",
@@ -219,6 +229,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "alias",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -242,6 +253,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "alias",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: strings.Repeat("a", maxGenesisChecksumLength+1),
@@ -260,6 +272,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "alias",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -281,6 +294,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "alias",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 			},
 		},
 		{
@@ -292,6 +306,7 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 				RollappId:        "dym_100-1",
 				Alias:            "alias",
 				VmType:           Rollapp_EVM,
+				DaScheme:         DASchemeCelestia,
 				GenesisInfo: &GenesisInfo{
 					Bech32Prefix:    bech32Prefix,
 					GenesisChecksum: "checksum",
@@ -301,6 +316,24 @@ func TestMsgCreateRollapp_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidInitialSupply,
 		},
+		{
+			name: "no da scheme",
+			msg: MsgCreateRollapp{
+				Creator:          sample.AccAddress(),
+				InitialSequencer: sample.AccAddress(),
+				MinSequencerBond: DefaultMinSequencerBondGlobalCoin,
+				RollappId:        "dym_100-1",
+				Alias:            "alias",
+				VmType:           Rollapp_EVM,
+				GenesisInfo: &GenesisInfo{
+					Bech32Prefix:    bech32Prefix,
+					GenesisChecksum: "checksum",
+					NativeDenom:     DenomMetadata{Display: "DEN", Base: "aden", Exponent: 18},
+					InitialSupply:   math.NewInt(1000),
+				},
+			},
+			err: ErrInvalidDAPath,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var (
+	_ sdk.Msg = &MsgSubmitFraudChallenge{}
+	_ sdk.Msg = &MsgBisectFraudChallenge{}
+	_ sdk.Msg = &MsgProveFraudChallenge{}
+)
+
+func (msg *MsgSubmitFraudChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Challenger); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "challenger")
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id")
+	}
+	if msg.StateIndex == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "state index")
+	}
+	return nil
+}
+
+func (msg *MsgBisectFraudChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Challenger); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "challenger")
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id")
+	}
+	if len(msg.StateRoot) == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "state root")
+	}
+	return nil
+}
+
+func (msg *MsgProveFraudChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Prover); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "prover")
+	}
+	if msg.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id")
+	}
+	return nil
+}
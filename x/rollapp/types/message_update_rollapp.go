@@ -60,6 +60,10 @@ func (msg *MsgUpdateRollappInformation) ValidateBasic() error {
 		}
 	}
 
+	if err := ValidateProposerSelection(msg.ProposerSelection); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -27,6 +27,10 @@ func (msg *MsgUpdateState) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
+	if err := ValidateBasicDAPath(msg.DAPath); err != nil {
+		return err
+	}
+
 	// an update can't be with no BDs
 	if msg.NumBlocks == uint64(0) {
 		return errorsmod.Wrap(ErrInvalidNumBlocks, "number of blocks can not be zero")
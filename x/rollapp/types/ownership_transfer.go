@@ -0,0 +1,23 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (t PendingOwnershipTransfer) ValidateBasic() error {
+	if t.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty rollapp id")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.CurrentOwner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "current owner: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(t.NewOwner); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "new owner: %s", err)
+	}
+	if t.CurrentOwner == t.NewOwner {
+		return ErrSameOwner
+	}
+	return nil
+}
@@ -0,0 +1,489 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/rollapp/ownership_transfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingOwnershipTransfer is a transfer of the ownership of a rollapp which
+// was proposed by the owner, and is waiting to be accepted by the new owner.
+type PendingOwnershipTransfer struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// current_owner is the bech32-encoded address of the owner which proposed
+	// the transfer
+	CurrentOwner string `protobuf:"bytes,2,opt,name=current_owner,json=currentOwner,proto3" json:"current_owner,omitempty"`
+	// new_owner is the bech32-encoded address which can accept the transfer
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// unlock_time is the time from which the transfer can be accepted
+	UnlockTime time.Time `protobuf:"bytes,4,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *PendingOwnershipTransfer) Reset()         { *m = PendingOwnershipTransfer{} }
+func (m *PendingOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingOwnershipTransfer) ProtoMessage()    {}
+func (*PendingOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a95b35e26063b3a8, []int{0}
+}
+func (m *PendingOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOwnershipTransfer.Merge(m, src)
+}
+func (m *PendingOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOwnershipTransfer proto.InternalMessageInfo
+
+func (m *PendingOwnershipTransfer) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *PendingOwnershipTransfer) GetCurrentOwner() string {
+	if m != nil {
+		return m.CurrentOwner
+	}
+	return ""
+}
+
+func (m *PendingOwnershipTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *PendingOwnershipTransfer) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PendingOwnershipTransfer)(nil), "dymensionxyz.dymension.rollapp.PendingOwnershipTransfer")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/ownership_transfer.proto", fileDescriptor_a95b35e26063b3a8)
+}
+
+var fileDescriptor_a95b35e26063b3a8 = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xff, 0x47, 0xa8, 0x75, 0x61, 0x89, 0x18, 0xa2, 0x22, 0xdc, 0x0a, 0x96, 0x4e,
+	0xb6, 0x44, 0x91, 0xd8, 0x2b, 0x31, 0xb0, 0x00, 0xaa, 0x3a, 0xb1, 0x44, 0x6d, 0xe3, 0xba, 0x16,
+	0x89, 0x6d, 0x39, 0x0e, 0x6d, 0x78, 0x8a, 0x3e, 0x13, 0x53, 0xc7, 0x8e, 0x4c, 0x80, 0x92, 0x17,
+	0x41, 0x71, 0x9c, 0x88, 0x85, 0xcd, 0xc7, 0xf7, 0x9c, 0x7b, 0x3e, 0x5d, 0x78, 0x1b, 0xe5, 0x09,
+	0x15, 0x29, 0x97, 0x62, 0x9b, 0xbf, 0x91, 0x56, 0x10, 0x2d, 0xe3, 0x78, 0xae, 0x14, 0x91, 0x1b,
+	0x41, 0x75, 0xba, 0xe6, 0x2a, 0x34, 0x7a, 0x2e, 0xd2, 0x15, 0xd5, 0x58, 0x69, 0x69, 0xa4, 0x8f,
+	0x7e, 0x07, 0x71, 0x2b, 0xb0, 0x0b, 0xf6, 0xcf, 0x98, 0x64, 0xd2, 0x5a, 0x49, 0xf5, 0xaa, 0x53,
+	0xfd, 0x01, 0x93, 0x92, 0xc5, 0x94, 0x58, 0xb5, 0xc8, 0x56, 0xc4, 0xf0, 0x84, 0xa6, 0x66, 0x9e,
+	0xa8, 0xda, 0x70, 0xf9, 0x0e, 0x60, 0xf0, 0x44, 0x45, 0xc4, 0x05, 0x7b, 0x6c, 0xaa, 0x67, 0xae,
+	0xd9, 0xbf, 0x80, 0xd0, 0xad, 0x0f, 0x79, 0x14, 0x80, 0x21, 0x18, 0x75, 0xa7, 0x5d, 0xf7, 0x73,
+	0x1f, 0xf9, 0x57, 0xf0, 0x74, 0x99, 0x69, 0x4d, 0x85, 0x09, 0x2d, 0x76, 0xf0, 0xcf, 0x3a, 0x4e,
+	0xdc, 0xa7, 0xdd, 0xe7, 0x9f, 0xc3, 0xae, 0xa0, 0x1b, 0x67, 0xf8, 0x6f, 0x0d, 0x1d, 0x41, 0x37,
+	0xf5, 0xf0, 0x0e, 0xf6, 0x32, 0x11, 0xcb, 0xe5, 0x4b, 0x58, 0x71, 0x05, 0x47, 0x43, 0x30, 0xea,
+	0x5d, 0xf7, 0x71, 0x0d, 0x8d, 0x1b, 0x68, 0x3c, 0x6b, 0xa0, 0x27, 0x9d, 0xfd, 0xe7, 0xc0, 0xdb,
+	0x7d, 0x0d, 0xc0, 0x14, 0xd6, 0xc1, 0x6a, 0x34, 0x79, 0xd8, 0x17, 0x08, 0x1c, 0x0a, 0x04, 0xbe,
+	0x0b, 0x04, 0x76, 0x25, 0xf2, 0x0e, 0x25, 0xf2, 0x3e, 0x4a, 0xe4, 0x3d, 0xdf, 0x30, 0x6e, 0xd6,
+	0xd9, 0x02, 0x2f, 0x65, 0x42, 0xfe, 0xb8, 0xfc, 0xeb, 0x98, 0x6c, 0xdb, 0xf3, 0x9b, 0x5c, 0xd1,
+	0x74, 0x71, 0x6c, 0x9b, 0xc7, 0x3f, 0x03, 0x00, 0x37, 0x16, 0xde, 0x1e, 0xad, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOwnershipTransfer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintOwnershipTransfer(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentOwner) > 0 {
+		i -= len(m.CurrentOwner)
+		copy(dAtA[i:], m.CurrentOwner)
+		i = encodeVarintOwnershipTransfer(dAtA, i, uint64(len(m.CurrentOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintOwnershipTransfer(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOwnershipTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOwnershipTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovOwnershipTransfer(uint64(l))
+	}
+	l = len(m.CurrentOwner)
+	if l > 0 {
+		n += 1 + l + sovOwnershipTransfer(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovOwnershipTransfer(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovOwnershipTransfer(uint64(l))
+	return n
+}
+
+func sovOwnershipTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOwnershipTransfer(x uint64) (n int) {
+	return sovOwnershipTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOwnershipTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOwnershipTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOwnershipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOwnershipTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOwnershipTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOwnershipTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOwnershipTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOwnershipTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOwnershipTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOwnershipTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOwnershipTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOwnershipTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOwnershipTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOwnershipTransfer = fmt.Errorf("proto: unexpected end of group")
+)
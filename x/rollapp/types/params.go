@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -15,6 +16,9 @@ import (
 var (
 	DefaultAppRegistrationFee         = commontypes.Dym(math.NewInt(1))
 	DefaultMinSequencerBondGlobalCoin = commontypes.Dym(math.NewInt(100))
+	// DefaultLivenessWarningThresholds warn at 50% and 90% of the time until the slash
+	DefaultLivenessWarningThresholds = []math.LegacyDec{math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(9, 1)}
+	DefaultFraudChallengeBond        = commontypes.Dym(math.NewInt(100))
 )
 
 const (
@@ -24,6 +28,18 @@ const (
 
 	DefaultLivenessSlashBlocks   = uint64(7200) // 12 hours worth of blocks at 1 block per 6 seconds
 	DefaultLivenessSlashInterval = uint64(600)  // 1 hour worth of blocks at 1 block per 6 seconds
+
+	DefaultFraudChallengeStepBlocks = uint64(600) // 1 hour worth of blocks at 1 block per 6 seconds
+
+	DefaultStateRetentionCount  = uint64(1000)
+	DefaultStateRetentionPeriod = 21 * 24 * time.Hour
+	DefaultStatePruningBudget   = uint64(100)
+
+	// DefaultOwnershipTransferTimelock is zero: a proposed ownership transfer can be accepted right away
+	DefaultOwnershipTransferTimelock = time.Duration(0)
+
+	// DefaultMinDRSDeprecationNotice gives the rollapps two weeks to upgrade from a deprecated DRS version
+	DefaultMinDRSDeprecationNotice = 14 * 24 * time.Hour
 )
 
 // NewParams creates a new Params instance
@@ -33,13 +49,33 @@ func NewParams(
 	livenessSlashInterval uint64,
 	appRegistrationFee sdk.Coin,
 	minSequencerBondGlobal sdk.Coin,
+	minDisputePeriodInBlocks uint64,
+	maxDisputePeriodInBlocks uint64,
+	livenessWarningThresholds []math.LegacyDec,
+	fraudChallengeBond sdk.Coin,
+	fraudChallengeStepBlocks uint64,
+	stateRetentionCount uint64,
+	stateRetentionPeriod time.Duration,
+	statePruningBudget uint64,
+	ownershipTransferTimelock time.Duration,
+	minDRSDeprecationNotice time.Duration,
 ) Params {
 	return Params{
-		DisputePeriodInBlocks:  disputePeriodInBlocks,
-		LivenessSlashBlocks:    livenessSlashBlocks,
-		LivenessSlashInterval:  livenessSlashInterval,
-		AppRegistrationFee:     appRegistrationFee,
-		MinSequencerBondGlobal: minSequencerBondGlobal,
+		DisputePeriodInBlocks:     disputePeriodInBlocks,
+		LivenessSlashBlocks:       livenessSlashBlocks,
+		LivenessSlashInterval:     livenessSlashInterval,
+		AppRegistrationFee:        appRegistrationFee,
+		MinSequencerBondGlobal:    minSequencerBondGlobal,
+		MinDisputePeriodInBlocks:  minDisputePeriodInBlocks,
+		MaxDisputePeriodInBlocks:  maxDisputePeriodInBlocks,
+		LivenessWarningThresholds: livenessWarningThresholds,
+		FraudChallengeBond:        fraudChallengeBond,
+		FraudChallengeStepBlocks:  fraudChallengeStepBlocks,
+		StateRetentionCount:       stateRetentionCount,
+		StateRetentionPeriod:      stateRetentionPeriod,
+		StatePruningBudget:        statePruningBudget,
+		OwnershipTransferTimelock: ownershipTransferTimelock,
+		MinDrsDeprecationNotice:   minDRSDeprecationNotice,
 	}
 }
 
@@ -51,6 +87,16 @@ func DefaultParams() Params {
 		DefaultLivenessSlashInterval,
 		DefaultAppRegistrationFee,
 		DefaultMinSequencerBondGlobalCoin,
+		DefaultDisputePeriodInBlocks,
+		DefaultDisputePeriodInBlocks,
+		DefaultLivenessWarningThresholds,
+		DefaultFraudChallengeBond,
+		DefaultFraudChallengeStepBlocks,
+		DefaultStateRetentionCount,
+		DefaultStateRetentionPeriod,
+		DefaultStatePruningBudget,
+		DefaultOwnershipTransferTimelock,
+		DefaultMinDRSDeprecationNotice,
 	)
 }
 
@@ -59,6 +105,17 @@ func (p Params) WithDisputePeriodInBlocks(x uint64) Params {
 	return p
 }
 
+func (p Params) WithDisputePeriodBounds(minPeriod, maxPeriod uint64) Params {
+	p.MinDisputePeriodInBlocks = minPeriod
+	p.MaxDisputePeriodInBlocks = maxPeriod
+	return p
+}
+
+// ClampDisputePeriod returns the dispute period within the bounds of the params
+func (p Params) ClampDisputePeriod(x uint64) uint64 {
+	return min(max(x, p.MinDisputePeriodInBlocks), p.MaxDisputePeriodInBlocks)
+}
+
 func (p Params) WithLivenessSlashBlocks(x uint64) Params {
 	p.LivenessSlashBlocks = x
 	return p
@@ -69,11 +126,29 @@ func (p Params) WithLivenessSlashInterval(x uint64) Params {
 	return p
 }
 
+func (p Params) WithStateRetention(count uint64, period time.Duration, budget uint64) Params {
+	p.StateRetentionCount = count
+	p.StateRetentionPeriod = period
+	p.StatePruningBudget = budget
+	return p
+}
+
+func (p Params) WithLivenessWarningThresholds(x ...math.LegacyDec) Params {
+	p.LivenessWarningThresholds = x
+	return p
+}
+
 // Validate validates the set of params
 func (p Params) ValidateBasic() error {
 	if err := validateDisputePeriodInBlocks(p.DisputePeriodInBlocks); err != nil {
 		return errorsmod.Wrap(err, "dispute period")
 	}
+	if err := validateDisputePeriodInBlocks(p.MinDisputePeriodInBlocks); err != nil {
+		return errorsmod.Wrap(err, "min dispute period")
+	}
+	if p.MaxDisputePeriodInBlocks < p.MinDisputePeriodInBlocks {
+		return errors.New("max dispute period cannot be lower than min dispute period")
+	}
 
 	if err := validateLivenessSlashBlocks(p.LivenessSlashBlocks); err != nil {
 		return errorsmod.Wrap(err, "liveness slash blocks")
@@ -81,6 +156,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateLivenessSlashInterval(p.LivenessSlashInterval); err != nil {
 		return errorsmod.Wrap(err, "liveness slash interval")
 	}
+	if err := validateLivenessWarningThresholds(p.LivenessWarningThresholds); err != nil {
+		return errorsmod.Wrap(err, "liveness warning thresholds")
+	}
 
 	if err := validateAppRegistrationFee(p.AppRegistrationFee); err != nil {
 		return errorsmod.Wrap(err, "app registration fee")
@@ -88,6 +166,24 @@ func (p Params) ValidateBasic() error {
 	if err := uparam.ValidateCoin(p.MinSequencerBondGlobal); err != nil {
 		return errorsmod.Wrap(err, "min sequencer bond")
 	}
+	if err := uparam.ValidateCoin(p.FraudChallengeBond); err != nil {
+		return errorsmod.Wrap(err, "fraud challenge bond")
+	}
+	if err := uparam.ValidatePositiveUint64(p.FraudChallengeStepBlocks); err != nil {
+		return errorsmod.Wrap(err, "fraud challenge step blocks")
+	}
+	if err := uparam.ValidatePositiveUint64(p.StateRetentionCount); err != nil {
+		return errorsmod.Wrap(err, "state retention count")
+	}
+	if p.StateRetentionPeriod < 0 {
+		return errors.New("state retention period cannot be negative")
+	}
+	if p.OwnershipTransferTimelock < 0 {
+		return errors.New("ownership transfer timelock cannot be negative")
+	}
+	if p.MinDrsDeprecationNotice < 0 {
+		return errors.New("min DRS deprecation notice cannot be negative")
+	}
 	return nil
 }
 
@@ -110,6 +206,19 @@ func validateLivenessSlashInterval(v uint64) error {
 	return uparam.ValidatePositiveUint64(v)
 }
 
+// validateLivenessWarningThresholds checks the thresholds are ascending and strictly between 0 and 1
+func validateLivenessWarningThresholds(v []math.LegacyDec) error {
+	for i, t := range v {
+		if t.IsNil() || !t.IsPositive() || t.GTE(math.LegacyOneDec()) {
+			return fmt.Errorf("threshold must be in (0, 1): %s", t)
+		}
+		if 0 < i && t.LTE(v[i-1]) {
+			return errors.New("thresholds must be ascending")
+		}
+	}
+	return nil
+}
+
 // validateDisputePeriodInBlocks validates the DisputePeriodInBlocks param
 func validateDisputePeriodInBlocks(disputePeriodInBlocks uint64) error {
 	if disputePeriodInBlocks < MinDisputePeriodInBlocks {
@@ -4,19 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +45,35 @@ type Params struct {
 	AppRegistrationFee types.Coin `protobuf:"bytes,7,opt,name=app_registration_fee,json=appRegistrationFee,proto3" json:"app_registration_fee" yaml:"app_registration_fee"`
 	// no rollapp can have a minimum less than this (in dym)
 	MinSequencerBondGlobal types.Coin `protobuf:"bytes,8,opt,name=min_sequencer_bond_global,json=minSequencerBondGlobal,proto3" json:"min_sequencer_bond_global" yaml:"min_sequencer_bond_global"`
+	// min_dispute_period_in_blocks and max_dispute_period_in_blocks bound the
+	// dispute period a rollapp owner can set for its rollapp, instead of
+	// dispute_period_in_blocks
+	MinDisputePeriodInBlocks uint64 `protobuf:"varint,9,opt,name=min_dispute_period_in_blocks,json=minDisputePeriodInBlocks,proto3" json:"min_dispute_period_in_blocks,omitempty" yaml:"min_dispute_period_in_blocks"`
+	MaxDisputePeriodInBlocks uint64 `protobuf:"varint,10,opt,name=max_dispute_period_in_blocks,json=maxDisputePeriodInBlocks,proto3" json:"max_dispute_period_in_blocks,omitempty" yaml:"max_dispute_period_in_blocks"`
+	// liveness_warning_thresholds are the fractions of the time until a
+	// liveness slash at which a warning is emitted, in ascending order
+	LivenessWarningThresholds []cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,rep,name=liveness_warning_thresholds,json=livenessWarningThresholds,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liveness_warning_thresholds" yaml:"liveness_warning_thresholds"`
+	// fraud_challenge_bond is locked by a challenger of a state update
+	FraudChallengeBond types.Coin `protobuf:"bytes,12,opt,name=fraud_challenge_bond,json=fraudChallengeBond,proto3" json:"fraud_challenge_bond" yaml:"fraud_challenge_bond"`
+	// fraud_challenge_step_blocks is the time (num hub blocks) for each move of
+	// a fraud challenge
+	FraudChallengeStepBlocks uint64 `protobuf:"varint,13,opt,name=fraud_challenge_step_blocks,json=fraudChallengeStepBlocks,proto3" json:"fraud_challenge_step_blocks,omitempty" yaml:"fraud_challenge_step_blocks"`
+	// state_retention_count is the max number of finalized state infos kept per
+	// rollapp, including the latest finalized one, which is never pruned
+	StateRetentionCount uint64 `protobuf:"varint,14,opt,name=state_retention_count,json=stateRetentionCount,proto3" json:"state_retention_count,omitempty" yaml:"state_retention_count"`
+	// state_retention_period is the max time a finalized state info is kept
+	// after it was created, zero for no limit. A state info is pruned once it is
+	// beyond either the count or the period.
+	StateRetentionPeriod time.Duration `protobuf:"bytes,15,opt,name=state_retention_period,json=stateRetentionPeriod,proto3,stdduration" json:"state_retention_period" yaml:"state_retention_period"`
+	// state_pruning_budget is the max number of state infos pruned per block,
+	// pruning is disabled if it is zero
+	StatePruningBudget uint64 `protobuf:"varint,16,opt,name=state_pruning_budget,json=statePruningBudget,proto3" json:"state_pruning_budget,omitempty" yaml:"state_pruning_budget"`
+	// ownership_transfer_timelock is the time a proposed ownership transfer
+	// must wait before it can be accepted, zero for none
+	OwnershipTransferTimelock time.Duration `protobuf:"bytes,17,opt,name=ownership_transfer_timelock,json=ownershipTransferTimelock,proto3,stdduration" json:"ownership_transfer_timelock" yaml:"ownership_transfer_timelock"`
+	// min_drs_deprecation_notice is the minimum time between the deprecation of
+	// a DRS version and its upgrade deadline
+	MinDrsDeprecationNotice time.Duration `protobuf:"bytes,18,opt,name=min_drs_deprecation_notice,json=minDrsDeprecationNotice,proto3,stdduration" json:"min_drs_deprecation_notice" yaml:"min_drs_deprecation_notice"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,6 +143,69 @@ func (m *Params) GetMinSequencerBondGlobal() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetMinDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MinDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MaxDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetFraudChallengeBond() types.Coin {
+	if m != nil {
+		return m.FraudChallengeBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetFraudChallengeStepBlocks() uint64 {
+	if m != nil {
+		return m.FraudChallengeStepBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetStateRetentionCount() uint64 {
+	if m != nil {
+		return m.StateRetentionCount
+	}
+	return 0
+}
+
+func (m *Params) GetStateRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.StateRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetStatePruningBudget() uint64 {
+	if m != nil {
+		return m.StatePruningBudget
+	}
+	return 0
+}
+
+func (m *Params) GetOwnershipTransferTimelock() time.Duration {
+	if m != nil {
+		return m.OwnershipTransferTimelock
+	}
+	return 0
+}
+
+func (m *Params) GetMinDrsDeprecationNotice() time.Duration {
+	if m != nil {
+		return m.MinDrsDeprecationNotice
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
 }
@@ -118,36 +215,60 @@ func init() {
 }
 
 var fileDescriptor_75a44aa904ae1ba5 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x6d, 0x6a, 0xd2, 0xc8, 0x6c, 0x22, 0xd3, 0x80, 0x5b, 0xd0, 0x38, 0x72, 0x37, 0x91,
-	0x90, 0x3c, 0x2a, 0x65, 0xd5, 0xa5, 0x91, 0x40, 0xcd, 0x02, 0x55, 0x2e, 0xab, 0x0a, 0x69, 0x34,
-	0xb6, 0x07, 0x77, 0xc4, 0x78, 0xee, 0xe0, 0x71, 0xa2, 0x86, 0x05, 0xcf, 0xc0, 0x92, 0x25, 0xe2,
-	0x69, 0xba, 0xec, 0x92, 0x95, 0x85, 0x92, 0x37, 0xc8, 0x13, 0xa0, 0xf8, 0x27, 0x2a, 0x28, 0x11,
-	0xbb, 0xb9, 0xe7, 0x9c, 0xf9, 0x8e, 0x74, 0x75, 0xed, 0x17, 0xe9, 0x3c, 0x67, 0x52, 0x73, 0x90,
-	0x37, 0xf3, 0x2f, 0x78, 0x33, 0xe0, 0x02, 0x84, 0xa0, 0x4a, 0x61, 0x45, 0x0b, 0x9a, 0xeb, 0x40,
-	0x15, 0x50, 0x82, 0x83, 0xee, 0x87, 0x83, 0xcd, 0x10, 0xb4, 0xe1, 0xa3, 0x83, 0x0c, 0x32, 0xa8,
-	0xa3, 0x78, 0xfd, 0x6a, 0x7e, 0x1d, 0xa1, 0x04, 0x74, 0x0e, 0x1a, 0xc7, 0x54, 0x33, 0x3c, 0x3b,
-	0x89, 0x59, 0x49, 0x4f, 0x70, 0x02, 0x5c, 0x36, 0xbe, 0xff, 0xd3, 0xb2, 0x7b, 0x17, 0x75, 0x8d,
-	0xf3, 0xc1, 0x76, 0x53, 0xae, 0xd5, 0xb4, 0x64, 0x44, 0xb1, 0x82, 0x43, 0x4a, 0xb8, 0x24, 0xb1,
-	0x80, 0xe4, 0x93, 0x76, 0xcd, 0x91, 0x39, 0xb6, 0xc2, 0xe3, 0x55, 0xe5, 0x79, 0x73, 0x9a, 0x8b,
-	0x33, 0x7f, 0x57, 0xd2, 0x8f, 0x86, 0xad, 0x75, 0x51, 0x3b, 0xe7, 0x32, 0xac, 0x75, 0xe7, 0xbd,
-	0x3d, 0x14, 0x7c, 0xc6, 0x24, 0xd3, 0x9a, 0x68, 0x41, 0xf5, 0x75, 0x87, 0xb6, 0x6a, 0xf4, 0x68,
-	0x55, 0x79, 0xcf, 0x1b, 0xf4, 0xd6, 0x98, 0x1f, 0x3d, 0xee, 0xf4, 0xcb, 0xb5, 0xdc, 0x52, 0xaf,
-	0xec, 0xa7, 0xff, 0xc4, 0xb9, 0x2c, 0x59, 0x31, 0xa3, 0xc2, 0x7d, 0x58, 0x73, 0xfd, 0x55, 0xe5,
-	0xa1, 0xad, 0xdc, 0x2e, 0xe8, 0x47, 0xc3, 0xbf, 0xc8, 0xe7, 0xad, 0xee, 0x28, 0xfb, 0x80, 0x2a,
-	0x45, 0x0a, 0x96, 0x71, 0x5d, 0x16, 0xb4, 0xe4, 0x20, 0xc9, 0x47, 0xc6, 0xdc, 0xfd, 0x91, 0x39,
-	0x7e, 0xf4, 0xf2, 0x30, 0x68, 0x36, 0x1b, 0xac, 0x37, 0x1b, 0xb4, 0x9b, 0x0d, 0x5e, 0x03, 0x97,
-	0xe1, 0xf1, 0x6d, 0xe5, 0x19, 0xab, 0xca, 0x7b, 0xd6, 0xf4, 0x6e, 0x83, 0xf8, 0x91, 0x43, 0x95,
-	0x8a, 0xee, 0xa9, 0x6f, 0x18, 0x73, 0xbe, 0xda, 0x87, 0x39, 0x97, 0x44, 0xb3, 0xcf, 0x53, 0x26,
-	0x13, 0x56, 0x90, 0x18, 0x64, 0x4a, 0x32, 0x01, 0x31, 0x15, 0x6e, 0xff, 0x7f, 0xb5, 0xe3, 0xb6,
-	0x76, 0xd4, 0xd4, 0xee, 0x24, 0xf9, 0xd1, 0x93, 0x9c, 0xcb, 0xcb, 0xce, 0x0a, 0x41, 0xa6, 0x6f,
-	0x6b, 0xe3, 0xcc, 0xfa, 0xfe, 0xc3, 0x33, 0x26, 0x56, 0xff, 0xc1, 0x60, 0x6f, 0x62, 0xf5, 0xf7,
-	0x06, 0xd6, 0xc4, 0xea, 0xf7, 0x06, 0xfb, 0xe1, 0xbb, 0xdb, 0x05, 0x32, 0xef, 0x16, 0xc8, 0xfc,
-	0xbd, 0x40, 0xe6, 0xb7, 0x25, 0x32, 0xee, 0x96, 0xc8, 0xf8, 0xb5, 0x44, 0xc6, 0xd5, 0xab, 0x8c,
-	0x97, 0xd7, 0xd3, 0x38, 0x48, 0x20, 0xc7, 0x3b, 0x8e, 0x79, 0x76, 0x8a, 0x6f, 0x36, 0x17, 0x5d,
-	0xce, 0x15, 0xd3, 0x71, 0xaf, 0xbe, 0xbd, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0xbb,
-	0xec, 0xbf, 0x00, 0x03, 0x00, 0x00,
+	// 837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x93, 0x25, 0x4d, 0x5d, 0x0a, 0xc1, 0x4d, 0x5a, 0x27, 0x29, 0xf6, 0xe2, 0x48, 0x10,
+	0x84, 0xb0, 0x55, 0xca, 0xa9, 0xc7, 0x4d, 0x04, 0x4a, 0x84, 0xaa, 0xe0, 0x44, 0x42, 0xaa, 0x90,
+	0xac, 0xb1, 0xfd, 0xe2, 0x1d, 0xd5, 0x9e, 0x19, 0x66, 0xc6, 0xdb, 0xdd, 0x1e, 0xb8, 0x71, 0xe0,
+	0x86, 0x38, 0xf5, 0xc8, 0xcf, 0xe9, 0xb1, 0x47, 0xc4, 0x61, 0x41, 0xc9, 0x3f, 0xd8, 0x5f, 0x80,
+	0x3c, 0x63, 0xef, 0x26, 0x1b, 0x6f, 0x22, 0x6e, 0xeb, 0xef, 0x7d, 0xfb, 0x7d, 0x33, 0xdf, 0x7b,
+	0x7e, 0x36, 0xbf, 0x4c, 0xc7, 0x05, 0x10, 0x81, 0x29, 0x19, 0x8d, 0x5f, 0x07, 0xb3, 0x87, 0x80,
+	0xd3, 0x3c, 0x47, 0x8c, 0x05, 0x0c, 0x71, 0x54, 0x08, 0x9f, 0x71, 0x2a, 0xa9, 0xe5, 0x5c, 0x26,
+	0xfb, 0xb3, 0x07, 0xbf, 0x26, 0x6f, 0x6f, 0x64, 0x34, 0xa3, 0x8a, 0x1a, 0x54, 0xbf, 0xf4, 0xbf,
+	0xb6, 0x9d, 0x84, 0x8a, 0x82, 0x8a, 0x20, 0x46, 0x02, 0x82, 0xe1, 0x93, 0x18, 0x24, 0x7a, 0x12,
+	0x24, 0x14, 0x93, 0xa6, 0x9e, 0x51, 0x9a, 0xe5, 0x10, 0xa8, 0xa7, 0xb8, 0x3c, 0x0b, 0xd2, 0x92,
+	0x23, 0x59, 0xe9, 0x2a, 0xc4, 0xfb, 0xe3, 0xbe, 0xb9, 0x7a, 0xac, 0x8e, 0x61, 0xfd, 0x64, 0xda,
+	0x29, 0x16, 0xac, 0x94, 0x10, 0x31, 0xe0, 0x98, 0xa6, 0x11, 0x26, 0x51, 0x9c, 0xd3, 0xe4, 0xa5,
+	0xb0, 0x8d, 0x9e, 0xb1, 0xd7, 0xed, 0xef, 0x4e, 0x27, 0xae, 0x3b, 0x46, 0x45, 0xfe, 0xcc, 0x5b,
+	0xc6, 0xf4, 0xc2, 0xcd, 0xba, 0x74, 0xac, 0x2a, 0x87, 0xa4, 0xaf, 0x70, 0xeb, 0xd4, 0xdc, 0xcc,
+	0xf1, 0x10, 0x08, 0x08, 0x11, 0x89, 0x1c, 0x89, 0x41, 0x23, 0xdd, 0x55, 0xd2, 0xbd, 0xe9, 0xc4,
+	0x7d, 0xac, 0xa5, 0x5b, 0x69, 0x5e, 0xf8, 0xa0, 0xc1, 0x4f, 0x2a, 0xb8, 0x56, 0x7d, 0x61, 0x3e,
+	0x5a, 0xa0, 0x63, 0x22, 0x81, 0x0f, 0x51, 0x6e, 0xbf, 0xaf, 0x74, 0xbd, 0xe9, 0xc4, 0x75, 0x5a,
+	0x75, 0x1b, 0xa2, 0x17, 0x6e, 0x5e, 0x51, 0x3e, 0xac, 0x71, 0x8b, 0x99, 0x1b, 0x88, 0xb1, 0x88,
+	0x43, 0x86, 0x85, 0xd4, 0xa1, 0x45, 0x67, 0x00, 0xf6, 0x9d, 0x9e, 0xb1, 0x77, 0xef, 0xeb, 0x2d,
+	0x5f, 0x27, 0xef, 0x57, 0xc9, 0xfb, 0x75, 0xf2, 0xfe, 0x3e, 0xc5, 0xa4, 0xbf, 0xfb, 0x76, 0xe2,
+	0x76, 0xa6, 0x13, 0x77, 0x47, 0xfb, 0xb6, 0x89, 0x78, 0xa1, 0x85, 0x18, 0x0b, 0x2f, 0xa1, 0xdf,
+	0x02, 0x58, 0xbf, 0x98, 0x5b, 0x05, 0x26, 0x91, 0x80, 0x9f, 0x4b, 0x20, 0x09, 0xf0, 0x28, 0xa6,
+	0x24, 0x8d, 0xb2, 0x9c, 0xc6, 0x28, 0xb7, 0xd7, 0x6e, 0xb3, 0xdd, 0xab, 0x6d, 0x7b, 0xda, 0x76,
+	0xa9, 0x92, 0x17, 0x3e, 0x2c, 0x30, 0x39, 0x69, 0x4a, 0x7d, 0x4a, 0xd2, 0xef, 0x54, 0xc1, 0xca,
+	0xcc, 0xc7, 0xd5, 0xbf, 0x96, 0x4e, 0xc1, 0x5d, 0x15, 0xe9, 0xe7, 0xd3, 0x89, 0xbb, 0x3b, 0xf7,
+	0x58, 0x3e, 0x09, 0x76, 0x81, 0xc9, 0x41, 0xeb, 0x30, 0x54, 0x46, 0x68, 0xb4, 0xdc, 0xc8, 0xbc,
+	0x66, 0x84, 0x46, 0x37, 0x1a, 0xa1, 0x51, 0xbb, 0xd1, 0x6f, 0x86, 0xb9, 0x33, 0xeb, 0xfb, 0x2b,
+	0xc4, 0x09, 0x26, 0x59, 0x24, 0x07, 0x1c, 0xc4, 0x80, 0xe6, 0xa9, 0xb0, 0xef, 0xf5, 0x56, 0xf6,
+	0xee, 0xf6, 0x0f, 0xab, 0xe4, 0xfe, 0x9e, 0xb8, 0x3b, 0x3a, 0x5b, 0x91, 0xbe, 0xf4, 0x31, 0x0d,
+	0x0a, 0x24, 0x07, 0xfe, 0xf7, 0x90, 0xa1, 0x64, 0x7c, 0x00, 0xc9, 0x74, 0xe2, 0x7a, 0x0b, 0x73,
+	0x74, 0x5d, 0xcf, 0x0b, 0xb7, 0x9a, 0xea, 0x8f, 0xba, 0x78, 0x3a, 0xab, 0x55, 0xf3, 0x74, 0xc6,
+	0x51, 0x99, 0x46, 0xc9, 0x00, 0xe5, 0x39, 0x90, 0x0c, 0x54, 0x57, 0xec, 0x0f, 0xfe, 0xe7, 0x3c,
+	0xb5, 0x89, 0x78, 0xa1, 0xa5, 0xe0, 0xfd, 0x06, 0xad, 0xba, 0x6a, 0x81, 0xb9, 0xb3, 0x48, 0x16,
+	0x12, 0x58, 0x93, 0xf2, 0x7d, 0x95, 0xf2, 0x67, 0xf3, 0x9b, 0xdd, 0x40, 0xf6, 0x42, 0xfb, 0xaa,
+	0xc1, 0x89, 0x04, 0x36, 0x7f, 0xb5, 0x85, 0x44, 0x12, 0x22, 0x0e, 0x12, 0x88, 0x1a, 0xf1, 0x84,
+	0x96, 0x44, 0xda, 0x1f, 0x2e, 0xbe, 0xda, 0xad, 0x34, 0x2f, 0x7c, 0xa0, 0xf0, 0xb0, 0x81, 0xf7,
+	0x2b, 0xd4, 0x7a, 0x6d, 0x3e, 0x5c, 0xa4, 0xeb, 0xce, 0xdb, 0x1f, 0xd5, 0x81, 0xe9, 0xd5, 0xe6,
+	0x37, 0xab, 0xcd, 0x3f, 0xa8, 0x57, 0x5b, 0xff, 0x8b, 0x3a, 0xb0, 0x4f, 0xda, 0x5d, 0xb5, 0x8c,
+	0xf7, 0xe6, 0x1f, 0xd7, 0x08, 0x37, 0xae, 0x5a, 0xeb, 0xf1, 0xb1, 0x7e, 0x30, 0x35, 0x1e, 0x31,
+	0x5e, 0xaa, 0x16, 0xc7, 0x65, 0x9a, 0x81, 0xb4, 0xd7, 0xd5, 0x85, 0xdc, 0x79, 0x2f, 0xda, 0x58,
+	0x5e, 0x68, 0x29, 0xf8, 0x58, 0xa3, 0x7d, 0x05, 0xaa, 0x49, 0xa4, 0xaf, 0x08, 0x70, 0x31, 0xc0,
+	0x2c, 0x92, 0x1c, 0x11, 0x71, 0x06, 0x3c, 0x92, 0xb8, 0x80, 0x2a, 0x45, 0xfb, 0xe3, 0xdb, 0x2e,
+	0xe5, 0xd7, 0x97, 0xaa, 0x7b, 0x75, 0x83, 0x96, 0xbe, 0xd9, 0xd6, 0x8c, 0x71, 0x5a, 0x13, 0x4e,
+	0xeb, 0xba, 0xf5, 0xab, 0x61, 0x6e, 0xab, 0x57, 0x97, 0x8b, 0x28, 0x05, 0xc6, 0x21, 0xd1, 0x8b,
+	0x89, 0x50, 0x89, 0x13, 0xb0, 0xad, 0xdb, 0x8e, 0xf2, 0x55, 0x7d, 0x94, 0x4f, 0x2f, 0x6d, 0x81,
+	0x56, 0x29, 0x7d, 0x92, 0x47, 0xd5, 0x1e, 0xe0, 0xe2, 0x60, 0x5e, 0x7e, 0xae, 0xaa, 0xcf, 0xba,
+	0x6f, 0xfe, 0x74, 0x3b, 0x47, 0xdd, 0xb5, 0xf7, 0xd6, 0x57, 0x8e, 0xba, 0x6b, 0x2b, 0xeb, 0xdd,
+	0xa3, 0xee, 0xda, 0xea, 0xfa, 0x9d, 0xfe, 0xf3, 0xb7, 0xe7, 0x8e, 0xf1, 0xee, 0xdc, 0x31, 0xfe,
+	0x3d, 0x77, 0x8c, 0xdf, 0x2f, 0x9c, 0xce, 0xbb, 0x0b, 0xa7, 0xf3, 0xd7, 0x85, 0xd3, 0x79, 0xf1,
+	0x4d, 0x86, 0xe5, 0xa0, 0x8c, 0xfd, 0x84, 0x16, 0xc1, 0x92, 0x8f, 0xeb, 0xf0, 0x69, 0x30, 0x9a,
+	0x7d, 0x61, 0xe5, 0x98, 0x81, 0x88, 0x57, 0xd5, 0x15, 0x9e, 0xfe, 0x37, 0x00, 0x71, 0xe5, 0xaa,
+	0x11, 0x90, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -170,6 +291,85 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinDrsDeprecationNotice, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDrsDeprecationNotice):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OwnershipTransferTimelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OwnershipTransferTimelock):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.StatePruningBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StatePruningBudget))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StateRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StateRetentionPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x7a
+	if m.StateRetentionCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StateRetentionCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.FraudChallengeStepBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FraudChallengeStepBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.FraudChallengeBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.LivenessWarningThresholds) > 0 {
+		for iNdEx := len(m.LivenessWarningThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.LivenessWarningThresholds[iNdEx].Size()
+				i -= size
+				if _, err := m.LivenessWarningThresholds[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size, err := m.MinSequencerBondGlobal.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -238,6 +438,35 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MinSequencerBondGlobal.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MinDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinDisputePeriodInBlocks))
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxDisputePeriodInBlocks))
+	}
+	if len(m.LivenessWarningThresholds) > 0 {
+		for _, e := range m.LivenessWarningThresholds {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FraudChallengeBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.FraudChallengeStepBlocks != 0 {
+		n += 1 + sovParams(uint64(m.FraudChallengeStepBlocks))
+	}
+	if m.StateRetentionCount != 0 {
+		n += 1 + sovParams(uint64(m.StateRetentionCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StateRetentionPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.StatePruningBudget != 0 {
+		n += 2 + sovParams(uint64(m.StatePruningBudget))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OwnershipTransferTimelock)
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinDrsDeprecationNotice)
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -399,6 +628,269 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDisputePeriodInBlocks", wireType)
+			}
+			m.MinDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDisputePeriodInBlocks", wireType)
+			}
+			m.MaxDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessWarningThresholds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.LivenessWarningThresholds = append(m.LivenessWarningThresholds, v)
+			if err := m.LivenessWarningThresholds[len(m.LivenessWarningThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudChallengeBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FraudChallengeBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudChallengeStepBlocks", wireType)
+			}
+			m.FraudChallengeStepBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FraudChallengeStepBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRetentionCount", wireType)
+			}
+			m.StateRetentionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateRetentionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StateRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatePruningBudget", wireType)
+			}
+			m.StatePruningBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatePruningBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransferTimelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OwnershipTransferTimelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDrsDeprecationNotice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinDrsDeprecationNotice, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
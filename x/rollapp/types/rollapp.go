@@ -69,6 +69,7 @@ func NewRollapp(creator, rollappId, initialSequencer string, minSequencerBond sd
 // - GenesisInfo must pass basic validation
 // - VmType must be non-zero
 // - Metadata (if present) must be valid
+// - DaScheme (if present) must be a valid DA path scheme
 // - If rollapp is launched, GenesisInfo must be sealed
 //
 // Returns:
@@ -76,6 +77,7 @@ func NewRollapp(creator, rollappId, initialSequencer string, minSequencerBond sd
 //   - ErrInvalidInitialSequencer: if initial sequencer address is invalid
 //   - ErrInvalidVMType: if VM type is not set
 //   - ErrInvalidMetadata: if metadata validation fails
+//   - ErrInvalidDAPath: if the DA scheme is invalid
 //   - Other errors from GenesisInfo.ValidateBasic() or MinSeqBondCoins validation
 func (r Rollapp) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(r.Owner)
@@ -111,6 +113,16 @@ func (r Rollapp) ValidateBasic() error {
 		}
 	}
 
+	if r.DaScheme != "" {
+		if err = ValidateDAScheme(r.DaScheme); err != nil {
+			return err
+		}
+	}
+
+	if err = ValidateProposerSelection(r.ProposerSelection); err != nil {
+		return err
+	}
+
 	// if rollapp is started, genesis info must be sealed
 	if r.Launched && !r.GenesisInfo.Sealed {
 		return fmt.Errorf("genesis info needs to be sealed if rollapp is started")
@@ -119,6 +131,13 @@ func (r Rollapp) ValidateBasic() error {
 	return nil
 }
 
+func ValidateProposerSelection(s ProposerSelection) error {
+	if _, ok := ProposerSelection_name[int32(s)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidProposerSelection, "%d", s)
+	}
+	return nil
+}
+
 func (r Rollapp) IsTransferEnabled() bool {
 	return r.GenesisState.IsTransferEnabled()
 }
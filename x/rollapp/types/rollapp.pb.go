@@ -29,6 +29,49 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProposerSelection is the strategy for choosing the next proposer of a
+// rollapp
+type ProposerSelection int32
+
+const (
+	// PROPOSER_SELECTION_UNSPECIFIED is the default, the max bond strategy
+	ProposerSelectionUnspecified ProposerSelection = 0
+	// PROPOSER_SELECTION_MAX_BOND chooses the sequencer with the largest bond
+	ProposerSelectionMaxBond ProposerSelection = 1
+	// PROPOSER_SELECTION_ROUND_ROBIN chooses the sequencers in turn, by address
+	ProposerSelectionRoundRobin ProposerSelection = 2
+	// PROPOSER_SELECTION_STAKE_WEIGHTED_RANDOM chooses a sequencer at random,
+	// weighted by bond, seeded from the block hash
+	ProposerSelectionStakeWeightedRandom ProposerSelection = 3
+	// PROPOSER_SELECTION_LOWEST_DISHONOR chooses the sequencer with the lowest
+	// dishonor, then the largest bond
+	ProposerSelectionLowestDishonor ProposerSelection = 4
+)
+
+var ProposerSelection_name = map[int32]string{
+	0: "PROPOSER_SELECTION_UNSPECIFIED",
+	1: "PROPOSER_SELECTION_MAX_BOND",
+	2: "PROPOSER_SELECTION_ROUND_ROBIN",
+	3: "PROPOSER_SELECTION_STAKE_WEIGHTED_RANDOM",
+	4: "PROPOSER_SELECTION_LOWEST_DISHONOR",
+}
+
+var ProposerSelection_value = map[string]int32{
+	"PROPOSER_SELECTION_UNSPECIFIED":           0,
+	"PROPOSER_SELECTION_MAX_BOND":              1,
+	"PROPOSER_SELECTION_ROUND_ROBIN":           2,
+	"PROPOSER_SELECTION_STAKE_WEIGHTED_RANDOM": 3,
+	"PROPOSER_SELECTION_LOWEST_DISHONOR":       4,
+}
+
+func (x ProposerSelection) String() string {
+	return proto.EnumName(ProposerSelection_name, int32(x))
+}
+
+func (ProposerSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{0}
+}
+
 type Rollapp_VMType int32
 
 const (
@@ -152,6 +195,15 @@ type Rollapp struct {
 	LivenessCountdownStartHeight int64 `protobuf:"varint,18,opt,name=liveness_countdown_start_height,json=livenessCountdownStartHeight,proto3" json:"liveness_countdown_start_height,omitempty"`
 	// Revisions is a list of all the rollapp revisions.
 	Revisions []Revision `protobuf:"bytes,19,rep,name=revisions,proto3" json:"revisions"`
+	// dispute_period_in_blocks overrides the dispute period of the module
+	// params for this rollapp, within the bounds of the params. 0 means not set.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,21,opt,name=dispute_period_in_blocks,json=disputePeriodInBlocks,proto3" json:"dispute_period_in_blocks,omitempty"`
+	// da_scheme is the DA layer the rollapp posts its batches to, as the scheme
+	// prefix of the DA paths of its state updates. It is required on creation.
+	DaScheme string `protobuf:"bytes,22,opt,name=da_scheme,json=daScheme,proto3" json:"da_scheme,omitempty"`
+	// proposer_selection is the strategy for choosing the next proposer among
+	// the opted in sequencers, chosen by the owner
+	ProposerSelection ProposerSelection `protobuf:"varint,23,opt,name=proposer_selection,json=proposerSelection,proto3,enum=dymensionxyz.dymension.rollapp.ProposerSelection" json:"proposer_selection,omitempty"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -285,6 +337,27 @@ func (m *Rollapp) GetRevisions() []Revision {
 	return nil
 }
 
+func (m *Rollapp) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Rollapp) GetDaScheme() string {
+	if m != nil {
+		return m.DaScheme
+	}
+	return ""
+}
+
+func (m *Rollapp) GetProposerSelection() ProposerSelection {
+	if m != nil {
+		return m.ProposerSelection
+	}
+	return ProposerSelectionUnspecified
+}
+
 // Revision is a representation of the rollapp revision.
 type Revision struct {
 	// Number is the revision number of the rollapp. Always start with 0 revision.
@@ -422,6 +495,7 @@ func (m *RollappSummary) GetLatestFinalizedHeight() uint64 {
 }
 
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.rollapp.ProposerSelection", ProposerSelection_name, ProposerSelection_value)
 	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_VMType", Rollapp_VMType_name, Rollapp_VMType_value)
 	proto.RegisterType((*RollappGenesisState)(nil), "dymensionxyz.dymension.rollapp.RollappGenesisState")
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
@@ -434,62 +508,80 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x2d, 0xc6, 0xa2, 0x47, 0x8a, 0xcd, 0xac, 0x9c, 0x82, 0x16, 0x12, 0x49, 0xd5, 0x89,
-	0x40, 0x12, 0x12, 0xb6, 0x73, 0xea, 0xad, 0x0a, 0xdc, 0xc4, 0x6e, 0x54, 0x14, 0x94, 0x93, 0x02,
-	0x39, 0x94, 0xa0, 0xc4, 0x15, 0xb5, 0x28, 0xb9, 0xcb, 0x72, 0x57, 0x8a, 0x95, 0xaf, 0xc8, 0xa9,
-	0x1f, 0xd1, 0x2f, 0xc9, 0x31, 0xe8, 0xa9, 0x27, 0xa7, 0xb0, 0xff, 0xa0, 0x5f, 0x50, 0x70, 0xb9,
-	0x94, 0x94, 0x3a, 0xa9, 0x82, 0x9e, 0xa8, 0x99, 0x37, 0xf3, 0x76, 0x66, 0x76, 0xde, 0x0a, 0x1e,
-	0x86, 0x8b, 0x04, 0x53, 0x4e, 0x18, 0xbd, 0x58, 0xbc, 0x71, 0x97, 0x86, 0x9b, 0xb1, 0x38, 0x0e,
-	0xd2, 0xb4, 0xfc, 0x3a, 0x69, 0xc6, 0x04, 0x43, 0xed, 0xf5, 0x68, 0x67, 0x69, 0x38, 0x2a, 0xaa,
-	0xb5, 0x1f, 0xb1, 0x88, 0xc9, 0x50, 0x37, 0xff, 0x55, 0x64, 0xb5, 0x3a, 0x11, 0x63, 0x51, 0x8c,
-	0x5d, 0x69, 0x8d, 0x66, 0x13, 0x57, 0x90, 0x04, 0x73, 0x11, 0x24, 0x8a, 0xb6, 0xe5, 0x6e, 0x28,
-	0x82, 0x8b, 0x40, 0x60, 0x9f, 0xd0, 0x49, 0xc9, 0xf8, 0x68, 0x43, 0x42, 0x82, 0x45, 0x10, 0x06,
-	0x22, 0x50, 0xe1, 0xed, 0x31, 0xe3, 0x09, 0xe3, 0xee, 0x28, 0xe0, 0xd8, 0x9d, 0x1f, 0x8e, 0xb0,
-	0x08, 0x0e, 0xdd, 0x31, 0x23, 0x54, 0xe1, 0x87, 0x1b, 0xe8, 0x22, 0x4c, 0x31, 0x27, 0x7c, 0xad,
-	0x82, 0xde, 0x0b, 0x68, 0x7a, 0x05, 0xfa, 0xb4, 0x00, 0x87, 0x79, 0x8d, 0xe8, 0x08, 0xee, 0x8a,
-	0x2c, 0xa0, 0x7c, 0x82, 0x33, 0x3f, 0xcd, 0x18, 0x9b, 0xf8, 0x53, 0x4c, 0xa2, 0xa9, 0xb0, 0xaa,
-	0x5d, 0xcd, 0xd6, 0xbd, 0x66, 0x09, 0xfe, 0x98, 0x63, 0xcf, 0x24, 0x74, 0xa6, 0x1b, 0x9a, 0xb9,
-	0x75, 0xa6, 0x1b, 0x5b, 0x66, 0xb5, 0x77, 0x59, 0x83, 0x9a, 0xe2, 0x45, 0xf7, 0x01, 0x54, 0x01,
-	0x3e, 0x09, 0x2d, 0xad, 0xab, 0xd9, 0x3b, 0xde, 0x8e, 0xf2, 0x9c, 0x86, 0x68, 0x1f, 0x6e, 0xb1,
-	0xd7, 0x14, 0x67, 0xd6, 0x96, 0x44, 0x0a, 0x03, 0xfd, 0x0c, 0xb7, 0xcb, 0x6a, 0xe5, 0xd4, 0xac,
-	0x5a, 0x57, 0xb3, 0xeb, 0x47, 0xc7, 0xce, 0x7f, 0xdf, 0x9c, 0xf3, 0x89, 0x66, 0xfa, 0xfa, 0xbb,
-	0xcb, 0x4e, 0xc5, 0x6b, 0x44, 0xeb, 0x0d, 0xde, 0x07, 0x18, 0x4f, 0x03, 0x4a, 0x71, 0x9c, 0x17,
-	0x65, 0x14, 0x45, 0x29, 0xcf, 0x69, 0x88, 0xbe, 0x07, 0xa3, 0x9c, 0xbd, 0x55, 0x97, 0x27, 0xbb,
-	0x5f, 0x78, 0xf2, 0x40, 0xa5, 0x79, 0x4b, 0x02, 0x74, 0x0e, 0x8d, 0xf5, 0xc9, 0x5b, 0x0d, 0x49,
-	0xf8, 0x60, 0x13, 0xa1, 0xea, 0xe1, 0x94, 0x4e, 0x98, 0x6a, 0xa1, 0x1e, 0xad, 0x5c, 0xe8, 0x01,
-	0xdc, 0x21, 0x94, 0x08, 0x12, 0xc4, 0x3e, 0xc7, 0xbf, 0xce, 0x30, 0x1d, 0xe3, 0xcc, 0xba, 0x2d,
-	0x1b, 0x31, 0x15, 0x30, 0x2c, 0xfd, 0xe8, 0x37, 0x0d, 0x50, 0x42, 0xe8, 0x2a, 0xd2, 0x1f, 0x31,
-	0x1a, 0x5a, 0xfb, 0xdd, 0xaa, 0x5d, 0x3f, 0x3a, 0x70, 0x8a, 0xbd, 0x72, 0xf2, 0xbd, 0x72, 0xd4,
-	0x5e, 0x39, 0x4f, 0x18, 0xa1, 0xfd, 0x41, 0x7e, 0xee, 0xdf, 0x97, 0x9d, 0x83, 0x45, 0x90, 0xc4,
-	0xdf, 0xf4, 0x6e, 0x52, 0xf4, 0x7e, 0xff, 0xd0, 0xb1, 0x23, 0x22, 0xa6, 0xb3, 0x91, 0x33, 0x66,
-	0x89, 0xab, 0x36, 0xb4, 0xf8, 0x3c, 0xe2, 0xe1, 0x2f, 0xae, 0x58, 0xa4, 0x98, 0x4b, 0x36, 0xee,
-	0x99, 0x09, 0xa1, 0xcb, 0xa2, 0xfa, 0x8c, 0x86, 0xe8, 0x29, 0xd4, 0xe6, 0x89, 0x9f, 0xc7, 0x58,
-	0xbb, 0x5d, 0xcd, 0xde, 0x3d, 0x72, 0xbe, 0x70, 0xce, 0xce, 0xcb, 0xc1, 0xf9, 0x22, 0xc5, 0xde,
-	0xf6, 0x3c, 0xc9, 0xbf, 0xa8, 0x05, 0x46, 0x1c, 0xcc, 0xe8, 0x78, 0x8a, 0x43, 0x6b, 0xaf, 0xab,
-	0xd9, 0x86, 0xb7, 0xb4, 0xd1, 0x33, 0xd8, 0x4b, 0x33, 0xec, 0x17, 0xb6, 0x9f, 0xab, 0xd6, 0x32,
-	0xe5, 0x1d, 0xb4, 0x9c, 0x42, 0xd2, 0x4e, 0x29, 0x69, 0xe7, 0xbc, 0x94, 0x74, 0x5f, 0x7f, 0xfb,
-	0xa1, 0xa3, 0x79, 0xb7, 0xd3, 0x0c, 0x3f, 0x97, 0x79, 0x39, 0x92, 0xeb, 0x22, 0x26, 0xf3, 0xfc,
-	0x16, 0xb8, 0x8f, 0xe7, 0x98, 0x8a, 0x52, 0x17, 0x77, 0xba, 0x9a, 0x5d, 0xf5, 0x9a, 0x25, 0x78,
-	0x92, 0x63, 0x85, 0x2e, 0xd0, 0x09, 0x74, 0x96, 0x39, 0x63, 0x36, 0xa3, 0x22, 0x64, 0xaf, 0x69,
-	0xbe, 0xd5, 0xd9, 0x32, 0x1b, 0xc9, 0xec, 0x7b, 0x65, 0xd8, 0x93, 0x32, 0x6a, 0x98, 0x07, 0x29,
-	0x9a, 0xe7, 0xb0, 0x93, 0xe1, 0x39, 0xc9, 0x67, 0xc1, 0xad, 0xa6, 0xbc, 0x38, 0x7b, 0xe3, 0xac,
-	0x54, 0x82, 0xda, 0x9f, 0x15, 0x41, 0xef, 0x21, 0x6c, 0x17, 0x03, 0x44, 0x7b, 0x50, 0x7f, 0x41,
-	0x79, 0x8a, 0xc7, 0x64, 0x42, 0x70, 0x68, 0x56, 0x50, 0x0d, 0xaa, 0x27, 0x2f, 0x07, 0xa6, 0x86,
-	0x0c, 0xd0, 0x7f, 0xfa, 0x76, 0x38, 0x90, 0xa2, 0xae, 0x9a, 0xb5, 0x33, 0xdd, 0xd8, 0x31, 0xe1,
-	0x4c, 0x37, 0xc0, 0xac, 0xf7, 0x4e, 0xc0, 0x28, 0xc9, 0xd1, 0x57, 0xb0, 0x4d, 0x67, 0xc9, 0x08,
-	0x67, 0x56, 0x53, 0xbe, 0x0e, 0xca, 0x42, 0x5f, 0x43, 0xe3, 0xa3, 0x2e, 0xf7, 0x25, 0x5a, 0xe7,
-	0xab, 0xa6, 0x7a, 0x7f, 0x6c, 0xc1, 0xae, 0xba, 0xd0, 0xe1, 0x2c, 0x49, 0x82, 0x6c, 0x81, 0xee,
-	0xc1, 0xea, 0x71, 0xb8, 0xf9, 0x5a, 0xbc, 0x02, 0x33, 0x0e, 0x04, 0xe6, 0x42, 0xca, 0xf8, 0x94,
-	0x86, 0xf8, 0x42, 0x3e, 0x1c, 0xf5, 0xcd, 0x8b, 0xa3, 0x32, 0x26, 0x4c, 0x66, 0x79, 0x37, 0x78,
-	0x50, 0x0c, 0x07, 0x85, 0xef, 0x3b, 0x42, 0x83, 0x98, 0xbc, 0xc1, 0xe1, 0xda, 0x21, 0xd5, 0xff,
-	0x75, 0xc8, 0xe7, 0x09, 0x51, 0x0f, 0x1a, 0x05, 0x58, 0x8c, 0xc2, 0xd2, 0xe5, 0x74, 0x3e, 0xf2,
-	0xa1, 0xc7, 0x70, 0xf7, 0x5f, 0x04, 0x2a, 0xf8, 0x96, 0x0c, 0xfe, 0x34, 0xd8, 0xff, 0xe1, 0xdd,
-	0x55, 0x5b, 0x7b, 0x7f, 0xd5, 0xd6, 0xfe, 0xba, 0x6a, 0x6b, 0x6f, 0xaf, 0xdb, 0x95, 0xf7, 0xd7,
-	0xed, 0xca, 0x9f, 0xd7, 0xed, 0xca, 0xab, 0xc7, 0x6b, 0x4a, 0xfd, 0xcc, 0x7f, 0xc5, 0xfc, 0xd8,
-	0xbd, 0x58, 0xfe, 0x61, 0x48, 0xed, 0x8e, 0xb6, 0xa5, 0x3a, 0x8e, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x89, 0x4e, 0x0e, 0xd0, 0x64, 0x07, 0x00, 0x00,
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x16, 0x2d, 0xc5, 0x96, 0x56, 0x4e, 0x42, 0xaf, 0xed, 0xfc, 0x18, 0xc5, 0x91, 0xf8, 0x53,
+	0x7b, 0x10, 0x9a, 0x84, 0x84, 0x9d, 0x00, 0x05, 0x0a, 0xf4, 0x60, 0x59, 0x4c, 0x2c, 0xc7, 0xfa,
+	0x03, 0x52, 0x8e, 0x8b, 0x1c, 0xca, 0x52, 0xe2, 0x4a, 0x5a, 0x84, 0xdc, 0x65, 0xb9, 0x94, 0x62,
+	0xe5, 0x09, 0x02, 0x9f, 0x72, 0xca, 0xcd, 0xa7, 0xde, 0xfa, 0x24, 0x39, 0x06, 0x3d, 0xf5, 0x94,
+	0x14, 0xc9, 0x1b, 0xf4, 0x09, 0x0a, 0x2e, 0x49, 0x59, 0x89, 0x94, 0x2a, 0xe8, 0x89, 0x9a, 0xfd,
+	0x66, 0xbe, 0x9d, 0x9d, 0xf9, 0x66, 0x20, 0x70, 0xd7, 0x9e, 0xb8, 0x88, 0x30, 0x4c, 0xc9, 0xd9,
+	0xe4, 0x85, 0x3a, 0x35, 0x54, 0x9f, 0x3a, 0x8e, 0xe5, 0x79, 0xc9, 0x57, 0xf1, 0x7c, 0x1a, 0x50,
+	0x58, 0x9c, 0xf5, 0x56, 0xa6, 0x86, 0x12, 0x7b, 0x15, 0xb6, 0x06, 0x74, 0x40, 0xb9, 0xab, 0x1a,
+	0xfe, 0x8a, 0xa2, 0x0a, 0xa5, 0x01, 0xa5, 0x03, 0x07, 0xa9, 0xdc, 0xea, 0x8e, 0xfa, 0x6a, 0x80,
+	0x5d, 0xc4, 0x02, 0xcb, 0x8d, 0x69, 0x0b, 0xea, 0x92, 0x24, 0x58, 0x60, 0x05, 0xc8, 0xc4, 0xa4,
+	0x9f, 0x30, 0xde, 0x5b, 0x12, 0xe0, 0xa2, 0xc0, 0xb2, 0xad, 0xc0, 0x8a, 0xdd, 0x8b, 0x3d, 0xca,
+	0x5c, 0xca, 0xd4, 0xae, 0xc5, 0x90, 0x3a, 0xde, 0xed, 0xa2, 0xc0, 0xda, 0x55, 0x7b, 0x14, 0x93,
+	0x18, 0xdf, 0x5d, 0x42, 0x37, 0x40, 0x04, 0x31, 0xcc, 0x66, 0x32, 0x28, 0x9f, 0x80, 0x4d, 0x3d,
+	0x42, 0x1f, 0x45, 0xa0, 0x11, 0xe6, 0x08, 0xf7, 0xc0, 0x76, 0xe0, 0x5b, 0x84, 0xf5, 0x91, 0x6f,
+	0x7a, 0x3e, 0xa5, 0x7d, 0x73, 0x88, 0xf0, 0x60, 0x18, 0x48, 0x69, 0x59, 0xa8, 0x64, 0xf4, 0xcd,
+	0x04, 0x6c, 0x87, 0xd8, 0x21, 0x87, 0x8e, 0x32, 0x59, 0x41, 0x5c, 0x39, 0xca, 0x64, 0x57, 0xc4,
+	0x74, 0xf9, 0x75, 0x0e, 0xac, 0xc5, 0xbc, 0xf0, 0x36, 0x00, 0x71, 0x02, 0x26, 0xb6, 0x25, 0x41,
+	0x16, 0x2a, 0x39, 0x3d, 0x17, 0x9f, 0xd4, 0x6d, 0xb8, 0x05, 0xae, 0xd0, 0xe7, 0x04, 0xf9, 0xd2,
+	0x0a, 0x47, 0x22, 0x03, 0xfe, 0x0c, 0xae, 0x26, 0xd9, 0xf2, 0xaa, 0x49, 0x6b, 0xb2, 0x50, 0xc9,
+	0xef, 0xdd, 0x57, 0xfe, 0xbd, 0x73, 0xca, 0x82, 0xc7, 0x54, 0x33, 0x6f, 0xde, 0x95, 0x52, 0xfa,
+	0xfa, 0x60, 0xf6, 0x81, 0xb7, 0x01, 0xe8, 0x0d, 0x2d, 0x42, 0x90, 0x13, 0x26, 0x95, 0x8d, 0x92,
+	0x8a, 0x4f, 0xea, 0x36, 0x7c, 0x0c, 0xb2, 0x49, 0xed, 0xa5, 0x3c, 0xbf, 0x59, 0xfd, 0xca, 0x9b,
+	0x1b, 0x71, 0x98, 0x3e, 0x25, 0x80, 0x1d, 0xb0, 0x3e, 0x5b, 0x79, 0x69, 0x9d, 0x13, 0xde, 0x59,
+	0x46, 0x18, 0xbf, 0xa1, 0x4e, 0xfa, 0x34, 0x7e, 0x42, 0x7e, 0x70, 0x79, 0x04, 0xef, 0x80, 0x0d,
+	0x4c, 0x70, 0x80, 0x2d, 0xc7, 0x64, 0xe8, 0xd7, 0x11, 0x22, 0x3d, 0xe4, 0x4b, 0x57, 0xf9, 0x43,
+	0xc4, 0x18, 0x30, 0x92, 0x73, 0xf8, 0x5a, 0x00, 0xd0, 0xc5, 0xe4, 0xd2, 0xd3, 0xec, 0x52, 0x62,
+	0x4b, 0x5b, 0x72, 0xba, 0x92, 0xdf, 0xbb, 0xa9, 0x44, 0xba, 0x52, 0x42, 0x5d, 0x29, 0xb1, 0xae,
+	0x94, 0x03, 0x8a, 0x49, 0xb5, 0x11, 0xde, 0xfb, 0xf7, 0xbb, 0xd2, 0xcd, 0x89, 0xe5, 0x3a, 0x3f,
+	0x94, 0xe7, 0x29, 0xca, 0xbf, 0xbf, 0x2f, 0x55, 0x06, 0x38, 0x18, 0x8e, 0xba, 0x4a, 0x8f, 0xba,
+	0x6a, 0xac, 0xd0, 0xe8, 0x73, 0x8f, 0xd9, 0xcf, 0xd4, 0x60, 0xe2, 0x21, 0xc6, 0xd9, 0x98, 0x2e,
+	0xba, 0x98, 0x4c, 0x93, 0xaa, 0x52, 0x62, 0xc3, 0x47, 0x60, 0x6d, 0xec, 0x9a, 0xa1, 0x8f, 0x74,
+	0x4d, 0x16, 0x2a, 0xd7, 0xf6, 0x94, 0xaf, 0xac, 0xb3, 0xf2, 0xa4, 0xd1, 0x99, 0x78, 0x48, 0x5f,
+	0x1d, 0xbb, 0xe1, 0x17, 0x16, 0x40, 0xd6, 0xb1, 0x46, 0xa4, 0x37, 0x44, 0xb6, 0x74, 0x5d, 0x16,
+	0x2a, 0x59, 0x7d, 0x6a, 0xc3, 0x43, 0x70, 0xdd, 0xf3, 0x91, 0x19, 0xd9, 0x66, 0x38, 0xb5, 0x92,
+	0xc8, 0x7b, 0x50, 0x50, 0xa2, 0x91, 0x56, 0x92, 0x91, 0x56, 0x3a, 0xc9, 0x48, 0x57, 0x33, 0xaf,
+	0xde, 0x97, 0x04, 0xfd, 0xaa, 0xe7, 0xa3, 0x63, 0x1e, 0x17, 0x22, 0xe1, 0x5c, 0x38, 0x78, 0x1c,
+	0x76, 0x81, 0x99, 0x68, 0x8c, 0x48, 0x90, 0xcc, 0xc5, 0x86, 0x2c, 0x54, 0xd2, 0xfa, 0x66, 0x02,
+	0x6a, 0x21, 0x16, 0xcd, 0x05, 0xd4, 0x40, 0x69, 0x1a, 0xd3, 0xa3, 0x23, 0x12, 0xd8, 0xf4, 0x39,
+	0x09, 0x55, 0xed, 0x4f, 0xa3, 0x21, 0x8f, 0xde, 0x49, 0xdc, 0x0e, 0x12, 0x2f, 0x23, 0x74, 0x8a,
+	0x69, 0x8e, 0x41, 0xce, 0x47, 0x63, 0x1c, 0xd6, 0x82, 0x49, 0x9b, 0xbc, 0x71, 0x95, 0xa5, 0xb5,
+	0x8a, 0x03, 0x62, 0xfd, 0x5c, 0x12, 0xc0, 0xef, 0x81, 0x64, 0x63, 0xe6, 0x8d, 0x02, 0x64, 0x7a,
+	0xc8, 0xc7, 0xd4, 0x36, 0x31, 0x31, 0xbb, 0x0e, 0xed, 0x3d, 0x63, 0xd2, 0x36, 0x9f, 0xf1, 0xed,
+	0x18, 0x6f, 0x73, 0xb8, 0x4e, 0xaa, 0x1c, 0x84, 0xb7, 0x40, 0xce, 0xb6, 0x4c, 0xd6, 0x1b, 0x22,
+	0x17, 0x49, 0x37, 0xb8, 0xdc, 0xb2, 0xb6, 0x65, 0x70, 0x1b, 0xfe, 0x02, 0xa0, 0xe7, 0x53, 0x8f,
+	0x32, 0xe4, 0x9b, 0x0c, 0x39, 0xa8, 0x17, 0x60, 0x4a, 0xa4, 0xff, 0xf1, 0xc6, 0xee, 0x2e, 0x4b,
+	0xb6, 0x1d, 0x47, 0x1a, 0x49, 0xa0, 0xbe, 0xe1, 0x7d, 0x7e, 0x54, 0xbe, 0x0b, 0x56, 0xa3, 0xc6,
+	0xc3, 0xeb, 0x20, 0x7f, 0x42, 0x98, 0x87, 0x7a, 0xb8, 0x8f, 0x91, 0x2d, 0xa6, 0xe0, 0x1a, 0x48,
+	0x6b, 0x4f, 0x1a, 0xa2, 0x00, 0xb3, 0x20, 0x73, 0xba, 0x6f, 0x34, 0xf8, 0x32, 0x4a, 0x8b, 0x6b,
+	0x47, 0x99, 0x6c, 0x4e, 0x04, 0x47, 0x99, 0x2c, 0x10, 0xf3, 0x65, 0x0d, 0x64, 0x93, 0xa2, 0xc0,
+	0x1b, 0x60, 0x95, 0x8c, 0xdc, 0x2e, 0xf2, 0xa5, 0x4d, 0xfe, 0xe2, 0xd8, 0x82, 0xff, 0x07, 0xeb,
+	0x9f, 0x74, 0x67, 0x8b, 0xa3, 0x79, 0x76, 0xd9, 0x8c, 0xf2, 0x1f, 0x2b, 0xe0, 0x5a, 0x2c, 0x44,
+	0x63, 0xe4, 0xba, 0x96, 0x3f, 0x81, 0x3b, 0xe0, 0x72, 0xa9, 0xcd, 0x6f, 0xb9, 0xa7, 0x40, 0x74,
+	0xac, 0x00, 0xb1, 0x80, 0xaf, 0x9f, 0x3a, 0xb1, 0xd1, 0x19, 0x5f, 0x78, 0xf9, 0xe5, 0x82, 0x8f,
+	0x23, 0xfa, 0x94, 0x47, 0xe9, 0x73, 0x3c, 0xd0, 0x01, 0x37, 0xa3, 0xb3, 0x87, 0x98, 0x58, 0x0e,
+	0x7e, 0x81, 0xec, 0x99, 0x4b, 0xd2, 0xff, 0xe9, 0x92, 0x2f, 0x13, 0xc2, 0x32, 0x58, 0x8f, 0xc0,
+	0xa8, 0x14, 0x52, 0x86, 0x57, 0xe7, 0x93, 0x33, 0xf8, 0x00, 0x6c, 0x7f, 0x46, 0x10, 0x3b, 0x5f,
+	0x89, 0xa4, 0xb5, 0x10, 0xfc, 0xee, 0x65, 0x1a, 0x6c, 0xcc, 0x89, 0x00, 0xd6, 0x40, 0xb1, 0xad,
+	0xb7, 0xda, 0x2d, 0x43, 0xd3, 0x4d, 0x43, 0x3b, 0xd6, 0x0e, 0x3a, 0xf5, 0x56, 0xd3, 0x3c, 0x69,
+	0x1a, 0x6d, 0xed, 0xa0, 0xfe, 0xb0, 0xae, 0xd5, 0xc4, 0x54, 0x41, 0x3e, 0xbf, 0x90, 0x77, 0xe6,
+	0x42, 0x67, 0xe4, 0x01, 0x7f, 0x04, 0xb7, 0x16, 0xb0, 0x34, 0xf6, 0x7f, 0x32, 0xab, 0xad, 0x66,
+	0x4d, 0x14, 0x0a, 0x3b, 0xe7, 0x17, 0xb2, 0x34, 0x47, 0xd1, 0xb0, 0xce, 0xf8, 0x9a, 0x3a, 0x58,
+	0x98, 0x84, 0xde, 0x3a, 0x69, 0xd6, 0x4c, 0xbd, 0x55, 0xad, 0x37, 0xc5, 0x95, 0x42, 0xe9, 0xfc,
+	0x42, 0xbe, 0x35, 0x2f, 0x62, 0x3a, 0x22, 0xb6, 0x4e, 0xbb, 0x98, 0xc0, 0x27, 0xa0, 0xb2, 0x80,
+	0xc4, 0xe8, 0xec, 0x3f, 0xd6, 0xcc, 0x53, 0xad, 0xfe, 0xe8, 0xb0, 0xa3, 0xd5, 0x4c, 0x7d, 0xbf,
+	0x59, 0x6b, 0x35, 0xc4, 0x74, 0xa1, 0x72, 0x7e, 0x21, 0x7f, 0x3b, 0x47, 0x67, 0x04, 0xd6, 0x33,
+	0x74, 0xca, 0xeb, 0x85, 0x6c, 0xdd, 0x22, 0x36, 0x75, 0xe1, 0x63, 0x50, 0x5e, 0xc0, 0x7b, 0xdc,
+	0x3a, 0xd5, 0x8c, 0x8e, 0x59, 0xab, 0x1b, 0x87, 0xad, 0x66, 0x4b, 0x17, 0x33, 0x85, 0x6f, 0xce,
+	0x2f, 0xe4, 0xd2, 0x1c, 0xe3, 0x31, 0x7d, 0x8e, 0x58, 0x50, 0xc3, 0x6c, 0x48, 0x09, 0xf5, 0x0b,
+	0x99, 0x97, 0xbf, 0x15, 0x53, 0xd5, 0xe6, 0x9b, 0x0f, 0x45, 0xe1, 0xed, 0x87, 0xa2, 0xf0, 0xd7,
+	0x87, 0xa2, 0xf0, 0xea, 0x63, 0x31, 0xf5, 0xf6, 0x63, 0x31, 0xf5, 0xe7, 0xc7, 0x62, 0xea, 0xe9,
+	0x83, 0x99, 0x65, 0xff, 0x85, 0xbf, 0x1b, 0xe3, 0xfb, 0xea, 0xd9, 0xf4, 0x3f, 0x07, 0x5f, 0xff,
+	0xdd, 0x55, 0xbe, 0x60, 0xef, 0xff, 0x33, 0x00, 0xd2, 0x13, 0x82, 0x20, 0xa7, 0x09, 0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -540,6 +632,29 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposerSelection != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.ProposerSelection))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.DaScheme) > 0 {
+		i -= len(m.DaScheme)
+		copy(dAtA[i:], m.DaScheme)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.DaScheme)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.MinSequencerBond) > 0 {
 		for iNdEx := len(m.MinSequencerBond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -858,6 +973,16 @@ func (m *Rollapp) Size() (n int) {
 			n += 2 + l + sovRollapp(uint64(l))
 		}
 	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 2 + sovRollapp(uint64(m.DisputePeriodInBlocks))
+	}
+	l = len(m.DaScheme)
+	if l > 0 {
+		n += 2 + l + sovRollapp(uint64(l))
+	}
+	if m.ProposerSelection != 0 {
+		n += 2 + sovRollapp(uint64(m.ProposerSelection))
+	}
 	return n
 }
 
@@ -1418,6 +1543,76 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaScheme", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DaScheme = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelection", wireType)
+			}
+			m.ProposerSelection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerSelection |= ProposerSelection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -356,11 +356,121 @@ func (m *BlockHeightToFinalizationQueue) GetRollappId() string {
 	return ""
 }
 
+// ParsedDAPath is the DA path of a state update, parsed by the parser of its
+// scheme
+type ParsedDAPath struct {
+	// scheme is the DA layer of the path, e.g. celestia
+	Scheme string `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// fields are the parsed fields of the path, in order
+	Fields []DAPathField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields"`
+}
+
+func (m *ParsedDAPath) Reset()         { *m = ParsedDAPath{} }
+func (m *ParsedDAPath) String() string { return proto.CompactTextString(m) }
+func (*ParsedDAPath) ProtoMessage()    {}
+func (*ParsedDAPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_750f3a9f16533ec4, []int{4}
+}
+func (m *ParsedDAPath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParsedDAPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParsedDAPath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParsedDAPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParsedDAPath.Merge(m, src)
+}
+func (m *ParsedDAPath) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParsedDAPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParsedDAPath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParsedDAPath proto.InternalMessageInfo
+
+func (m *ParsedDAPath) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
+func (m *ParsedDAPath) GetFields() []DAPathField {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type DAPathField struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *DAPathField) Reset()         { *m = DAPathField{} }
+func (m *DAPathField) String() string { return proto.CompactTextString(m) }
+func (*DAPathField) ProtoMessage()    {}
+func (*DAPathField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_750f3a9f16533ec4, []int{5}
+}
+func (m *DAPathField) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAPathField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DAPathField.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DAPathField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAPathField.Merge(m, src)
+}
+func (m *DAPathField) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAPathField) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAPathField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAPathField proto.InternalMessageInfo
+
+func (m *DAPathField) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DAPathField) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StateInfoIndex)(nil), "dymensionxyz.dymension.rollapp.StateInfoIndex")
 	proto.RegisterType((*StateInfo)(nil), "dymensionxyz.dymension.rollapp.StateInfo")
 	proto.RegisterType((*StateInfoSummary)(nil), "dymensionxyz.dymension.rollapp.StateInfoSummary")
 	proto.RegisterType((*BlockHeightToFinalizationQueue)(nil), "dymensionxyz.dymension.rollapp.BlockHeightToFinalizationQueue")
+	proto.RegisterType((*ParsedDAPath)(nil), "dymensionxyz.dymension.rollapp.ParsedDAPath")
+	proto.RegisterType((*DAPathField)(nil), "dymensionxyz.dymension.rollapp.DAPathField")
 }
 
 func init() {
@@ -368,44 +478,48 @@ func init() {
 }
 
 var fileDescriptor_750f3a9f16533ec4 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6a, 0xdb, 0x4a,
-	0x14, 0xf5, 0xd8, 0x8e, 0x13, 0x8d, 0x1f, 0x26, 0x19, 0xc2, 0x43, 0x98, 0x46, 0x36, 0x82, 0x16,
-	0xd3, 0x85, 0x54, 0x92, 0x76, 0x53, 0xe8, 0x22, 0xc6, 0x94, 0xb8, 0x8b, 0x92, 0x2a, 0x59, 0x94,
-	0x52, 0x30, 0xb2, 0x35, 0x96, 0x87, 0x4a, 0x33, 0xaa, 0x66, 0x54, 0xec, 0x7c, 0x45, 0x16, 0xfd,
-	0xa8, 0x40, 0x37, 0xd9, 0xb5, 0xab, 0xb4, 0xd8, 0x7f, 0xd0, 0x2f, 0x28, 0x1a, 0x4d, 0x2c, 0xdb,
-	0xb1, 0x1b, 0x08, 0x74, 0xe7, 0x7b, 0x7c, 0xcf, 0xe1, 0xdc, 0x73, 0xaf, 0x06, 0xda, 0xde, 0x24,
-	0xc4, 0x94, 0x13, 0x46, 0xc7, 0x93, 0x8b, 0xbc, 0xb0, 0x63, 0x16, 0x04, 0x6e, 0x14, 0xd9, 0x5c,
-	0xb8, 0x02, 0xf7, 0x08, 0x1d, 0x32, 0x2b, 0x8a, 0x99, 0x60, 0xc8, 0x58, 0x24, 0x58, 0xf3, 0xc2,
-	0x52, 0x84, 0xfa, 0xbe, 0xcf, 0x7c, 0x26, 0x5b, 0xed, 0xf4, 0x57, 0xc6, 0xaa, 0x37, 0x7c, 0xc6,
-	0xfc, 0x00, 0xdb, 0xb2, 0xea, 0x27, 0x43, 0x5b, 0x90, 0x10, 0x73, 0xe1, 0x86, 0x91, 0x6a, 0x78,
-	0x71, 0x8f, 0x8f, 0x7e, 0xc0, 0x06, 0x9f, 0x7a, 0x1e, 0xe6, 0x83, 0x98, 0x44, 0x82, 0xc5, 0x8a,
-	0xf6, 0x74, 0x03, 0x6d, 0xc0, 0xc2, 0x90, 0x51, 0xe9, 0x3e, 0xe1, 0x59, 0xaf, 0xd9, 0x81, 0xb5,
-	0xb3, 0x74, 0x9a, 0x2e, 0x1d, 0xb2, 0x2e, 0xf5, 0xf0, 0x18, 0x3d, 0x82, 0x9a, 0xd2, 0xef, 0x7a,
-	0x3a, 0x68, 0x82, 0x96, 0xe6, 0xe4, 0x00, 0xda, 0x87, 0x5b, 0x24, 0x6d, 0xd3, 0x8b, 0x4d, 0xd0,
-	0x2a, 0x3b, 0x59, 0x61, 0x7e, 0x2d, 0x43, 0x6d, 0x2e, 0x83, 0x3e, 0xc2, 0x1a, 0x5f, 0xd2, 0x94,
-	0x32, 0xd5, 0x43, 0xcb, 0xfa, 0x7b, 0x4c, 0xd6, 0xb2, 0x93, 0x76, 0xf9, 0xea, 0xa6, 0x51, 0x70,
-	0x56, 0xb4, 0x52, 0x7f, 0x1c, 0x7f, 0x4e, 0x30, 0x1d, 0xe0, 0x58, 0xba, 0xd0, 0x9c, 0x1c, 0x40,
-	0x4d, 0x58, 0xe5, 0xc2, 0x8d, 0xc5, 0x09, 0x26, 0xfe, 0x48, 0xe8, 0x25, 0xe9, 0x72, 0x11, 0x4a,
-	0xf9, 0x34, 0x09, 0xdb, 0x69, 0x74, 0x5c, 0x2f, 0xcb, 0xff, 0x73, 0x00, 0xfd, 0x0f, 0x2b, 0x9d,
-	0xe3, 0x53, 0x57, 0x8c, 0xf4, 0x2d, 0x29, 0xad, 0x2a, 0xf4, 0x04, 0xd6, 0x06, 0x31, 0x76, 0x05,
-	0x61, 0x54, 0x49, 0x6f, 0x4b, 0xea, 0x0a, 0x8a, 0x5e, 0xc1, 0x4a, 0x96, 0xaf, 0xbe, 0xd3, 0x04,
-	0xad, 0xda, 0xe1, 0xe3, 0x4d, 0x33, 0x67, 0xcb, 0x90, 0x23, 0x27, 0xdc, 0x51, 0x24, 0x74, 0x02,
-	0x4b, 0xed, 0x0e, 0xd7, 0x35, 0x99, 0xd7, 0xb3, 0xfb, 0xf2, 0x92, 0x9e, 0x3b, 0xf3, 0xf5, 0x73,
-	0x95, 0x58, 0x2a, 0x81, 0xde, 0x43, 0x28, 0xad, 0x61, 0xaf, 0xe7, 0x0a, 0x1d, 0x4a, 0xc1, 0xba,
-	0x95, 0x5d, 0x9c, 0x75, 0x7b, 0x71, 0xd6, 0xf9, 0xed, 0xc5, 0xb5, 0x0f, 0x52, 0xea, 0xef, 0x9b,
-	0xc6, 0xde, 0xc4, 0x0d, 0x83, 0x97, 0x66, 0xce, 0x35, 0x2f, 0x7f, 0x36, 0x80, 0xa3, 0x29, 0xe0,
-	0x58, 0x20, 0x13, 0xfe, 0x47, 0xf1, 0x58, 0x9c, 0xc6, 0x2c, 0x62, 0x1c, 0xc7, 0x7a, 0x55, 0x06,
-	0xb5, 0x84, 0xbd, 0x29, 0xef, 0x54, 0x76, 0xb7, 0xcd, 0xef, 0x00, 0xee, 0xce, 0x77, 0x7a, 0x96,
-	0x84, 0xa1, 0x1b, 0x4f, 0xfe, 0xf1, 0x75, 0xe4, 0xf9, 0x17, 0x1f, 0x92, 0xff, 0xdd, 0x35, 0x97,
-	0xd6, 0xad, 0xd9, 0xfc, 0x06, 0xa0, 0x21, 0xd3, 0xcf, 0xea, 0x73, 0xf6, 0x9a, 0x50, 0x37, 0x20,
-	0x17, 0xb2, 0xe7, 0x5d, 0x82, 0x13, 0xbc, 0x46, 0x0a, 0xac, 0xbd, 0x98, 0x3e, 0xdc, 0x1b, 0xae,
-	0x92, 0xf5, 0x62, 0xb3, 0xf4, 0xe0, 0x48, 0xee, 0xca, 0xa1, 0x03, 0x08, 0x15, 0xa5, 0x47, 0x3c,
-	0x39, 0xd2, 0xe2, 0x47, 0xdd, 0x7e, 0x7b, 0x35, 0x35, 0xc0, 0xf5, 0xd4, 0x00, 0xbf, 0xa6, 0x06,
-	0xb8, 0x9c, 0x19, 0x85, 0xeb, 0x99, 0x51, 0xf8, 0x31, 0x33, 0x0a, 0x1f, 0x9e, 0xfb, 0x44, 0x8c,
-	0x92, 0x7e, 0x9a, 0xd6, 0xa6, 0x47, 0xf1, 0xcb, 0x91, 0x3d, 0x9e, 0xbf, 0x48, 0x62, 0x12, 0x61,
-	0xde, 0xaf, 0xc8, 0xfb, 0x3a, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x18, 0x9c, 0xca, 0x48,
-	0x05, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x5f, 0x4b, 0x1b, 0x4f,
+	0x14, 0xcd, 0x9a, 0x18, 0xcd, 0x8d, 0x04, 0x1d, 0x44, 0x16, 0xf9, 0xb9, 0x09, 0x0b, 0xbf, 0x22,
+	0x2d, 0xec, 0x16, 0xad, 0x2f, 0x85, 0x3e, 0x18, 0x82, 0x98, 0x3e, 0x14, 0x1b, 0x7d, 0x28, 0xa5,
+	0x10, 0x26, 0xd9, 0xc9, 0x66, 0x70, 0x77, 0x67, 0xdd, 0x99, 0x95, 0xac, 0x9f, 0xc2, 0x87, 0x7e,
+	0x28, 0xa1, 0x2f, 0xbe, 0xb5, 0x4f, 0xb6, 0xe8, 0x37, 0xe8, 0x27, 0x28, 0x33, 0x3b, 0x66, 0xfd,
+	0x5b, 0x41, 0xe8, 0x5b, 0xee, 0xd9, 0x7b, 0x4e, 0xce, 0x9c, 0x7b, 0x67, 0xc0, 0xf5, 0xb2, 0x90,
+	0x44, 0x9c, 0xb2, 0x68, 0x92, 0x9d, 0x14, 0x85, 0x9b, 0xb0, 0x20, 0xc0, 0x71, 0xec, 0x72, 0x81,
+	0x05, 0xe9, 0xd3, 0x68, 0xc4, 0x9c, 0x38, 0x61, 0x82, 0x21, 0xeb, 0x26, 0xc1, 0x99, 0x16, 0x8e,
+	0x26, 0xac, 0x2e, 0xfb, 0xcc, 0x67, 0xaa, 0xd5, 0x95, 0xbf, 0x72, 0xd6, 0x6a, 0xd3, 0x67, 0xcc,
+	0x0f, 0x88, 0xab, 0xaa, 0x41, 0x3a, 0x72, 0x05, 0x0d, 0x09, 0x17, 0x38, 0x8c, 0x75, 0xc3, 0xd6,
+	0x13, 0x3e, 0x06, 0x01, 0x1b, 0x1e, 0xf6, 0x3d, 0xc2, 0x87, 0x09, 0x8d, 0x05, 0x4b, 0x34, 0xed,
+	0xe5, 0x23, 0xb4, 0x21, 0x0b, 0x43, 0x16, 0x29, 0xf7, 0x29, 0xcf, 0x7b, 0xed, 0x0e, 0x34, 0xf6,
+	0xe5, 0x69, 0xba, 0xd1, 0x88, 0x75, 0x23, 0x8f, 0x4c, 0xd0, 0x7f, 0x50, 0xd3, 0xfa, 0x5d, 0xcf,
+	0x34, 0x5a, 0xc6, 0x7a, 0xad, 0x57, 0x00, 0x68, 0x19, 0x66, 0xa9, 0x6c, 0x33, 0x67, 0x5a, 0xc6,
+	0x7a, 0xa5, 0x97, 0x17, 0xf6, 0xd7, 0x0a, 0xd4, 0xa6, 0x32, 0xe8, 0x0b, 0x34, 0xf8, 0x2d, 0x4d,
+	0x25, 0x53, 0xdf, 0x70, 0x9c, 0xbf, 0xc7, 0xe4, 0xdc, 0x76, 0xd2, 0xae, 0x9c, 0x5d, 0x34, 0x4b,
+	0xbd, 0x06, 0xbf, 0xe7, 0x8f, 0x93, 0xa3, 0x94, 0x44, 0x43, 0x92, 0x28, 0x17, 0xb5, 0x5e, 0x01,
+	0xa0, 0x16, 0xd4, 0xb9, 0xc0, 0x89, 0xd8, 0x25, 0xd4, 0x1f, 0x0b, 0xb3, 0xac, 0x5c, 0xde, 0x84,
+	0x24, 0x3f, 0x4a, 0xc3, 0xb6, 0x8c, 0x8e, 0x9b, 0x15, 0xf5, 0xbd, 0x00, 0xd0, 0x0a, 0x54, 0x3b,
+	0xdb, 0x7b, 0x58, 0x8c, 0xcd, 0x59, 0x25, 0xad, 0x2b, 0xf4, 0x02, 0x1a, 0xc3, 0x84, 0x60, 0x41,
+	0x59, 0xa4, 0xa5, 0xe7, 0x14, 0xf5, 0x0e, 0x8a, 0xde, 0x41, 0x35, 0xcf, 0xd7, 0x9c, 0x6f, 0x19,
+	0xeb, 0x8d, 0x8d, 0xff, 0x1f, 0x3b, 0x73, 0x3e, 0x0c, 0x75, 0xe4, 0x94, 0xf7, 0x34, 0x09, 0xed,
+	0x42, 0xb9, 0xdd, 0xe1, 0x66, 0x4d, 0xe5, 0xf5, 0xfa, 0xa9, 0xbc, 0x94, 0xe7, 0xce, 0x74, 0xfc,
+	0x5c, 0x27, 0x26, 0x25, 0xd0, 0x27, 0x00, 0x65, 0x8d, 0x78, 0x7d, 0x2c, 0x4c, 0x50, 0x82, 0xab,
+	0x4e, 0xbe, 0x71, 0xce, 0xf5, 0xc6, 0x39, 0x07, 0xd7, 0x1b, 0xd7, 0x5e, 0x93, 0xd4, 0xdf, 0x17,
+	0xcd, 0xa5, 0x0c, 0x87, 0xc1, 0x5b, 0xbb, 0xe0, 0xda, 0xa7, 0x3f, 0x9b, 0x46, 0xaf, 0xa6, 0x81,
+	0x6d, 0x81, 0x6c, 0x58, 0x88, 0xc8, 0x44, 0xec, 0x25, 0x2c, 0x66, 0x9c, 0x24, 0x66, 0x5d, 0x05,
+	0x75, 0x0b, 0x7b, 0x5f, 0x99, 0xaf, 0x2e, 0xce, 0xd9, 0xdf, 0x0d, 0x58, 0x9c, 0xce, 0x74, 0x3f,
+	0x0d, 0x43, 0x9c, 0x64, 0xff, 0x78, 0x3b, 0x8a, 0xfc, 0x67, 0x9e, 0x93, 0xff, 0xfd, 0x31, 0x97,
+	0x1f, 0x1a, 0xb3, 0xfd, 0xcd, 0x00, 0x4b, 0xa5, 0x9f, 0xd7, 0x07, 0x6c, 0x87, 0x46, 0x38, 0xa0,
+	0x27, 0xaa, 0xe7, 0x63, 0x4a, 0x52, 0xf2, 0x80, 0x94, 0xf1, 0xe0, 0xc6, 0x0c, 0x60, 0x69, 0x74,
+	0x97, 0x6c, 0xce, 0xb4, 0xca, 0xcf, 0x8e, 0xe4, 0xbe, 0x1c, 0x5a, 0x03, 0xd0, 0x94, 0x3e, 0xf5,
+	0xcc, 0xf2, 0x9d, 0x4b, 0x6d, 0x1f, 0xc1, 0xc2, 0x1e, 0x4e, 0x38, 0xf1, 0xf4, 0xb2, 0xaf, 0x40,
+	0x95, 0x0f, 0xc7, 0x24, 0x24, 0xfa, 0xfe, 0xeb, 0x0a, 0x75, 0xa1, 0x3a, 0xa2, 0x24, 0xf0, 0xb8,
+	0xf6, 0xf7, 0xea, 0x29, 0x7f, 0xb9, 0xde, 0x8e, 0xe4, 0x68, 0x73, 0x5a, 0xc0, 0xde, 0x82, 0xfa,
+	0x8d, 0x8f, 0x68, 0x11, 0xca, 0x87, 0x24, 0xd3, 0x7f, 0x27, 0x7f, 0xca, 0x87, 0xe6, 0x18, 0x07,
+	0x2a, 0x0a, 0x89, 0xe5, 0x45, 0xfb, 0xc3, 0xd9, 0xa5, 0x65, 0x9c, 0x5f, 0x5a, 0xc6, 0xaf, 0x4b,
+	0xcb, 0x38, 0xbd, 0xb2, 0x4a, 0xe7, 0x57, 0x56, 0xe9, 0xc7, 0x95, 0x55, 0xfa, 0xfc, 0xc6, 0xa7,
+	0x62, 0x9c, 0x0e, 0xe4, 0x5c, 0x1f, 0x7b, 0xbe, 0x8f, 0x37, 0xdd, 0xc9, 0xf4, 0xed, 0x14, 0x59,
+	0x4c, 0xf8, 0xa0, 0xaa, 0x6e, 0xc2, 0xe6, 0x9f, 0x01, 0x00, 0x06, 0xa2, 0x55, 0xd4, 0xf2, 0x05,
+	0x00, 0x00,
 }
 
 func (m *StateInfoIndex) Marshal() (dAtA []byte, err error) {
@@ -627,6 +741,87 @@ func (m *BlockHeightToFinalizationQueue) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ParsedDAPath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParsedDAPath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParsedDAPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateInfo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Scheme) > 0 {
+		i -= len(m.Scheme)
+		copy(dAtA[i:], m.Scheme)
+		i = encodeVarintStateInfo(dAtA, i, uint64(len(m.Scheme)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DAPathField) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAPathField) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAPathField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintStateInfo(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintStateInfo(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStateInfo(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStateInfo(v)
 	base := offset
@@ -732,6 +927,42 @@ func (m *BlockHeightToFinalizationQueue) Size() (n int) {
 	return n
 }
 
+func (m *ParsedDAPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scheme)
+	if l > 0 {
+		n += 1 + l + sovStateInfo(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovStateInfo(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DAPathField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStateInfo(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovStateInfo(uint64(l))
+	}
+	return n
+}
+
 func sovStateInfo(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1416,6 +1647,236 @@ func (m *BlockHeightToFinalizationQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParsedDAPath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParsedDAPath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParsedDAPath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheme = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, DAPathField{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAPathField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateInfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAPathField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAPathField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateInfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStateInfo(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
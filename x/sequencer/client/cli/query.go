@@ -27,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetProposerByRollapp())
 	cmd.AddCommand(CmdGetNextProposerByRollapp())
 	cmd.AddCommand(CmdGetAllProposers())
+	cmd.AddCommand(CmdShowDelegationPool())
+	cmd.AddCommand(CmdShowDelegation())
+	cmd.AddCommand(CmdShowReputation())
 
 	return cmd
 }
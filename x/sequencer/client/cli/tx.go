@@ -35,6 +35,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDecreaseBond())
 	cmd.AddCommand(CmdKickProposer())
 	cmd.AddCommand(CmdUpdateOptInStatus())
+	cmd.AddCommand(CmdSetDelegationCommission())
+	cmd.AddCommand(CmdDelegate())
+	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdWithdrawDelegationRewards())
 
 	return cmd
 }
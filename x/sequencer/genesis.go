@@ -21,12 +21,48 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 		k.AddToNoticeQueue(ctx, seq)
 	}
 
+	inNotice := make(map[string]bool, len(genState.NoticeQueue))
+	for _, s := range genState.NoticeQueue {
+		inNotice[s] = true
+	}
 	for _, elem := range genState.GenesisProposers {
 		k.SetProposer(ctx, elem.RollappId, elem.Address)
+		// the queue is not exported: a proposer out of the notice queue with a notice is awaiting its last block
+		seq := k.GetSequencer(ctx, elem.Address)
+		if !seq.Sentinel() && seq.NoticeStarted() && !inNotice[elem.Address] {
+			k.AddToAwaitingLastBlockQueue(ctx, seq)
+		}
 	}
 	for _, elem := range genState.GenesisSuccessors {
 		k.SetSuccessor(ctx, elem.RollappId, elem.Address)
 	}
+
+	for _, elem := range genState.DelegationPools {
+		if err := k.SetDelegationPool(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Delegations {
+		if err := k.SetDelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.UnbondingDelegations {
+		if err := k.SetUnbondingDelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, elem := range genState.Reputations {
+		if err := k.SetReputation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.PenaltyLedger {
+		if err := k.SetPenaltyRecord(ctx, elem.Sequencer, elem.Record); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
@@ -58,5 +94,27 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		genesis.NoticeQueue = append(genesis.NoticeQueue, seq.Address)
 	}
 
+	genesis.DelegationPools, err = k.GetAllDelegationPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Delegations, err = k.GetAllDelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.UnbondingDelegations, err = k.GetUnbondingDelegations(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.Reputations, err = k.GetAllReputations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PenaltyLedger, err = k.GetAllPenaltyRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis
 }
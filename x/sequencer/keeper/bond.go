@@ -17,6 +17,7 @@ type UnbondBlocker interface {
 // The sequencer may not be allowed to unbond, based on certain conditions.
 // A partial unbonding refunds tokens, but doesn't allow the remaining bond to fall below a threshold.
 // A total unbond refunds all tokens and changes status to unbonded.
+// Only the operator part of the tokens can be unbonded, the delegated tokens are undelegated by their owners.
 func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
 	if k.IsProposer(ctx, *seq) || k.IsSuccessor(ctx, *seq) {
 		return types.ErrUnbondProposerOrSuccessor
@@ -26,9 +27,12 @@ func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) e
 			return errorsmod.Wrap(err, "other module")
 		}
 	}
-	bond := seq.TokensCoin()
+	bond := k.operatorBond(ctx, *seq)
+	if bond.IsLT(amt) {
+		return errorsmod.Wrapf(types.ErrUnbondNotAllowed, "attempted reduction: %s, operator bond: %s", amt, bond)
+	}
 	minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
-	maxReduction, _ := bond.SafeSub(minBond)
+	maxReduction, _ := seq.TokensCoin().SafeSub(minBond)
 	isPartial := !amt.IsEqual(bond)
 	if isPartial && maxReduction.IsLT(amt) {
 		return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
@@ -39,7 +43,7 @@ func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) e
 	if err := k.refund(ctx, seq, amt); err != nil {
 		return errorsmod.Wrap(err, "refund")
 	}
-	if seq.Tokens.IsZero() {
+	if k.operatorBond(ctx, *seq).IsZero() {
 		k.unbond(ctx, seq)
 	}
 	return nil
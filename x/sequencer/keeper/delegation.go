@@ -0,0 +1,447 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+/*
+Delegators bond tokens behind a sequencer which opened a delegation pool. The delegated tokens are part of the
+sequencer tokens, so they count for the min bond and the proposer choice, and are slashed together with the
+operator's, pro-rata. The pool tracks the delegated part of the tokens, and the delegators own shares of it.
+
+Once open, the sequencer reward address is the pool address. Whatever it receives is split pro-rata to the tokens
+between the operator and the delegators, and the operator takes a commission on the delegators' part.
+Undelegated tokens serve the notice period of the sequencer, during which they are still slashable.
+*/
+
+func (k Keeper) GetDelegationPool(ctx sdk.Context, seqAddr string) (types.DelegationPool, error) {
+	p, err := k.delegationPools.Get(ctx, seqAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return p, errorsmod.Wrapf(types.ErrDelegationPoolNotFound, "sequencer: %s", seqAddr)
+	}
+	return p, err
+}
+
+func (k Keeper) SetDelegationPool(ctx sdk.Context, p types.DelegationPool) error {
+	return k.delegationPools.Set(ctx, p.Sequencer, p)
+}
+
+func (k Keeper) GetAllDelegationPools(ctx sdk.Context) ([]types.DelegationPool, error) {
+	it, err := k.delegationPools.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+func (k Keeper) GetDelegation(ctx sdk.Context, seqAddr, delegator string) (types.Delegation, error) {
+	d, err := k.delegations.Get(ctx, collections.Join(seqAddr, delegator))
+	if errors.Is(err, collections.ErrNotFound) {
+		return d, errorsmod.Wrapf(types.ErrDelegationNotFound, "sequencer: %s: delegator: %s", seqAddr, delegator)
+	}
+	return d, err
+}
+
+// SetDelegation sets the delegation, or removes it if it has no shares left
+func (k Keeper) SetDelegation(ctx sdk.Context, d types.Delegation) error {
+	key := collections.Join(d.Sequencer, d.Delegator)
+	if d.Shares.IsZero() {
+		return k.delegations.Remove(ctx, key)
+	}
+	return k.delegations.Set(ctx, key, d)
+}
+
+func (k Keeper) GetAllDelegations(ctx sdk.Context) ([]types.Delegation, error) {
+	it, err := k.delegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, d types.UnbondingDelegation) error {
+	return k.unbondingDelegations.Set(ctx, collections.Join3(d.CompletionTime, d.Sequencer, d.Delegator), d)
+}
+
+// GetUnbondingDelegations returns the unbonding delegations which complete until endTime (inclusive), or all if nil
+func (k Keeper) GetUnbondingDelegations(ctx sdk.Context, endTime *time.Time) ([]types.UnbondingDelegation, error) {
+	var rng collections.Ranger[collections.Triple[time.Time, string, string]]
+	if endTime != nil {
+		rng = collections.NewPrefixUntilTripleRange[time.Time, string, string](*endTime)
+	}
+	it, err := k.unbondingDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+// operatorBond is the part of the sequencer tokens which is owned by the operator
+func (k Keeper) operatorBond(ctx sdk.Context, seq types.Sequencer) sdk.Coin {
+	p, err := k.delegationPools.Get(ctx, seq.Address)
+	if err != nil {
+		return seq.TokensCoin()
+	}
+	return seq.TokensCoin().SubAmount(p.Tokens)
+}
+
+// SetDelegationCommission opens the sequencer to delegations, or updates the commission.
+// On opening, the reward address of the sequencer becomes the pool address, and the operator part of the rewards
+// goes to the previous reward address.
+// Like the validator commission, an update may change the commission by at most the max change rate, and only once
+// per change period, so delegators have time to react.
+func (k Keeper) SetDelegationCommission(ctx sdk.Context, seq *types.Sequencer, commission math.LegacyDec) error {
+	p, err := k.GetDelegationPool(ctx, seq.Address)
+	switch {
+	case errorsmod.IsOf(err, types.ErrDelegationPoolNotFound):
+		operatorRewardAddr := seq.RewardAddr
+		if operatorRewardAddr == "" {
+			operatorRewardAddr = seq.Address
+		}
+		p = types.NewDelegationPool(seq.Address, commission, operatorRewardAddr)
+		seq.RewardAddr = types.DelegationPoolAddr(seq.Address).String()
+		err = uevent.EmitTypedEvent(ctx, &types.EventUpdateRewardAddress{
+			Creator:    seq.Address,
+			RewardAddr: seq.RewardAddr,
+		})
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		if err := validateCommissionChange(ctx.BlockTime(), k.GetParams(ctx), p, commission); err != nil {
+			return err
+		}
+		// the rewards received so far are split with the previous commission
+		if err := k.sweepDelegationRewards(ctx, *seq, &p); err != nil {
+			return errorsmod.Wrap(err, "sweep rewards")
+		}
+		p.Commission = commission
+	}
+	p.CommissionUpdatedAt = ctx.BlockTime()
+
+	if err := k.SetDelegationPool(ctx, p); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDelegationCommissionUpdated{
+		Sequencer:  seq.Address,
+		Commission: commission,
+	})
+}
+
+// validateCommissionChange checks the update of the pool commission against the change rate and period params
+func validateCommissionChange(now time.Time, params types.Params, p types.DelegationPool, commission math.LegacyDec) error {
+	if next := p.CommissionUpdatedAt.Add(params.CommissionChangePeriod); now.Before(next) {
+		return errorsmod.Wrapf(types.ErrInvalidCommission, "updated too soon: next update at: %s", next)
+	}
+	if change := commission.Sub(p.Commission).Abs(); change.GT(params.CommissionMaxChangeRate) {
+		return errorsmod.Wrapf(types.ErrInvalidCommission, "change exceeds max change rate: change: %s: max: %s",
+			change, params.CommissionMaxChangeRate)
+	}
+	return nil
+}
+
+// Delegate bonds the amount of the delegator behind the sequencer
+func (k Keeper) Delegate(ctx sdk.Context, delegator sdk.AccAddress, seq *types.Sequencer, amt sdk.Coin) error {
+	if !seq.Bonded() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "sequencer is not bonded")
+	}
+	if err := validBondDenom(amt); err != nil {
+		return err
+	}
+	p, err := k.GetDelegationPool(ctx, seq.Address)
+	if err != nil {
+		return err
+	}
+	if err := k.sweepDelegationRewards(ctx, *seq, &p); err != nil {
+		return errorsmod.Wrap(err, "sweep rewards")
+	}
+	shares, err := p.SharesFor(amt.Amount)
+	if err != nil {
+		return err
+	}
+
+	d, err := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if errorsmod.IsOf(err, types.ErrDelegationNotFound) {
+		d = types.Delegation{
+			Delegator:      delegator.String(),
+			Sequencer:      seq.Address,
+			Shares:         math.LegacyZeroDec(),
+			RewardPerShare: p.RewardPerShare,
+		}
+	} else if err != nil {
+		return err
+	}
+	// the existing shares are settled before the new ones start accruing
+	if _, err := k.withdrawDelegationRewards(ctx, &p, delegator, &d.RewardPerShare, d.Shares); err != nil {
+		return errorsmod.Wrap(err, "withdraw rewards")
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.ModuleName, sdk.NewCoins(amt))
+	if err != nil {
+		return err
+	}
+	seq.SetTokensCoin(seq.TokensCoin().Add(amt))
+	p.Tokens = p.Tokens.Add(amt.Amount)
+	p.Shares = p.Shares.Add(shares)
+	d.Shares = d.Shares.Add(shares)
+
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return err
+	}
+	if err := k.SetDelegationPool(ctx, p); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDelegated{
+		Delegator: d.Delegator,
+		Sequencer: d.Sequencer,
+		Amount:    amt,
+		Shares:    shares,
+	})
+}
+
+// Undelegate moves the shares worth the amount to an unbonding delegation, which completes after the notice period.
+// It returns the completion time.
+func (k Keeper) Undelegate(ctx sdk.Context, delegator sdk.AccAddress, seq types.Sequencer, amt sdk.Coin) (time.Time, error) {
+	if err := validBondDenom(amt); err != nil {
+		return time.Time{}, err
+	}
+	p, err := k.GetDelegationPool(ctx, seq.Address)
+	if err != nil {
+		return time.Time{}, err
+	}
+	d, err := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if err != nil {
+		return time.Time{}, err
+	}
+	if value := p.TokensFor(d.Shares); value.LT(amt.Amount) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInsufficientDelegation, "delegated: %s: requested: %s", value, amt.Amount)
+	}
+	shares := p.Shares.MulInt(amt.Amount).QuoInt(p.Tokens)
+	if d.Shares.LT(shares) {
+		// rounding
+		shares = d.Shares
+	}
+
+	if err := k.sweepDelegationRewards(ctx, seq, &p); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "sweep rewards")
+	}
+	if _, err := k.withdrawDelegationRewards(ctx, &p, delegator, &d.RewardPerShare, d.Shares); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "withdraw rewards")
+	}
+	d.Shares = d.Shares.Sub(shares)
+
+	completion := ctx.BlockTime().Add(k.GetParams(ctx).NoticePeriod)
+	u, err := k.unbondingDelegations.Get(ctx, collections.Join3(completion, seq.Address, d.Delegator))
+	if errors.Is(err, collections.ErrNotFound) {
+		u = types.UnbondingDelegation{
+			Delegator:      d.Delegator,
+			Sequencer:      seq.Address,
+			Shares:         math.LegacyZeroDec(),
+			RewardPerShare: p.RewardPerShare,
+			CompletionTime: completion,
+		}
+	} else if err != nil {
+		return time.Time{}, err
+	}
+	if _, err := k.withdrawDelegationRewards(ctx, &p, delegator, &u.RewardPerShare, u.Shares); err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "withdraw rewards")
+	}
+	u.Shares = u.Shares.Add(shares)
+
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return time.Time{}, err
+	}
+	if err := k.SetUnbondingDelegation(ctx, u); err != nil {
+		return time.Time{}, err
+	}
+	if err := k.SetDelegationPool(ctx, p); err != nil {
+		return time.Time{}, err
+	}
+	return completion, uevent.EmitTypedEvent(ctx, &types.EventUndelegated{
+		Delegator:      d.Delegator,
+		Sequencer:      seq.Address,
+		Amount:         amt,
+		CompletionTime: completion,
+	})
+}
+
+// CompleteUnbondingDelegations refunds the unbonding delegations which completed by now, at the value of their
+// shares after any slashing, together with their pending rewards.
+func (k Keeper) CompleteUnbondingDelegations(ctx sdk.Context, now time.Time) error {
+	matured, err := k.GetUnbondingDelegations(ctx, &now)
+	if err != nil {
+		return errorsmod.Wrap(err, "get unbonding delegations")
+	}
+	for _, u := range matured {
+		if err := k.completeUnbondingDelegation(ctx, u); err != nil {
+			return errorsmod.Wrapf(err, "sequencer: %s: delegator: %s", u.Sequencer, u.Delegator)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) completeUnbondingDelegation(ctx sdk.Context, u types.UnbondingDelegation) error {
+	seq := k.GetSequencer(ctx, u.Sequencer)
+	p, err := k.GetDelegationPool(ctx, u.Sequencer)
+	if err != nil {
+		return err
+	}
+	delegator, err := sdk.AccAddressFromBech32(u.Delegator)
+	if err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "delegator")
+	}
+
+	if err := k.sweepDelegationRewards(ctx, seq, &p); err != nil {
+		return errorsmod.Wrap(err, "sweep rewards")
+	}
+	if _, err := k.withdrawDelegationRewards(ctx, &p, delegator, &u.RewardPerShare, u.Shares); err != nil {
+		return errorsmod.Wrap(err, "withdraw rewards")
+	}
+
+	tokens := p.TokensFor(u.Shares)
+	if u.Shares.Equal(p.Shares) {
+		// the last shares take the rounding dust
+		tokens = p.Tokens
+	}
+	p.Tokens = p.Tokens.Sub(tokens)
+	p.Shares = p.Shares.Sub(u.Shares)
+
+	amt := sdk.NewCoin(seq.TokensCoin().Denom, tokens)
+	if tokens.IsPositive() {
+		if err := k.sendFromModule(ctx, &seq, amt, delegator); err != nil {
+			return errorsmod.Wrap(err, "refund")
+		}
+		k.SetSequencer(ctx, seq)
+	}
+
+	if err := k.unbondingDelegations.Remove(ctx, collections.Join3(u.CompletionTime, u.Sequencer, u.Delegator)); err != nil {
+		return err
+	}
+	if err := k.SetDelegationPool(ctx, p); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventUnbondingDelegationCompleted{
+		Delegator: u.Delegator,
+		Sequencer: u.Sequencer,
+		Amount:    amt,
+	})
+}
+
+// WithdrawDelegationRewards pays the pending rewards of the delegation
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delegator sdk.AccAddress, seq types.Sequencer) (sdk.Coins, error) {
+	p, err := k.GetDelegationPool(ctx, seq.Address)
+	if err != nil {
+		return nil, err
+	}
+	d, err := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if err != nil {
+		return nil, err
+	}
+	if err := k.sweepDelegationRewards(ctx, seq, &p); err != nil {
+		return nil, errorsmod.Wrap(err, "sweep rewards")
+	}
+	rewards, err := k.withdrawDelegationRewards(ctx, &p, delegator, &d.RewardPerShare, d.Shares)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return nil, err
+	}
+	if err := k.SetDelegationPool(ctx, p); err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+// sweepDelegationRewards splits the rewards received by the pool address since the last sweep. The operator part
+// is sent to the operator reward address, and the delegators part is accounted in the reward per share.
+// The delegators part is pro-rata to the delegated tokens, minus the commission.
+func (k Keeper) sweepDelegationRewards(ctx sdk.Context, seq types.Sequencer, p *types.DelegationPool) error {
+	poolAddr := types.DelegationPoolAddr(p.Sequencer)
+	balance := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, poolAddr)...)
+	received, neg := balance.SafeSub(p.OutstandingRewards)
+	if neg {
+		return errorsmod.Wrap(gerrc.ErrInternal, "pool balance below outstanding rewards")
+	}
+	if received.IsZero() {
+		return nil
+	}
+
+	var delegatorsPart sdk.DecCoins
+	bond := seq.TokensCoin().Amount
+	if p.Shares.IsPositive() && p.Tokens.IsPositive() && bond.IsPositive() {
+		frac := math.LegacyNewDecFromInt(p.Tokens).QuoInt(bond).Mul(math.LegacyOneDec().Sub(p.Commission))
+		delegatorsPart = received.MulDecTruncate(frac)
+		p.AddRewards(delegatorsPart)
+	}
+
+	// the fractions stay in the pool for the next sweep
+	operatorPart, _ := received.Sub(delegatorsPart).TruncateDecimal()
+	if operatorPart.IsZero() {
+		return nil
+	}
+	operator, err := sdk.AccAddressFromBech32(p.OperatorRewardAddr)
+	if err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "operator reward addr")
+	}
+	return k.bankKeeper.SendCoins(ctx, poolAddr, operator, operatorPart)
+}
+
+// withdrawDelegationRewards pays the rewards accrued by the shares since snapshot, and resets the snapshot.
+// The pool must be swept before.
+func (k Keeper) withdrawDelegationRewards(
+	ctx sdk.Context,
+	p *types.DelegationPool,
+	delegator sdk.AccAddress,
+	snapshot *sdk.DecCoins,
+	shares math.LegacyDec,
+) (sdk.Coins, error) {
+	rewards := p.PendingRewards(*snapshot, shares)
+	*snapshot = p.RewardPerShare
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+	p.OutstandingRewards = p.OutstandingRewards.Sub(sdk.NewDecCoinsFromCoins(rewards...))
+	if err := k.bankKeeper.SendCoins(ctx, types.DelegationPoolAddr(p.Sequencer), delegator, rewards); err != nil {
+		return nil, errorsmod.Wrap(err, "send")
+	}
+	return rewards, uevent.EmitTypedEvent(ctx, &types.EventDelegationRewardsWithdrawn{
+		Delegator: delegator.String(),
+		Sequencer: p.Sequencer,
+		Rewards:   rewards,
+	})
+}
+
+// slashDelegationPool reduces the delegated tokens pro-rata, before the sequencer tokens are reduced by amt.
+// The rewards received so far are split before, with the tokens they were earned by.
+func (k Keeper) slashDelegationPool(ctx sdk.Context, seq types.Sequencer, amt sdk.Coin) error {
+	p, err := k.delegationPools.Get(ctx, seq.Address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.sweepDelegationRewards(ctx, seq, &p); err != nil {
+		return errorsmod.Wrap(err, "sweep rewards")
+	}
+	bond := seq.TokensCoin().Amount
+	if !bond.IsPositive() || !p.Tokens.IsPositive() {
+		return nil
+	}
+	cut := amt.Amount.Mul(p.Tokens).Quo(bond)
+	p.Tokens = p.Tokens.Sub(math.MinInt(cut, p.Tokens))
+	return k.SetDelegationPool(ctx, p)
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) fundDelegator(amt sdk.Coin) sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	err := bankutil.FundAccount(s.Ctx, s.App.BankKeeper, addr, sdk.NewCoins(amt))
+	s.Require().NoError(err)
+	return addr
+}
+
+func (s *SequencerTestSuite) openDelegations(seq types.Sequencer, commission string) {
+	_, err := s.msgServer.SetDelegationCommission(s.Ctx, &types.MsgSetDelegationCommission{
+		Creator:    seq.Address,
+		Commission: math.LegacyMustNewDecFromStr(commission),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *SequencerTestSuite) delegate(delegator sdk.AccAddress, seq types.Sequencer, amt sdk.Coin) error {
+	_, err := s.msgServer.Delegate(s.Ctx, &types.MsgDelegate{
+		Delegator: delegator.String(),
+		Sequencer: seq.Address,
+		Amount:    amt,
+	})
+	return err
+}
+
+func (s *SequencerTestSuite) pool(seq types.Sequencer) types.DelegationPool {
+	p, err := s.k().GetDelegationPool(s.Ctx, seq.Address)
+	s.Require().NoError(err)
+	return p
+}
+
+func (s *SequencerTestSuite) requireInvariants() {
+	msg, broken := keeper.AllInvariants(*s.k())(s.Ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *SequencerTestSuite) TestDelegate() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	seq := s.seq(bob)
+	delegator := s.fundDelegator(bond)
+
+	s.Run("not open", func() {
+		err := s.delegate(delegator, seq, bond)
+		s.Require().ErrorIs(err, types.ErrDelegationPoolNotFound)
+	})
+
+	s.openDelegations(seq, "0.1")
+	seq = s.seq(bob)
+	s.Require().Equal(types.DelegationPoolAddr(seq.Address).String(), seq.RewardAddr)
+	s.Require().Equal(seq.Address, s.pool(seq).OperatorRewardAddr)
+
+	s.Run("delegate", func() {
+		err := s.delegate(delegator, seq, bond)
+		s.Require().NoError(err)
+		seq = s.seq(bob)
+		s.Require().True(seq.TokensCoin().IsEqual(ucoin.SimpleMul(bond, 2)))
+		s.Require().True(s.pool(seq).Tokens.Equal(bond.Amount))
+		d, err := s.k().GetDelegation(s.Ctx, seq.Address, delegator.String())
+		s.Require().NoError(err)
+		s.Require().True(d.Shares.Equal(math.LegacyNewDecFromInt(bond.Amount)))
+		s.requireInvariants()
+	})
+
+	s.Run("operator cannot unbond the delegated tokens", func() {
+		_, err := s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{
+			Creator:        seq.Address,
+			DecreaseAmount: ucoin.SimpleMul(bond, 2),
+		})
+		s.Require().ErrorIs(err, types.ErrUnbondNotAllowed)
+	})
+
+	s.Run("operator keeps the min bond with the delegated tokens", func() {
+		half := bond.SubAmount(bond.Amount.QuoRaw(2))
+		_, err := s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{
+			Creator:        seq.Address,
+			DecreaseAmount: half,
+		})
+		s.Require().NoError(err)
+		seq = s.seq(bob)
+		s.Require().True(seq.Bonded())
+		s.Require().True(seq.TokensCoin().IsEqual(ucoin.SimpleMul(bond, 2).Sub(half)))
+		s.requireInvariants()
+	})
+
+	s.Run("reward address is the pool", func() {
+		operatorRewards := sample.AccAddress()
+		_, err := s.msgServer.UpdateRewardAddress(s.Ctx, &types.MsgUpdateRewardAddress{
+			Creator:    seq.Address,
+			RewardAddr: operatorRewards,
+		})
+		s.Require().NoError(err)
+		seq = s.seq(bob)
+		s.Require().Equal(types.DelegationPoolAddr(seq.Address).String(), seq.RewardAddr)
+		s.Require().Equal(operatorRewards, s.pool(seq).OperatorRewardAddr)
+	})
+}
+
+// the delegated tokens are slashed pro-rata with the operator ones
+func (s *SequencerTestSuite) TestDelegationSlashed() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seq := s.seq(alice)
+	s.Require().True(s.k().IsProposer(s.Ctx, seq))
+	s.openDelegations(seq, "0")
+	delegator := s.fundDelegator(bond)
+	s.Require().NoError(s.delegate(delegator, seq, bond))
+
+	s.Run("liveness", func() {
+		err := s.k().SlashLiveness(s.Ctx, ra.RollappId)
+		s.Require().NoError(err)
+		seq = s.seq(alice)
+		p := s.pool(seq)
+		s.Require().True(p.Tokens.LT(bond.Amount))
+		// half of the bond is delegated
+		diff := seq.TokensCoin().Amount.Sub(p.Tokens.MulRaw(2)).Abs()
+		s.Require().True(diff.LTE(math.OneInt()), diff)
+		s.requireInvariants()
+	})
+
+	s.Run("fraud", func() {
+		err := s.k().PunishSequencer(s.Ctx, seq.Address, nil)
+		s.Require().NoError(err)
+		seq = s.seq(alice)
+		s.Require().True(seq.TokensCoin().IsZero())
+		s.Require().True(s.pool(seq).Tokens.IsZero())
+		res, err := s.k().Delegation(s.Ctx, &types.QueryDelegationRequest{
+			Sequencer: seq.Address,
+			Delegator: delegator.String(),
+		})
+		s.Require().NoError(err)
+		s.Require().True(res.Tokens.IsZero())
+		s.requireInvariants()
+	})
+}
+
+func (s *SequencerTestSuite) TestUndelegate() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seq := s.seq(alice)
+	s.openDelegations(seq, "0")
+	delegator := s.fundDelegator(bond)
+	s.Require().NoError(s.delegate(delegator, seq, bond))
+
+	s.Run("more than delegated", func() {
+		_, err := s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{
+			Delegator: delegator.String(),
+			Sequencer: seq.Address,
+			Amount:    ucoin.SimpleMul(bond, 2),
+		})
+		s.Require().ErrorIs(err, types.ErrInsufficientDelegation)
+	})
+
+	half := bond.SubAmount(bond.Amount.QuoRaw(2))
+	res, err := s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{
+		Delegator: delegator.String(),
+		Sequencer: seq.Address,
+		Amount:    half,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime().Add(s.k().GetParams(s.Ctx).NoticePeriod), res.CompletionTime)
+
+	// still bonded, and slashable, during the notice
+	err = s.k().CompleteUnbondingDelegations(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	seq = s.seq(alice)
+	s.Require().True(seq.TokensCoin().IsEqual(ucoin.SimpleMul(bond, 2)))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom).IsZero())
+
+	s.Ctx = s.Ctx.WithBlockTime(res.CompletionTime)
+	err = s.k().CompleteUnbondingDelegations(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	seq = s.seq(alice)
+	s.Require().True(seq.TokensCoin().IsEqual(ucoin.SimpleMul(bond, 2).Sub(half)))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom).IsEqual(half))
+	s.Require().True(s.pool(seq).Tokens.Equal(bond.Amount.Sub(half.Amount)))
+	ubds, err := s.k().GetUnbondingDelegations(s.Ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(ubds)
+	s.requireInvariants()
+}
+
+// the rewards sent to the reward address are split with the delegators, minus the commission
+func (s *SequencerTestSuite) TestDelegationRewards() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seq := s.seq(alice)
+	s.openDelegations(seq, "0.1")
+	d1 := s.fundDelegator(bond)
+	d2 := s.fundDelegator(bond)
+	half := bond.SubAmount(bond.Amount.QuoRaw(2))
+	s.Require().NoError(s.delegate(d1, seq, half))
+	s.Require().NoError(s.delegate(d2, seq, half))
+	seq = s.seq(alice)
+
+	reward := sdk.NewInt64Coin("urew", 1000)
+	err := bankutil.FundAccount(s.Ctx, s.App.BankKeeper, sdk.MustAccAddressFromBech32(seq.RewardAddr), sdk.NewCoins(reward))
+	s.Require().NoError(err)
+
+	// half of the bond is delegated: 1000 * 0.5 * 0.9 to the delegators
+	res, err := s.msgServer.WithdrawDelegationRewards(s.Ctx, &types.MsgWithdrawDelegationRewards{
+		Delegator: d1.String(),
+		Sequencer: seq.Address,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("urew", 225)), res.Rewards)
+	s.Require().Equal(int64(550), s.App.BankKeeper.GetBalance(s.Ctx, seq.AccAddr(), "urew").Amount.Int64())
+
+	q, err := s.k().Delegation(s.Ctx, &types.QueryDelegationRequest{
+		Sequencer: seq.Address,
+		Delegator: d2.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("urew", 225)), q.PendingRewards)
+	s.Require().True(q.Tokens.Equal(half))
+
+	// nothing new
+	res, err = s.msgServer.WithdrawDelegationRewards(s.Ctx, &types.MsgWithdrawDelegationRewards{
+		Delegator: d1.String(),
+		Sequencer: seq.Address,
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.Rewards.IsZero())
+	s.requireInvariants()
+}
+
+// the rewards received before a slash are split with the tokens they were earned by
+func (s *SequencerTestSuite) TestDelegationRewardsBeforeSlash() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seq := s.seq(alice)
+	s.openDelegations(seq, "0")
+	delegator := s.fundDelegator(bond)
+	s.Require().NoError(s.delegate(delegator, seq, bond))
+	seq = s.seq(alice)
+
+	reward := sdk.NewInt64Coin("urew", 1000)
+	err := bankutil.FundAccount(s.Ctx, s.App.BankKeeper, sdk.MustAccAddressFromBech32(seq.RewardAddr), sdk.NewCoins(reward))
+	s.Require().NoError(err)
+
+	err = s.k().PunishSequencer(s.Ctx, seq.Address, nil)
+	s.Require().NoError(err)
+
+	// half of the bond was delegated
+	q, err := s.k().Delegation(s.Ctx, &types.QueryDelegationRequest{
+		Sequencer: seq.Address,
+		Delegator: delegator.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("urew", 500)), q.PendingRewards)
+	s.Require().Equal(int64(500), s.App.BankKeeper.GetBalance(s.Ctx, seq.AccAddr(), "urew").Amount.Int64())
+	s.requireInvariants()
+}
+
+func (s *SequencerTestSuite) TestDelegationCommissionChange() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seq := s.seq(alice)
+	params := s.k().GetParams(s.Ctx)
+	s.openDelegations(seq, "0.5")
+
+	set := func(commission string) error {
+		_, err := s.msgServer.SetDelegationCommission(s.Ctx, &types.MsgSetDelegationCommission{
+			Creator:    seq.Address,
+			Commission: math.LegacyMustNewDecFromStr(commission),
+		})
+		return err
+	}
+
+	s.Run("too soon", func() {
+		err := set("0.5")
+		s.Require().ErrorIs(err, types.ErrInvalidCommission)
+	})
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(params.CommissionChangePeriod))
+
+	s.Run("above max change rate", func() {
+		above := math.LegacyMustNewDecFromStr("0.5").Add(params.CommissionMaxChangeRate).Add(math.LegacySmallestDec())
+		err := set(above.String())
+		s.Require().ErrorIs(err, types.ErrInvalidCommission)
+	})
+
+	s.Run("within max change rate", func() {
+		down := math.LegacyMustNewDecFromStr("0.5").Sub(params.CommissionMaxChangeRate)
+		s.Require().NoError(set(down.String()))
+		s.Require().True(s.pool(seq).Commission.Equal(down))
+		s.Require().Equal(s.Ctx.BlockTime(), s.pool(seq).CommissionUpdatedAt)
+	})
+}
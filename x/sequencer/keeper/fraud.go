@@ -25,6 +25,10 @@ func (k Keeper) TryKickProposer(ctx sdk.Context, kicker types.Sequencer) error {
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "not kickable")
 	}
 
+	if err := k.recordPenalty(ctx, proposer, types.PenaltyKindKicked, sdk.NewCoin(proposer.TokensCoin().Denom, math.ZeroInt()), 0); err != nil {
+		return errorsmod.Wrap(err, "record penalty")
+	}
+
 	// clear the proposer
 	k.abruptRemoveProposer(ctx, ra)
 
@@ -65,33 +69,36 @@ func (k Keeper) SlashLiveness(ctx sdk.Context, rollappID string) error {
 
 	// correct formula is e.g. min(sequencer tokens, max(1, sequencer tokens * 0.01 ))
 
-	err := k.livenessSlash(ctx, &seq)
+	slashed, err := k.livenessSlash(ctx, &seq)
 	if err != nil {
 		return errorsmod.Wrap(err, "slash")
 	}
-	k.increasePenaltyDowntime(ctx, &seq)
+	if err := k.increasePenaltyDowntime(ctx, &seq, slashed); err != nil {
+		return errorsmod.Wrap(err, "increase penalty")
+	}
 	k.SetSequencer(ctx, seq)
 	return nil
 }
 
-func (k Keeper) livenessSlash(ctx sdk.Context, seq *types.Sequencer) error {
+// livenessSlash returns the slashed amount
+func (k Keeper) livenessSlash(ctx sdk.Context, seq *types.Sequencer) (sdk.Coin, error) {
 	mul := k.GetParams(ctx).LivenessSlashMinMultiplier
 	abs := k.GetParams(ctx).LivenessSlashMinAbsolute
 	tokens := seq.TokensCoin()
 	tokensMul := ucoin.MulDec(mul, tokens)
 	amt := ucoin.SimpleMin(tokens, ucoin.SimpleMax(abs, tokensMul[0]))
-	return errorsmod.Wrap(k.slash(ctx, seq, amt, math.LegacyZeroDec(), nil), "slash")
+	return amt, errorsmod.Wrap(k.slash(ctx, seq, amt, math.LegacyZeroDec(), nil), "slash")
 }
 
-func (k Keeper) reducePenaltyUptime(ctx sdk.Context, seq *types.Sequencer) {
-	diff := k.GetParams(ctx).PenaltyReductionStateUpdate()
-	diff = min(diff, seq.GetPenalty())
-	seq.SetPenalty(seq.GetPenalty() - diff)
+func (k Keeper) reducePenaltyUptime(ctx sdk.Context, seq *types.Sequencer) error {
+	diff := k.reduceDishonor(ctx, seq, k.GetParams(ctx).PenaltyReductionStateUpdate())
+	return k.creditStateUpdate(ctx, *seq, diff)
 }
 
-func (k Keeper) increasePenaltyDowntime(ctx sdk.Context, seq *types.Sequencer) {
+func (k Keeper) increasePenaltyDowntime(ctx sdk.Context, seq *types.Sequencer, slashed sdk.Coin) error {
 	penalty := k.GetParams(ctx).PenaltyLiveness()
-	seq.SetPenalty(seq.GetPenalty() + penalty)
+	k.addDishonor(ctx, seq, penalty)
+	return k.recordPenalty(ctx, *seq, types.PenaltyKindLiveness, slashed, penalty)
 }
 
 // Takes an optional rewardee addr who will receive some bounty
@@ -112,15 +119,23 @@ func (k Keeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.A
 		addr = *rewardee
 	}
 
-	err = k.slash(ctx, &seq, seq.TokensCoin(), rewardMul, addr)
+	slashed := seq.TokensCoin()
+	err = k.slash(ctx, &seq, slashed, rewardMul, addr)
 	if err != nil {
 		return errorsmod.Wrap(err, "slash")
 	}
+	if err := k.recordPenalty(ctx, seq, types.PenaltyKindPunished, slashed, 0); err != nil {
+		return errorsmod.Wrap(err, "record penalty")
+	}
 	k.SetSequencer(ctx, seq)
 	return nil
 }
 
 func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	// the delegators share the slashing risk
+	if err := k.slashDelegationPool(ctx, *seq, amt); err != nil {
+		return errorsmod.Wrap(err, "slash delegation pool")
+	}
 	rewardCoin := ucoin.MulDec(rewardMul, amt)[0]
 	if !rewardCoin.IsZero() {
 		err := k.sendFromModule(ctx, seq, rewardCoin, rewardee)
@@ -27,7 +27,7 @@ func (k Keeper) sufficientBond(ctx sdk.Context, rollapp string, c sdk.Coin) erro
 
 func (k Keeper) Kickable(ctx sdk.Context, proposer types.Sequencer) bool {
 	kickThreshold := k.GetParams(ctx).PenaltyKickThreshold()
-	return !proposer.Sentinel() && kickThreshold <= proposer.GetPenalty()
+	return !proposer.Sentinel() && kickThreshold <= k.Dishonor(ctx, proposer)
 }
 
 func (k Keeper) burn(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
@@ -65,6 +65,19 @@ func (k Keeper) removeFromNoticeQueue(ctx sdk.Context, seq types.Sequencer) {
 	store.Delete(noticePeriodKey)
 }
 
+// AddToAwaitingLastBlockQueue : the proposer's notice period elapsed and the successor is chosen
+func (k Keeper) AddToAwaitingLastBlockQueue(ctx sdk.Context, seq types.Sequencer) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AwaitingLastBlockQueueBySeqTimeKey(seq.Address, seq.NoticePeriodTime)
+	store.Set(key, []byte(seq.Address))
+}
+
+func (k Keeper) removeFromAwaitingLastBlockQueue(ctx sdk.Context, seq types.Sequencer) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AwaitingLastBlockQueueBySeqTimeKey(seq.Address, seq.NoticePeriodTime)
+	store.Delete(key)
+}
+
 func (k Keeper) RollappSequencers(ctx sdk.Context, rollappId string) []types.Sequencer {
 	return k.prefixSequencers(ctx, types.SequencersByRollappKey(rollappId))
 }
@@ -197,6 +210,31 @@ func (k Keeper) GetSuccessor(ctx sdk.Context, rollapp string) types.Sequencer {
 	return k.GetSequencer(ctx, string(bz))
 }
 
+// AwaitingLastBlockQueue returns the proposers awaiting their last block, whose notice period elapsed
+// not after noticeTime. If noticeTime is nil, the entire queue is returned.
+func (k Keeper) AwaitingLastBlockQueue(ctx sdk.Context, noticeTime *time.Time) ([]types.Sequencer, error) {
+	ret := []types.Sequencer{}
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.AwaitingLastBlockQueueKey
+	if noticeTime != nil {
+		prefix = types.AwaitingLastBlockQueueByTimeKey(*noticeTime)
+	}
+	iterator := store.Iterator(types.AwaitingLastBlockQueueKey, storetypes.PrefixEndBytes(prefix))
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := string(iterator.Value())
+		seq, err := k.RealSequencer(ctx, addr)
+		if err != nil {
+			return nil, gerrc.ErrInternal.Wrapf("sequencer in awaiting last block queue but missing sequencer object: addr: %s", addr)
+		}
+		ret = append(ret, seq)
+	}
+
+	return ret, nil
+}
+
 // NoticeQueue - the entire notice queue
 func (k Keeper) NoticeQueue(ctx sdk.Context, endTime *time.Time) ([]types.Sequencer, error) {
 	ret := []types.Sequencer{}
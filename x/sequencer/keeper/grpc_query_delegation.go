@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) DelegationPool(c context.Context, req *types.QueryDelegationPoolRequest) (*types.QueryDelegationPoolResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	p, err := k.GetDelegationPool(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDelegationPoolResponse{Pool: p}, nil
+}
+
+func (k Keeper) Delegation(c context.Context, req *types.QueryDelegationRequest) (*types.QueryDelegationResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	p, err := k.GetDelegationPool(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	d, err := k.GetDelegation(ctx, req.Sequencer, req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	// include the rewards received since the last sweep, without writing
+	seq := k.GetSequencer(ctx, req.Sequencer)
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.sweepDelegationRewards(cacheCtx, seq, &p); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationResponse{
+		Delegation:     d,
+		Tokens:         sdk.NewCoin(seq.TokensCoin().Denom, p.TokensFor(d.Shares)),
+		PendingRewards: p.PendingRewards(d.RewardPerShare, d.Shares),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) SequencerReputation(c context.Context, req *types.QuerySequencerReputationRequest) (*types.QuerySequencerReputationResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	seq, err := k.RealSequencer(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.GetReputation(ctx, seq.Address)
+	if err != nil {
+		return nil, err
+	}
+	penalties, pageRes, err := k.GetPenaltyRecordsPaginated(ctx, seq.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySequencerReputationResponse{
+		Reputation: r,
+		Dishonor:   k.Dishonor(ctx, seq),
+		Kickable:   k.Kickable(ctx, seq),
+		Penalties:  penalties,
+		Pagination: pageRes,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
@@ -26,6 +27,18 @@ type Keeper struct {
 	hooks          types.Hooks
 
 	dymintProposerAddrToAccAddr collections.Map[[]byte, string]
+
+	// seq addr -> pool
+	delegationPools collections.Map[string, types.DelegationPool]
+	// (seq addr, delegator) -> delegation
+	delegations collections.Map[collections.Pair[string, string], types.Delegation]
+	// (completion time, seq addr, delegator) -> unbonding delegation
+	unbondingDelegations collections.Map[collections.Triple[time.Time, string, string], types.UnbondingDelegation]
+
+	// seq addr -> reputation
+	reputations collections.Map[string, types.SequencerReputation]
+	// (seq addr, index) -> penalty
+	penaltyLedger collections.Map[collections.Pair[string, uint64], types.PenaltyRecord]
 }
 
 func NewKeeper(
@@ -59,6 +72,41 @@ func NewKeeper(
 			collections.BytesKey,
 			collections.StringValue,
 		),
+		delegationPools: collections.NewMap(
+			sb,
+			types.DelegationPoolKeyPrefix,
+			"delegationPools",
+			collections.StringKey,
+			codec.CollValue[types.DelegationPool](cdc),
+		),
+		delegations: collections.NewMap(
+			sb,
+			types.DelegationKeyPrefix,
+			"delegations",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.Delegation](cdc),
+		),
+		unbondingDelegations: collections.NewMap(
+			sb,
+			types.UnbondingDelegationKeyPrefix,
+			"unbondingDelegations",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.UnbondingDelegation](cdc),
+		),
+		reputations: collections.NewMap(
+			sb,
+			types.ReputationKeyPrefix,
+			"reputations",
+			collections.StringKey,
+			codec.CollValue[types.SequencerReputation](cdc),
+		),
+		penaltyLedger: collections.NewMap(
+			sb,
+			types.PenaltyLedgerKeyPrefix,
+			"penaltyLedger",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.PenaltyRecord](cdc),
+		),
 	}
 }
 
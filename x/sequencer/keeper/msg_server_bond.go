@@ -93,7 +93,7 @@ func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.M
 
 	}
 
-	err = k.TryUnbond(ctx, &seq, seq.TokensCoin())
+	err = k.TryUnbond(ctx, &seq, k.operatorBond(ctx, seq))
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "try unbond")
 	}
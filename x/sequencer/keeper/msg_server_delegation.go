@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k msgServer) SetDelegationCommission(goCtx context.Context, msg *types.MsgSetDelegationCommission) (*types.MsgSetDelegationCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetDelegationCommission(ctx, &seq, msg.Commission); err != nil {
+		return nil, err
+	}
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgSetDelegationCommissionResponse{}, nil
+}
+
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddr, "delegator: %s", err)
+	}
+
+	if err := k.Keeper.Delegate(ctx, delegator, &seq, msg.Amount); err != nil {
+		return nil, err
+	}
+	k.SetSequencer(ctx, seq)
+
+	return &types.MsgDelegateResponse{}, nil
+}
+
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddr, "delegator: %s", err)
+	}
+
+	completion, err := k.Keeper.Undelegate(ctx, delegator, seq, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateResponse{CompletionTime: completion}, nil
+}
+
+func (k msgServer) WithdrawDelegationRewards(goCtx context.Context, msg *types.MsgWithdrawDelegationRewards) (*types.MsgWithdrawDelegationRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	delegator, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAddr, "delegator: %s", err)
+	}
+
+	rewards, err := k.Keeper.WithdrawDelegationRewards(ctx, delegator, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawDelegationRewardsResponse{Rewards: rewards}, nil
+}
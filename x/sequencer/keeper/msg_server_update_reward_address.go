@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
@@ -21,7 +22,22 @@ func (k msgServer) UpdateRewardAddress(goCtx context.Context, msg *types.MsgUpda
 		k.SetSequencer(ctx, seq)
 	}()
 
-	seq.RewardAddr = msg.RewardAddr
+	pool, err := k.GetDelegationPool(ctx, seq.Address)
+	switch {
+	case errorsmod.IsOf(err, types.ErrDelegationPoolNotFound):
+		seq.RewardAddr = msg.RewardAddr
+	case err != nil:
+		return nil, err
+	default:
+		// the sequencer reward address stays the pool address, the operator part of the rewards is redirected
+		if err := k.sweepDelegationRewards(ctx, seq, &pool); err != nil {
+			return nil, errorsmod.Wrap(err, "sweep rewards")
+		}
+		pool.OperatorRewardAddr = msg.RewardAddr
+		if err := k.SetDelegationPool(ctx, pool); err != nil {
+			return nil, err
+		}
+	}
 
 	err = uevent.EmitTypedEvent(ctx, &types.EventUpdateRewardAddress{
 		Creator:    msg.Creator,
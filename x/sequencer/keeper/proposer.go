@@ -12,7 +12,9 @@ import (
 
 // when the proposer did a state update
 func (k Keeper) afterStateUpdate(ctx sdk.Context, prop types.Sequencer, last bool) error {
-	k.reducePenaltyUptime(ctx, &prop)
+	if err := k.reducePenaltyUptime(ctx, &prop); err != nil {
+		return errorsmod.Wrap(err, "reduce penalty")
+	}
 	k.SetSequencer(ctx, prop)
 	if last {
 		return k.OnProposerLastBlock(ctx, prop)
@@ -26,6 +28,7 @@ func (k Keeper) abruptRemoveProposer(ctx sdk.Context, rollapp string) {
 		return
 	}
 	k.removeFromNoticeQueue(ctx, proposer)
+	k.removeFromAwaitingLastBlockQueue(ctx, proposer)
 	k.unbond(ctx, &proposer)
 	k.SetSequencer(ctx, proposer)
 	k.SetProposer(ctx, rollapp, types.SentinelSeqAddr)
@@ -53,7 +56,7 @@ func (k Keeper) RollappPotentialProposers(ctx sdk.Context, rollappId string) []t
 }
 
 // ChooseProposerAfterSentinel will assign a new proposer to the rollapp.
-// It will choose a new proposer from the list of potential proposers.
+// It will choose a new proposer from the list of potential proposers, with the strategy of the rollapp.
 // A proposer must be available.
 func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) error {
 	proposer := k.GetProposer(ctx, rollapp)
@@ -62,7 +65,7 @@ func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) err
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "proposer is not sentinel")
 	}
 
-	successor, err := ProposerChoiceAlgo(k.RollappPotentialProposers(ctx, rollapp))
+	successor, err := k.chooseProposer(ctx, rollapp)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// chooseProposer chooses among the potential proposers of the rollapp, with the proposer selection
+// strategy of the rollapp. The sentinel is chosen only if there is no other potential proposer.
+func (k Keeper) chooseProposer(ctx sdk.Context, rollapp string) (types.Sequencer, error) {
+	seqs := k.RollappPotentialProposers(ctx, rollapp)
+	switch k.rollappKeeper.MustGetRollapp(ctx, rollapp).ProposerSelection {
+	case rollapptypes.ProposerSelectionRoundRobin:
+		return RoundRobinChoice(seqs, k.previousProposer(ctx, rollapp))
+	case rollapptypes.ProposerSelectionStakeWeightedRandom:
+		return StakeWeightedRandomChoice(seqs, proposerSelectionSeed(ctx, rollapp))
+	case rollapptypes.ProposerSelectionLowestDishonor:
+		return LowestDishonorChoice(k.withDecayedDishonor(ctx, seqs))
+	default:
+		return ProposerChoiceAlgo(seqs)
+	}
+}
+
+// previousProposer returns the current proposer, or the last one which updated the state if the
+// current proposer is the sentinel.
+func (k Keeper) previousProposer(ctx sdk.Context, rollapp string) string {
+	proposer := k.GetProposer(ctx, rollapp)
+	if !proposer.Sentinel() {
+		return proposer.Address
+	}
+	if s, ok := k.rollappKeeper.GetLatestStateInfo(ctx, rollapp); ok {
+		return s.Sequencer
+	}
+	return ""
+}
+
+// proposerSelectionSeed is different for each block and rollapp, and is not known before the block
+func proposerSelectionSeed(ctx sdk.Context, rollapp string) []byte {
+	h := sha256.New()
+	h.Write(ctx.HeaderHash())
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight())))
+	h.Write([]byte(rollapp))
+	return h.Sum(nil)
+}
+
+// withoutSentinel returns the sequencers other than the sentinel, and the sentinel.
+// Requires sentinel to be passed in, as last resort.
+func withoutSentinel(seqs []types.Sequencer) ([]types.Sequencer, types.Sequencer, error) {
+	i := slices.IndexFunc(seqs, func(seq types.Sequencer) bool { return seq.Sentinel() })
+	if i < 0 {
+		return nil, types.Sequencer{}, gerrc.ErrInternal.Wrap("seqs must at least include sentinel")
+	}
+	sentinel := seqs[i]
+	others := slices.DeleteFunc(slices.Clone(seqs), func(seq types.Sequencer) bool { return seq.Sentinel() })
+	return others, sentinel, nil
+}
+
+func sortByAddress(seqs []types.Sequencer) {
+	slices.SortFunc(seqs, func(a, b types.Sequencer) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+}
+
+// RoundRobinChoice : choose the one with the next address after the previous proposer, wrapping around
+// Requires sentinel to be passed in, as last resort.
+func RoundRobinChoice(seqs []types.Sequencer, prev string) (types.Sequencer, error) {
+	others, sentinel, err := withoutSentinel(seqs)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	if len(others) == 0 {
+		return sentinel, nil
+	}
+	sortByAddress(others)
+	i := slices.IndexFunc(others, func(seq types.Sequencer) bool { return prev < seq.Address })
+	if i < 0 {
+		i = 0
+	}
+	return others[i], nil
+}
+
+// StakeWeightedRandomChoice : choose one at random with a probability proportional to the bond, using the seed
+// Requires sentinel to be passed in, as last resort.
+func StakeWeightedRandomChoice(seqs []types.Sequencer, seed []byte) (types.Sequencer, error) {
+	others, sentinel, err := withoutSentinel(seqs)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	if len(others) == 0 {
+		return sentinel, nil
+	}
+	sortByAddress(others)
+	total := math.ZeroInt()
+	for _, seq := range others {
+		total = total.Add(seq.TokensCoin().Amount)
+	}
+	if !total.IsPositive() {
+		return others[0], nil
+	}
+	r := math.NewIntFromBigInt(new(big.Int).Mod(new(big.Int).SetBytes(seed), total.BigInt()))
+	for _, seq := range others {
+		r = r.Sub(seq.TokensCoin().Amount)
+		if r.IsNegative() {
+			return seq, nil
+		}
+	}
+	return types.Sequencer{}, gerrc.ErrInternal.Wrap("weighted choice out of range")
+}
+
+// LowestDishonorChoice : choose the one with the lowest dishonor, ties are broken by the most bond
+// Requires sentinel to be passed in, as last resort.
+func LowestDishonorChoice(seqs []types.Sequencer) (types.Sequencer, error) {
+	others, sentinel, err := withoutSentinel(seqs)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	if len(others) == 0 {
+		return sentinel, nil
+	}
+	slices.SortStableFunc(others, func(a, b types.Sequencer) int {
+		if a.Dishonor != b.Dishonor {
+			if a.Dishonor < b.Dishonor {
+				return -1
+			}
+			return 1
+		}
+		// flipped to sort decreasing
+		return b.TokensCoin().Amount.BigInt().Cmp(a.TokensCoin().Amount.BigInt())
+	})
+	return others[0], nil
+}
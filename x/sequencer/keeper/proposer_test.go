@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
 	"reflect"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_proposerChoiceAlgo(t *testing.T) {
@@ -81,3 +83,59 @@ func Test_proposerChoiceAlgo(t *testing.T) {
 		})
 	}
 }
+
+func TestProposerSelectionStrategies(t *testing.T) {
+	sentinel := types.Sequencer{Address: types.SentinelSeqAddr, Tokens: sdk.NewCoins()}
+	seq := func(addr string, mul int64, dishonor uint64) types.Sequencer {
+		return types.Sequencer{Address: addr, Tokens: sdk.NewCoins(ucoin.SimpleMul(bond, mul)), Dishonor: dishonor}
+	}
+	seqs := func() []types.Sequencer {
+		return []types.Sequencer{seq("b", 3, 10), seq("c", 1, 0), seq("a", 2, 0), sentinel}
+	}
+
+	t.Run("round robin", func(t *testing.T) {
+		for prev, want := range map[string]string{"": "a", "a": "b", "b": "c", "c": "a", "bb": "c"} {
+			got, err := keeper.RoundRobinChoice(seqs(), prev)
+			require.NoError(t, err)
+			require.Equal(t, want, got.Address, "prev: %s", prev)
+		}
+	})
+
+	t.Run("lowest dishonor", func(t *testing.T) {
+		got, err := keeper.LowestDishonorChoice(seqs())
+		require.NoError(t, err)
+		require.Equal(t, "a", got.Address)
+	})
+
+	t.Run("stake weighted random", func(t *testing.T) {
+		counts := make(map[string]int)
+		for i := 0; i < 600; i++ {
+			seed := sha256.Sum256([]byte{byte(i), byte(i >> 8)})
+			got, err := keeper.StakeWeightedRandomChoice(seqs(), seed[:])
+			require.NoError(t, err)
+			counts[got.Address]++
+		}
+		require.Len(t, counts, 3)
+		require.Greater(t, counts["b"], counts["a"])
+		require.Greater(t, counts["a"], counts["c"])
+
+		// deterministic
+		x, _ := keeper.StakeWeightedRandomChoice(seqs(), []byte("seed"))
+		y, _ := keeper.StakeWeightedRandomChoice(seqs(), []byte("seed"))
+		require.Equal(t, x, y)
+	})
+
+	t.Run("sentinel as last resort", func(t *testing.T) {
+		for _, f := range []func([]types.Sequencer) (types.Sequencer, error){
+			func(s []types.Sequencer) (types.Sequencer, error) { return keeper.RoundRobinChoice(s, "a") },
+			func(s []types.Sequencer) (types.Sequencer, error) { return keeper.StakeWeightedRandomChoice(s, nil) },
+			keeper.LowestDishonorChoice,
+		} {
+			got, err := f([]types.Sequencer{sentinel})
+			require.NoError(t, err)
+			require.True(t, got.Sentinel())
+			_, err = f(nil)
+			require.Error(t, err)
+		}
+	})
+}
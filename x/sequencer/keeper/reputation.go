@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// Dishonor returns the current dishonor of the sequencer. The stored dishonor is the base of the decay, as of
+// when dishonor was last added.
+func (k Keeper) Dishonor(ctx sdk.Context, seq types.Sequencer) uint64 {
+	return types.DecayedDishonor(seq.GetPenalty(), dishonorElapsed(ctx, seq), k.GetParams(ctx).DishonorHalfLife)
+}
+
+func dishonorElapsed(ctx sdk.Context, seq types.Sequencer) time.Duration {
+	if seq.DishonorUpdatedAt.IsZero() {
+		return 0
+	}
+	return ctx.BlockTime().Sub(seq.DishonorUpdatedAt)
+}
+
+// addDishonor adds to the current dishonor of the sequencer, and decays the sum from now on
+func (k Keeper) addDishonor(ctx sdk.Context, seq *types.Sequencer, x uint64) {
+	seq.SetPenalty(k.Dishonor(ctx, *seq) + x)
+	seq.DishonorUpdatedAt = ctx.BlockTime()
+}
+
+// reduceDishonor removes up to x from the current dishonor of the sequencer, and returns what was removed.
+// The decay keeps its time, its base is reduced by what decayed to the removed dishonor by now.
+func (k Keeper) reduceDishonor(ctx sdk.Context, seq *types.Sequencer, x uint64) uint64 {
+	x = min(x, k.Dishonor(ctx, *seq))
+	if x == 0 {
+		return 0
+	}
+	base := types.UndecayedDishonor(x, dishonorElapsed(ctx, *seq), k.GetParams(ctx).DishonorHalfLife)
+	seq.SetPenalty(seq.GetPenalty() - min(base, seq.GetPenalty()))
+	return x
+}
+
+func (k Keeper) GetReputation(ctx sdk.Context, seqAddr string) (types.SequencerReputation, error) {
+	r, err := k.reputations.Get(ctx, seqAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewSequencerReputation(seqAddr), nil
+	}
+	return r, err
+}
+
+func (k Keeper) SetReputation(ctx sdk.Context, r types.SequencerReputation) error {
+	return k.reputations.Set(ctx, r.Sequencer, r)
+}
+
+func (k Keeper) GetAllReputations(ctx sdk.Context) ([]types.SequencerReputation, error) {
+	it, err := k.reputations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+func (k Keeper) SetPenaltyRecord(ctx sdk.Context, seqAddr string, rec types.PenaltyRecord) error {
+	return k.penaltyLedger.Set(ctx, collections.Join(seqAddr, rec.Index), rec)
+}
+
+// GetPenaltyRecordsPaginated returns the ledger of the sequencer, oldest first
+func (k Keeper) GetPenaltyRecordsPaginated(ctx sdk.Context, seqAddr string, pageReq *query.PageRequest) ([]types.PenaltyRecord, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.penaltyLedger, pageReq,
+		func(_ collections.Pair[string, uint64], rec types.PenaltyRecord) (types.PenaltyRecord, error) {
+			return rec, nil
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](seqAddr),
+	)
+}
+
+func (k Keeper) GetAllPenaltyRecords(ctx sdk.Context) ([]types.GenesisPenaltyRecord, error) {
+	var ret []types.GenesisPenaltyRecord
+	err := k.penaltyLedger.Walk(ctx, nil, func(key collections.Pair[string, uint64], rec types.PenaltyRecord) (bool, error) {
+		ret = append(ret, types.GenesisPenaltyRecord{Sequencer: key.K1(), Record: rec})
+		return false, nil
+	})
+	return ret, err
+}
+
+// recordPenalty appends the penalty to the ledger of the sequencer. The dishonor must already include the penalty.
+func (k Keeper) recordPenalty(ctx sdk.Context, seq types.Sequencer, kind types.PenaltyKind, slashed sdk.Coin, dishonorAdded uint64) error {
+	r, err := k.GetReputation(ctx, seq.Address)
+	if err != nil {
+		return err
+	}
+	rec := r.AddPenalty(types.PenaltyRecord{
+		Kind:          kind,
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime(),
+		Slashed:       slashed,
+		DishonorAdded: dishonorAdded,
+		DishonorAfter: k.Dishonor(ctx, seq),
+	})
+	if err := k.SetPenaltyRecord(ctx, seq.Address, rec); err != nil {
+		return err
+	}
+	return k.SetReputation(ctx, r)
+}
+
+// creditStateUpdate counts the state update of the sequencer. State updates are too frequent to be recorded one
+// by one.
+func (k Keeper) creditStateUpdate(ctx sdk.Context, seq types.Sequencer, dishonorCredited uint64) error {
+	r, err := k.GetReputation(ctx, seq.Address)
+	if err != nil {
+		return err
+	}
+	r.StateUpdates++
+	r.DishonorCredited += dishonorCredited
+	r.LastStateUpdate = ctx.BlockTime()
+	return k.SetReputation(ctx, r)
+}
+
+// withDecayedDishonor returns copies of the sequencers with their current dishonor, to compare them
+func (k Keeper) withDecayedDishonor(ctx sdk.Context, seqs []types.Sequencer) []types.Sequencer {
+	ret := make([]types.Sequencer, len(seqs))
+	for i, seq := range seqs {
+		seq.SetPenalty(k.Dishonor(ctx, seq))
+		ret[i] = seq
+	}
+	return ret
+}
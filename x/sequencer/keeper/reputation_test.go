@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) reputation(seq types.Sequencer) *types.QuerySequencerReputationResponse {
+	res, err := s.k().SequencerReputation(s.Ctx, &types.QuerySequencerReputationRequest{Sequencer: seq.Address})
+	s.Require().NoError(err)
+	return res
+}
+
+func (s *SequencerTestSuite) TestReputation() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seq := s.seq(alice)
+	params := s.k().GetParams(s.Ctx)
+
+	s.Run("liveness slash is recorded", func() {
+		tokens := seq.TokensCoin()
+		err := s.k().SlashLiveness(s.Ctx, ra.RollappId)
+		s.Require().NoError(err)
+		seq = s.seq(alice)
+
+		res := s.reputation(seq)
+		s.Require().Equal(uint64(1), res.Reputation.LivenessPenalties)
+		s.Require().Equal(params.PenaltyLiveness(), res.Dishonor)
+		s.Require().Len(res.Penalties, 1)
+		rec := res.Penalties[0]
+		s.Require().Equal(types.PenaltyKindLiveness, rec.Kind)
+		s.Require().Equal(params.PenaltyLiveness(), rec.DishonorAdded)
+		s.Require().True(rec.Slashed.IsEqual(tokens.Sub(seq.TokensCoin())))
+		s.Require().Equal(res.Reputation.TotalSlashed[0], rec.Slashed)
+	})
+
+	s.Run("dishonor decays", func() {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(params.DishonorHalfLife))
+		res := s.reputation(seq)
+		s.Require().Equal(params.PenaltyLiveness()/2, res.Dishonor)
+		// nothing is written by reading
+		seq = s.seq(alice)
+		s.Require().Equal(params.PenaltyLiveness(), seq.GetPenalty())
+	})
+
+	s.Run("decay is applied on the next penalty", func() {
+		err := s.k().SlashLiveness(s.Ctx, ra.RollappId)
+		s.Require().NoError(err)
+		seq = s.seq(alice)
+		exp := params.PenaltyLiveness()/2 + params.PenaltyLiveness()
+		s.Require().Equal(exp, seq.GetPenalty())
+		s.Require().Equal(s.Ctx.BlockTime(), seq.DishonorUpdatedAt)
+
+		res := s.reputation(seq)
+		s.Require().Equal(uint64(2), res.Reputation.Penalties())
+		s.Require().Equal(exp, res.Penalties[1].DishonorAfter)
+		s.Require().Equal(uint64(1), res.Penalties[1].Index)
+	})
+
+	s.Run("state update is credited", func() {
+		before := seq.GetPenalty()
+		s.submitAFewRollappStates(ra.RollappId)
+		res := s.reputation(seq)
+		s.Require().Equal(uint64(1), res.Reputation.StateUpdates)
+		s.Require().Equal(params.PenaltyReductionStateUpdate(), res.Reputation.DishonorCredited)
+		s.Require().Equal(before-params.PenaltyReductionStateUpdate(), res.Dishonor)
+		s.Require().Equal(s.Ctx.BlockTime(), res.Reputation.LastStateUpdate)
+	})
+
+	s.Run("punishment is recorded", func() {
+		tokens := s.seq(alice).TokensCoin()
+		err := s.k().PunishSequencer(s.Ctx, seq.Address, nil)
+		s.Require().NoError(err)
+		res := s.reputation(seq)
+		s.Require().Equal(uint64(1), res.Reputation.Punishments)
+		s.Require().True(res.Penalties[2].Slashed.IsEqual(tokens))
+	})
+
+	s.Run("unknown sequencer", func() {
+		_, err := s.k().SequencerReputation(s.Ctx, &types.QuerySequencerReputationRequest{Sequencer: pkAddr(bob)})
+		s.Require().ErrorIs(err, types.ErrSequencerNotFound)
+	})
+}
+
+// Decaying the dishonor in many steps is the same as decaying it in one step
+func (s *SequencerTestSuite) TestDishonorDecaySteps() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	params := s.k().GetParams(s.Ctx)
+	params.DishonorStateUpdate = 0
+	s.k().SetParams(s.Ctx, params)
+
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	err := s.k().SlashLiveness(s.Ctx, ra.RollappId)
+	s.Require().NoError(err)
+	start := s.Ctx.BlockTime()
+	seq := s.seq(alice)
+	base := seq.GetPenalty()
+
+	// every state update touches the dishonor
+	step := params.DishonorHalfLife / 7
+	h, _ := s.App.RollappKeeper.GetLatestHeight(s.Ctx, ra.RollappId)
+	next := h + 1
+	for range 20 {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(step))
+		next, err = s.PostStateUpdate(s.Ctx, ra.RollappId, seq.Address, next, 10)
+		s.Require().NoError(err)
+	}
+
+	exp := types.DecayedDishonor(base, s.Ctx.BlockTime().Sub(start), params.DishonorHalfLife)
+	s.Require().Less(exp, base)
+	seq = s.seq(alice)
+	s.Require().Equal(exp, s.reputation(seq).Dishonor)
+	s.Require().Equal(base, seq.GetPenalty())
+	s.Require().Equal(start, seq.DishonorUpdatedAt)
+}
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
@@ -46,6 +48,7 @@ func (k Keeper) ChooseSuccessorForFinishedNotices(ctx sdk.Context, now time.Time
 	}
 	for _, seq := range seqs {
 		k.removeFromNoticeQueue(ctx, seq)
+		k.AddToAwaitingLastBlockQueue(ctx, seq)
 		if err := k.setSuccessorForRotatingRollapp(ctx, seq.RollappId); err != nil {
 			return errorsmod.Wrap(err, "choose successor")
 		}
@@ -82,6 +85,7 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 	}
 
 	rollapp := proposer.RollappId
+	k.removeFromAwaitingLastBlockQueue(ctx, proposer)
 
 	successor := k.GetSuccessor(ctx, rollapp)
 	k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr) // clear successor
@@ -107,12 +111,92 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 	return nil
 }
 
+// ForceRotateTimedOutProposers goes through all proposers which did not submit their last block
+// within the final state update timeout after their notice period elapsed. The proposer is penalized,
+// and the rollapp is forked to its latest state so that the successor can take over without the last block.
+// A proposer is only dequeued once its rotation succeeded, so a failed rotation is retried on the next call.
+// Contract: must be called after ChooseSuccessorForFinishedNotices for a given block time
+func (k Keeper) ForceRotateTimedOutProposers(ctx sdk.Context, now time.Time) error {
+	noticeTime := now.Add(-k.GetParams(ctx).FinalStateUpdateTimeout)
+	seqs, err := k.AwaitingLastBlockQueue(ctx, &noticeTime)
+	if err != nil {
+		return errorsmod.Wrap(err, "get timed out proposers")
+	}
+	for _, seq := range seqs {
+		if !k.IsProposer(ctx, seq) {
+			k.removeFromAwaitingLastBlockQueue(ctx, seq)
+			continue
+		}
+		// a failure must not halt the chain, the proposer stays queued and the rotation is retried next block
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			if err := k.forceRotate(ctx, seq); err != nil {
+				return err
+			}
+			k.removeFromAwaitingLastBlockQueue(ctx, seq)
+			return nil
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Force rotate timed out proposer.", "rollapp", seq.RollappId, "proposer", seq.Address, "err", err)
+		}
+	}
+	return nil
+}
+
+// forceRotate penalizes the proposer for missing its last block and promotes the successor.
+// The rollapp never saw the handover, so it is forked to the latest state, which also opts
+// out all sequencers and unbonds the proposer. The successor is opted back in, like a kicker.
+func (k Keeper) forceRotate(ctx sdk.Context, proposer types.Sequencer) error {
+	rollapp := proposer.RollappId
+
+	if !k.rollappKeeper.ForkLatestAllowed(ctx, rollapp) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "fork not allowed")
+	}
+
+	slashed, err := k.livenessSlash(ctx, &proposer)
+	if err != nil {
+		return errorsmod.Wrap(err, "slash")
+	}
+	if err := k.increasePenaltyDowntime(ctx, &proposer, slashed); err != nil {
+		return errorsmod.Wrap(err, "increase penalty")
+	}
+	k.SetSequencer(ctx, proposer)
+
+	successor := k.GetSuccessor(ctx, rollapp)
+	k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr)
+
+	// This will call hard fork on the rollapp, which will also optOut all sequencers
+	err = k.rollappKeeper.HardForkToLatest(ctx, rollapp)
+	if err != nil {
+		return errorsmod.Wrap(err, "hard fork to latest")
+	}
+
+	if !successor.Sentinel() {
+		successor = k.GetSequencer(ctx, successor.Address)
+		if err := successor.SetOptedIn(ctx, true); err != nil {
+			return errorsmod.Wrap(err, "set opted in")
+		}
+		k.SetSequencer(ctx, successor)
+
+		// the successor is the only opted in sequencer, if it is still bonded enough it becomes the proposer
+		if successor.IsPotentialProposer() {
+			if err := k.ChooseProposerAfterSentinel(ctx, rollapp); err != nil {
+				return errorsmod.Wrap(err, "choose proposer")
+			}
+		}
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventFinalStateUpdateTimeout{
+		Rollapp:   rollapp,
+		Proposer:  proposer.Address,
+		Successor: k.GetProposer(ctx, rollapp).Address,
+	})
+}
+
 // setSuccessorForRotatingRollapp will assign a successor to the rollapp.
 // It will prioritize non sentinel
 // called when a proposer has finished their notice period.
 func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string) error {
-	seqs := k.RollappPotentialProposers(ctx, rollapp)
-	successor, err := ProposerChoiceAlgo(seqs)
+	successor, err := k.chooseProposer(ctx, rollapp)
 	if err != nil {
 		return err
 	}
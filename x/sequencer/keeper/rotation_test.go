@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
@@ -171,3 +172,133 @@ func (s *SequencerTestSuite) TestRotationProposerAndSuccessorBothUnbond() {
 	_, err = s.msgServer.Unbond(s.Ctx, mUnbond)
 	s.Require().NoError(err)
 }
+
+// A wants to rotate, but never submits the last block. After the timeout, B is promoted anyway and A is penalized.
+func (s *SequencerTestSuite) TestRotationFinalStateUpdateTimeout() {
+	// init
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 3))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, ucoin.SimpleMul(bond, 2))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.submitAFewRollappStates(ra.RollappId)
+
+	// proposer tries to unbond
+	mUnbond := &types.MsgUnbond{Creator: pkAddr(alice)}
+	res, err := s.msgServer.Unbond(s.Ctx, mUnbond)
+	s.Require().NoError(err)
+
+	// advance clock past notice
+	s.Ctx = s.Ctx.WithBlockTime(*res.GetNoticePeriodCompletionTime())
+	err = s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(bob)))
+
+	// the timeout did not pass yet
+	timeout := s.k().GetParams(s.Ctx).FinalStateUpdateTimeout
+	s.Ctx = s.Ctx.WithBlockTime(res.GetNoticePeriodCompletionTime().Add(timeout - 1))
+	err = s.k().ForceRotateTimedOutProposers(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.Require().True(s.k().AwaitingLastProposerBlock(s.Ctx, ra.RollappId))
+
+	// the timeout passed
+	s.Ctx = s.Ctx.WithBlockTime(res.GetNoticePeriodCompletionTime().Add(timeout))
+	tokensBefore := s.seq(alice).TokensCoin()
+	revision := s.App.RollappKeeper.MustGetRollapp(s.Ctx, ra.RollappId).LatestRevision().Number
+	err = s.k().ForceRotateTimedOutProposers(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().False(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+	s.Require().False(s.k().AwaitingLastProposerBlock(s.Ctx, ra.RollappId))
+
+	// alice is penalized and unbonded
+	seqAlice := s.seq(alice)
+	s.Require().True(seqAlice.TokensCoin().IsLT(tokensBefore))
+	s.Require().Equal(types.DefaultDishonorLiveness, seqAlice.GetPenalty())
+	s.Require().Equal(types.Unbonded, seqAlice.Status)
+
+	// bob can submit state updates on the new revision
+	s.Require().Equal(revision+1, s.App.RollappKeeper.MustGetRollapp(s.Ctx, ra.RollappId).LatestRevision().Number)
+	s.Require().NoError(s.k().RollappHooks().BeforeUpdateState(s.Ctx, pkAddr(bob), ra.RollappId, false))
+
+	// nothing left to rotate
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(timeout))
+	err = s.k().ForceRotateTimedOutProposers(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+}
+
+// A wants to rotate, but never submits the last block. The forced rotation fails at first, and is retried in later blocks.
+func (s *SequencerTestSuite) TestRotationFinalStateUpdateTimeoutRetry() {
+	// init
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 3))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, ucoin.SimpleMul(bond, 2))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.submitAFewRollappStates(ra.RollappId)
+
+	// proposer tries to unbond
+	mUnbond := &types.MsgUnbond{Creator: pkAddr(alice)}
+	res, err := s.msgServer.Unbond(s.Ctx, mUnbond)
+	s.Require().NoError(err)
+
+	// advance clock past notice
+	s.Ctx = s.Ctx.WithBlockTime(*res.GetNoticePeriodCompletionTime())
+	err = s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(bob)))
+
+	// the fork is not allowed, as it would roll back past the transfer proof height
+	rollapp := s.raK().MustGetRollapp(s.Ctx, ra.RollappId)
+	proofHeight := rollapp.GenesisState.TransferProofHeight
+	rollapp.GenesisState.TransferProofHeight = 1000
+	s.raK().SetRollapp(s.Ctx, rollapp)
+
+	// the timeout passed, but the rotation fails
+	timeout := s.k().GetParams(s.Ctx).FinalStateUpdateTimeout
+	s.Ctx = s.Ctx.WithBlockTime(res.GetNoticePeriodCompletionTime().Add(timeout))
+	err = s.k().ForceRotateTimedOutProposers(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(bob)))
+	s.Require().True(s.k().AwaitingLastProposerBlock(s.Ctx, ra.RollappId))
+
+	// the fork is allowed again, the rotation succeeds in a later block
+	rollapp = s.raK().MustGetRollapp(s.Ctx, ra.RollappId)
+	rollapp.GenesisState.TransferProofHeight = proofHeight
+	s.raK().SetRollapp(s.Ctx, rollapp)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(timeout))
+	err = s.k().ForceRotateTimedOutProposers(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().False(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+	s.Require().False(s.k().AwaitingLastProposerBlock(s.Ctx, ra.RollappId))
+	s.Require().Equal(types.Unbonded, s.seq(alice).Status)
+}
+
+// The successor is chosen with the strategy of the rollapp
+func (s *SequencerTestSuite) TestRotationLowestDishonorSelection() {
+	ra := s.createRollapp()
+	ra.ProposerSelection = rollapptypes.ProposerSelectionLowestDishonor
+	s.raK().SetRollapp(s.Ctx, ra)
+
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 3))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, ucoin.SimpleMul(bond, 2))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, ucoin.SimpleMul(bond, 1))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.submitAFewRollappStates(ra.RollappId)
+
+	// bob has more bond, but was dishonest
+	b := s.seq(bob)
+	b.Dishonor = 1
+	s.k().SetSequencer(s.Ctx, b)
+
+	res, err := s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: pkAddr(alice)})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(*res.GetNoticePeriodCompletionTime())
+	err = s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(charlie)))
+}
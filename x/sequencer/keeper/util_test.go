@@ -139,6 +139,7 @@ func (s *SequencerTestSuite) createRollappWithInitialSeqConstraint(initSeq strin
 		},
 		InitialSequencer: initSeq,
 		GenesisState:     rollapptypes.RollappGenesisState{TransferProofHeight: 1},
+		DaScheme:         rollapptypes.DASchemeMock,
 		MinSequencerBond: sdk.NewCoins(rollapptypes.DefaultMinSequencerBondGlobalCoin),
 	}
 	s.raK().SetRollapp(s.Ctx, rollapp)
@@ -167,5 +167,17 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 		return err
 	}
 
+	err = am.keeper.ForceRotateTimedOutProposers(ctx, ctx.BlockTime())
+	if err != nil {
+		ctx.Logger().Error("ForceRotateTimedOutProposers", "err", err)
+		return err
+	}
+
+	err = am.keeper.CompleteUnbondingDelegations(ctx, ctx.BlockTime())
+	if err != nil {
+		ctx.Logger().Error("CompleteUnbondingDelegations", "err", err)
+		return err
+	}
+
 	return nil
 }
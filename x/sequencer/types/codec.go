@@ -21,6 +21,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPunishSequencer{}, "sequencer/PunishSequencer", nil)
 	cdc.RegisterConcrete(&MsgUpdateSequencerInformation{}, "sequencer/UpdateSequencerInformation", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "sequencer/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetDelegationCommission{}, "sequencer/SetDelegationCommission", nil)
+	cdc.RegisterConcrete(&MsgDelegate{}, "sequencer/Delegate", nil)
+	cdc.RegisterConcrete(&MsgUndelegate{}, "sequencer/Undelegate", nil)
+	cdc.RegisterConcrete(&MsgWithdrawDelegationRewards{}, "sequencer/WithdrawDelegationRewards", nil)
 	cdc.RegisterConcrete(Params{}, "sequencer/Params", nil)
 }
 
@@ -37,6 +41,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgPunishSequencer{},
 		&MsgUpdateSequencerInformation{},
+		&MsgSetDelegationCommission{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgWithdrawDelegationRewards{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
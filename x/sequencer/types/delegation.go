@@ -0,0 +1,116 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// RewardPerShareScale is the number of shares the reward per share is expressed for. Shares are worth about
+// one base unit of the bond denom, so rewards per share would be lost to the decimal precision otherwise.
+var RewardPerShareScale = math.LegacyNewDec(1_000_000_000_000_000_000)
+
+// DelegationPoolAddr is the reward address of a sequencer which is open to delegations.
+// It holds the rewards until they are split between the operator and the delegators.
+func DelegationPoolAddr(seqAddr string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(seqAddr))
+}
+
+func NewDelegationPool(seqAddr string, commission math.LegacyDec, operatorRewardAddr string) DelegationPool {
+	return DelegationPool{
+		Sequencer:          seqAddr,
+		Tokens:             math.ZeroInt(),
+		Shares:             math.LegacyZeroDec(),
+		Commission:         commission,
+		OperatorRewardAddr: operatorRewardAddr,
+	}
+}
+
+func ValidateCommission(c math.LegacyDec) error {
+	if c.IsNil() || c.IsNegative() || c.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidCommission, "must be in [0, 1]: %s", c)
+	}
+	return nil
+}
+
+// SharesFor returns the shares issued for delegating the amount
+func (p DelegationPool) SharesFor(amt math.Int) (math.LegacyDec, error) {
+	if p.Shares.IsZero() {
+		return math.LegacyNewDecFromInt(amt), nil
+	}
+	if !p.Tokens.IsPositive() {
+		// the shares are worthless, new delegators would share nothing with them
+		return math.LegacyDec{}, ErrDelegationPoolSlashed
+	}
+	return p.Shares.MulInt(amt).QuoInt(p.Tokens), nil
+}
+
+// TokensFor returns the current value of the shares, rounded down
+func (p DelegationPool) TokensFor(shares math.LegacyDec) math.Int {
+	if p.Shares.IsZero() {
+		return math.ZeroInt()
+	}
+	return shares.MulInt(p.Tokens).Quo(p.Shares).TruncateInt()
+}
+
+// AddRewards accounts the rewards of the delegators in the reward per share
+func (p *DelegationPool) AddRewards(rewards sdk.DecCoins) {
+	p.RewardPerShare = p.RewardPerShare.Add(rewards.MulDecTruncate(RewardPerShareScale).QuoDecTruncate(p.Shares)...)
+	p.OutstandingRewards = p.OutstandingRewards.Add(rewards...)
+}
+
+// PendingRewards returns the rewards accrued by the shares since the reward per share was at snapshot
+func (p DelegationPool) PendingRewards(snapshot sdk.DecCoins, shares math.LegacyDec) sdk.Coins {
+	pending, _ := p.RewardPerShare.Sub(snapshot).MulDecTruncate(shares).QuoDecTruncate(RewardPerShareScale).TruncateDecimal()
+	return pending
+}
+
+func (p DelegationPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "sequencer: %s", err)
+	}
+	if p.Tokens.IsNil() || p.Tokens.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidCoins, "negative tokens")
+	}
+	if p.Shares.IsNil() || p.Shares.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidCoins, "negative shares")
+	}
+	if err := ValidateCommission(p.Commission); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.OperatorRewardAddr); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "operator reward addr: %s", err)
+	}
+	if err := p.RewardPerShare.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "reward per share: %s", err)
+	}
+	if err := p.OutstandingRewards.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "outstanding rewards: %s", err)
+	}
+	return nil
+}
+
+func (d Delegation) ValidateBasic() error {
+	return validateDelegation(d.Delegator, d.Sequencer, d.Shares, d.RewardPerShare)
+}
+
+func (d UnbondingDelegation) ValidateBasic() error {
+	return validateDelegation(d.Delegator, d.Sequencer, d.Shares, d.RewardPerShare)
+}
+
+func validateDelegation(delegator, sequencer string, shares math.LegacyDec, rewardPerShare sdk.DecCoins) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "delegator: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "sequencer: %s", err)
+	}
+	if shares.IsNil() || !shares.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidCoins, "shares must be positive")
+	}
+	if err := rewardPerShare.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "reward per share: %s", err)
+	}
+	return nil
+}
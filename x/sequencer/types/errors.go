@@ -25,4 +25,9 @@ var (
 	ErrInvalidPubKey             = gerrc.ErrInvalidArgument.Wrap("pubkey")
 	ErrUnknownRequest            = gerrc.ErrInvalidArgument.Wrap("unknown request")
 	ErrInvalidFeeDenom           = gerrc.ErrInvalidArgument.Wrap("invalid fee denom")
+	ErrInvalidCommission         = gerrc.ErrInvalidArgument.Wrap("commission")
+	ErrDelegationPoolNotFound    = gerrc.ErrNotFound.Wrap("delegation pool")
+	ErrDelegationNotFound        = gerrc.ErrNotFound.Wrap("delegation")
+	ErrInsufficientDelegation    = gerrc.ErrOutOfRange.Wrap("insufficient delegation")
+	ErrDelegationPoolSlashed     = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "delegation pool fully slashed")
 )
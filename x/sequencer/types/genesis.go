@@ -42,9 +42,64 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.validateDelegations(sequencerIndexMap); err != nil {
+		return err
+	}
+
+	if err := gs.validateReputations(); err != nil {
+		return err
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
+func (gs GenesisState) validateDelegations(sequencerIndexMap map[string]struct{}) error {
+	pools := make(map[string]struct{})
+	for _, p := range gs.DelegationPools {
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("delegation pool: %s: %w", p.Sequencer, err)
+		}
+		if _, ok := sequencerIndexMap[string(SequencerKey(p.Sequencer))]; !ok {
+			return fmt.Errorf("delegation pool of non-existent sequencer: %s", p.Sequencer)
+		}
+		if _, ok := pools[p.Sequencer]; ok {
+			return fmt.Errorf("duplicated delegation pool: %s", p.Sequencer)
+		}
+		pools[p.Sequencer] = struct{}{}
+	}
+
+	delegations := make(map[string]struct{})
+	for _, d := range gs.Delegations {
+		if err := d.ValidateBasic(); err != nil {
+			return fmt.Errorf("delegation: %w", err)
+		}
+		if _, ok := pools[d.Sequencer]; !ok {
+			return fmt.Errorf("delegation to sequencer without delegation pool: %s", d.Sequencer)
+		}
+		k := d.Sequencer + KeySeparator + d.Delegator
+		if _, ok := delegations[k]; ok {
+			return fmt.Errorf("duplicated delegation: %s", k)
+		}
+		delegations[k] = struct{}{}
+	}
+
+	unbondings := make(map[string]struct{})
+	for _, d := range gs.UnbondingDelegations {
+		if err := d.ValidateBasic(); err != nil {
+			return fmt.Errorf("unbonding delegation: %w", err)
+		}
+		if _, ok := pools[d.Sequencer]; !ok {
+			return fmt.Errorf("unbonding delegation to sequencer without delegation pool: %s", d.Sequencer)
+		}
+		k := d.CompletionTime.String() + KeySeparator + d.Sequencer + KeySeparator + d.Delegator
+		if _, ok := unbondings[k]; ok {
+			return fmt.Errorf("duplicated unbonding delegation: %s", k)
+		}
+		unbondings[k] = struct{}{}
+	}
+	return nil
+}
+
 func checkSecondIndex(seqs []GenesisProposer, sequencerIndexMap map[string]struct{}) error {
 	proposerIndexMap := make(map[string]struct{})
 	for _, elem := range seqs {
@@ -59,3 +114,36 @@ func checkSecondIndex(seqs []GenesisProposer, sequencerIndexMap map[string]struc
 	}
 	return nil
 }
+
+func (gs GenesisState) validateReputations() error {
+	reputations := make(map[string]SequencerReputation)
+	for _, r := range gs.Reputations {
+		if err := r.ValidateBasic(); err != nil {
+			return fmt.Errorf("reputation: %s: %w", r.Sequencer, err)
+		}
+		if _, ok := reputations[r.Sequencer]; ok {
+			return fmt.Errorf("duplicated reputation: %s", r.Sequencer)
+		}
+		reputations[r.Sequencer] = r
+	}
+
+	records := make(map[string]struct{})
+	for _, r := range gs.PenaltyLedger {
+		if err := r.Record.ValidateBasic(); err != nil {
+			return fmt.Errorf("penalty record: %s: %w", r.Sequencer, err)
+		}
+		rep, ok := reputations[r.Sequencer]
+		if !ok {
+			return fmt.Errorf("penalty record without reputation: %s", r.Sequencer)
+		}
+		if rep.Penalties() <= r.Record.Index {
+			return fmt.Errorf("penalty record index out of range: %s: %d", r.Sequencer, r.Record.Index)
+		}
+		k := fmt.Sprintf("%s%s%d", r.Sequencer, KeySeparator, r.Record.Index)
+		if _, ok := records[k]; ok {
+			return fmt.Errorf("duplicated penalty record: %s", k)
+		}
+		records[k] = struct{}{}
+	}
+	return nil
+}
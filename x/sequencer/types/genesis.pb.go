@@ -34,6 +34,16 @@ type GenesisState struct {
 	GenesisSuccessors []GenesisProposer `protobuf:"bytes,5,rep,name=genesisSuccessors,proto3" json:"genesisSuccessors"`
 	// list of sequencers in the notice queue
 	NoticeQueue []string `protobuf:"bytes,4,rep,name=noticeQueue,proto3" json:"noticeQueue,omitempty"`
+	// delegationPools of the sequencers open to delegations
+	DelegationPools []DelegationPool `protobuf:"bytes,6,rep,name=delegationPools,proto3" json:"delegationPools"`
+	// delegations which are bonded
+	Delegations []Delegation `protobuf:"bytes,7,rep,name=delegations,proto3" json:"delegations"`
+	// unbondingDelegations which serve the notice period
+	UnbondingDelegations []UnbondingDelegation `protobuf:"bytes,8,rep,name=unbondingDelegations,proto3" json:"unbondingDelegations"`
+	// reputations of the sequencers with a history
+	Reputations []SequencerReputation `protobuf:"bytes,9,rep,name=reputations,proto3" json:"reputations"`
+	// penaltyLedger of all the sequencers
+	PenaltyLedger []GenesisPenaltyRecord `protobuf:"bytes,10,rep,name=penaltyLedger,proto3" json:"penaltyLedger"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -104,6 +114,93 @@ func (m *GenesisState) GetNoticeQueue() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDelegationPools() []DelegationPool {
+	if m != nil {
+		return m.DelegationPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingDelegations() []UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReputations() []SequencerReputation {
+	if m != nil {
+		return m.Reputations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPenaltyLedger() []GenesisPenaltyRecord {
+	if m != nil {
+		return m.PenaltyLedger
+	}
+	return nil
+}
+
+type GenesisPenaltyRecord struct {
+	Sequencer string        `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Record    PenaltyRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+}
+
+func (m *GenesisPenaltyRecord) Reset()         { *m = GenesisPenaltyRecord{} }
+func (m *GenesisPenaltyRecord) String() string { return proto.CompactTextString(m) }
+func (*GenesisPenaltyRecord) ProtoMessage()    {}
+func (*GenesisPenaltyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3115db717b16c2af, []int{1}
+}
+func (m *GenesisPenaltyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPenaltyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPenaltyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPenaltyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPenaltyRecord.Merge(m, src)
+}
+func (m *GenesisPenaltyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPenaltyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPenaltyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPenaltyRecord proto.InternalMessageInfo
+
+func (m *GenesisPenaltyRecord) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *GenesisPenaltyRecord) GetRecord() PenaltyRecord {
+	if m != nil {
+		return m.Record
+	}
+	return PenaltyRecord{}
+}
+
 type GenesisProposer struct {
 	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
@@ -113,7 +210,7 @@ func (m *GenesisProposer) Reset()         { *m = GenesisProposer{} }
 func (m *GenesisProposer) String() string { return proto.CompactTextString(m) }
 func (*GenesisProposer) ProtoMessage()    {}
 func (*GenesisProposer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3115db717b16c2af, []int{1}
+	return fileDescriptor_3115db717b16c2af, []int{2}
 }
 func (m *GenesisProposer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,6 +255,7 @@ func (m *GenesisProposer) GetRollappId() string {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.sequencer.GenesisState")
+	proto.RegisterType((*GenesisPenaltyRecord)(nil), "dymensionxyz.dymension.sequencer.GenesisPenaltyRecord")
 	proto.RegisterType((*GenesisProposer)(nil), "dymensionxyz.dymension.sequencer.GenesisProposer")
 }
 
@@ -166,30 +264,40 @@ func init() {
 }
 
 var fileDescriptor_3115db717b16c2af = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x5b, 0x8a, 0x18, 0x16, 0x8d, 0xba, 0xf1, 0xb0, 0x21, 0xa6, 0x36, 0x9c, 0x9a, 0x18,
-	0x5b, 0x85, 0xc4, 0x07, 0xe0, 0x20, 0x21, 0xf1, 0x80, 0x70, 0x30, 0xf1, 0x56, 0xda, 0x49, 0x6d,
-	0x02, 0xdd, 0xba, 0xb3, 0x35, 0xe0, 0x53, 0xf8, 0x02, 0xbe, 0x0f, 0x47, 0x8e, 0x9e, 0x8c, 0x81,
-	0x17, 0x31, 0xb6, 0xa5, 0x80, 0xc4, 0xf4, 0xe0, 0x6d, 0x66, 0xfa, 0xff, 0xdf, 0x3f, 0xe9, 0x0e,
-	0xb1, 0xbc, 0xe9, 0x18, 0x42, 0x0c, 0x78, 0x38, 0x99, 0xbe, 0xda, 0x79, 0x63, 0x23, 0x3c, 0xc7,
-	0x10, 0xba, 0x20, 0x6c, 0x1f, 0x42, 0xc0, 0x00, 0xad, 0x48, 0x70, 0xc9, 0xa9, 0xb1, 0xa9, 0x5f,
-	0x9b, 0xad, 0x5c, 0x5f, 0x3f, 0xf5, 0xb9, 0xcf, 0x13, 0xb1, 0xfd, 0x53, 0xa5, 0xbe, 0xfa, 0x65,
-	0x61, 0x4e, 0xe4, 0x08, 0x67, 0x9c, 0xc5, 0xd4, 0xaf, 0x0a, 0xe5, 0x79, 0x95, 0x3a, 0x1a, 0xef,
-	0x1a, 0x39, 0xe8, 0xa4, 0xab, 0x0e, 0xa4, 0x23, 0x81, 0xde, 0x92, 0x4a, 0x8a, 0x64, 0xaa, 0xa1,
-	0x9a, 0xb5, 0xa6, 0x69, 0x15, 0xad, 0x6e, 0xf5, 0x12, 0x7d, 0xbb, 0x3c, 0xfb, 0x3c, 0x57, 0xfa,
-	0x99, 0x9b, 0x3e, 0x90, 0xc3, 0x5c, 0x71, 0x17, 0xa0, 0x64, 0x25, 0x43, 0x33, 0x6b, 0xcd, 0x8b,
-	0x62, 0xdc, 0x60, 0x55, 0x65, 0xc4, 0x6d, 0x0e, 0x75, 0xc9, 0x71, 0xf6, 0x6f, 0x7b, 0x82, 0x47,
-	0x1c, 0x41, 0x20, 0xd3, 0x12, 0xf6, 0x75, 0x31, 0xbb, 0xb3, 0xed, 0xcc, 0x12, 0x76, 0x80, 0x14,
-	0xc8, 0x49, 0x36, 0x1b, 0xc4, 0xae, 0x0b, 0x88, 0x5c, 0x20, 0xdb, 0xfb, 0x5f, 0xca, 0x2e, 0x91,
-	0x1a, 0xa4, 0x16, 0x72, 0x19, 0xb8, 0x70, 0x1f, 0x43, 0x0c, 0xac, 0x6c, 0x68, 0x66, 0xb5, 0xbf,
-	0x39, 0x6a, 0x74, 0xc9, 0xd1, 0x2f, 0x1a, 0x65, 0x64, 0xdf, 0xf1, 0x3c, 0x01, 0x98, 0x3e, 0x51,
-	0xb5, 0xbf, 0x6a, 0xe9, 0x19, 0xa9, 0x0a, 0x3e, 0x1a, 0x39, 0x51, 0xd4, 0xf5, 0x58, 0x29, 0xf9,
-	0xb6, 0x1e, 0xb4, 0x7b, 0xb3, 0x85, 0xae, 0xce, 0x17, 0xba, 0xfa, 0xb5, 0xd0, 0xd5, 0xb7, 0xa5,
-	0xae, 0xcc, 0x97, 0xba, 0xf2, 0xb1, 0xd4, 0x95, 0xc7, 0x1b, 0x3f, 0x90, 0x4f, 0xf1, 0xd0, 0x72,
-	0xf9, 0xd8, 0xfe, 0xe3, 0x82, 0x5e, 0x5a, 0xf6, 0x64, 0xe3, 0x8c, 0xe4, 0x34, 0x02, 0x1c, 0x56,
-	0x92, 0x1b, 0x6a, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x53, 0x26, 0xab, 0x0e, 0x03, 0x00,
-	0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x9b, 0xdd, 0xb5, 0x35, 0x13, 0x97, 0xd5, 0xa1, 0x87, 0xa1, 0x48, 0x0c, 0x3d, 0x15,
+	0xd4, 0x64, 0xff, 0xe0, 0x7e, 0x80, 0x65, 0x71, 0x59, 0x58, 0xa1, 0xa6, 0x8a, 0x20, 0x08, 0xa6,
+	0xc9, 0x4b, 0x0c, 0xa4, 0x33, 0x71, 0x66, 0x22, 0x5b, 0xaf, 0x9e, 0xbc, 0xf9, 0xb1, 0xf6, 0xb8,
+	0x47, 0x4f, 0x22, 0xed, 0x17, 0x91, 0x4e, 0xa6, 0x49, 0xba, 0xad, 0xa4, 0xe2, 0x6d, 0xf2, 0xf2,
+	0x3c, 0xbf, 0xe7, 0xcd, 0xcc, 0x3b, 0x83, 0xdc, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x46, 0xaf, 0xa7,
+	0x5f, 0xbd, 0xf2, 0xc3, 0x13, 0xf0, 0x39, 0x07, 0x1a, 0x02, 0xf7, 0x62, 0xa0, 0x20, 0x12, 0xe1,
+	0x66, 0x9c, 0x49, 0x86, 0x9d, 0xba, 0xbe, 0x32, 0xbb, 0xa5, 0xbe, 0xd7, 0x8d, 0x59, 0xcc, 0x94,
+	0xd8, 0x5b, 0xac, 0x0a, 0x5f, 0xef, 0x79, 0x63, 0x4e, 0x16, 0xf0, 0x60, 0xa2, 0x63, 0x7a, 0x87,
+	0x8d, 0xf2, 0x72, 0xa5, 0x1d, 0x47, 0x8d, 0x8e, 0x08, 0x52, 0x88, 0x03, 0xb9, 0xe8, 0x76, 0x5b,
+	0x0b, 0x87, 0x2c, 0x97, 0x35, 0x4b, 0xff, 0x7b, 0x07, 0x3d, 0xb8, 0x28, 0x36, 0x64, 0x24, 0x03,
+	0x09, 0xf8, 0x25, 0x6a, 0x17, 0x8d, 0x13, 0xc3, 0x31, 0x06, 0xd6, 0xf1, 0xc0, 0x6d, 0xda, 0x20,
+	0x77, 0xa8, 0xf4, 0x67, 0x7b, 0x37, 0xbf, 0x9e, 0xb4, 0x7c, 0xed, 0xc6, 0xef, 0xd0, 0x7e, 0xa9,
+	0xb8, 0x4a, 0x84, 0x24, 0x3b, 0xce, 0xee, 0xc0, 0x3a, 0x7e, 0xda, 0x8c, 0x1b, 0x2d, 0x57, 0x9a,
+	0xb8, 0xca, 0xc1, 0x21, 0x7a, 0xa8, 0x4f, 0x70, 0xc8, 0x59, 0xc6, 0x04, 0x70, 0x41, 0x76, 0x15,
+	0xfb, 0xa8, 0x99, 0x7d, 0xb1, 0xea, 0xd4, 0x09, 0x6b, 0x40, 0x0c, 0xe8, 0x91, 0xae, 0x8d, 0xf2,
+	0x30, 0x04, 0x21, 0x18, 0x17, 0xe4, 0xde, 0xff, 0xa5, 0xac, 0x13, 0xb1, 0x83, 0x2c, 0xca, 0x64,
+	0x12, 0xc2, 0xeb, 0x1c, 0x72, 0x20, 0x7b, 0xce, 0xee, 0xc0, 0xf4, 0xeb, 0x25, 0xfc, 0x11, 0x1d,
+	0x54, 0xc7, 0x3c, 0x64, 0x2c, 0x15, 0xa4, 0xad, 0xda, 0x38, 0x6c, 0x6e, 0xe3, 0x7c, 0xc5, 0xa8,
+	0xbb, 0xb8, 0x8b, 0xc3, 0x6f, 0x90, 0x55, 0x95, 0x04, 0xe9, 0x28, 0xfa, 0xb3, 0x7f, 0xa1, 0x6b,
+	0x72, 0x1d, 0x83, 0x19, 0xea, 0xe6, 0x74, 0xcc, 0x68, 0x94, 0xd0, 0xf8, 0xbc, 0x86, 0xbf, 0xaf,
+	0xf0, 0x2f, 0x9a, 0xf1, 0x6f, 0xd7, 0xdd, 0x3a, 0x67, 0x23, 0x18, 0x7f, 0x40, 0x56, 0x35, 0xdc,
+	0x82, 0x98, 0xdb, 0xe6, 0x94, 0xd3, 0xe6, 0x97, 0xee, 0xe5, 0xff, 0xd4, 0x78, 0x78, 0x8c, 0xf6,
+	0x33, 0xa0, 0x41, 0x2a, 0xa7, 0x57, 0x10, 0xc5, 0xc0, 0x09, 0x52, 0x01, 0xa7, 0xdb, 0x0f, 0x43,
+	0xe1, 0xf6, 0x21, 0x64, 0x3c, 0x5a, 0x4e, 0xf6, 0x0a, 0xb2, 0xff, 0xcd, 0x40, 0xdd, 0x4d, 0x6a,
+	0xfc, 0x18, 0x99, 0x25, 0x4f, 0x5d, 0x4b, 0xd3, 0xaf, 0x0a, 0xf8, 0x15, 0x6a, 0x73, 0xa5, 0x23,
+	0x3b, 0xea, 0xc6, 0x7a, 0x5b, 0xdc, 0xd8, 0x0d, 0xcd, 0x68, 0x48, 0xff, 0x12, 0x1d, 0xdc, 0x99,
+	0x5f, 0x4c, 0x50, 0x27, 0x88, 0x22, 0x0e, 0x42, 0xe8, 0xf4, 0xe5, 0xe7, 0xa2, 0x33, 0xce, 0xd2,
+	0x34, 0xc8, 0xb2, 0xcb, 0x22, 0xde, 0xf4, 0xab, 0xc2, 0xd9, 0xf0, 0x66, 0x66, 0x1b, 0xb7, 0x33,
+	0xdb, 0xf8, 0x3d, 0xb3, 0x8d, 0x1f, 0x73, 0xbb, 0x75, 0x3b, 0xb7, 0x5b, 0x3f, 0xe7, 0x76, 0xeb,
+	0xfd, 0x69, 0x9c, 0xc8, 0x4f, 0xf9, 0xd8, 0x0d, 0xd9, 0xc4, 0xfb, 0xcb, 0xa3, 0xf5, 0xe5, 0xc4,
+	0xbb, 0xae, 0xbd, 0x5c, 0x72, 0x9a, 0x81, 0x18, 0xb7, 0xd5, 0xab, 0x75, 0xf2, 0x67, 0x00, 0x47,
+	0x9b, 0x72, 0x96, 0xe6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -212,6 +320,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PenaltyLedger) > 0 {
+		for iNdEx := len(m.PenaltyLedger) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PenaltyLedger[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Reputations) > 0 {
+		for iNdEx := len(m.Reputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegationPools) > 0 {
+		for iNdEx := len(m.DelegationPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenesisSuccessors) > 0 {
 		for iNdEx := len(m.GenesisSuccessors) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -276,6 +454,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisPenaltyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPenaltyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPenaltyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisProposer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -356,6 +574,51 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegationPools) > 0 {
+		for _, e := range m.DelegationPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Reputations) > 0 {
+		for _, e := range m.Reputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PenaltyLedger) > 0 {
+		for _, e := range m.PenaltyLedger {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisPenaltyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -578,6 +841,291 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationPools = append(m.DelegationPools, DelegationPool{})
+			if err := m.DelegationPools[len(m.DelegationPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegations = append(m.UnbondingDelegations, UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reputations = append(m.Reputations, SequencerReputation{})
+			if err := m.Reputations[len(m.Reputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyLedger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyLedger = append(m.PenaltyLedger, GenesisPenaltyRecord{})
+			if err := m.PenaltyLedger[len(m.PenaltyLedger)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPenaltyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPenaltyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPenaltyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
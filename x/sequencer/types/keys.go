@@ -55,6 +55,16 @@ var (
 
 	DymintProposerAddrToAccAddrKeyPrefix = collections.NewPrefix([]byte{0x43})
 
+	// prefix for the timestamps in the queue of proposers whose notice period elapsed, awaiting their last block
+	AwaitingLastBlockQueueKey = []byte{0x44}
+
+	DelegationPoolKeyPrefix      = collections.NewPrefix([]byte{0x45}) // prefix/seqAddr
+	DelegationKeyPrefix          = collections.NewPrefix([]byte{0x46}) // prefix/seqAddr/delegator
+	UnbondingDelegationKeyPrefix = collections.NewPrefix([]byte{0x47}) // prefix/completionTime/seqAddr/delegator
+
+	ReputationKeyPrefix    = collections.NewPrefix([]byte{0x48}) // prefix/seqAddr
+	PenaltyLedgerKeyPrefix = collections.NewPrefix([]byte{0x49}) // prefix/seqAddr/index
+
 	// These keys were already used on mainnet. Don't reuse
 	_ = []byte{0xa3}
 	_ = []byte{0x41}
@@ -112,6 +122,17 @@ func NoticeQueueBySeqTimeKey(sequencerAddress string, endTime time.Time) []byte
 	return key
 }
 
+func AwaitingLastBlockQueueByTimeKey(noticeTime time.Time) []byte {
+	return utils.EncodeTimeToKey(AwaitingLastBlockQueueKey, noticeTime)
+}
+
+func AwaitingLastBlockQueueBySeqTimeKey(sequencerAddress string, noticeTime time.Time) []byte {
+	key := AwaitingLastBlockQueueByTimeKey(noticeTime)
+	key = append(key, KeySeparator...)
+	key = append(key, []byte(sequencerAddress)...)
+	return key
+}
+
 /* --------------------- proposer and successor keys --------------------- */
 
 func ProposerByRollappKey(rollappId string) []byte {
@@ -0,0 +1,87 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgSetDelegationCommission{}
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgWithdrawDelegationRewards{}
+)
+
+func NewMsgSetDelegationCommission(creator string, commission math.LegacyDec) *MsgSetDelegationCommission {
+	return &MsgSetDelegationCommission{
+		Creator:    creator,
+		Commission: commission,
+	}
+}
+
+func (msg *MsgSetDelegationCommission) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	return ValidateCommission(msg.Commission)
+}
+
+func NewMsgDelegate(delegator, sequencer string, amount sdk.Coin) *MsgDelegate {
+	return &MsgDelegate{
+		Delegator: delegator,
+		Sequencer: sequencer,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgDelegate) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Sequencer, msg.Amount)
+}
+
+func NewMsgUndelegate(delegator, sequencer string, amount sdk.Coin) *MsgUndelegate {
+	return &MsgUndelegate{
+		Delegator: delegator,
+		Sequencer: sequencer,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Sequencer, msg.Amount)
+}
+
+func NewMsgWithdrawDelegationRewards(delegator, sequencer string) *MsgWithdrawDelegationRewards {
+	return &MsgWithdrawDelegationRewards{
+		Delegator: delegator,
+		Sequencer: sequencer,
+	}
+}
+
+func (msg *MsgWithdrawDelegationRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Delegator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Sequencer)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	return nil
+}
+
+func validateDelegationMsg(delegator, sequencer string, amount sdk.Coin) error {
+	_, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(sequencer)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid amount: %s", amount.String())
+	}
+	return nil
+}
@@ -22,6 +22,17 @@ var (
 	DefaultDishonorStateUpdate   = uint64(1)
 	DefaultDishonorLiveness      = uint64(300)
 	DefaultDishonorKickThreshold = uint64(900)
+
+	// DefaultFinalStateUpdateTimeout is the time the proposer has to submit its last block after the notice period
+	DefaultFinalStateUpdateTimeout = time.Hour * 24 // 1 day
+
+	// DefaultDishonorHalfLife is the time for the dishonor to halve
+	DefaultDishonorHalfLife = time.Hour * 24 * 14 // 2 weeks
+
+	// DefaultCommissionMaxChangeRate is the maximum change of a delegation commission in one update
+	DefaultCommissionMaxChangeRate = math.LegacyMustNewDecFromStr("0.01")
+	// DefaultCommissionChangePeriod is the minimum time between two updates of a delegation commission
+	DefaultCommissionChangePeriod = time.Hour * 24 // 1 day
 )
 
 // NewParams creates a new Params instance
@@ -29,6 +40,10 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 	dishonorStateUpdate uint64,
 	dishonorLiveness uint64,
 	dishonorKickThreshold uint64,
+	finalStateUpdateTimeout time.Duration,
+	dishonorHalfLife time.Duration,
+	commissionMaxChangeRate math.LegacyDec,
+	commissionChangePeriod time.Duration,
 ) Params {
 	return Params{
 		NoticePeriod:               noticePeriod,
@@ -37,12 +52,16 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 		DishonorStateUpdate:        dishonorStateUpdate,
 		DishonorLiveness:           dishonorLiveness,
 		DishonorKickThreshold:      dishonorKickThreshold,
+		FinalStateUpdateTimeout:    finalStateUpdateTimeout,
+		DishonorHalfLife:           dishonorHalfLife,
+		CommissionMaxChangeRate:    commissionMaxChangeRate,
+		CommissionChangePeriod:     commissionChangePeriod,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold)
+	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold, DefaultFinalStateUpdateTimeout, DefaultDishonorHalfLife, DefaultCommissionMaxChangeRate, DefaultCommissionChangePeriod)
 }
 
 func validateTime(v time.Duration) error {
@@ -81,6 +100,22 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateTime(p.FinalStateUpdateTimeout); err != nil {
+		return err
+	}
+
+	if p.DishonorHalfLife < 0 {
+		return fmt.Errorf("dishonor half life must not be negative: %d", p.DishonorHalfLife)
+	}
+
+	if err := uparam.ValidateZeroToOneDec(p.CommissionMaxChangeRate); err != nil {
+		return err
+	}
+
+	if p.CommissionChangePeriod < 0 {
+		return fmt.Errorf("commission change period must not be negative: %d", p.CommissionChangePeriod)
+	}
+
 	return nil
 }
 
@@ -47,6 +47,19 @@ type Params struct {
 	DishonorStateUpdate uint64 `protobuf:"varint,8,opt,name=dishonor_state_update,json=dishonorStateUpdate,proto3" json:"dishonor_state_update,omitempty"`
 	// the minimum dishonor at which a sequencer can be kicked (<=)
 	DishonorKickThreshold uint64 `protobuf:"varint,9,opt,name=dishonor_kick_threshold,json=dishonorKickThreshold,proto3" json:"dishonor_kick_threshold,omitempty"`
+	// final_state_update_timeout is how long the proposer has to submit its
+	// last state update after its notice period elapsed. After that, the
+	// successor is promoted without it and the proposer is penalized.
+	FinalStateUpdateTimeout time.Duration `protobuf:"bytes,10,opt,name=final_state_update_timeout,json=finalStateUpdateTimeout,proto3,stdduration" json:"final_state_update_timeout"`
+	// dishonor_half_life is the time it takes for the dishonor of a sequencer
+	// to halve. Zero means no decay.
+	DishonorHalfLife time.Duration `protobuf:"bytes,11,opt,name=dishonor_half_life,json=dishonorHalfLife,proto3,stdduration" json:"dishonor_half_life"`
+	// commission_max_change_rate is the maximum change of a delegation pool
+	// commission in one update
+	CommissionMaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=commission_max_change_rate,json=commissionMaxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_max_change_rate"`
+	// commission_change_period is the minimum time between two updates of a
+	// delegation pool commission
+	CommissionChangePeriod time.Duration `protobuf:"bytes,13,opt,name=commission_change_period,json=commissionChangePeriod,proto3,stdduration" json:"commission_change_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -116,6 +129,27 @@ func (m *Params) GetDishonorKickThreshold() uint64 {
 	return 0
 }
 
+func (m *Params) GetFinalStateUpdateTimeout() time.Duration {
+	if m != nil {
+		return m.FinalStateUpdateTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetDishonorHalfLife() time.Duration {
+	if m != nil {
+		return m.DishonorHalfLife
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangePeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.sequencer.Params")
 }
@@ -125,41 +159,48 @@ func init() {
 }
 
 var fileDescriptor_599b0eefba99ee26 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xad, 0x09, 0xae, 0x01, 0x29, 0x18, 0x10, 0x6e, 0x2a, 0x6c, 0x2b, 0x12, 0x52,
-	0x24, 0xc8, 0x9d, 0xda, 0x4a, 0x1d, 0xba, 0x11, 0x32, 0xa0, 0xd0, 0x4a, 0x51, 0x4a, 0x17, 0x16,
-	0xeb, 0x6c, 0x1f, 0xf6, 0x29, 0xb6, 0xcf, 0xf8, 0xce, 0x51, 0xcd, 0x17, 0x60, 0x65, 0xcc, 0xd8,
-	0x0f, 0xc0, 0xc8, 0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x80, 0x92, 0x05, 0x31, 0xf2, 0x09, 0x90,
-	0xff, 0xaa, 0xaa, 0xa0, 0x5b, 0x9e, 0x7b, 0x7e, 0xcf, 0x93, 0xf7, 0x5e, 0xf9, 0x94, 0x81, 0x9b,
-	0x85, 0x24, 0xe2, 0x94, 0x45, 0x67, 0xd9, 0x07, 0xd4, 0x08, 0xc4, 0xc9, 0xfb, 0x94, 0x44, 0x0e,
-	0x49, 0x50, 0x8c, 0x13, 0x1c, 0x72, 0x18, 0x27, 0x4c, 0x30, 0xd5, 0xbc, 0x8a, 0xc3, 0x46, 0xc0,
-	0x06, 0xef, 0x3e, 0xf4, 0x98, 0xc7, 0x0a, 0x18, 0xe5, 0xbf, 0xca, 0x5c, 0x77, 0xdb, 0x61, 0x3c,
-	0x64, 0xdc, 0x2a, 0x8d, 0x52, 0x54, 0x96, 0x5e, 0x2a, 0x64, 0x63, 0x4e, 0xd0, 0x7c, 0xd7, 0x26,
-	0x02, 0xef, 0x22, 0x87, 0xd1, 0xa8, 0xf6, 0x3d, 0xc6, 0xbc, 0x80, 0xa0, 0x42, 0xd9, 0xe9, 0x3b,
-	0xe4, 0xa6, 0x09, 0x16, 0xf9, 0x9f, 0x16, 0x27, 0xbd, 0xcf, 0x92, 0xd2, 0x9e, 0x14, 0x33, 0xaa,
-	0xaf, 0x94, 0x7b, 0x11, 0x13, 0xd4, 0x21, 0x56, 0x4c, 0x12, 0xca, 0x5c, 0x6d, 0xd3, 0x04, 0xfd,
-	0x3b, 0x7b, 0xdb, 0xb0, 0xac, 0x80, 0x75, 0x05, 0x1c, 0x55, 0x15, 0x43, 0xf9, 0x62, 0x69, 0xb4,
-	0x16, 0x3f, 0x0c, 0x30, 0xbd, 0x5b, 0x26, 0x27, 0x45, 0x50, 0x5d, 0x00, 0xe5, 0x49, 0x40, 0xe7,
-	0x24, 0x22, 0x9c, 0x5b, 0x3c, 0xc0, 0xdc, 0xb7, 0x42, 0x1a, 0x59, 0x61, 0x1a, 0x08, 0x1a, 0x07,
-	0x94, 0x24, 0x9a, 0x64, 0x82, 0xfe, 0xd6, 0xf0, 0x34, 0xcf, 0x7f, 0x5f, 0x1a, 0x3b, 0xe5, 0x25,
-	0xb8, 0x3b, 0x83, 0x94, 0xa1, 0x10, 0x0b, 0x1f, 0x1e, 0x11, 0x0f, 0x3b, 0xd9, 0x88, 0x38, 0x7f,
-	0x96, 0x86, 0x99, 0xe1, 0x30, 0x38, 0xec, 0x5d, 0x6f, 0x6c, 0xda, 0x7a, 0x5f, 0xbf, 0x0c, 0x94,
-	0x6a, 0x2b, 0x23, 0xe2, 0x4c, 0xbb, 0x35, 0x79, 0x92, 0x83, 0xc7, 0x34, 0x3a, 0x6e, 0x50, 0xf5,
-	0x23, 0x50, 0x76, 0xfe, 0x31, 0x1a, 0xb6, 0x39, 0x0b, 0x52, 0x41, 0xb4, 0x76, 0x75, 0xe7, 0xaa,
-	0x2e, 0x5f, 0x2b, 0xac, 0xd6, 0x0a, 0x5f, 0x32, 0x1a, 0x0d, 0x07, 0xf9, 0xcc, 0xbf, 0x97, 0xc6,
-	0xd3, 0x1b, 0x5a, 0x9e, 0xb3, 0x90, 0x0a, 0x12, 0xc6, 0x22, 0x9b, 0x6a, 0xd7, 0x67, 0x79, 0x51,
-	0x31, 0xea, 0x33, 0xe5, 0xbe, 0x4b, 0xb9, 0xcf, 0x22, 0x96, 0x58, 0x35, 0xa4, 0xdd, 0x36, 0x41,
-	0x5f, 0x9a, 0x76, 0x6a, 0xe3, 0xa8, 0x3a, 0x57, 0xf7, 0x94, 0x47, 0x0d, 0xcc, 0x05, 0x16, 0xc4,
-	0x4a, 0x63, 0x17, 0x0b, 0xa2, 0xc9, 0x45, 0xe0, 0x41, 0x6d, 0x9e, 0xe4, 0xde, 0x69, 0x61, 0xa9,
-	0x07, 0xca, 0xe3, 0x26, 0x33, 0xa3, 0xce, 0xcc, 0x12, 0x7e, 0x42, 0xb8, 0xcf, 0x02, 0x57, 0xdb,
-	0x2a, 0x52, 0x4d, 0xe5, 0x6b, 0xea, 0xcc, 0xde, 0xd4, 0xe6, 0xa1, 0xbc, 0x38, 0x37, 0x5a, 0xbf,
-	0xce, 0x0d, 0x30, 0x96, 0x64, 0xd0, 0xd9, 0x18, 0x4b, 0xf2, 0xad, 0x4e, 0x7b, 0x2c, 0xc9, 0x1b,
-	0x9d, 0xcd, 0xe1, 0xe4, 0x62, 0xa5, 0x83, 0xcb, 0x95, 0x0e, 0x7e, 0xae, 0x74, 0xf0, 0x69, 0xad,
-	0xb7, 0x2e, 0xd7, 0x7a, 0xeb, 0xdb, 0x5a, 0x6f, 0xbd, 0x3d, 0xf0, 0xa8, 0xf0, 0x53, 0x1b, 0x3a,
-	0x2c, 0x44, 0xff, 0x79, 0x15, 0xf3, 0x7d, 0x74, 0x76, 0xe5, 0x69, 0x88, 0x2c, 0x26, 0xdc, 0x6e,
-	0x17, 0x9f, 0xd5, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xe4, 0xe4, 0x9d, 0x4b, 0x03,
-	0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4e, 0xdb, 0x4e,
+	0x10, 0xc7, 0x63, 0xc8, 0x2f, 0x84, 0x05, 0xa4, 0xfc, 0xdc, 0x3f, 0x98, 0xa0, 0xda, 0x11, 0x52,
+	0x25, 0xa4, 0x16, 0x5b, 0x80, 0xc4, 0x81, 0x5b, 0x03, 0x07, 0x44, 0x41, 0xa2, 0x01, 0x2e, 0x95,
+	0x2a, 0x77, 0x63, 0x4f, 0xec, 0x55, 0xbc, 0xbb, 0xae, 0x77, 0x8d, 0x92, 0xbe, 0x40, 0xaf, 0x3d,
+	0x72, 0xe4, 0x11, 0x7a, 0xe8, 0x43, 0x70, 0x44, 0x3d, 0x55, 0x3d, 0xa4, 0x15, 0x5c, 0xaa, 0x1e,
+	0xfb, 0x04, 0x95, 0xff, 0x92, 0xa2, 0xb6, 0xca, 0xcd, 0xe3, 0xef, 0x77, 0x3e, 0x33, 0x3b, 0x9a,
+	0x5d, 0xb4, 0xe6, 0x0e, 0x29, 0x30, 0x41, 0x38, 0x1b, 0x0c, 0xdf, 0x5a, 0x65, 0x60, 0x09, 0x78,
+	0x13, 0x03, 0x73, 0x20, 0xb2, 0x42, 0x1c, 0x61, 0x2a, 0xcc, 0x30, 0xe2, 0x92, 0xab, 0xad, 0x71,
+	0xbb, 0x59, 0x06, 0x66, 0x69, 0x6f, 0xde, 0xf7, 0xb8, 0xc7, 0x53, 0xb3, 0x95, 0x7c, 0x65, 0x79,
+	0xcd, 0x25, 0x87, 0x0b, 0xca, 0x85, 0x9d, 0x09, 0x59, 0x90, 0x4b, 0x7a, 0x16, 0x59, 0x5d, 0x2c,
+	0xc0, 0x3a, 0x5b, 0xef, 0x82, 0xc4, 0xeb, 0x96, 0xc3, 0x09, 0x2b, 0x74, 0x8f, 0x73, 0x2f, 0x00,
+	0x2b, 0x8d, 0xba, 0x71, 0xcf, 0x72, 0xe3, 0x08, 0xcb, 0xa4, 0x68, 0xfa, 0x67, 0xe5, 0xc3, 0x0c,
+	0xaa, 0x1d, 0xa5, 0x3d, 0xaa, 0x7b, 0x68, 0x81, 0x71, 0x49, 0x1c, 0xb0, 0x43, 0x88, 0x08, 0x77,
+	0xb5, 0xe9, 0x96, 0xb2, 0x3a, 0xb7, 0xb1, 0x64, 0x66, 0x08, 0xb3, 0x40, 0x98, 0xbb, 0x39, 0xa2,
+	0x5d, 0xbf, 0x1c, 0x19, 0x95, 0xf3, 0xaf, 0x86, 0xd2, 0x99, 0xcf, 0x32, 0x8f, 0xd2, 0x44, 0xf5,
+	0x5c, 0x41, 0x8f, 0x02, 0x72, 0x06, 0x0c, 0x84, 0xb0, 0x45, 0x80, 0x85, 0x6f, 0x53, 0xc2, 0x6c,
+	0x1a, 0x07, 0x92, 0x84, 0x01, 0x81, 0x48, 0xab, 0xb6, 0x94, 0xd5, 0xd9, 0xf6, 0x69, 0x92, 0xff,
+	0x65, 0x64, 0x2c, 0x67, 0x87, 0x10, 0x6e, 0xdf, 0x24, 0xdc, 0xa2, 0x58, 0xfa, 0xe6, 0x01, 0x78,
+	0xd8, 0x19, 0xee, 0x82, 0xf3, 0x73, 0x64, 0xb4, 0x86, 0x98, 0x06, 0xdb, 0x2b, 0x77, 0x89, 0x25,
+	0x6d, 0xe5, 0xd3, 0xc7, 0x35, 0x94, 0x4f, 0x65, 0x17, 0x9c, 0x4e, 0xb3, 0x70, 0x1e, 0x27, 0xc6,
+	0x43, 0xc2, 0x0e, 0x4b, 0xab, 0xfa, 0x4e, 0x41, 0xcb, 0x7f, 0x68, 0x0d, 0x77, 0x05, 0x0f, 0x62,
+	0x09, 0x5a, 0x2d, 0x3f, 0x73, 0x8e, 0x4b, 0xc6, 0x6a, 0xe6, 0x63, 0x35, 0x77, 0x38, 0x61, 0xed,
+	0xb5, 0xa4, 0xe7, 0x1f, 0x23, 0xe3, 0xf1, 0x3f, 0x28, 0x4f, 0x39, 0x25, 0x12, 0x68, 0x28, 0x87,
+	0x1d, 0xed, 0x6e, 0x2f, 0xcf, 0x72, 0x8f, 0xfa, 0x04, 0xfd, 0xef, 0x12, 0xe1, 0x73, 0xc6, 0x23,
+	0xbb, 0x30, 0x69, 0x33, 0x2d, 0x65, 0xb5, 0xda, 0x69, 0x14, 0xc2, 0x41, 0xfe, 0x5f, 0xdd, 0x40,
+	0x0f, 0x4a, 0xb3, 0x90, 0x58, 0x82, 0x1d, 0x87, 0x2e, 0x96, 0xa0, 0xd5, 0xd3, 0x84, 0x7b, 0x85,
+	0x78, 0x9c, 0x68, 0xa7, 0xa9, 0xa4, 0x6e, 0xa1, 0xc5, 0x32, 0xa7, 0x4f, 0x9c, 0xbe, 0x2d, 0xfd,
+	0x08, 0x84, 0xcf, 0x03, 0x57, 0x9b, 0x4d, 0xb3, 0x4a, 0xe4, 0x73, 0xe2, 0xf4, 0x4f, 0x0a, 0x51,
+	0x7d, 0x8d, 0x9a, 0x3d, 0xc2, 0x70, 0xf0, 0x5b, 0x21, 0x5b, 0x12, 0x0a, 0x3c, 0x96, 0x1a, 0x9a,
+	0x7c, 0x29, 0x16, 0x53, 0xcc, 0x58, 0x4b, 0x27, 0x19, 0x43, 0x7d, 0x81, 0xd4, 0xb2, 0x33, 0x1f,
+	0x07, 0x3d, 0x3b, 0x20, 0x3d, 0xd0, 0xe6, 0x26, 0x27, 0x97, 0x03, 0xda, 0xc3, 0x41, 0xef, 0x80,
+	0xf4, 0x40, 0x65, 0xa8, 0xe9, 0x70, 0x4a, 0x89, 0x48, 0x2e, 0x94, 0x4d, 0xf1, 0xc0, 0x76, 0x7c,
+	0xcc, 0x3c, 0xb0, 0xa3, 0x64, 0x4a, 0xf3, 0xe9, 0xba, 0xad, 0x4f, 0xb0, 0x6e, 0x77, 0x56, 0x69,
+	0xf1, 0x16, 0x7a, 0x88, 0x07, 0x3b, 0x29, 0xb2, 0x93, 0x0c, 0xf7, 0x15, 0xd2, 0xc6, 0xea, 0xe5,
+	0xb5, 0xf2, 0x7b, 0xb3, 0x30, 0xf9, 0x41, 0x1e, 0xde, 0x42, 0x32, 0x78, 0x76, 0x83, 0xb6, 0xeb,
+	0xe7, 0x17, 0x46, 0xe5, 0xfb, 0x85, 0xa1, 0xec, 0x57, 0xeb, 0x4a, 0x63, 0x6a, 0xbf, 0x5a, 0xff,
+	0xaf, 0x51, 0xdb, 0xaf, 0xd6, 0xa7, 0x1a, 0xd3, 0xed, 0xa3, 0xcb, 0x6b, 0x5d, 0xb9, 0xba, 0xd6,
+	0x95, 0x6f, 0xd7, 0xba, 0xf2, 0xfe, 0x46, 0xaf, 0x5c, 0xdd, 0xe8, 0x95, 0xcf, 0x37, 0x7a, 0xe5,
+	0xe5, 0x96, 0x47, 0xa4, 0x1f, 0x77, 0x4d, 0x87, 0x53, 0xeb, 0x2f, 0x2f, 0xd3, 0xd9, 0xa6, 0x35,
+	0x18, 0x7b, 0x9e, 0xe4, 0x30, 0x04, 0xd1, 0xad, 0xa5, 0x2d, 0x6e, 0xfe, 0x1a, 0x00, 0xd8, 0xde,
+	0x59, 0x3b, 0xcf, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -199,6 +240,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DishonorKickThreshold != that1.DishonorKickThreshold {
 		return false
 	}
+	if this.FinalStateUpdateTimeout != that1.FinalStateUpdateTimeout {
+		return false
+	}
+	if this.DishonorHalfLife != that1.DishonorHalfLife {
+		return false
+	}
+	if !this.CommissionMaxChangeRate.Equal(that1.CommissionMaxChangeRate) {
+		return false
+	}
+	if this.CommissionChangePeriod != that1.CommissionChangePeriod {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -221,6 +274,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.CommissionMaxChangeRate.Size()
+		i -= size
+		if _, err := m.CommissionMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DishonorHalfLife, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DishonorHalfLife):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FinalStateUpdateTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FinalStateUpdateTimeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
 	if m.DishonorKickThreshold != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DishonorKickThreshold))
 		i--
@@ -256,12 +343,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
@@ -299,6 +386,14 @@ func (m *Params) Size() (n int) {
 	if m.DishonorKickThreshold != 0 {
 		n += 1 + sovParams(uint64(m.DishonorKickThreshold))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FinalStateUpdateTimeout)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DishonorHalfLife)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommissionMaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangePeriod)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -494,6 +589,139 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalStateUpdateTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FinalStateUpdateTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorHalfLife", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DishonorHalfLife, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
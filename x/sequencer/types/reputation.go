@@ -0,0 +1,96 @@
+package types
+
+import (
+	"math"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// DecayedDishonor returns the dishonor d after elapsed, halving every half life: d * 2^(-elapsed/halfLife).
+// It rounds down.
+func DecayedDishonor(d uint64, elapsed, halfLife time.Duration) uint64 {
+	if halfLife <= 0 || elapsed <= 0 || d == 0 {
+		return d
+	}
+	if 64 <= elapsed/halfLife {
+		return 0
+	}
+	return osmomath.NewDecFromInt(osmomath.NewIntFromUint64(d)).Quo(dishonorDecay(elapsed, halfLife)).TruncateInt().Uint64()
+}
+
+// UndecayedDishonor is the inverse of DecayedDishonor, it returns the dishonor which decays to d after elapsed:
+// d * 2^(elapsed/halfLife). It rounds up, and saturates at the max uint64.
+func UndecayedDishonor(d uint64, elapsed, halfLife time.Duration) uint64 {
+	if halfLife <= 0 || elapsed <= 0 || d == 0 {
+		return d
+	}
+	if 64 <= elapsed/halfLife {
+		return math.MaxUint64
+	}
+	u := osmomath.NewDecFromInt(osmomath.NewIntFromUint64(d)).Mul(dishonorDecay(elapsed, halfLife)).Ceil().TruncateInt()
+	if !u.IsUint64() {
+		return math.MaxUint64
+	}
+	return u.Uint64()
+}
+
+// dishonorDecay returns 2^(elapsed/halfLife), elapsed must be less than 64 half lives
+func dishonorDecay(elapsed, halfLife time.Duration) osmomath.BigDec {
+	return osmomath.Exp2(osmomath.NewBigDec(int64(elapsed)).QuoInt64(int64(halfLife)))
+}
+
+func NewSequencerReputation(seqAddr string) SequencerReputation {
+	return SequencerReputation{
+		Sequencer:    seqAddr,
+		TotalSlashed: sdk.NewCoins(),
+	}
+}
+
+// Penalties is the number of records in the penalty ledger
+func (r SequencerReputation) Penalties() uint64 {
+	return r.LivenessPenalties + r.Kicks + r.Punishments
+}
+
+// AddPenalty counts the penalty, and returns it as the next ledger record
+func (r *SequencerReputation) AddPenalty(rec PenaltyRecord) PenaltyRecord {
+	rec.Index = r.Penalties()
+	switch rec.Kind {
+	case PenaltyKindLiveness:
+		r.LivenessPenalties++
+	case PenaltyKindKicked:
+		r.Kicks++
+	case PenaltyKindPunished:
+		r.Punishments++
+	}
+	r.DishonorPenalized += rec.DishonorAdded
+	if !rec.Slashed.IsNil() && rec.Slashed.IsPositive() {
+		r.TotalSlashed = r.TotalSlashed.Add(rec.Slashed)
+	}
+	return rec
+}
+
+func (r SequencerReputation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "sequencer: %s", err)
+	}
+	if err := r.TotalSlashed.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "total slashed: %s", err)
+	}
+	return nil
+}
+
+func (r PenaltyRecord) ValidateBasic() error {
+	switch r.Kind {
+	case PenaltyKindLiveness, PenaltyKindKicked, PenaltyKindPunished:
+	default:
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "penalty kind: %s", r.Kind)
+	}
+	if err := r.Slashed.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "slashed: %s", err)
+	}
+	return nil
+}
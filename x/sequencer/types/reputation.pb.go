@@ -0,0 +1,1132 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/reputation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PenaltyKind is the reason of a sequencer penalty
+type PenaltyKind int32
+
+const (
+	PenaltyKindUnspecified PenaltyKind = 0
+	// the proposer missed a liveness deadline, or did not submit its last state
+	// update in time
+	PenaltyKindLiveness PenaltyKind = 1
+	// the proposer was kicked for its dishonor
+	PenaltyKindKicked PenaltyKind = 2
+	// the sequencer was punished for fraud
+	PenaltyKindPunished PenaltyKind = 3
+)
+
+var PenaltyKind_name = map[int32]string{
+	0: "PENALTY_KIND_UNSPECIFIED",
+	1: "PENALTY_KIND_LIVENESS",
+	2: "PENALTY_KIND_KICKED",
+	3: "PENALTY_KIND_PUNISHED",
+}
+
+var PenaltyKind_value = map[string]int32{
+	"PENALTY_KIND_UNSPECIFIED": 0,
+	"PENALTY_KIND_LIVENESS":    1,
+	"PENALTY_KIND_KICKED":      2,
+	"PENALTY_KIND_PUNISHED":    3,
+}
+
+func (x PenaltyKind) String() string {
+	return proto.EnumName(PenaltyKind_name, int32(x))
+}
+
+func (PenaltyKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d88cf06f233cab7a, []int{0}
+}
+
+// PenaltyRecord is an entry of the penalty ledger of a sequencer
+type PenaltyRecord struct {
+	// Index is the position in the ledger of the sequencer, starting at 0
+	Index  uint64      `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Kind   PenaltyKind `protobuf:"varint,2,opt,name=kind,proto3,enum=dymensionxyz.dymension.sequencer.PenaltyKind" json:"kind,omitempty"`
+	Height int64       `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time   `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// Slashed is the amount slashed from the bond, if any
+	Slashed types.Coin `protobuf:"bytes,5,opt,name=slashed,proto3" json:"slashed"`
+	// DishonorAdded is the dishonor gained
+	DishonorAdded uint64 `protobuf:"varint,6,opt,name=dishonor_added,json=dishonorAdded,proto3" json:"dishonor_added,omitempty"`
+	// DishonorAfter is the dishonor of the sequencer after the penalty
+	DishonorAfter uint64 `protobuf:"varint,7,opt,name=dishonor_after,json=dishonorAfter,proto3" json:"dishonor_after,omitempty"`
+}
+
+func (m *PenaltyRecord) Reset()         { *m = PenaltyRecord{} }
+func (m *PenaltyRecord) String() string { return proto.CompactTextString(m) }
+func (*PenaltyRecord) ProtoMessage()    {}
+func (*PenaltyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d88cf06f233cab7a, []int{0}
+}
+func (m *PenaltyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PenaltyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PenaltyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PenaltyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PenaltyRecord.Merge(m, src)
+}
+func (m *PenaltyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PenaltyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PenaltyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PenaltyRecord proto.InternalMessageInfo
+
+func (m *PenaltyRecord) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PenaltyRecord) GetKind() PenaltyKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PenaltyKindUnspecified
+}
+
+func (m *PenaltyRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PenaltyRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *PenaltyRecord) GetSlashed() types.Coin {
+	if m != nil {
+		return m.Slashed
+	}
+	return types.Coin{}
+}
+
+func (m *PenaltyRecord) GetDishonorAdded() uint64 {
+	if m != nil {
+		return m.DishonorAdded
+	}
+	return 0
+}
+
+func (m *PenaltyRecord) GetDishonorAfter() uint64 {
+	if m != nil {
+		return m.DishonorAfter
+	}
+	return 0
+}
+
+// SequencerReputation aggregates the history of a sequencer. The penalties are
+// also recorded one by one in its ledger, the state updates are only counted.
+type SequencerReputation struct {
+	// Sequencer is the bech32-encoded address of the sequencer
+	Sequencer         string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	LivenessPenalties uint64 `protobuf:"varint,2,opt,name=liveness_penalties,json=livenessPenalties,proto3" json:"liveness_penalties,omitempty"`
+	Kicks             uint64 `protobuf:"varint,3,opt,name=kicks,proto3" json:"kicks,omitempty"`
+	Punishments       uint64 `protobuf:"varint,4,opt,name=punishments,proto3" json:"punishments,omitempty"`
+	// StateUpdates is the number of state updates the sequencer was credited for
+	StateUpdates uint64 `protobuf:"varint,5,opt,name=state_updates,json=stateUpdates,proto3" json:"state_updates,omitempty"`
+	// DishonorCredited is the total dishonor removed by state updates
+	DishonorCredited uint64 `protobuf:"varint,6,opt,name=dishonor_credited,json=dishonorCredited,proto3" json:"dishonor_credited,omitempty"`
+	// DishonorPenalized is the total dishonor added by penalties
+	DishonorPenalized uint64 `protobuf:"varint,7,opt,name=dishonor_penalized,json=dishonorPenalized,proto3" json:"dishonor_penalized,omitempty"`
+	// TotalSlashed is the total amount slashed by penalties
+	TotalSlashed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=total_slashed,json=totalSlashed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_slashed"`
+	// LastStateUpdate is the time of the last credited state update
+	LastStateUpdate time.Time `protobuf:"bytes,9,opt,name=last_state_update,json=lastStateUpdate,proto3,stdtime" json:"last_state_update"`
+}
+
+func (m *SequencerReputation) Reset()         { *m = SequencerReputation{} }
+func (m *SequencerReputation) String() string { return proto.CompactTextString(m) }
+func (*SequencerReputation) ProtoMessage()    {}
+func (*SequencerReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d88cf06f233cab7a, []int{1}
+}
+func (m *SequencerReputation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequencerReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequencerReputation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequencerReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequencerReputation.Merge(m, src)
+}
+func (m *SequencerReputation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequencerReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequencerReputation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequencerReputation proto.InternalMessageInfo
+
+func (m *SequencerReputation) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *SequencerReputation) GetLivenessPenalties() uint64 {
+	if m != nil {
+		return m.LivenessPenalties
+	}
+	return 0
+}
+
+func (m *SequencerReputation) GetKicks() uint64 {
+	if m != nil {
+		return m.Kicks
+	}
+	return 0
+}
+
+func (m *SequencerReputation) GetPunishments() uint64 {
+	if m != nil {
+		return m.Punishments
+	}
+	return 0
+}
+
+func (m *SequencerReputation) GetStateUpdates() uint64 {
+	if m != nil {
+		return m.StateUpdates
+	}
+	return 0
+}
+
+func (m *SequencerReputation) GetDishonorCredited() uint64 {
+	if m != nil {
+		return m.DishonorCredited
+	}
+	return 0
+}
+
+func (m *SequencerReputation) GetDishonorPenalized() uint64 {
+	if m != nil {
+		return m.DishonorPenalized
+	}
+	return 0
+}
+
+func (m *SequencerReputation) GetTotalSlashed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSlashed
+	}
+	return nil
+}
+
+func (m *SequencerReputation) GetLastStateUpdate() time.Time {
+	if m != nil {
+		return m.LastStateUpdate
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.PenaltyKind", PenaltyKind_name, PenaltyKind_value)
+	proto.RegisterType((*PenaltyRecord)(nil), "dymensionxyz.dymension.sequencer.PenaltyRecord")
+	proto.RegisterType((*SequencerReputation)(nil), "dymensionxyz.dymension.sequencer.SequencerReputation")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/reputation.proto", fileDescriptor_d88cf06f233cab7a)
+}
+
+var fileDescriptor_d88cf06f233cab7a = []byte{
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xeb, 0x46,
+	0x18, 0x8d, 0x89, 0x2f, 0xf7, 0x32, 0xdc, 0xdc, 0x26, 0x03, 0x97, 0x1a, 0x2f, 0x1c, 0x8b, 0xaa,
+	0x52, 0xd4, 0x2a, 0x76, 0x01, 0x09, 0xd1, 0x65, 0xfe, 0xaa, 0x46, 0x41, 0x91, 0x65, 0x93, 0x4a,
+	0xed, 0xc6, 0x72, 0x3c, 0x43, 0x32, 0x4a, 0xe2, 0x71, 0x3d, 0x13, 0x44, 0x78, 0x82, 0x8a, 0x15,
+	0x2f, 0xc0, 0xaa, 0xbb, 0xae, 0xfb, 0x06, 0xdd, 0xb0, 0x44, 0x5d, 0x75, 0x55, 0x2a, 0x50, 0x1f,
+	0xa2, 0xbb, 0xca, 0xe3, 0x1f, 0x0c, 0xfd, 0x53, 0x57, 0xc9, 0xf7, 0x7d, 0xe7, 0xcc, 0xcc, 0x39,
+	0xe7, 0x93, 0xc1, 0x3e, 0x5a, 0x2d, 0x70, 0xc0, 0x08, 0x0d, 0x2e, 0x56, 0x97, 0x66, 0x5e, 0x98,
+	0x0c, 0x7f, 0xbb, 0xc4, 0x81, 0x8f, 0x23, 0x33, 0xc2, 0xe1, 0x92, 0x7b, 0x9c, 0xd0, 0xc0, 0x08,
+	0x23, 0xca, 0x29, 0xd4, 0x8b, 0x14, 0x23, 0x2f, 0x8c, 0x9c, 0xa2, 0x6e, 0x4f, 0xe8, 0x84, 0x0a,
+	0xb0, 0x19, 0xff, 0x4b, 0x78, 0x6a, 0x7d, 0x42, 0xe9, 0x64, 0x8e, 0x4d, 0x51, 0x8d, 0x97, 0x67,
+	0x26, 0x27, 0x0b, 0xcc, 0xb8, 0xb7, 0x08, 0x53, 0x80, 0xe6, 0x53, 0xb6, 0xa0, 0xcc, 0x1c, 0x7b,
+	0x0c, 0x9b, 0xe7, 0xfb, 0x63, 0xcc, 0xbd, 0x7d, 0xd3, 0xa7, 0x24, 0xbd, 0x58, 0xdd, 0x4d, 0xe6,
+	0x6e, 0x72, 0x72, 0x52, 0x24, 0xa3, 0xbd, 0x9f, 0xd6, 0x40, 0xc5, 0xc2, 0x81, 0x37, 0xe7, 0x2b,
+	0x1b, 0xfb, 0x34, 0x42, 0x70, 0x1b, 0xbc, 0x22, 0x01, 0xc2, 0x17, 0x8a, 0xa4, 0x4b, 0x0d, 0xd9,
+	0x4e, 0x0a, 0xd8, 0x02, 0xf2, 0x8c, 0x04, 0x48, 0x59, 0xd3, 0xa5, 0xc6, 0xbb, 0x83, 0xa6, 0xf1,
+	0x5f, 0x52, 0x8c, 0xf4, 0xd0, 0x01, 0x09, 0x90, 0x2d, 0xa8, 0x70, 0x07, 0xac, 0x4f, 0x31, 0x99,
+	0x4c, 0xb9, 0x52, 0xd6, 0xa5, 0x46, 0xd9, 0x4e, 0x2b, 0x78, 0x0c, 0xe4, 0x58, 0x90, 0x22, 0xeb,
+	0x52, 0x63, 0xf3, 0x40, 0x35, 0x12, 0xb5, 0x46, 0xa6, 0xd6, 0x38, 0xcd, 0xd4, 0xb6, 0xdf, 0xdc,
+	0xfe, 0x5a, 0x2f, 0x5d, 0xdf, 0xd7, 0x25, 0x5b, 0x30, 0xe0, 0xe7, 0xe0, 0x35, 0x9b, 0x7b, 0x6c,
+	0x8a, 0x91, 0xf2, 0x4a, 0x90, 0x77, 0x8d, 0x54, 0x5c, 0xec, 0x84, 0x91, 0x3a, 0x61, 0x74, 0x28,
+	0x09, 0xda, 0x72, 0xcc, 0xb5, 0x33, 0x3c, 0xfc, 0x18, 0xbc, 0x43, 0x84, 0x4d, 0x69, 0x40, 0x23,
+	0xd7, 0x43, 0x08, 0x23, 0x65, 0x5d, 0xc8, 0xad, 0x64, 0xdd, 0x16, 0x42, 0x2f, 0x61, 0x67, 0x1c,
+	0x47, 0xca, 0xeb, 0x17, 0xb0, 0xb8, 0xb9, 0xf7, 0x47, 0x19, 0x6c, 0x39, 0x99, 0x74, 0x3b, 0xcf,
+	0x1d, 0x1e, 0x81, 0x8d, 0xdc, 0x11, 0xe1, 0xe7, 0x46, 0x5b, 0xf9, 0xf9, 0xc7, 0xe6, 0x76, 0xfa,
+	0xca, 0x16, 0x42, 0x11, 0x66, 0xcc, 0xe1, 0x11, 0x09, 0x26, 0xf6, 0x13, 0x14, 0x36, 0x01, 0x9c,
+	0x93, 0x73, 0x1c, 0x60, 0xc6, 0xdc, 0x50, 0x18, 0x49, 0x30, 0x13, 0xde, 0xcb, 0x76, 0x2d, 0x9b,
+	0x58, 0xd9, 0x20, 0x8e, 0x6c, 0x46, 0xfc, 0x19, 0x13, 0xc6, 0xca, 0x76, 0x52, 0x40, 0x1d, 0x6c,
+	0x86, 0xcb, 0x80, 0xb0, 0xe9, 0x02, 0x07, 0x9c, 0x09, 0x7b, 0x65, 0xbb, 0xd8, 0x82, 0x1f, 0x81,
+	0x0a, 0xe3, 0x1e, 0xc7, 0xee, 0x32, 0x44, 0x1e, 0xc7, 0x4c, 0xb8, 0x28, 0xdb, 0x6f, 0x45, 0x73,
+	0x94, 0xf4, 0xe0, 0xa7, 0xa0, 0x96, 0x5b, 0xe0, 0x47, 0x18, 0x11, 0x9e, 0x9b, 0x55, 0xcd, 0x06,
+	0x9d, 0xb4, 0x1f, 0x3f, 0x3c, 0x07, 0x8b, 0x87, 0x93, 0x4b, 0x8c, 0x52, 0xcf, 0xf2, 0x63, 0xac,
+	0x6c, 0x00, 0x43, 0x50, 0xe1, 0x94, 0x7b, 0x73, 0x37, 0x8b, 0xf1, 0x8d, 0x5e, 0xfe, 0xf7, 0x18,
+	0x3f, 0x8b, 0x63, 0xfc, 0xe1, 0xbe, 0xde, 0x98, 0x10, 0x3e, 0x5d, 0x8e, 0x0d, 0x9f, 0x2e, 0xd2,
+	0x85, 0x4e, 0x7f, 0x9a, 0x0c, 0xcd, 0x4c, 0xbe, 0x0a, 0x31, 0x13, 0x04, 0x66, 0xbf, 0x15, 0x37,
+	0x38, 0x69, 0xee, 0x16, 0xa8, 0xcd, 0x3d, 0xc6, 0xdd, 0xa2, 0x6e, 0x65, 0xe3, 0x7f, 0x6c, 0xde,
+	0x07, 0x31, 0xdd, 0x79, 0x32, 0xe8, 0x93, 0xdf, 0x25, 0xb0, 0x59, 0x58, 0x76, 0x78, 0x0c, 0x14,
+	0xab, 0x37, 0x6c, 0x9d, 0x9c, 0x7e, 0xed, 0x0e, 0xfa, 0xc3, 0xae, 0x3b, 0x1a, 0x3a, 0x56, 0xaf,
+	0xd3, 0xff, 0xa2, 0xdf, 0xeb, 0x56, 0x4b, 0xaa, 0x7a, 0x75, 0xa3, 0xef, 0x14, 0xe0, 0xa3, 0x80,
+	0x85, 0xd8, 0x27, 0x67, 0x04, 0x23, 0x78, 0x00, 0xde, 0x3f, 0x63, 0x9e, 0xf4, 0xbf, 0xea, 0x0d,
+	0x7b, 0x8e, 0x53, 0x95, 0xd4, 0x0f, 0xaf, 0x6e, 0xf4, 0xad, 0x02, 0xed, 0x24, 0xdd, 0x01, 0x68,
+	0x80, 0xad, 0x67, 0x9c, 0x41, 0xbf, 0x33, 0xe8, 0x75, 0xab, 0x6b, 0xea, 0xfb, 0xab, 0x1b, 0xbd,
+	0x56, 0x60, 0x0c, 0x88, 0x3f, 0xfb, 0x9b, 0x3b, 0xac, 0xd1, 0xb0, 0xef, 0x7c, 0xd9, 0xeb, 0x56,
+	0xcb, 0x7f, 0xb9, 0xc3, 0x12, 0x9b, 0x82, 0x91, 0x2a, 0x7f, 0xf7, 0xbd, 0x56, 0x6a, 0x5b, 0xb7,
+	0x0f, 0x9a, 0x74, 0xf7, 0xa0, 0x49, 0xbf, 0x3d, 0x68, 0xd2, 0xf5, 0xa3, 0x56, 0xba, 0x7b, 0xd4,
+	0x4a, 0xbf, 0x3c, 0x6a, 0xa5, 0x6f, 0x8e, 0x0a, 0x59, 0xfc, 0xc3, 0x57, 0xf1, 0xfc, 0xd0, 0xbc,
+	0x28, 0x7c, 0x1a, 0x45, 0x3e, 0xe3, 0x75, 0x61, 0xf4, 0xe1, 0x9f, 0x03, 0x00, 0x05, 0x4d, 0xb2,
+	0x94, 0x4b, 0x05, 0x00, 0x00,
+}
+
+func (m *PenaltyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PenaltyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PenaltyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DishonorAfter != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.DishonorAfter))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DishonorAdded != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.DishonorAdded))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReputation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintReputation(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Kind != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SequencerReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequencerReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequencerReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastStateUpdate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastStateUpdate):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintReputation(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.TotalSlashed) > 0 {
+		for iNdEx := len(m.TotalSlashed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSlashed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReputation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DishonorPenalized != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.DishonorPenalized))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DishonorCredited != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.DishonorCredited))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StateUpdates != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.StateUpdates))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Punishments != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Punishments))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Kicks != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.Kicks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LivenessPenalties != 0 {
+		i = encodeVarintReputation(dAtA, i, uint64(m.LivenessPenalties))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintReputation(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReputation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReputation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PenaltyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovReputation(uint64(m.Index))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovReputation(uint64(m.Kind))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReputation(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovReputation(uint64(l))
+	l = m.Slashed.Size()
+	n += 1 + l + sovReputation(uint64(l))
+	if m.DishonorAdded != 0 {
+		n += 1 + sovReputation(uint64(m.DishonorAdded))
+	}
+	if m.DishonorAfter != 0 {
+		n += 1 + sovReputation(uint64(m.DishonorAfter))
+	}
+	return n
+}
+
+func (m *SequencerReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovReputation(uint64(l))
+	}
+	if m.LivenessPenalties != 0 {
+		n += 1 + sovReputation(uint64(m.LivenessPenalties))
+	}
+	if m.Kicks != 0 {
+		n += 1 + sovReputation(uint64(m.Kicks))
+	}
+	if m.Punishments != 0 {
+		n += 1 + sovReputation(uint64(m.Punishments))
+	}
+	if m.StateUpdates != 0 {
+		n += 1 + sovReputation(uint64(m.StateUpdates))
+	}
+	if m.DishonorCredited != 0 {
+		n += 1 + sovReputation(uint64(m.DishonorCredited))
+	}
+	if m.DishonorPenalized != 0 {
+		n += 1 + sovReputation(uint64(m.DishonorPenalized))
+	}
+	if len(m.TotalSlashed) > 0 {
+		for _, e := range m.TotalSlashed {
+			l = e.Size()
+			n += 1 + l + sovReputation(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastStateUpdate)
+	n += 1 + l + sovReputation(uint64(l))
+	return n
+}
+
+func sovReputation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReputation(x uint64) (n int) {
+	return sovReputation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PenaltyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PenaltyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PenaltyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PenaltyKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorAdded", wireType)
+			}
+			m.DishonorAdded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DishonorAdded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorAfter", wireType)
+			}
+			m.DishonorAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DishonorAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SequencerReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequencerReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequencerReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessPenalties", wireType)
+			}
+			m.LivenessPenalties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessPenalties |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kicks", wireType)
+			}
+			m.Kicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kicks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Punishments", wireType)
+			}
+			m.Punishments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Punishments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateUpdates", wireType)
+			}
+			m.StateUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorCredited", wireType)
+			}
+			m.DishonorCredited = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DishonorCredited |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorPenalized", wireType)
+			}
+			m.DishonorPenalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DishonorPenalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSlashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSlashed = append(m.TotalSlashed, types.Coin{})
+			if err := m.TotalSlashed[len(m.TotalSlashed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStateUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReputation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastStateUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReputation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReputation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReputation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReputation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReputation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReputation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReputation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReputation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReputation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecayedDishonor(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		name     string
+		d        uint64
+		elapsed  time.Duration
+		halfLife time.Duration
+		exp      uint64
+	}{
+		{"no time", 1000, 0, day, 1000},
+		{"no decay", 1000, day, 0, 1000},
+		{"half life", 1000, day, day, 500},
+		{"two half lives", 1000, 2 * day, day, 250},
+		{"half a half life", 1000, day / 2, day, 707},
+		{"one and a half half lives", 1000, 3 * day / 2, day, 353},
+		{"rounds down", 3, day / 2, day, 2},
+		{"long after", 1000, 100 * day, day, 0},
+		{"max", ^uint64(0), day, day, ^uint64(0) >> 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, DecayedDishonor(tc.d, tc.elapsed, tc.halfLife))
+		})
+	}
+}
+
+func TestUndecayedDishonor(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		name     string
+		d        uint64
+		elapsed  time.Duration
+		halfLife time.Duration
+		exp      uint64
+	}{
+		{"no time", 1000, 0, day, 1000},
+		{"no decay", 1000, day, 0, 1000},
+		{"half life", 500, day, day, 1000},
+		{"half a half life", 707, day / 2, day, 1000},
+		{"rounds up", 2, day / 2, day, 3},
+		{"saturates", ^uint64(0), day, day, ^uint64(0)},
+		{"long after", 1, 100 * day, day, ^uint64(0)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, UndecayedDishonor(tc.d, tc.elapsed, tc.halfLife))
+		})
+	}
+}
@@ -62,7 +62,12 @@ type Sequencer struct {
 	WhitelistedRelayers []string `protobuf:"bytes,13,rep,name=whitelisted_relayers,json=whitelistedRelayers,proto3" json:"whitelisted_relayers,omitempty"`
 	// how badly behaved sequencer is, can incur penalties (kicking) when high
 	// 0 is good/default, more is worse
+	// It is the base of the decay, as of dishonor_updated_at, the current
+	// dishonor halves every dishonor half life from there.
 	Dishonor uint64 `protobuf:"varint,15,opt,name=dishonor,proto3" json:"dishonor,omitempty"`
+	// DishonorUpdatedAt is when dishonor was last added, and the decay re-based.
+	// Zero means never.
+	DishonorUpdatedAt time.Time `protobuf:"bytes,16,opt,name=dishonor_updated_at,json=dishonorUpdatedAt,proto3,stdtime" json:"dishonor_updated_at"`
 }
 
 func (m *Sequencer) Reset()         { *m = Sequencer{} }
@@ -175,6 +180,13 @@ func (m *Sequencer) GetDishonor() uint64 {
 	return 0
 }
 
+func (m *Sequencer) GetDishonorUpdatedAt() time.Time {
+	if m != nil {
+		return m.DishonorUpdatedAt
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Sequencer)(nil), "dymensionxyz.dymension.sequencer.Sequencer")
 }
@@ -184,44 +196,46 @@ func init() {
 }
 
 var fileDescriptor_997b8663a5fc0f58 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0x58, 0xd6, 0xa6, 0xee, 0x18, 0x95, 0xe9, 0xc1, 0xad, 0x50, 0x1a, 0x71, 0xca, 0x65,
-	0xce, 0xba, 0x49, 0x70, 0x5e, 0x39, 0xad, 0x08, 0x31, 0x65, 0x70, 0xe1, 0x52, 0xb9, 0xb1, 0xe9,
-	0xac, 0x35, 0x76, 0xb0, 0xdd, 0x6d, 0xe1, 0x57, 0xec, 0xc2, 0x9f, 0xe0, 0xcc, 0x8f, 0x98, 0x38,
-	0xed, 0xc8, 0x89, 0xa1, 0xf5, 0x8f, 0xa0, 0x24, 0x6e, 0x57, 0x40, 0xa8, 0xa7, 0xf8, 0xf9, 0xfb,
-	0xde, 0xe7, 0xbc, 0xf7, 0x3e, 0xb0, 0x4f, 0xf3, 0x94, 0x09, 0xcd, 0xa5, 0xb8, 0xca, 0x3f, 0x47,
-	0x2b, 0x10, 0x69, 0xf6, 0x69, 0xce, 0x44, 0xc2, 0xd4, 0xc3, 0x09, 0x67, 0x4a, 0x1a, 0x09, 0x83,
-	0x75, 0x06, 0x5e, 0x01, 0xbc, 0xea, 0xeb, 0x75, 0x13, 0xa9, 0x53, 0xa9, 0xc7, 0x65, 0x7f, 0x54,
-	0x81, 0x8a, 0xdc, 0xeb, 0x4e, 0xa5, 0x9c, 0xce, 0x58, 0x54, 0xa2, 0xc9, 0xfc, 0x63, 0x44, 0x44,
-	0x6e, 0x4b, 0x9d, 0xa9, 0x9c, 0xca, 0x8a, 0x52, 0x9c, 0xec, 0x6d, 0xff, 0x6f, 0x82, 0xe1, 0x29,
-	0xd3, 0x86, 0xa4, 0x99, 0x6d, 0xf0, 0xab, 0xf9, 0xd1, 0x84, 0x68, 0x16, 0x5d, 0x0c, 0x26, 0xcc,
-	0x90, 0x41, 0x94, 0x48, 0x2e, 0x6c, 0x3d, 0xda, 0x28, 0x30, 0x65, 0x86, 0x50, 0x62, 0x88, 0x25,
-	0xbc, 0xdc, 0x48, 0x90, 0x19, 0x53, 0xc4, 0x70, 0x31, 0x1d, 0x6b, 0x43, 0xcc, 0xdc, 0x6a, 0x7b,
-	0xfe, 0x65, 0x1b, 0x34, 0x4f, 0x97, 0x4d, 0x10, 0x81, 0x06, 0xa1, 0x54, 0x31, 0xad, 0x91, 0x13,
-	0x38, 0x61, 0x33, 0x5e, 0x42, 0x18, 0x83, 0x1d, 0x9a, 0xa7, 0x5c, 0x98, 0x93, 0xf9, 0xe4, 0x35,
-	0xcb, 0xd1, 0xa3, 0xc0, 0x09, 0x5b, 0x07, 0x1d, 0x5c, 0x29, 0xc5, 0x4b, 0xa5, 0xf8, 0x48, 0xe4,
-	0x43, 0xf4, 0xfd, 0xdb, 0x5e, 0xc7, 0x3a, 0x98, 0xa8, 0x3c, 0x33, 0x12, 0x57, 0xac, 0xf8, 0x8f,
-	0x19, 0xf0, 0x19, 0x68, 0x2a, 0x39, 0x9b, 0x91, 0x2c, 0x3b, 0xa6, 0x68, 0xab, 0x7c, 0xef, 0xe1,
-	0x02, 0xbe, 0x07, 0xde, 0x52, 0x24, 0x72, 0xcb, 0xd7, 0x0e, 0xf1, 0xa6, 0x14, 0xf1, 0x4a, 0xca,
-	0x1b, 0x4b, 0x1d, 0xba, 0x37, 0x3f, 0xfb, 0xb5, 0x78, 0x35, 0x0a, 0x1e, 0x83, 0x7a, 0x65, 0x00,
-	0x6a, 0x04, 0x4e, 0xb8, 0x7b, 0x30, 0xd8, 0x3c, 0xf4, 0xed, 0xd2, 0xba, 0xd3, 0x92, 0x18, 0xdb,
-	0x01, 0xb0, 0x0b, 0x3c, 0x99, 0x19, 0x46, 0xc7, 0x5c, 0xa0, 0xdd, 0xc0, 0x09, 0xbd, 0xb8, 0x51,
-	0xe2, 0x63, 0x01, 0x13, 0x50, 0x37, 0xf2, 0x9c, 0x09, 0x8d, 0xbc, 0x60, 0x2b, 0x6c, 0x1d, 0x74,
-	0xb1, 0xf5, 0xa3, 0x48, 0x1c, 0xdb, 0xc4, 0xf1, 0x2b, 0xc9, 0xc5, 0x70, 0xbf, 0xf8, 0xc1, 0xaf,
-	0x77, 0xfd, 0x70, 0xca, 0xcd, 0xd9, 0x7c, 0x82, 0x13, 0x99, 0xda, 0xf5, 0xb3, 0x9f, 0x3d, 0x4d,
-	0xcf, 0x23, 0x93, 0x67, 0x4c, 0x97, 0x04, 0x1d, 0xdb, 0xd1, 0x30, 0x06, 0x50, 0x48, 0xc3, 0x13,
-	0x36, 0xce, 0x98, 0xe2, 0x92, 0x8e, 0x8b, 0x35, 0x43, 0xad, 0xd2, 0xab, 0xde, 0x3f, 0xc9, 0xbc,
-	0x5b, 0xee, 0xe0, 0xd0, 0x2b, 0x5e, 0xbc, 0xbe, 0xeb, 0x3b, 0x71, 0xbb, 0xe2, 0x9f, 0x94, 0xf4,
-	0xa2, 0x01, 0xf6, 0x41, 0x4b, 0xb1, 0x4b, 0xa2, 0xe8, 0xb8, 0x48, 0x1e, 0xed, 0x94, 0xa9, 0x80,
-	0xea, 0xea, 0x88, 0x52, 0x05, 0x07, 0xa0, 0x73, 0x79, 0xc6, 0x0d, 0x9b, 0x71, 0x5d, 0x48, 0x57,
-	0x6c, 0x46, 0x72, 0xa6, 0x34, 0x7a, 0x1c, 0x6c, 0x85, 0xcd, 0xf8, 0xe9, 0x5a, 0x2d, 0xb6, 0x25,
-	0xd8, 0x03, 0x1e, 0xe5, 0xfa, 0x4c, 0x0a, 0xa9, 0xd0, 0x93, 0xc0, 0x09, 0xdd, 0x78, 0x85, 0x47,
-	0xae, 0xb7, 0xdd, 0xae, 0x8f, 0x5c, 0xaf, 0xde, 0x6e, 0x8c, 0x5c, 0xaf, 0xd9, 0x06, 0x23, 0xd7,
-	0x03, 0xed, 0xd6, 0xf0, 0xe4, 0xe6, 0xde, 0x77, 0x6e, 0xef, 0x7d, 0xe7, 0xd7, 0xbd, 0xef, 0x5c,
-	0x2f, 0xfc, 0xda, 0xed, 0xc2, 0xaf, 0xfd, 0x58, 0xf8, 0xb5, 0x0f, 0x2f, 0xd6, 0x7c, 0xfa, 0xcf,
-	0xd6, 0x5f, 0x1c, 0x46, 0x57, 0x6b, 0xab, 0x5f, 0x7a, 0x37, 0xa9, 0x97, 0x4e, 0x1c, 0xfe, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0xaa, 0xee, 0xfa, 0x07, 0x3d, 0x04, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xb1, 0x6e, 0xdb, 0x3a,
+	0x14, 0xb5, 0x5e, 0x14, 0x5b, 0xa6, 0xf3, 0xf2, 0xfc, 0x18, 0x0f, 0xb4, 0xf1, 0x20, 0x0b, 0x6f,
+	0xd2, 0x12, 0x29, 0x4e, 0x80, 0x76, 0x8e, 0x3b, 0xc5, 0x45, 0xd1, 0x40, 0x49, 0x96, 0x2e, 0x02,
+	0x2d, 0xb2, 0x0e, 0x11, 0x8b, 0x54, 0x49, 0x3a, 0x89, 0xfa, 0x15, 0xf9, 0x8e, 0xce, 0xfd, 0x88,
+	0xa0, 0x53, 0xc6, 0x4e, 0x4d, 0x91, 0x8c, 0xfd, 0x89, 0x42, 0x12, 0xe5, 0xb8, 0x2d, 0x0a, 0xa3,
+	0x93, 0x78, 0x78, 0xef, 0x39, 0xd4, 0xb9, 0x87, 0x04, 0x7b, 0x24, 0x4f, 0x29, 0x57, 0x4c, 0xf0,
+	0xeb, 0xfc, 0x7d, 0xb8, 0x04, 0xa1, 0xa2, 0xef, 0x16, 0x94, 0x27, 0x54, 0x3e, 0xad, 0x82, 0x4c,
+	0x0a, 0x2d, 0xa0, 0xb7, 0xca, 0x08, 0x96, 0x20, 0x58, 0xf6, 0x0d, 0xfa, 0x89, 0x50, 0xa9, 0x50,
+	0x71, 0xd9, 0x1f, 0x56, 0xa0, 0x22, 0x0f, 0xfa, 0x33, 0x21, 0x66, 0x73, 0x1a, 0x96, 0x68, 0xba,
+	0x78, 0x1b, 0x62, 0x9e, 0x9b, 0x52, 0x6f, 0x26, 0x66, 0xa2, 0xa2, 0x14, 0x2b, 0xb3, 0x3b, 0xfc,
+	0x99, 0xa0, 0x59, 0x4a, 0x95, 0xc6, 0x69, 0x66, 0x1a, 0xdc, 0x4a, 0x3f, 0x9c, 0x62, 0x45, 0xc3,
+	0xcb, 0xd1, 0x94, 0x6a, 0x3c, 0x0a, 0x13, 0xc1, 0xb8, 0xa9, 0x87, 0x6b, 0x0d, 0xa6, 0x54, 0x63,
+	0x82, 0x35, 0x36, 0x84, 0xe7, 0x6b, 0x09, 0x22, 0xa3, 0x12, 0x6b, 0xc6, 0x67, 0xb1, 0xd2, 0x58,
+	0x2f, 0x8c, 0xb7, 0xff, 0xbf, 0x6d, 0x82, 0xf6, 0x49, 0xdd, 0x04, 0x11, 0x68, 0x61, 0x42, 0x24,
+	0x55, 0x0a, 0x59, 0x9e, 0xe5, 0xb7, 0xa3, 0x1a, 0xc2, 0x08, 0x6c, 0x91, 0x3c, 0x65, 0x5c, 0x1f,
+	0x2f, 0xa6, 0x2f, 0x69, 0x8e, 0xfe, 0xf2, 0x2c, 0xbf, 0xb3, 0xdf, 0x0b, 0x2a, 0xa7, 0x41, 0xed,
+	0x34, 0x38, 0xe4, 0xf9, 0x18, 0x7d, 0xfa, 0xb8, 0xdb, 0x33, 0x13, 0x4c, 0x64, 0x9e, 0x69, 0x11,
+	0x54, 0xac, 0xe8, 0x07, 0x0d, 0xf8, 0x1f, 0x68, 0x4b, 0x31, 0x9f, 0xe3, 0x2c, 0x3b, 0x22, 0x68,
+	0xa3, 0x3c, 0xef, 0x69, 0x03, 0x9e, 0x01, 0xa7, 0x36, 0x89, 0xec, 0xf2, 0xb4, 0x83, 0x60, 0x5d,
+	0x8a, 0xc1, 0xd2, 0xca, 0x2b, 0x43, 0x1d, 0xdb, 0xb7, 0x5f, 0x86, 0x8d, 0x68, 0x29, 0x05, 0x8f,
+	0x40, 0xb3, 0x1a, 0x00, 0x6a, 0x79, 0x96, 0xbf, 0xbd, 0x3f, 0x5a, 0x2f, 0xfa, 0xba, 0x1e, 0xdd,
+	0x49, 0x49, 0x8c, 0x8c, 0x00, 0xec, 0x03, 0x47, 0x64, 0x9a, 0x92, 0x98, 0x71, 0xb4, 0xed, 0x59,
+	0xbe, 0x13, 0xb5, 0x4a, 0x7c, 0xc4, 0x61, 0x02, 0x9a, 0x5a, 0x5c, 0x50, 0xae, 0x90, 0xe3, 0x6d,
+	0xf8, 0x9d, 0xfd, 0x7e, 0x60, 0xe6, 0x51, 0x24, 0x1e, 0x98, 0xc4, 0x83, 0x17, 0x82, 0xf1, 0xf1,
+	0x5e, 0xf1, 0x83, 0x1f, 0xee, 0x87, 0xfe, 0x8c, 0xe9, 0xf3, 0xc5, 0x34, 0x48, 0x44, 0x6a, 0xae,
+	0x9f, 0xf9, 0xec, 0x2a, 0x72, 0x11, 0xea, 0x3c, 0xa3, 0xaa, 0x24, 0xa8, 0xc8, 0x48, 0xc3, 0x08,
+	0x40, 0x2e, 0x34, 0x4b, 0x68, 0x9c, 0x51, 0xc9, 0x04, 0x89, 0x8b, 0x6b, 0x86, 0x3a, 0xe5, 0xac,
+	0x06, 0xbf, 0x24, 0x73, 0x5a, 0xdf, 0xc1, 0xb1, 0x53, 0x9c, 0x78, 0x73, 0x3f, 0xb4, 0xa2, 0x6e,
+	0xc5, 0x3f, 0x2e, 0xe9, 0x45, 0x03, 0x1c, 0x82, 0x8e, 0xa4, 0x57, 0x58, 0x92, 0xb8, 0x48, 0x1e,
+	0x6d, 0x95, 0xa9, 0x80, 0x6a, 0xeb, 0x90, 0x10, 0x09, 0x47, 0xa0, 0x77, 0x75, 0xce, 0x34, 0x9d,
+	0x33, 0x55, 0x58, 0x97, 0x74, 0x8e, 0x73, 0x2a, 0x15, 0xfa, 0xdb, 0xdb, 0xf0, 0xdb, 0xd1, 0xce,
+	0x4a, 0x2d, 0x32, 0x25, 0x38, 0x00, 0x0e, 0x61, 0xea, 0x5c, 0x70, 0x21, 0xd1, 0x3f, 0x9e, 0xe5,
+	0xdb, 0xd1, 0x12, 0xc3, 0x53, 0xb0, 0x53, 0xaf, 0xe3, 0x45, 0x46, 0x70, 0xa1, 0x89, 0x35, 0xea,
+	0xfe, 0x81, 0x89, 0x7f, 0x6b, 0x81, 0xb3, 0x8a, 0x7f, 0xa8, 0x27, 0xb6, 0xb3, 0xd9, 0x6d, 0x4e,
+	0x6c, 0xa7, 0xd9, 0x6d, 0x4d, 0x6c, 0xa7, 0xdd, 0x05, 0x13, 0xdb, 0x01, 0xdd, 0xce, 0xf8, 0xf8,
+	0xf6, 0xc1, 0xb5, 0xee, 0x1e, 0x5c, 0xeb, 0xeb, 0x83, 0x6b, 0xdd, 0x3c, 0xba, 0x8d, 0xbb, 0x47,
+	0xb7, 0xf1, 0xf9, 0xd1, 0x6d, 0xbc, 0x79, 0xb6, 0x32, 0xfd, 0xdf, 0xbc, 0xa5, 0xcb, 0x83, 0xf0,
+	0x7a, 0xe5, 0x41, 0x95, 0x89, 0x4c, 0x9b, 0xe5, 0xaf, 0x1d, 0x7c, 0x1f, 0x00, 0x1f, 0xac, 0x54,
+	0x4f, 0x93, 0x04, 0x00, 0x00,
 }
 
 func (m *Sequencer) Marshal() (dAtA []byte, err error) {
@@ -244,6 +258,16 @@ func (m *Sequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DishonorUpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DishonorUpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSequencer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	if m.Dishonor != 0 {
 		i = encodeVarintSequencer(dAtA, i, uint64(m.Dishonor))
 		i--
@@ -275,12 +299,12 @@ func (m *Sequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x62
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NoticePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NoticePeriodTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NoticePeriodTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NoticePeriodTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSequencer(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSequencer(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x5a
 	if len(m.Tokens) > 0 {
@@ -399,6 +423,8 @@ func (m *Sequencer) Size() (n int) {
 	if m.Dishonor != 0 {
 		n += 1 + sovSequencer(uint64(m.Dishonor))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DishonorUpdatedAt)
+	n += 2 + l + sovSequencer(uint64(l))
 	return n
 }
 
@@ -759,6 +785,39 @@ func (m *Sequencer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DishonorUpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DishonorUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSequencer(dAtA[iNdEx:])